@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db/pg"
+	"github.com/Prrromanssss/platform_common/pkg/db/transaction"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	auditArchiveRepository "github.com/Prrromanssss/auth/internal/repository/audit_archive"
+	logRepository "github.com/Prrromanssss/auth/internal/repository/log"
+	"github.com/Prrromanssss/auth/internal/service"
+	auditChain "github.com/Prrromanssss/auth/internal/service/audit_chain"
+)
+
+const usage = `usage: audit_chain <command> [flags]
+
+commands:
+  verify               walk the audit chain and report the first broken record
+  export-checkpoints   print the signed checkpoints as JSON lines
+
+The signature of a checkpoint is the Ed25519 signature, by public_key, of its statement:
+  auth-audit-checkpoint:v1:<record_id>:<hash>:<created_at>
+`
+
+// exportedCheckpoint is a checkpoint as handed to the parties that verify the audit log.
+type exportedCheckpoint struct {
+	CheckpointID int64     `json:"checkpoint_id"`
+	RecordID     int64     `json:"record_id"`
+	Hash         string    `json:"hash"`
+	CreatedAt    time.Time `json:"created_at"`
+	Statement    string    `json:"statement"`
+	Signature    string    `json:"signature"`
+	PublicKey    string    `json:"public_key"`
+}
+
+// audit_chain verifies the hash chain of the audit log and exports its signed checkpoints.
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Panicf("Cannot load config: %v", err)
+	}
+
+	signingKey, err := cfg.AuditChain.SigningKey()
+	if err != nil {
+		log.Panicf("Cannot get checkpoint signing key: %v", err)
+	}
+
+	dbClient, err := pg.New(ctx, cfg.Postgres.DSN())
+	if err != nil {
+		log.Panicf("Cannot create db client: %v", err)
+	}
+	defer func() {
+		if closeErr := dbClient.Close(); closeErr != nil {
+			log.Printf("Cannot close db client: %v", closeErr)
+		}
+	}()
+
+	txManager := transaction.NewTransactionManager(dbClient.DB())
+	chainService := auditChain.NewService(
+		cfg,
+		logRepository.NewRepository(dbClient, txManager),
+		auditArchiveRepository.NewRepository(dbClient),
+		signingKey,
+	)
+
+	switch command := flag.Arg(0); command {
+	case "verify":
+		verify(ctx, chainService)
+	case "export-checkpoints":
+		exportCheckpoints(ctx, chainService, signingKey, flag.Args()[1:])
+	default:
+		log.Printf("Unknown command: %s", command)
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func verify(ctx context.Context, chainService service.AuditChainService) {
+	resp, err := chainService.VerifyChain(ctx)
+	if err != nil {
+		log.Panicf("Cannot verify audit chain: %v", err)
+	}
+
+	if resp.Break != nil {
+		log.Printf(
+			"Audit chain is broken at record %d: %s (%d records verified before it)",
+			resp.Break.EventID,
+			resp.Break.Reason,
+			resp.Records,
+		)
+		os.Exit(1)
+	}
+
+	log.Printf(
+		"Audit chain verified: %d records, %d checkpoints, %d records written before the chain",
+		resp.Records,
+		resp.Checkpoints,
+		resp.Unchained,
+	)
+}
+
+func exportCheckpoints(
+	ctx context.Context,
+	chainService service.AuditChainService,
+	signingKey ed25519.PrivateKey,
+	args []string,
+) {
+	flags := flag.NewFlagSet("export-checkpoints", flag.ExitOnError)
+	after := flags.Int64("after", 0, "export the checkpoints after this checkpoint id")
+	_ = flags.Parse(args)
+
+	publicKey, _ := signingKey.Public().(ed25519.PublicKey)
+	encoder := json.NewEncoder(os.Stdout)
+	afterCheckpointID := *after
+
+	for {
+		checkpoints, err := chainService.ListCheckpoints(ctx, model.ListAuditCheckpointsParams{
+			AfterCheckpointID: afterCheckpointID,
+		})
+		if err != nil {
+			log.Panicf("Cannot list audit checkpoints: %v", err)
+		}
+
+		if len(checkpoints) == 0 {
+			return
+		}
+
+		for _, checkpoint := range checkpoints {
+			err = encoder.Encode(exportedCheckpoint{
+				CheckpointID: checkpoint.CheckpointID,
+				RecordID:     checkpoint.EventID,
+				Hash:         hex.EncodeToString(checkpoint.Hash),
+				CreatedAt:    checkpoint.CreatedAt.UTC(),
+				Statement:    string(audit.CheckpointPayload(checkpoint)),
+				Signature:    base64.StdEncoding.EncodeToString(checkpoint.Signature),
+				PublicKey:    base64.StdEncoding.EncodeToString(publicKey),
+			})
+			if err != nil {
+				log.Panicf("Cannot write audit checkpoint: %v", err)
+			}
+		}
+
+		afterCheckpointID = checkpoints[len(checkpoints)-1].CheckpointID
+	}
+}
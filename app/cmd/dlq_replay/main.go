@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/IBM/sarama"
+	kafkaConsumer "github.com/Prrromanssss/platform_common/pkg/kafka/consumer"
+	fiberLog "github.com/gofiber/fiber/v2/log"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
+	dlqReplayer "github.com/Prrromanssss/auth/internal/service/consumer/dlq_replayer"
+)
+
+// dlq_replay re-injects the messages of the user creation dead-letter topic into their original topic.
+func main() {
+	limit := flag.Int("limit", 0, "maximum number of messages to replay, 0 means all")
+	idleTimeout := flag.Duration("idle-timeout", 10*time.Second, "stop when no message arrives for that long")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Panicf("Cannot load config: %v", err)
+	}
+
+	// The consumer logs the values of the messages, which hold plaintext passwords.
+	redact.SetPII(cfg.Redaction.RedactEmails)
+	redact.RegisterJSON(model.CreateUserKafkaParams{})
+	fiberLog.SetLogger(redact.NewLogger(os.Stderr))
+
+	consumerGroup, err := sarama.NewConsumerGroup(
+		cfg.KafkaConsumer.BrokersList(),
+		cfg.KafkaConsumer.ReplayGroupID,
+		cfg.KafkaConsumer.Config(),
+	)
+	if err != nil {
+		log.Panicf("Cannot create consumer group: %v", err)
+	}
+
+	consumer := kafkaConsumer.NewConsumer(consumerGroup, kafkaConsumer.NewGroupHandler())
+	defer func() {
+		if closeErr := consumer.Close(); closeErr != nil {
+			log.Printf("Cannot close consumer: %v", closeErr)
+		}
+	}()
+
+	producer, err := sarama.NewSyncProducer(cfg.KafkaProducer.BrokersList(), cfg.KafkaProducer.Config())
+	if err != nil {
+		log.Panicf("Cannot create producer: %v", err)
+	}
+	defer func() {
+		if closeErr := producer.Close(); closeErr != nil {
+			log.Printf("Cannot close producer: %v", closeErr)
+		}
+	}()
+
+	replayed, err := dlqReplayer.NewService(cfg, consumer, producer).Replay(ctx, dlqReplayer.ReplayParams{
+		Limit:       *limit,
+		IdleTimeout: *idleTimeout,
+	})
+	if err != nil {
+		log.Panicf("Cannot replay dead-letter messages: %v", err)
+	}
+
+	log.Printf("Replayed %d dead-letter messages", replayed)
+}
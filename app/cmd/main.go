@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"log"
+	"os/signal"
+	"syscall"
 
 	"github.com/Prrromanssss/auth/internal/app"
 
@@ -10,8 +12,8 @@ import (
 )
 
 func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
 
 	log.Println("Starting auth app")
 
@@ -20,7 +22,7 @@ func main() {
 		log.Panicf("Cannot start app: %v", err)
 	}
 
-	if err = app.Run(ctx, cancel); err != nil {
-		log.Panicf("Cannot start auth app: %v", err)
+	if err = app.Run(ctx); err != nil {
+		log.Panicf("Cannot run auth app: %v", err)
 	}
 }
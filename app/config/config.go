@@ -12,12 +12,30 @@ import (
 
 // Config holds the configuration for the application.
 type Config struct {
-	GRPC          yaml.Server        `validate:"required" yaml:"grpc"`
-	Postgres      yaml.Postgres      `validate:"required" yaml:"postgres"`
-	Redis         yaml.Redis         `validate:"required" yaml:"redis"`
-	HTTP          yaml.Server        `validate:"required" yaml:"http"`
-	Swagger       yaml.Server        `validate:"required" yaml:"swagger"`
-	KafkaConsumer yaml.KafkaConsumer `validate:"required" yaml:"kafka_consumer"`
+	GRPC           yaml.Server         `validate:"required" yaml:"grpc"`
+	Postgres       yaml.Postgres       `validate:"required" yaml:"postgres"`
+	Redis          yaml.Redis          `validate:"required" yaml:"redis"`
+	HTTP           yaml.Server         `validate:"required" yaml:"http"`
+	Swagger        yaml.Server         `validate:"required" yaml:"swagger"`
+	Admin          yaml.Server         `validate:"required" yaml:"admin"`
+	KafkaConsumer  yaml.KafkaConsumer  `validate:"required" yaml:"kafka_consumer"`
+	KafkaProducer  yaml.KafkaProducer  `validate:"required" yaml:"kafka_producer"`
+	JWT            yaml.JWT            `validate:"required" yaml:"jwt"`
+	Argon2         yaml.Argon2         `validate:"required" yaml:"argon2"`
+	Access         yaml.Access         `validate:"required" yaml:"access"`
+	Pagination     yaml.Pagination     `validate:"required" yaml:"pagination"`
+	Outbox         yaml.Outbox         `validate:"required" yaml:"outbox"`
+	UserCache      yaml.UserCache      `validate:"required" yaml:"user_cache"`
+	Redaction      yaml.Redaction      `yaml:"redaction"`
+	AuditWriter    yaml.AuditWriter    `validate:"required" yaml:"audit_writer"`
+	AuditChain     yaml.AuditChain     `validate:"required" yaml:"audit_chain"`
+	AuditRetention yaml.AuditRetention `validate:"required" yaml:"audit_retention"`
+	Tracing        yaml.Tracing        `validate:"required" yaml:"tracing"`
+	Health         yaml.Health         `validate:"required" yaml:"health"`
+	Shutdown       yaml.Shutdown       `validate:"required" yaml:"shutdown"`
+	GatewayTLS     yaml.TLSClient      `yaml:"gateway_tls"`
+	RateLimit      yaml.RateLimit      `yaml:"rate_limit"`
+	LoginLockout   yaml.LoginLockout   `yaml:"login_lockout"`
 }
 
 // LoadConfig reads and parses the configuration from a file specified by the CONFIG_PATH environment variable.
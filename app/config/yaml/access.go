@@ -0,0 +1,9 @@
+package yaml
+
+import "time"
+
+// Access holds the configuration for access checks.
+// DecisionCacheTTL also bounds how long a change to the access rules takes to apply.
+type Access struct {
+	DecisionCacheTTL time.Duration `validate:"required" yaml:"decision_cache_ttl"`
+}
@@ -0,0 +1,23 @@
+package yaml
+
+import "github.com/Prrromanssss/auth/pkg/crypto"
+
+// Argon2 holds the cost parameters for hashing passwords with Argon2id.
+type Argon2 struct {
+	Memory      uint32 `validate:"required" yaml:"memory"`
+	Iterations  uint32 `validate:"required" yaml:"iterations"`
+	Parallelism uint8  `validate:"required" yaml:"parallelism"`
+	SaltLength  uint32 `validate:"required" yaml:"salt_length"`
+	KeyLength   uint32 `validate:"required" yaml:"key_length"`
+}
+
+// Params returns the Argon2id parameters used by the password hasher.
+func (a Argon2) Params() crypto.Argon2Params {
+	return crypto.Argon2Params{
+		Memory:      a.Memory,
+		Iterations:  a.Iterations,
+		Parallelism: a.Parallelism,
+		SaltLength:  a.SaltLength,
+		KeyLength:   a.KeyLength,
+	}
+}
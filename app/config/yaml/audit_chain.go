@@ -0,0 +1,31 @@
+package yaml
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AuditChain holds the configuration for the checkpoints of the hash-chained audit log.
+type AuditChain struct {
+	// CheckpointSigningKey is the base64 encoded 32 byte Ed25519 seed the checkpoints are signed with.
+	CheckpointSigningKey string        `validate:"required" yaml:"checkpoint_signing_key"`
+	CheckpointInterval   time.Duration `validate:"required" yaml:"checkpoint_interval"`
+	VerifyBatchSize      int64         `validate:"required" yaml:"verify_batch_size"`
+}
+
+// SigningKey returns the Ed25519 private key the checkpoints are signed with.
+func (a AuditChain) SigningKey() (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(a.CheckpointSigningKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode checkpoint signing key")
+	}
+
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.Errorf("checkpoint signing key must be %d bytes, got %d", ed25519.SeedSize, len(seed))
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
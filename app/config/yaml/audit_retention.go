@@ -0,0 +1,17 @@
+package yaml
+
+import "time"
+
+// AuditRetention holds the configuration for the monthly partitions of the audit log and their retention.
+type AuditRetention struct {
+	Interval time.Duration `validate:"required" yaml:"interval"`
+	// RetentionMonths is how many whole months of records are kept besides the current one.
+	RetentionMonths int `validate:"required" yaml:"retention_months"`
+	// PremakeMonths is how many partitions are created ahead of the current month.
+	PremakeMonths int `validate:"required" yaml:"premake_months"`
+	// ArchiveDir is the directory the expired partitions are exported to before they are removed.
+	ArchiveDir      string `validate:"required" yaml:"archive_dir"`
+	ExportBatchSize int64  `validate:"required" yaml:"export_batch_size"`
+	// DetachOnly leaves the expired partitions in the database as standalone tables instead of dropping them.
+	DetachOnly bool `yaml:"detach_only"`
+}
@@ -0,0 +1,14 @@
+package yaml
+
+import "time"
+
+// AuditWriter holds the configuration for the asynchronous writer of read audit records.
+type AuditWriter struct {
+	QueueSize     int           `validate:"required" yaml:"queue_size"`
+	BatchSize     int           `validate:"required" yaml:"batch_size"`
+	FlushInterval time.Duration `validate:"required" yaml:"flush_interval"`
+	FlushTimeout  time.Duration `validate:"required" yaml:"flush_timeout"`
+	// EnqueueTimeout is how long a call waits for room in a full queue before its record is dropped.
+	// Zero drops the record at once.
+	EnqueueTimeout time.Duration `yaml:"enqueue_timeout"`
+}
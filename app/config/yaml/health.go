@@ -0,0 +1,13 @@
+package yaml
+
+import "time"
+
+// Health holds the configuration for the health checks of the dependencies.
+type Health struct {
+	CheckInterval time.Duration `validate:"required" yaml:"check_interval"`
+	// CheckTimeout bounds each check, a dependency that does not answer in time is reported as not serving.
+	CheckTimeout time.Duration `validate:"required" yaml:"check_timeout"`
+	// DrainDelay is how long the service keeps serving once it is reported as not serving on shutdown,
+	// so that load balancers notice it before the servers stop accepting requests.
+	DrainDelay time.Duration `yaml:"drain_delay"`
+}
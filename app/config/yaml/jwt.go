@@ -0,0 +1,11 @@
+package yaml
+
+import "time"
+
+// JWT holds the configuration for signing access and refresh tokens.
+type JWT struct {
+	AccessTokenSecretKey  string        `validate:"required" yaml:"access_token_secret_key"`
+	RefreshTokenSecretKey string        `validate:"required" yaml:"refresh_token_secret_key"`
+	AccessTokenTTL        time.Duration `validate:"required" yaml:"access_token_ttl"`
+	RefreshTokenTTL       time.Duration `validate:"required" yaml:"refresh_token_ttl"`
+}
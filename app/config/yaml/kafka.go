@@ -2,15 +2,21 @@ package yaml
 
 import (
 	"strings"
+	"time"
 
 	"github.com/IBM/sarama"
 )
 
 // KafkaConsumer represents the configuration for KafkaConsumer.
 type KafkaConsumer struct {
-	UsersCreationTopicName string `validate:"required" yaml:"user_creation_topic_name"`
-	Brokers                string `validate:"required" yaml:"brokers"`
-	GroupID                string `validate:"required" yaml:"group_id"`
+	UsersCreationTopicName string        `validate:"required" yaml:"user_creation_topic_name"`
+	Brokers                string        `validate:"required" yaml:"brokers"`
+	GroupID                string        `validate:"required" yaml:"group_id"`
+	DeadLetterTopicName    string        `validate:"required" yaml:"dead_letter_topic_name"`
+	ReplayGroupID          string        `validate:"required" yaml:"replay_group_id"`
+	RetryMaxAttempts       int           `validate:"required" yaml:"retry_max_attempts"`
+	RetryInitialBackoff    time.Duration `validate:"required" yaml:"retry_initial_backoff"`
+	RetryMaxBackoff        time.Duration `validate:"required" yaml:"retry_max_backoff"`
 }
 
 func (k KafkaConsumer) BrokersList() []string {
@@ -25,3 +31,34 @@ func (k *KafkaConsumer) Config() *sarama.Config {
 
 	return config
 }
+
+// KafkaProducer represents the configuration for KafkaProducer.
+// Events of the same user are only published in order when their topics are the same.
+type KafkaProducer struct {
+	Brokers              string `validate:"required" yaml:"brokers"`
+	UserCreatedTopicName string `validate:"required" yaml:"user_created_topic_name"`
+	UserUpdatedTopicName string `validate:"required" yaml:"user_updated_topic_name"`
+	UserDeletedTopicName string `validate:"required" yaml:"user_deleted_topic_name"`
+}
+
+func (k KafkaProducer) BrokersList() []string {
+	brokers := strings.Split(k.Brokers, ",")
+	for i := range brokers {
+		brokers[i] = strings.TrimSpace(brokers[i])
+	}
+
+	return brokers
+}
+
+func (k *KafkaProducer) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V2_6_0_0
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	// Retries must not reorder messages of the same key.
+	config.Producer.Idempotent = true
+	config.Net.MaxOpenRequests = 1
+
+	return config
+}
@@ -0,0 +1,64 @@
+package yaml
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LoginLockout holds the configuration for delaying and locking out logins after failed attempts.
+// The failures are counted per account and per client address, each with a policy of its own.
+type LoginLockout struct {
+	Enabled bool               `yaml:"enabled"`
+	Account LoginLockoutPolicy `yaml:"account"`
+	IP      LoginLockoutPolicy `yaml:"ip"`
+}
+
+// LoginLockoutPolicy tells how long the logins for a key have to wait after its failures.
+type LoginLockoutPolicy struct {
+	// Window is how long a failure is remembered: the count restarts after a window without failures.
+	Window time.Duration `yaml:"window"`
+	// FreeAttempts is the number of failures that do not delay the next login.
+	FreeAttempts int64 `yaml:"free_attempts"`
+	// BaseDelay is the wait after the first delayed failure. It doubles with every further failure up to MaxDelay.
+	BaseDelay time.Duration `yaml:"base_delay"`
+	MaxDelay  time.Duration `yaml:"max_delay"`
+	// Threshold is the number of failures that locks the key out for LockoutDuration.
+	Threshold       int64         `yaml:"threshold"`
+	LockoutDuration time.Duration `yaml:"lockout_duration"`
+}
+
+// Validate checks the policies of an enabled login lockout.
+func (l LoginLockout) Validate() error {
+	if !l.Enabled {
+		return nil
+	}
+
+	err := l.Account.validate()
+	if err != nil {
+		return errors.Wrap(err, "login_lockout: account")
+	}
+
+	err = l.IP.validate()
+	if err != nil {
+		return errors.Wrap(err, "login_lockout: ip")
+	}
+
+	return nil
+}
+
+func (p LoginLockoutPolicy) validate() error {
+	if p.Window <= 0 || p.LockoutDuration <= 0 {
+		return errors.New("window and lockout_duration must be positive")
+	}
+
+	if p.FreeAttempts < 0 || p.Threshold <= p.FreeAttempts {
+		return errors.New("threshold must be greater than free_attempts")
+	}
+
+	if p.BaseDelay <= 0 || p.MaxDelay < p.BaseDelay {
+		return errors.New("base_delay must be positive and max_delay must not be less than base_delay")
+	}
+
+	return nil
+}
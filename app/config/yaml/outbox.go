@@ -0,0 +1,9 @@
+package yaml
+
+import "time"
+
+// Outbox holds the configuration for the outbox relay.
+type Outbox struct {
+	RelayInterval time.Duration `validate:"required" yaml:"relay_interval"`
+	BatchSize     int64         `validate:"required" yaml:"batch_size"`
+}
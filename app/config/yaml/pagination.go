@@ -0,0 +1,6 @@
+package yaml
+
+// Pagination holds the configuration for signing page tokens.
+type Pagination struct {
+	PageTokenSecretKey string `validate:"required" yaml:"page_token_secret_key"`
+}
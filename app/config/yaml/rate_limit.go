@@ -0,0 +1,64 @@
+package yaml
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Keys the calls are counted by.
+const (
+	RateLimitKeyIP     = "ip"
+	RateLimitKeyUser   = "user"
+	RateLimitKeyMethod = "method"
+)
+
+// RateLimit holds the configuration for the rate limits of the gRPC methods. The buckets are kept in Redis,
+// so that the limits hold across replicas, and in memory while Redis is unavailable.
+type RateLimit struct {
+	Enabled bool            `yaml:"enabled"`
+	Rules   []RateLimitRule `yaml:"rules"`
+	// RedisRetryInterval is how long the buckets stay in memory after Redis fails before Redis is tried again.
+	RedisRetryInterval time.Duration `yaml:"redis_retry_interval"`
+}
+
+// RateLimitRule limits the calls to a method with a token bucket per key.
+type RateLimitRule struct {
+	// Method is the full name of the gRPC method, such as /auth_v1.AuthV1/Login, or * for every method.
+	Method string `yaml:"method"`
+	// Key is one of ip, user and method: a bucket per client address, per authenticated user,
+	// which leaves anonymous calls out, or a single bucket shared by every caller of the method.
+	Key string `yaml:"key"`
+	// Rate is the number of calls per second the bucket refills with, Burst the capacity of the bucket.
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+// Validate checks the rules of enabled rate limits.
+func (r RateLimit) Validate() error {
+	if !r.Enabled {
+		return nil
+	}
+
+	if r.RedisRetryInterval <= 0 {
+		return errors.New("rate_limit: redis_retry_interval must be positive")
+	}
+
+	for i, rule := range r.Rules {
+		if rule.Method == "" {
+			return errors.Errorf("rate_limit: rules[%d]: method is required", i)
+		}
+
+		switch rule.Key {
+		case RateLimitKeyIP, RateLimitKeyUser, RateLimitKeyMethod:
+		default:
+			return errors.Errorf("rate_limit: rules[%d]: unknown key %q", i, rule.Key)
+		}
+
+		if rule.Rate <= 0 || rule.Burst <= 0 {
+			return errors.Errorf("rate_limit: rules[%d]: rate and burst must be positive", i)
+		}
+	}
+
+	return nil
+}
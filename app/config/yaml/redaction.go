@@ -0,0 +1,7 @@
+package yaml
+
+// Redaction holds the configuration for masking sensitive data in logs and audit records.
+// Secrets are always masked, emails only when RedactEmails is set.
+type Redaction struct {
+	RedactEmails bool `yaml:"redact_emails"`
+}
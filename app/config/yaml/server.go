@@ -6,6 +6,7 @@ import "net"
 type Server struct {
 	Host string `validate:"required" yaml:"host"`
 	Port string `validate:"required" yaml:"port"`
+	TLS  TLS    `yaml:"tls"`
 }
 
 // Address returns the server address.
@@ -0,0 +1,9 @@
+package yaml
+
+import "time"
+
+// Shutdown holds the configuration for the shutdown of the application.
+type Shutdown struct {
+	// Timeout bounds the whole shutdown, including the drain delay of the health checks.
+	Timeout time.Duration `validate:"required" yaml:"timeout"`
+}
@@ -0,0 +1,84 @@
+package yaml
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TLS holds the configuration for the TLS of a server. The files are read again when they change on disk.
+type TLS struct {
+	Enabled  bool   `yaml:"enabled"`
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile enables mutual TLS: clients must present a certificate issued by one of these CAs.
+	ClientCAFile string `yaml:"client_ca_file"`
+	// MinVersion is 1.2 or 1.3, 1.2 if unset.
+	MinVersion     string        `yaml:"min_version"`
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// Validate checks that the certificate of an enabled server is set.
+func (t TLS) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	if t.CertFile == "" || t.KeyFile == "" {
+		return errors.New("tls: cert_file and key_file are required")
+	}
+
+	if t.ReloadInterval <= 0 {
+		return errors.New("tls: reload_interval is required")
+	}
+
+	_, err := MinTLSVersion(t.MinVersion)
+
+	return err
+}
+
+// TLSClient holds the configuration for the TLS of the gateway calls to the gRPC server.
+type TLSClient struct {
+	// CAFile holds the CAs the certificate of the gRPC server is verified with, the system ones if unset.
+	CAFile string `yaml:"ca_file"`
+	// CertFile and KeyFile are the certificate presented to a gRPC server that requires mutual TLS.
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ServerName is the name the certificate of the gRPC server is verified against.
+	ServerName     string        `yaml:"server_name"`
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// Validate checks the client of a gRPC server with the given TLS configuration.
+func (t TLSClient) Validate(server TLS) error {
+	if !server.Enabled {
+		return nil
+	}
+
+	if t.ServerName == "" {
+		return errors.New("gateway_tls: server_name is required by the tls of the grpc server")
+	}
+
+	if server.ClientCAFile != "" && (t.CertFile == "" || t.KeyFile == "") {
+		return errors.New("gateway_tls: cert_file and key_file are required by the mutual tls of the grpc server")
+	}
+
+	if t.ReloadInterval <= 0 {
+		return errors.New("gateway_tls: reload_interval is required")
+	}
+
+	return nil
+}
+
+// MinTLSVersion returns the TLS version with the given name.
+func MinTLSVersion(name string) (uint16, error) {
+	switch name {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, errors.Errorf("tls: unsupported min_version %q", name)
+	}
+}
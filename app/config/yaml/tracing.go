@@ -0,0 +1,54 @@
+package yaml
+
+import "github.com/pkg/errors"
+
+// Tracing exporters.
+const (
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+	TracingExporterFile   = "file"
+)
+
+// Tracing holds the configuration for OpenTelemetry tracing. When tracing is disabled, the trace context
+// of incoming calls is still passed on, but no span is recorded.
+type Tracing struct {
+	Enabled     bool   `yaml:"enabled"`
+	ServiceName string `validate:"required" yaml:"service_name"`
+	// Exporter is one of otlp, stdout and file.
+	Exporter string `yaml:"exporter"`
+	// OTLPEndpoint is the host:port of the OTLP gRPC receiver, such as an OpenTelemetry collector.
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+	OTLPInsecure bool   `yaml:"otlp_insecure"`
+	// FilePath is the file the file exporter appends the spans to, one JSON document per span.
+	FilePath string `yaml:"file_path"`
+	// SampleRatio is the share of the traces started by this service that are recorded. Traces started
+	// by a caller follow the sampling decision of the caller.
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// Validate checks that the settings of the configured exporter are set.
+func (t Tracing) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	switch t.Exporter {
+	case TracingExporterOTLP:
+		if t.OTLPEndpoint == "" {
+			return errors.New("tracing: otlp_endpoint is required by the otlp exporter")
+		}
+	case TracingExporterFile:
+		if t.FilePath == "" {
+			return errors.New("tracing: file_path is required by the file exporter")
+		}
+	case TracingExporterStdout:
+	default:
+		return errors.Errorf("tracing: unknown exporter %q", t.Exporter)
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return errors.Errorf("tracing: sample_ratio %v is not between 0 and 1", t.SampleRatio)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package yaml
+
+import "time"
+
+// UserCache holds the configuration for the user cache.
+type UserCache struct {
+	TTL          time.Duration `validate:"required" yaml:"ttl"`
+	TTLJitter    time.Duration `yaml:"ttl_jitter"`
+	NegativeTTL  time.Duration `validate:"required" yaml:"negative_ttl"`
+	TombstoneTTL time.Duration `validate:"required" yaml:"tombstone_ttl"`
+
+	// LocalEnabled puts an in-process LRU in front of Redis.
+	LocalEnabled        bool          `yaml:"local_enabled"`
+	LocalSize           int           `validate:"required_if=LocalEnabled true" yaml:"local_size"`
+	LocalTTL            time.Duration `validate:"required_if=LocalEnabled true" yaml:"local_ttl"`
+	InvalidationChannel string        `validate:"required_if=LocalEnabled true" yaml:"invalidation_channel"`
+}
@@ -0,0 +1,46 @@
+package access
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/Prrromanssss/auth/internal/converter"
+	"github.com/Prrromanssss/auth/internal/service"
+	"github.com/Prrromanssss/auth/internal/token"
+	pb "github.com/Prrromanssss/auth/pkg/access_v1"
+)
+
+// GRPCHandlers represents the gRPC handlers that implement the AccessV1Server interface
+// and use the AccessService for business logic operations.
+type GRPCHandlers struct {
+	pb.UnimplementedAccessV1Server
+	accessService service.AccessService
+}
+
+// NewGRPCHandlers creates a new instance of GRPCHandlers with the provided AccessService.
+func NewGRPCHandlers(accessService service.AccessService) *GRPCHandlers {
+	return &GRPCHandlers{
+		accessService: accessService,
+	}
+}
+
+// Check handles the request for checking whether the bearer of the access token may call the endpoint.
+func (h *GRPCHandlers) Check(ctx context.Context, req *pb.CheckRequest) (*emptypb.Empty, error) {
+	log.Infof("rpc Check, endpoint: %s", req.EndpointAddress)
+
+	accessToken, err := token.BearerFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	err = h.accessService.Check(ctx, converter.ConvertCheckRequestFromHandlerToService(req, accessToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
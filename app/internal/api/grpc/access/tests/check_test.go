@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	accessAPI "github.com/Prrromanssss/auth/internal/api/grpc/access"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/service"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/access_v1"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	type accessServiceMockFunc func(mc *minimock.Controller) service.AccessService
+
+	type args struct {
+		ctx context.Context
+		req *pb.CheckRequest
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		accessToken     = gofakeit.UUID()
+		endpointAddress = "/user_v1.UserV1/Get"
+
+		ctx = metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("authorization", "Bearer "+accessToken),
+		)
+
+		ErrService = errors.New("service error")
+
+		req = &pb.CheckRequest{
+			EndpointAddress: endpointAddress,
+		}
+
+		serviceParams = model.CheckParams{
+			AccessToken:     accessToken,
+			EndpointAddress: endpointAddress,
+		}
+	)
+
+	tests := []struct {
+		name              string
+		args              args
+		want              *emptypb.Empty
+		err               error
+		accessServiceMock accessServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: &emptypb.Empty{},
+			err:  nil,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, serviceParams).Return(nil)
+				return mock
+			},
+		},
+		{
+			name: "missing metadata",
+			args: args{
+				ctx: context.Background(),
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "metadata is not provided"),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				return mock
+			},
+		},
+		{
+			name: "missing authorization header",
+			args: args{
+				ctx: metadata.NewIncomingContext(context.Background(), metadata.MD{}),
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "authorization header is not provided"),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				return mock
+			},
+		},
+		{
+			name: "invalid authorization header format",
+			args: args{
+				ctx: metadata.NewIncomingContext(
+					context.Background(),
+					metadata.Pairs("authorization", accessToken),
+				),
+				req: req,
+			},
+			want: nil,
+			err:  status.Error(codes.Unauthenticated, "invalid authorization header format"),
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				return mock
+			},
+		},
+		{
+			name: "invalid access token",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrInvalidAccessToken,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, serviceParams).Return(model.ErrInvalidAccessToken)
+				return mock
+			},
+		},
+		{
+			name: "access denied",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrAccessDenied,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, serviceParams).Return(model.ErrAccessDenied)
+				return mock
+			},
+		},
+		{
+			name: "service error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  ErrService,
+			accessServiceMock: func(mc *minimock.Controller) service.AccessService {
+				mock := serviceMocks.NewAccessServiceMock(mc)
+				mock.CheckMock.Expect(ctx, serviceParams).Return(ErrService)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			accessServiceMock := tt.accessServiceMock(mc)
+			api := accessAPI.NewGRPCHandlers(accessServiceMock)
+
+			resp, err := api.Check(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
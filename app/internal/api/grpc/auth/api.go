@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/Prrromanssss/auth/internal/converter"
+	"github.com/Prrromanssss/auth/internal/service"
+	pb "github.com/Prrromanssss/auth/pkg/auth_v1"
+)
+
+// GRPCHandlers represents the gRPC handlers that implement the AuthV1Server interface
+// and use the AuthService for business logic operations.
+type GRPCHandlers struct {
+	pb.UnimplementedAuthV1Server
+	authService service.AuthService
+}
+
+// NewGRPCHandlers creates a new instance of GRPCHandlers with the provided AuthService.
+func NewGRPCHandlers(authService service.AuthService) *GRPCHandlers {
+	return &GRPCHandlers{
+		authService: authService,
+	}
+}
+
+// Login handles the request for authenticating a user.
+func (h *GRPCHandlers) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	log.Infof("rpc Login, email: %s", req.Email)
+
+	resp, err := h.authService.Login(ctx, converter.ConvertLoginRequestFromHandlerToService(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertLoginResponseFromServiceToHandler(resp), nil
+}
+
+// RefreshToken handles the request for rotating a refresh token.
+func (h *GRPCHandlers) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	log.Info("rpc RefreshToken")
+
+	resp, err := h.authService.RefreshToken(ctx, converter.ConvertRefreshTokenRequestFromHandlerToService(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertRefreshTokenResponseFromServiceToHandler(resp), nil
+}
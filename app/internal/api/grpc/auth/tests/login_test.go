@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	authAPI "github.com/Prrromanssss/auth/internal/api/grpc/auth"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/service"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/auth_v1"
+)
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+
+	type authServiceMockFunc func(mc *minimock.Controller) service.AuthService
+
+	type args struct {
+		ctx context.Context
+		req *pb.LoginRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		email        = gofakeit.Email()
+		password     = gofakeit.Password(true, true, true, true, true, 10)
+		accessToken  = gofakeit.UUID()
+		refreshToken = gofakeit.UUID()
+
+		ErrService = errors.New("service error")
+
+		req = &pb.LoginRequest{
+			Email:    email,
+			Password: password,
+		}
+
+		resp = &pb.LoginResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+
+		serviceParams = model.LoginParams{
+			Email:    email,
+			Password: password,
+		}
+
+		serviceResp = model.LoginResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *pb.LoginResponse
+		err             error
+		authServiceMock authServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: resp,
+			err:  nil,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(ctx, serviceParams).Return(serviceResp, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid credentials case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrInvalidCredentials,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(ctx, serviceParams).Return(model.LoginResponse{}, model.ErrInvalidCredentials)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  ErrService,
+			authServiceMock: func(mc *minimock.Controller) service.AuthService {
+				mock := serviceMocks.NewAuthServiceMock(mc)
+				mock.LoginMock.Expect(ctx, serviceParams).Return(model.LoginResponse{}, ErrService)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authServiceMock := tt.authServiceMock(mc)
+			api := authAPI.NewGRPCHandlers(authServiceMock)
+
+			resp, err := api.Login(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
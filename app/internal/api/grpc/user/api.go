@@ -2,28 +2,37 @@ package user
 
 import (
 	"context"
-	"errors"
 
 	"github.com/gofiber/fiber/v2/log"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/Prrromanssss/auth/internal/converter"
+	"github.com/Prrromanssss/auth/internal/model"
 	"github.com/Prrromanssss/auth/internal/service"
 	"github.com/Prrromanssss/auth/pkg/crypto"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
 
 // GRPCHandlers represents the gRPC handlers that implement the UserV1Server interface
-// and use the UserService for business logic operations.
+// and use the UserService and the AuditService for business logic operations.
 type GRPCHandlers struct {
 	pb.UnimplementedUserV1Server
-	userService service.UserService
+	userService    service.UserService
+	auditService   service.AuditService
+	passwordHasher crypto.PasswordHasher
 }
 
-// NewGRPCHandlers creates a new instance of GRPCHandlers with the provided UserService.
-func NewGRPCHandlers(userService service.UserService) *GRPCHandlers {
+// NewGRPCHandlers creates a new instance of GRPCHandlers with the provided UserService, AuditService
+// and PasswordHasher.
+func NewGRPCHandlers(
+	userService service.UserService,
+	auditService service.AuditService,
+	passwordHasher crypto.PasswordHasher,
+) *GRPCHandlers {
 	return &GRPCHandlers{
-		userService: userService,
+		userService:    userService,
+		auditService:   auditService,
+		passwordHasher: passwordHasher,
 	}
 }
 
@@ -31,13 +40,14 @@ func NewGRPCHandlers(userService service.UserService) *GRPCHandlers {
 func (h *GRPCHandlers) Create(ctx context.Context, req *pb.CreateRequest) (*pb.CreateResponse, error) {
 	log.Infof("rpc Create, request: %+v", req)
 
-	log.Info(h.userService)
-
 	if req.Password != req.PasswordConfirm {
-		return nil, errors.New("passwords don't match")
+		return nil, model.ErrPasswordsMismatch
 	}
 
-	hashedPassword := crypto.HashPassword(req.Password)
+	hashedPassword, err := h.passwordHasher.Hash(req.Password)
+	if err != nil {
+		return nil, err
+	}
 
 	req.Password = hashedPassword
 
@@ -84,3 +94,42 @@ func (h *GRPCHandlers) Delete(ctx context.Context, req *pb.DeleteRequest) (*empt
 
 	return &emptypb.Empty{}, nil
 }
+
+// Unlock handles the request for lifting the login lockout of a user.
+func (h *GRPCHandlers) Unlock(ctx context.Context, req *pb.UnlockRequest) (*emptypb.Empty, error) {
+	log.Infof("rpc Unlock, request: %+v", req)
+
+	err := h.userService.UnlockUser(ctx, converter.ConvertUnlockRequestFromHandlerToService(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListUsers handles the request for listing a page of users.
+func (h *GRPCHandlers) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	log.Infof("rpc ListUsers, request: %+v", req)
+
+	resp, err := h.userService.ListUsers(ctx, converter.ConvertListUsersRequestFromHandlerToService(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertListUsersResponseFromServiceToHandler(resp), nil
+}
+
+// ListAuditEvents handles the request for listing a page of audit events.
+func (h *GRPCHandlers) ListAuditEvents(
+	ctx context.Context,
+	req *pb.ListAuditEventsRequest,
+) (*pb.ListAuditEventsResponse, error) {
+	log.Infof("rpc ListAuditEvents, request: %+v", req)
+
+	resp, err := h.auditService.ListAuditEvents(ctx, converter.ConvertListAuditEventsRequestFromHandlerToService(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertListAuditEventsResponseFromServiceToHandler(resp), nil
+}
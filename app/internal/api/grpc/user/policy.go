@@ -0,0 +1,87 @@
+package user
+
+import (
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+// Policies returns the authentication and authorization policy of every UserV1 method keyed by full method name.
+func Policies() map[string]interceptor.MethodPolicy {
+	return map[string]interceptor.MethodPolicy{
+		"/user_v1.UserV1/Create": {
+			Public: true,
+			Authorize: func(claims *model.UserClaims, req interface{}) error {
+				return checkRoleAssignment(claims, req.(*pb.CreateRequest).GetRole())
+			},
+		},
+		"/user_v1.UserV1/Get": {
+			Authorize: func(claims *model.UserClaims, req interface{}) error {
+				return checkSelfOrAdmin(claims, req.(*pb.GetRequest).GetId())
+			},
+		},
+		"/user_v1.UserV1/Update": {
+			Authorize: func(claims *model.UserClaims, req interface{}) error {
+				updateReq := req.(*pb.UpdateRequest)
+
+				err := checkSelfOrAdmin(claims, updateReq.GetId())
+				if err != nil {
+					return err
+				}
+
+				return checkRoleAssignment(claims, updateReq.GetRole())
+			},
+		},
+		"/user_v1.UserV1/Delete": {
+			Authorize: checkAdmin,
+		},
+		"/user_v1.UserV1/ListUsers": {
+			Authorize: checkAdmin,
+		},
+		"/user_v1.UserV1/ListAuditEvents": {
+			Authorize: checkAdmin,
+		},
+		"/user_v1.UserV1/Unlock": {
+			Authorize: checkAdmin,
+		},
+	}
+}
+
+// AuditedMethods returns the full names of the UserV1 methods whose failed calls are recorded in the audit log.
+// Successful calls are recorded by the service layer together with the change they make.
+func AuditedMethods() []string {
+	return []string{
+		"/user_v1.UserV1/Create",
+		"/user_v1.UserV1/Update",
+		"/user_v1.UserV1/Delete",
+		"/user_v1.UserV1/Unlock",
+	}
+}
+
+func checkAdmin(claims *model.UserClaims, _ interface{}) error {
+	if !isAdmin(claims) {
+		return model.ErrAccessDenied
+	}
+
+	return nil
+}
+
+func isAdmin(claims *model.UserClaims) bool {
+	return claims != nil && claims.Role == int64(pb.Role_ADMIN)
+}
+
+func checkSelfOrAdmin(claims *model.UserClaims, userID int64) error {
+	if isAdmin(claims) || (claims != nil && claims.UserID == userID) {
+		return nil
+	}
+
+	return model.ErrAccessDenied
+}
+
+func checkRoleAssignment(claims *model.UserClaims, role pb.Role) error {
+	if role == pb.Role_ADMIN && !isAdmin(claims) {
+		return model.ErrAdminRoleNotAllowed
+	}
+
+	return nil
+}
@@ -14,13 +14,17 @@ import (
 	"github.com/Prrromanssss/auth/internal/service"
 	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
 	"github.com/Prrromanssss/auth/pkg/crypto"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
 
 func TestCreate(t *testing.T) {
 	t.Parallel()
 
-	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+	type (
+		userServiceMockFunc    func(mc *minimock.Controller) service.UserService
+		passwordHasherMockFunc func(mc *minimock.Controller) crypto.PasswordHasher
+	)
 
 	type args struct {
 		ctx context.Context
@@ -36,12 +40,12 @@ func TestCreate(t *testing.T) {
 		email          = gofakeit.Email()
 		role           = pb.Role_ADMIN
 		password       = gofakeit.Password(true, true, true, true, true, 10)
-		hashedPassword = crypto.HashPassword(password)
+		hashedPassword = gofakeit.UUID()
 		createdAt      = gofakeit.Date()
 		updatedAt      = gofakeit.Date()
 
-		ErrInvalidPassword = errors.New("passwords don't match")
-		ErrService         = errors.New("service error")
+		ErrService        = errors.New("service error")
+		ErrPasswordHasher = errors.New("password hasher error")
 
 		serviceParams = model.CreateUserParams{
 			Name:           name,
@@ -67,11 +71,12 @@ func TestCreate(t *testing.T) {
 	)
 
 	tests := []struct {
-		name            string
-		args            args
-		want            *pb.CreateResponse
-		err             error
-		userServiceMock userServiceMockFunc
+		name               string
+		args               args
+		want               *pb.CreateResponse
+		err                error
+		userServiceMock    userServiceMockFunc
+		passwordHasherMock passwordHasherMockFunc
 	}{
 		{
 			name: "success case",
@@ -92,6 +97,11 @@ func TestCreate(t *testing.T) {
 				mock.CreateUserMock.Expect(ctx, serviceParams).Return(serviceResp, nil)
 				return mock
 			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(hashedPassword, nil)
+				return mock
+			},
 		},
 		{
 			name: "service error case",
@@ -112,6 +122,11 @@ func TestCreate(t *testing.T) {
 				mock.CreateUserMock.Expect(ctx, serviceParams).Return(model.CreateUserResponse{}, ErrService)
 				return mock
 			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return(hashedPassword, nil)
+				return mock
+			},
 		},
 		{
 			name: "different passwords",
@@ -126,11 +141,39 @@ func TestCreate(t *testing.T) {
 				},
 			},
 			want: nil,
-			err:  ErrInvalidPassword,
+			err:  model.ErrPasswordsMismatch,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				return mock
+			},
+		},
+		{
+			name: "password hasher error",
+			args: args{
+				ctx: ctx,
+				req: &pb.CreateRequest{
+					Name:            name,
+					Email:           email,
+					Password:        password,
+					PasswordConfirm: password,
+					Role:            role,
+				},
+			},
+			want: nil,
+			err:  ErrPasswordHasher,
 			userServiceMock: func(mc *minimock.Controller) service.UserService {
 				mock := serviceMocks.NewUserServiceMock(mc)
 				return mock
 			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return("", ErrPasswordHasher)
+				return mock
+			},
 		},
 	}
 
@@ -139,7 +182,8 @@ func TestCreate(t *testing.T) {
 			t.Parallel()
 
 			userServiceMock := tt.userServiceMock(mc)
-			api := userAPI.NewGRPCHandlers(userServiceMock)
+			passwordHasherMock := tt.passwordHasherMock(mc)
+			api := userAPI.NewGRPCHandlers(userServiceMock, serviceMocks.NewAuditServiceMock(mc), passwordHasherMock)
 
 			resp, err := api.Create(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -14,6 +14,7 @@ import (
 	"github.com/Prrromanssss/auth/internal/model"
 	"github.com/Prrromanssss/auth/internal/service"
 	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
 
@@ -86,7 +87,7 @@ func TestDelete(t *testing.T) {
 			t.Parallel()
 
 			userServiceMock := tt.userServiceMock(mc)
-			api := userAPI.NewGRPCHandlers(userServiceMock)
+			api := userAPI.NewGRPCHandlers(userServiceMock, serviceMocks.NewAuditServiceMock(mc), cryptoMocks.NewPasswordHasherMock(mc))
 
 			resp, err := api.Delete(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
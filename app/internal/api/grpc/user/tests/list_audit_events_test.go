@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userAPI "github.com/Prrromanssss/auth/internal/api/grpc/user"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/service"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	type auditServiceMockFunc func(mc *minimock.Controller) service.AuditService
+
+	type args struct {
+		ctx context.Context
+		req *pb.ListAuditEventsRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		eventID      = gofakeit.Int64()
+		actorID      = gofakeit.Int64()
+		targetUserID = gofakeit.Int64()
+		sourceIP     = gofakeit.IPv4Address()
+		userAgent    = gofakeit.UserAgent()
+		requestID    = gofakeit.UUID()
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		pageToken    = gofakeit.UUID()
+		nextToken    = gofakeit.UUID()
+		from         = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		to           = time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC)
+		createdAt    = time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC)
+
+		ErrService = errors.New("service error")
+
+		req = &pb.ListAuditEventsRequest{
+			PageSize:     10,
+			PageToken:    pageToken,
+			ActorId:      &actorID,
+			TargetUserId: &targetUserID,
+			Action:       "Update",
+			From:         timestamppb.New(from),
+			To:           timestamppb.New(to),
+		}
+
+		resp = &pb.ListAuditEventsResponse{
+			Events: []*pb.AuditEvent{
+				{
+					Id:           eventID,
+					Action:       "Update",
+					ActorId:      &actorID,
+					TargetUserId: &targetUserID,
+					SourceIp:     sourceIP,
+					UserAgent:    userAgent,
+					Method:       "/user_v1.UserV1/Update",
+					StatusCode:   model.AuditStatusOK,
+					RequestId:    requestID,
+					TraceId:      traceID,
+					CreatedAt:    timestamppb.New(createdAt),
+				},
+			},
+			NextPageToken: nextToken,
+		}
+
+		serviceParams = model.ListAuditEventsParams{
+			PageSize:  10,
+			PageToken: pageToken,
+			Filter: model.ListAuditEventsFilter{
+				ActorID:      &actorID,
+				TargetUserID: &targetUserID,
+				Action:       "Update",
+				From:         &from,
+				To:           &to,
+			},
+		}
+
+		serviceResp = model.ListAuditEventsResponse{
+			Events: []model.AuditEvent{
+				{
+					EventID:      eventID,
+					Action:       "Update",
+					ActorID:      &actorID,
+					TargetUserID: &targetUserID,
+					SourceIP:     sourceIP,
+					UserAgent:    userAgent,
+					Method:       "/user_v1.UserV1/Update",
+					StatusCode:   model.AuditStatusOK,
+					RequestID:    requestID,
+					TraceID:      traceID,
+					CreatedAt:    createdAt,
+				},
+			},
+			NextPageToken: nextToken,
+		}
+	)
+
+	tests := []struct {
+		name             string
+		args             args
+		want             *pb.ListAuditEventsResponse
+		err              error
+		auditServiceMock auditServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: resp,
+			err:  nil,
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.ListAuditEventsMock.Expect(ctx, serviceParams).Return(serviceResp, nil)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  ErrService,
+			auditServiceMock: func(mc *minimock.Controller) service.AuditService {
+				mock := serviceMocks.NewAuditServiceMock(mc)
+				mock.ListAuditEventsMock.Expect(ctx, serviceParams).Return(model.ListAuditEventsResponse{}, ErrService)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			auditServiceMock := tt.auditServiceMock(mc)
+			api := userAPI.NewGRPCHandlers(
+				serviceMocks.NewUserServiceMock(mc),
+				auditServiceMock,
+				cryptoMocks.NewPasswordHasherMock(mc),
+			)
+
+			resp, err := api.ListAuditEvents(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
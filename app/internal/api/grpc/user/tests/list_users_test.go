@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userAPI "github.com/Prrromanssss/auth/internal/api/grpc/user"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/service"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	type userServiceMockFunc func(mc *minimock.Controller) service.UserService
+
+	type args struct {
+		ctx context.Context
+		req *pb.ListUsersRequest
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id          = gofakeit.Int64()
+		name        = gofakeit.Name()
+		email       = gofakeit.Email()
+		role        = pb.Role_USER
+		roleID      = int64(role)
+		pageToken   = gofakeit.UUID()
+		nextToken   = gofakeit.UUID()
+		createdFrom = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		createdTo   = time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC)
+		createdAt   = time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC)
+		updatedAt   = time.Date(2024, 9, 3, 12, 0, 0, 0, time.UTC)
+
+		ErrService = errors.New("service error")
+
+		req = &pb.ListUsersRequest{
+			PageSize:    10,
+			PageToken:   pageToken,
+			Role:        &role,
+			EmailPrefix: "john",
+			CreatedFrom: timestamppb.New(createdFrom),
+			CreatedTo:   timestamppb.New(createdTo),
+			SortOrder:   pb.SortOrder_ASC,
+		}
+
+		resp = &pb.ListUsersResponse{
+			Users: []*pb.User{
+				{
+					Id:        id,
+					Name:      name,
+					Email:     email,
+					Role:      role,
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+				},
+			},
+			NextPageToken: nextToken,
+		}
+
+		serviceParams = model.ListUsersParams{
+			PageSize:  10,
+			PageToken: pageToken,
+			Filter: model.ListUsersFilter{
+				Role:        &roleID,
+				EmailPrefix: "john",
+				CreatedFrom: &createdFrom,
+				CreatedTo:   &createdTo,
+			},
+			SortOrder: model.SortOrderAsc,
+		}
+
+		serviceResp = model.ListUsersResponse{
+			Users: []model.User{
+				{
+					UserID:    id,
+					Name:      name,
+					Email:     email,
+					Role:      roleID,
+					CreatedAt: createdAt,
+					UpdatedAt: updatedAt,
+				},
+			},
+			NextPageToken: nextToken,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		args            args
+		want            *pb.ListUsersResponse
+		err             error
+		userServiceMock userServiceMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: resp,
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, serviceParams).Return(serviceResp, nil)
+				return mock
+			},
+		},
+		{
+			name: "empty filter",
+			args: args{
+				ctx: ctx,
+				req: &pb.ListUsersRequest{},
+			},
+			want: &pb.ListUsersResponse{Users: []*pb.User{}},
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, model.ListUsersParams{}).Return(model.ListUsersResponse{}, nil)
+				return mock
+			},
+		},
+		{
+			name: "invalid page token",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  model.ErrInvalidPageToken,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, serviceParams).Return(model.ListUsersResponse{}, model.ErrInvalidPageToken)
+				return mock
+			},
+		},
+		{
+			name: "service error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: nil,
+			err:  ErrService,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.ListUsersMock.Expect(ctx, serviceParams).Return(model.ListUsersResponse{}, ErrService)
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			userServiceMock := tt.userServiceMock(mc)
+			api := userAPI.NewGRPCHandlers(userServiceMock, serviceMocks.NewAuditServiceMock(mc), cryptoMocks.NewPasswordHasherMock(mc))
+
+			resp, err := api.ListUsers(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
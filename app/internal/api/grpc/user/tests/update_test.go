@@ -15,6 +15,7 @@ import (
 	"github.com/Prrromanssss/auth/internal/model"
 	"github.com/Prrromanssss/auth/internal/service"
 	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
 
@@ -118,7 +119,7 @@ func TestUpdate(t *testing.T) {
 			t.Parallel()
 
 			userServiceMock := tt.userServiceMock(mc)
-			api := userAPI.NewGRPCHandlers(userServiceMock)
+			api := userAPI.NewGRPCHandlers(userServiceMock, serviceMocks.NewAuditServiceMock(mc), cryptoMocks.NewPasswordHasherMock(mc))
 
 			resp, err := api.Update(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -2,13 +2,13 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
+	"expvar"
 	"io"
 	"net"
 	"net/http"
 	"os"
-	"os/signal"
-	"sync"
-	"syscall"
+	"strings"
 	"time"
 
 	"github.com/Prrromanssss/platform_common/pkg/closer"
@@ -17,12 +17,23 @@ import (
 	"github.com/pkg/errors"
 	"github.com/rakyll/statik/fs"
 	"github.com/rs/cors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/certs"
 	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/lifecycle"
+	"github.com/Prrromanssss/auth/internal/metrics"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
+	"github.com/Prrromanssss/auth/internal/tracing"
+	accessPb "github.com/Prrromanssss/auth/pkg/access_v1"
+	authPb "github.com/Prrromanssss/auth/pkg/auth_v1"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 	_ "github.com/Prrromanssss/auth/statik"
 )
@@ -33,6 +44,10 @@ type App struct {
 	grpcServer      *grpc.Server
 	httpServer      *http.Server
 	swaggerServer   *http.Server
+	adminServer     *http.Server
+
+	// certReloads reload the certificates of the servers and of the gateway client.
+	certReloads []lifecycle.Component
 }
 
 // NewApp creates a new instance of App.
@@ -47,70 +62,91 @@ func NewApp(ctx context.Context) (*App, error) {
 	return a, nil
 }
 
-// Run runs the App.
-func (a *App) Run(ctx context.Context, cancel context.CancelFunc) error {
+// Run runs the App until the context is done or one of its components fails, then shuts it down.
+func (a *App) Run(ctx context.Context) error {
 	defer func() {
 		closer.CloseAll()
 		closer.Wait()
 	}()
 
-	wg := &sync.WaitGroup{}
-
-	wg.Add(4)
-
-	// Starting gRPC server.
-	go func() {
-		defer wg.Done()
-
-		err := a.runGRPCServer()
-		if err != nil {
-			log.Panic(err)
-		}
-	}()
-
-	// Starting HTTP server.
-	go func() {
-		defer wg.Done()
-
-		err := a.runHTTPServer()
-		if err != nil {
-			log.Panic(err)
-		}
-	}()
-
-	// Starting Swagger server.
-	go func() {
-		defer wg.Done()
-
-		err := a.runSwaggerServer()
-		if err != nil {
-			log.Panic(err)
-		}
-	}()
-
-	// Starting Kafka consumer.
-	go func() {
-		defer wg.Done()
+	manager := lifecycle.NewManager(a.cfg.Shutdown.Timeout)
+
+	manager.Add(a.certReloads...)
+
+	// The components are stopped in the reverse order: the servers stop taking requests first,
+	// then the consumer commits the offsets of the finished messages and the audit writer
+	// flushes the records of the requests served, before the closers release the connections.
+	manager.Add(
+		lifecycle.Component{
+			Name: "audit writer",
+			Run:  a.serviceProvider.AuditWriter(ctx).RunWriter,
+		},
+		lifecycle.Component{
+			Name: "audit checkpoints",
+			Run:  a.serviceProvider.AuditChainService(ctx).RunCheckpoints,
+		},
+		lifecycle.Component{
+			Name: "audit retention",
+			Run:  a.serviceProvider.AuditRetentionService(ctx).RunRetention,
+		},
+	)
 
-		err := a.serviceProvider.UserSaverConsumer(ctx).RunConsumer(ctx)
-		if err != nil {
-			log.Panicf("failed to run consumer: %s", err.Error())
-		}
-	}()
+	if a.cfg.UserCache.LocalEnabled {
+		manager.Add(lifecycle.Component{
+			Name: "user cache invalidation listener",
+			Run:  a.serviceProvider.LocalUserCache(ctx).RunInvalidation,
+		})
+	}
 
-	// Handle graceful shutdown.
-	a.gracefulShutdown(ctx, cancel, wg)
+	manager.Add(
+		lifecycle.Component{
+			Name: "outbox relay",
+			Run:  a.serviceProvider.OutboxRelay(ctx).RunRelay,
+		},
+		lifecycle.Component{
+			Name: "user saver consumer",
+			Run:  a.serviceProvider.UserSaverConsumer(ctx).RunConsumer,
+		},
+		lifecycle.Component{
+			Name: "admin server",
+			Run:  a.runAdminServer,
+			Stop: shutdownHTTPServer(a.adminServer),
+		},
+		lifecycle.Component{
+			Name: "gRPC server",
+			Run:  a.runGRPCServer,
+			Stop: a.stopGRPCServer,
+		},
+		lifecycle.Component{
+			Name: "HTTP server",
+			Run:  a.runHTTPServer,
+			Stop: shutdownHTTPServer(a.httpServer),
+		},
+		lifecycle.Component{
+			Name: "Swagger server",
+			Run:  a.runSwaggerServer,
+			Stop: shutdownHTTPServer(a.swaggerServer),
+		},
+		lifecycle.Component{
+			Name: "health checks",
+			Run:  a.serviceProvider.HealthMonitor(ctx).RunChecks,
+			Stop: a.drain,
+		},
+	)
 
-	return nil
+	return manager.Run(ctx)
 }
 
 func (a *App) initDeps(ctx context.Context) error {
 	inits := []func(ctx context.Context) error{
 		a.initConfig,
+		a.initLogger,
+		a.initTracing,
 		a.initServiceProvider,
 		a.initGRPCServer,
 		a.initHTTPServer,
 		a.initSwaggerServer,
+		a.initAdminServer,
 	}
 
 	for _, f := range inits {
@@ -136,6 +172,29 @@ func (a *App) initConfig(_ context.Context) error {
 	return nil
 }
 
+// initLogger installs the redacting logger before anything else logs through fiber.
+func (a *App) initLogger(_ context.Context) error {
+	redact.SetPII(a.cfg.Redaction.RedactEmails)
+	redact.RegisterJSON(model.CreateUserKafkaParams{})
+	log.SetLogger(redact.NewLogger(os.Stderr))
+
+	return nil
+}
+
+// initTracing installs the tracer provider before the servers and clients that start spans are created.
+func (a *App) initTracing(ctx context.Context) error {
+	shutdown, err := tracing.Init(ctx, a.cfg.Tracing)
+	if err != nil {
+		return err
+	}
+
+	closer.Add(func() error {
+		return shutdown(context.Background())
+	})
+
+	return nil
+}
+
 func (a *App) initServiceProvider(_ context.Context) error {
 	a.serviceProvider = newServiceProvider(a.cfg)
 
@@ -143,26 +202,76 @@ func (a *App) initServiceProvider(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
+	tlsConfig, err := a.serverTLS("gRPC server", a.cfg.GRPC.TLS)
+	if err != nil {
+		return err
+	}
+
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
 	a.grpcServer = grpc.NewServer(
-		grpc.Creds(insecure.NewCredentials()),
-		grpc.UnaryInterceptor(interceptor.ValidateInterceptor),
+		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			interceptor.MetricsInterceptor,
+			interceptor.ErrorsInterceptor,
+			a.serviceProvider.AuditInterceptor(ctx).Unary,
+			a.serviceProvider.RateLimitInterceptor().UnaryByClient,
+			a.serviceProvider.AuthInterceptor().Unary,
+			a.serviceProvider.RateLimitInterceptor().UnaryByUser,
+			interceptor.ValidateInterceptor,
+		),
 	)
 
 	reflection.Register(a.grpcServer)
 
 	pb.RegisterUserV1Server(a.grpcServer, a.serviceProvider.UserAPI(ctx))
+	authPb.RegisterAuthV1Server(a.grpcServer, a.serviceProvider.AuthAPI(ctx))
+	accessPb.RegisterAccessV1Server(a.grpcServer, a.serviceProvider.AccessAPI(ctx))
+
+	a.serviceProvider.HealthMonitor(ctx).Register(a.grpcServer)
 
 	return nil
 }
 
 func (a *App) initHTTPServer(ctx context.Context) error {
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithMiddlewares(tracing.HTTPMiddleware, metrics.HTTPMiddleware),
+	)
+
+	creds, err := a.gatewayCredentials()
+	if err != nil {
+		return err
+	}
+
+	// The connection outlives the context, which is done on shutdown while the gateway drains,
+	// and is closed with the other resources.
+	conn, err := grpc.NewClient(
+		a.cfg.GRPC.Address(),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return err
+	}
+	closer.Add(conn.Close)
 
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	err = pb.RegisterUserV1Handler(ctx, mux, conn)
+	if err != nil {
+		return err
 	}
 
-	err := pb.RegisterUserV1HandlerFromEndpoint(ctx, mux, a.cfg.GRPC.Address(), opts)
+	err = authPb.RegisterAuthV1Handler(ctx, mux, conn)
+	if err != nil {
+		return err
+	}
+
+	err = accessPb.RegisterAccessV1Handler(ctx, mux, conn)
 	if err != nil {
 		return err
 	}
@@ -174,10 +283,16 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		AllowCredentials: true,
 	})
 
+	tlsConfig, err := a.serverTLS("HTTP server", a.cfg.HTTP.TLS)
+	if err != nil {
+		return err
+	}
+
 	a.httpServer = &http.Server{
 		ReadHeaderTimeout: 10 * time.Second,
 		Addr:              a.cfg.HTTP.Address(),
-		Handler:           corsMiddleware.Handler(mux),
+		Handler:           corsMiddleware.Handler(tracing.HTTPHandler(mux)),
+		TLSConfig:         tlsConfig,
 	}
 
 	return nil
@@ -194,16 +309,48 @@ func (a *App) initSwaggerServer(ctx context.Context) error {
 	mux.Handle("/", http.StripPrefix("/", http.FileServer(statikFs)))
 	mux.HandleFunc("/api.swagger.json", serveSwaggerFile("/api.swagger.json"))
 
+	tlsConfig, err := a.serverTLS("Swagger server", a.cfg.Swagger.TLS)
+	if err != nil {
+		return err
+	}
+
 	a.swaggerServer = &http.Server{
 		ReadHeaderTimeout: 10 * time.Second,
 		Addr:              a.cfg.Swagger.Address(),
 		Handler:           mux,
+		TLSConfig:         tlsConfig,
+	}
+
+	return nil
+}
+
+// initAdminServer serves the operational endpoints on their own port, away from the public API.
+func (a *App) initAdminServer(ctx context.Context) error {
+	metrics.Registry.MustRegister(a.serviceProvider.UserSaverLagCollector())
+
+	mux := http.NewServeMux()
+
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/healthz", a.serviceProvider.HealthMonitor(ctx).LivenessHandler())
+	mux.Handle("/readyz", a.serviceProvider.HealthMonitor(ctx).ReadinessHandler())
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	tlsConfig, err := a.serverTLS("admin server", a.cfg.Admin.TLS)
+	if err != nil {
+		return err
+	}
+
+	a.adminServer = &http.Server{
+		ReadHeaderTimeout: 10 * time.Second,
+		Addr:              a.cfg.Admin.Address(),
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
 	}
 
 	return nil
 }
 
-func (a *App) runGRPCServer() error {
+func (a *App) runGRPCServer(_ context.Context) error {
 	listener, err := net.Listen(
 		"tcp",
 		a.cfg.GRPC.Address(),
@@ -221,28 +368,177 @@ func (a *App) runGRPCServer() error {
 	return nil
 }
 
-func (a *App) runHTTPServer() error {
-	log.Infof("Starting HTTP server on %s", a.cfg.HTTP.Address())
+// serverTLS returns the TLS configuration of the server and adds the reload of its certificates to the App,
+// nil if the server does not use TLS.
+func (a *App) serverTLS(name string, cfg yaml.TLS) (*tls.Config, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
 
-	err := a.httpServer.ListenAndServe()
+	err := cfg.Validate()
 	if err != nil {
-		return err
+		return nil, errors.Wrapf(err, "%s", name)
 	}
 
-	return nil
+	minVersion, err := yaml.MinTLSVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := a.certStore(name, certs.Files{
+		CertFile: cfg.CertFile,
+		KeyFile:  cfg.KeyFile,
+		CAFile:   cfg.ClientCAFile,
+	}, cfg.ReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return certs.ServerConfig(store, minVersion), nil
 }
 
-func (a *App) runSwaggerServer() error {
-	log.Infof("Starting Swagger server on %s", a.cfg.Swagger.Address())
+// gatewayCredentials returns the credentials the gateway calls the gRPC server with, matching the TLS
+// of the gRPC server.
+func (a *App) gatewayCredentials() (credentials.TransportCredentials, error) {
+	if !a.cfg.GRPC.TLS.Enabled {
+		return insecure.NewCredentials(), nil
+	}
 
-	err := a.swaggerServer.ListenAndServe()
+	err := a.cfg.GatewayTLS.Validate(a.cfg.GRPC.TLS)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	minVersion, err := yaml.MinTLSVersion(a.cfg.GRPC.TLS.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := a.certStore("gateway client", certs.Files{
+		CertFile: a.cfg.GatewayTLS.CertFile,
+		KeyFile:  a.cfg.GatewayTLS.KeyFile,
+		CAFile:   a.cfg.GatewayTLS.CAFile,
+	}, a.cfg.GatewayTLS.ReloadInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(certs.ClientConfig(store, a.cfg.GatewayTLS.ServerName, minVersion)), nil
+}
+
+func (a *App) certStore(name string, files certs.Files, interval time.Duration) (*certs.Store, error) {
+	store, err := certs.NewStore(files, interval)
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s", name)
+	}
+
+	a.certReloads = append(a.certReloads, lifecycle.Component{
+		Name: name + " certificates reload",
+		Run:  store.RunReload,
+	})
+
+	return store, nil
+}
+
+// stopGRPCServer waits for the calls in progress and cancels them when the context is done.
+func (a *App) stopGRPCServer(ctx context.Context) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		a.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		a.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+func (a *App) runHTTPServer(_ context.Context) error {
+	log.Infof("Starting HTTP server on %s", a.cfg.HTTP.Address())
+
+	return listenAndServe(a.httpServer)
+}
+
+func (a *App) runSwaggerServer(_ context.Context) error {
+	log.Infof("Starting Swagger server on %s", a.cfg.Swagger.Address())
+
+	return listenAndServe(a.swaggerServer)
+}
+
+func (a *App) runAdminServer(_ context.Context) error {
+	log.Infof("Starting admin server on %s", a.cfg.Admin.Address())
+
+	return listenAndServe(a.adminServer)
+}
+
+// listenAndServe serves until the server is shut down, over TLS when the server has a TLS configuration.
+func listenAndServe(server *http.Server) error {
+	var err error
+	if server.TLSConfig != nil {
+		// The certificate comes from the configuration.
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return errors.Wrapf(err, "Error serving on %s", server.Addr)
 	}
 
 	return nil
 }
 
+// shutdownHTTPServer waits for the requests in progress and closes their connections when the context is done.
+func shutdownHTTPServer(server *http.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		err := server.Shutdown(ctx)
+		if err != nil {
+			_ = server.Close()
+			return err
+		}
+
+		return nil
+	}
+}
+
+// drain reports the App as not serving, so that load balancers stop sending it requests
+// before the servers stop taking them.
+func (a *App) drain(ctx context.Context) error {
+	a.serviceProvider.HealthMonitor(ctx).Shutdown()
+
+	select {
+	case <-time.After(a.cfg.Health.DrainDelay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// incomingHeaderMatcher forwards the request id to the gRPC server in addition to the headers forwarded
+// by default. The trace context is passed on by the tracing of the gateway client.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "x-request-id":
+		return strings.ToLower(key), true
+	default:
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// outgoingHeaderMatcher passes the retry-after metadata of rate limited calls on as the Retry-After header
+// of the 429 Too Many Requests responses, the other metadata keep the default prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == interceptor.RetryAfterHeader {
+		return "Retry-After", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func serveSwaggerFile(path string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log.Infof("Serving swagger file: %s", path)
@@ -287,29 +583,3 @@ func serveSwaggerFile(path string) http.HandlerFunc {
 		log.Infof("Served swagger file: %s", path)
 	}
 }
-
-func (a *App) gracefulShutdown(ctx context.Context, cancel context.CancelFunc, wg *sync.WaitGroup) {
-	select {
-	case <-ctx.Done():
-		log.Info("terminating: context cancelled")
-	case <-waitSignal():
-		log.Info("terminating: via signal")
-	}
-
-	a.grpcServer.GracefulStop()
-	err := a.httpServer.Shutdown(ctx)
-	if err != nil {
-		log.Panicf("cannot shutdown http server: %+v", err)
-	}
-
-	cancel()
-	if wg != nil {
-		wg.Wait()
-	}
-}
-
-func waitSignal() chan os.Signal {
-	sigterm := make(chan os.Signal, 1)
-	signal.Notify(sigterm, syscall.SIGINT, syscall.SIGTERM)
-	return sigterm
-}
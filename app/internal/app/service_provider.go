@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/IBM/sarama"
 	redisCache "github.com/Prrromanssss/platform_common/pkg/cache"
@@ -14,17 +15,45 @@ import (
 	"github.com/Prrromanssss/platform_common/pkg/kafka"
 	kafkaConsumer "github.com/Prrromanssss/platform_common/pkg/kafka/consumer"
 	redigo "github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	accessAPI "github.com/Prrromanssss/auth/internal/api/grpc/access"
+	authAPI "github.com/Prrromanssss/auth/internal/api/grpc/auth"
 	userAPI "github.com/Prrromanssss/auth/internal/api/grpc/user"
 	"github.com/Prrromanssss/auth/internal/cache"
+	accessCache "github.com/Prrromanssss/auth/internal/cache/access"
+	"github.com/Prrromanssss/auth/internal/cache/invalidation"
+	localCache "github.com/Prrromanssss/auth/internal/cache/local"
 	userCache "github.com/Prrromanssss/auth/internal/cache/user"
+	"github.com/Prrromanssss/auth/internal/health"
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/metrics"
+	"github.com/Prrromanssss/auth/internal/pagination"
+	"github.com/Prrromanssss/auth/internal/ratelimit"
 	"github.com/Prrromanssss/auth/internal/repository"
+	accessRepository "github.com/Prrromanssss/auth/internal/repository/access"
+	auditArchiveRepository "github.com/Prrromanssss/auth/internal/repository/audit_archive"
 	logRepository "github.com/Prrromanssss/auth/internal/repository/log"
+	loginFailureRepository "github.com/Prrromanssss/auth/internal/repository/login_failure"
+	outboxRepository "github.com/Prrromanssss/auth/internal/repository/outbox"
+	refreshTokenRepository "github.com/Prrromanssss/auth/internal/repository/refresh_token"
 	userRepository "github.com/Prrromanssss/auth/internal/repository/user"
 	"github.com/Prrromanssss/auth/internal/service"
+	accessService "github.com/Prrromanssss/auth/internal/service/access"
+	auditService "github.com/Prrromanssss/auth/internal/service/audit"
+	auditChain "github.com/Prrromanssss/auth/internal/service/audit_chain"
+	auditRetention "github.com/Prrromanssss/auth/internal/service/audit_retention"
+	auditWriter "github.com/Prrromanssss/auth/internal/service/audit_writer"
+	authService "github.com/Prrromanssss/auth/internal/service/auth"
 	userSaverConsumer "github.com/Prrromanssss/auth/internal/service/consumer/user_saver"
+	outboxRelay "github.com/Prrromanssss/auth/internal/service/outbox_relay"
 	userService "github.com/Prrromanssss/auth/internal/service/user"
+	"github.com/Prrromanssss/auth/internal/token"
+	jwtToken "github.com/Prrromanssss/auth/internal/token/jwt"
+	"github.com/Prrromanssss/auth/internal/tracing"
+	"github.com/Prrromanssss/auth/pkg/crypto"
 )
 
 type serviceProvider struct {
@@ -36,18 +65,50 @@ type serviceProvider struct {
 	redisPool   *redigo.Pool
 	redisClient redisCache.RedisClient
 
-	userRepository repository.UserRepository
-	logRepository  repository.LogRepository
-
-	userCache   cache.UserCache
-	userService service.UserService
-	userAPI     *userAPI.GRPCHandlers
+	userRepository         repository.UserRepository
+	logRepository          repository.LogRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	loginFailureRepository repository.LoginFailureRepository
+	accessRepository       repository.AccessRepository
+	outboxRepository       repository.OutboxRepository
+	auditArchiveRepository repository.AuditArchiveRepository
+
+	userCache      cache.UserCache
+	redisUserCache cache.UserCache
+	localUserCache cache.LocalUserCache
+	pageTokenCodec pagination.PageTokenCodec
+	userService    service.UserService
+	auditService   service.AuditService
+	auditWriter    service.AuditWriter
+	auditChain     service.AuditChainService
+	auditRetention service.AuditRetentionService
+	userAPI        *userAPI.GRPCHandlers
+
+	tokenManager   token.TokenManager
+	passwordHasher crypto.PasswordHasher
+	authService    service.AuthService
+	authAPI        *authAPI.GRPCHandlers
+
+	accessCache   cache.AccessCache
+	accessService service.AccessService
+	accessAPI     *accessAPI.GRPCHandlers
+
+	authInterceptor      *interceptor.AuthInterceptor
+	auditInterceptor     *interceptor.AuditInterceptor
+	rateLimitInterceptor *interceptor.RateLimitInterceptor
 
 	userSaverConsumer service.ConsumerService
 
 	consumer             kafka.Consumer
-	consumerGroup        sarama.ConsumerGroup
+	consumerGroup        *health.ConsumerGroup
 	consumerGroupHandler *kafkaConsumer.GroupHandler
+
+	outboxRelay  service.OutboxRelayService
+	syncProducer sarama.SyncProducer
+
+	userSaverLagCollector prometheus.Collector
+
+	healthMonitor *health.Monitor
 }
 
 func newServiceProvider(cfg *config.Config) *serviceProvider {
@@ -69,7 +130,7 @@ func (s *serviceProvider) DBClient(ctx context.Context) db.Client {
 		}
 		closer.Add(cl.Close)
 
-		s.db = cl
+		s.db = metrics.NewDBClient(tracing.NewDBClient(cl))
 	}
 
 	return s.db
@@ -93,7 +154,7 @@ func (s *serviceProvider) RedisPool() *redigo.Pool {
 
 func (s *serviceProvider) RedisClient(ctx context.Context) redisCache.RedisClient {
 	if s.redisClient == nil {
-		s.redisClient = redis.NewClient(s.RedisPool(), s.cfg.Redis.ConnectionTimeout)
+		s.redisClient = tracing.NewRedisClient(redis.NewClient(s.RedisPool(), s.cfg.Redis.ConnectionTimeout))
 
 		err := s.redisClient.Ping(ctx)
 		if err != nil {
@@ -115,26 +176,110 @@ func (s *serviceProvider) UserRepository(ctx context.Context) repository.UserRep
 
 func (s *serviceProvider) LogRepository(ctx context.Context) repository.LogRepository {
 	if s.logRepository == nil {
-		s.logRepository = logRepository.NewRepository(s.DBClient(ctx))
+		s.logRepository = logRepository.NewRepository(s.DBClient(ctx), s.TxManager(ctx))
 	}
 
 	return s.logRepository
 }
 
+func (s *serviceProvider) RefreshTokenRepository(ctx context.Context) repository.RefreshTokenRepository {
+	if s.refreshTokenRepository == nil {
+		s.refreshTokenRepository = refreshTokenRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.refreshTokenRepository
+}
+
+func (s *serviceProvider) LoginFailureRepository(ctx context.Context) repository.LoginFailureRepository {
+	if s.loginFailureRepository == nil {
+		s.loginFailureRepository = loginFailureRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.loginFailureRepository
+}
+
+func (s *serviceProvider) AccessRepository(ctx context.Context) repository.AccessRepository {
+	if s.accessRepository == nil {
+		s.accessRepository = accessRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.accessRepository
+}
+
+func (s *serviceProvider) OutboxRepository(ctx context.Context) repository.OutboxRepository {
+	if s.outboxRepository == nil {
+		s.outboxRepository = outboxRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.outboxRepository
+}
+
+func (s *serviceProvider) AuditArchiveRepository(ctx context.Context) repository.AuditArchiveRepository {
+	if s.auditArchiveRepository == nil {
+		s.auditArchiveRepository = auditArchiveRepository.NewRepository(s.DBClient(ctx))
+	}
+
+	return s.auditArchiveRepository
+}
+
 func (s *serviceProvider) UserCache(ctx context.Context) cache.UserCache {
 	if s.userCache == nil {
-		s.userCache = userCache.NewCache(s.RedisClient(ctx))
+		if s.cfg.UserCache.LocalEnabled {
+			s.userCache = s.LocalUserCache(ctx)
+		} else {
+			s.userCache = s.RedisUserCache(ctx)
+		}
 	}
 
 	return s.userCache
 }
 
+func (s *serviceProvider) RedisUserCache(ctx context.Context) cache.UserCache {
+	if s.redisUserCache == nil {
+		s.redisUserCache = userCache.NewCache(
+			s.RedisClient(ctx),
+			s.RedisPool(),
+			s.cfg.UserCache.TTL,
+			s.cfg.UserCache.TTLJitter,
+			s.cfg.UserCache.NegativeTTL,
+			s.cfg.UserCache.TombstoneTTL,
+		)
+	}
+
+	return s.redisUserCache
+}
+
+func (s *serviceProvider) LocalUserCache(ctx context.Context) cache.LocalUserCache {
+	if s.localUserCache == nil {
+		s.localUserCache = localCache.NewCache(
+			s.RedisUserCache(ctx),
+			invalidation.NewBus(s.RedisPool(), s.cfg.UserCache.InvalidationChannel),
+			s.cfg.UserCache.LocalSize,
+			s.cfg.UserCache.LocalTTL,
+		)
+	}
+
+	return s.localUserCache
+}
+
+func (s *serviceProvider) PageTokenCodec() pagination.PageTokenCodec {
+	if s.pageTokenCodec == nil {
+		s.pageTokenCodec = pagination.NewPageTokenCodec(s.cfg.Pagination.PageTokenSecretKey)
+	}
+
+	return s.pageTokenCodec
+}
+
 func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 	if s.userService == nil {
 		s.userService = userService.NewService(
 			s.UserRepository(ctx),
 			s.LogRepository(ctx),
+			s.OutboxRepository(ctx),
+			s.LoginFailureRepository(ctx),
+			s.AuditWriter(ctx),
 			s.UserCache(ctx),
+			s.PageTokenCodec(),
 			s.TxManager(ctx),
 		)
 	}
@@ -142,17 +287,145 @@ func (s *serviceProvider) UserService(ctx context.Context) service.UserService {
 	return s.userService
 }
 
+func (s *serviceProvider) AuditService(ctx context.Context) service.AuditService {
+	if s.auditService == nil {
+		s.auditService = auditService.NewService(s.LogRepository(ctx), s.PageTokenCodec())
+	}
+
+	return s.auditService
+}
+
+func (s *serviceProvider) AuditWriter(ctx context.Context) service.AuditWriter {
+	if s.auditWriter == nil {
+		s.auditWriter = auditWriter.NewService(s.cfg, s.LogRepository(ctx))
+	}
+
+	return s.auditWriter
+}
+
+func (s *serviceProvider) AuditChainService(ctx context.Context) service.AuditChainService {
+	if s.auditChain == nil {
+		signingKey, err := s.cfg.AuditChain.SigningKey()
+		if err != nil {
+			log.Panicf("failed to get audit checkpoint signing key: %s", err.Error())
+		}
+
+		s.auditChain = auditChain.NewService(s.cfg, s.LogRepository(ctx), s.AuditArchiveRepository(ctx), signingKey)
+	}
+
+	return s.auditChain
+}
+
+func (s *serviceProvider) AuditRetentionService(ctx context.Context) service.AuditRetentionService {
+	if s.auditRetention == nil {
+		s.auditRetention = auditRetention.NewService(s.cfg, s.AuditArchiveRepository(ctx), s.TxManager(ctx))
+	}
+
+	return s.auditRetention
+}
+
 func (s *serviceProvider) UserAPI(ctx context.Context) *userAPI.GRPCHandlers {
 	if s.userAPI == nil {
-		s.userAPI = userAPI.NewGRPCHandlers(s.UserService(ctx))
+		s.userAPI = userAPI.NewGRPCHandlers(s.UserService(ctx), s.AuditService(ctx), s.PasswordHasher())
 	}
 
 	return s.userAPI
 }
 
+func (s *serviceProvider) TokenManager() token.TokenManager {
+	if s.tokenManager == nil {
+		s.tokenManager = jwtToken.NewManager(s.cfg.JWT)
+	}
+
+	return s.tokenManager
+}
+
+func (s *serviceProvider) PasswordHasher() crypto.PasswordHasher {
+	if s.passwordHasher == nil {
+		s.passwordHasher = crypto.NewPasswordHasher(s.cfg.Argon2.Params())
+	}
+
+	return s.passwordHasher
+}
+
+// AuthService counts the failed logins and locks them out as the login lockout config requires.
+func (s *serviceProvider) AuthService(ctx context.Context) service.AuthService {
+	if s.authService == nil {
+		err := s.cfg.LoginLockout.Validate()
+		if err != nil {
+			log.Panicf("invalid login lockout config: %s", err.Error())
+		}
+
+		s.authService = authService.NewService(
+			s.cfg,
+			s.UserRepository(ctx),
+			s.RefreshTokenRepository(ctx),
+			s.LoginFailureRepository(ctx),
+			s.LogRepository(ctx),
+			s.TokenManager(),
+			s.PasswordHasher(),
+			s.TxManager(ctx),
+		)
+	}
+
+	return s.authService
+}
+
+func (s *serviceProvider) AuthAPI(ctx context.Context) *authAPI.GRPCHandlers {
+	if s.authAPI == nil {
+		s.authAPI = authAPI.NewGRPCHandlers(s.AuthService(ctx))
+	}
+
+	return s.authAPI
+}
+
+func (s *serviceProvider) AccessCache(ctx context.Context) cache.AccessCache {
+	if s.accessCache == nil {
+		s.accessCache = accessCache.NewCache(s.RedisClient(ctx), s.RedisPool(), s.cfg.Access.DecisionCacheTTL)
+	}
+
+	return s.accessCache
+}
+
+func (s *serviceProvider) AccessService(ctx context.Context) service.AccessService {
+	if s.accessService == nil {
+		s.accessService = accessService.NewService(
+			s.AccessRepository(ctx),
+			s.AccessCache(ctx),
+			s.TokenManager(),
+		)
+	}
+
+	return s.accessService
+}
+
+func (s *serviceProvider) AccessAPI(ctx context.Context) *accessAPI.GRPCHandlers {
+	if s.accessAPI == nil {
+		s.accessAPI = accessAPI.NewGRPCHandlers(s.AccessService(ctx))
+	}
+
+	return s.accessAPI
+}
+
+func (s *serviceProvider) AuditInterceptor(ctx context.Context) *interceptor.AuditInterceptor {
+	if s.auditInterceptor == nil {
+		s.auditInterceptor = interceptor.NewAuditInterceptor(s.LogRepository(ctx), userAPI.AuditedMethods())
+	}
+
+	return s.auditInterceptor
+}
+
+func (s *serviceProvider) AuthInterceptor() *interceptor.AuthInterceptor {
+	if s.authInterceptor == nil {
+		s.authInterceptor = interceptor.NewAuthInterceptor(s.TokenManager(), userAPI.Policies())
+	}
+
+	return s.authInterceptor
+}
+
 func (s *serviceProvider) TxManager(ctx context.Context) db.TxManager {
 	if s.txManager == nil {
-		s.txManager = transaction.NewTransactionManager(s.DBClient(ctx).DB())
+		s.txManager = tracing.NewTxManager(transaction.NewTransactionManager(s.DBClient(ctx).DB()))
 	}
 
 	return s.txManager
@@ -162,8 +435,10 @@ func (s *serviceProvider) UserSaverConsumer(ctx context.Context) service.Consume
 	if s.userSaverConsumer == nil {
 		s.userSaverConsumer = userSaverConsumer.NewService(
 			s.cfg,
-			s.UserRepository(ctx),
+			s.UserService(ctx),
+			s.PasswordHasher(),
 			s.Consumer(),
+			s.SyncProducer(),
 		)
 	}
 
@@ -182,7 +457,35 @@ func (s *serviceProvider) Consumer() kafka.Consumer {
 	return s.consumer
 }
 
-func (s *serviceProvider) ConsumerGroup() sarama.ConsumerGroup {
+// RateLimitInterceptor limits the calls with buckets in Redis and in memory while Redis is unavailable.
+// Without rate limits it lets every call through.
+func (s *serviceProvider) RateLimitInterceptor() *interceptor.RateLimitInterceptor {
+	if s.rateLimitInterceptor == nil {
+		err := s.cfg.RateLimit.Validate()
+		if err != nil {
+			log.Panicf("invalid rate limit config: %s", err.Error())
+		}
+
+		var rules []yaml.RateLimitRule
+		if s.cfg.RateLimit.Enabled {
+			rules = s.cfg.RateLimit.Rules
+		}
+
+		s.rateLimitInterceptor = interceptor.NewRateLimitInterceptor(
+			ratelimit.NewFallbackLimiter(
+				ratelimit.NewRedisLimiter(s.RedisPool()),
+				ratelimit.NewLocalLimiter(),
+				s.cfg.RateLimit.RedisRetryInterval,
+			),
+			rules,
+		)
+	}
+
+	return s.rateLimitInterceptor
+}
+
+// ConsumerGroup keeps track of the session of the group for the health checks.
+func (s *serviceProvider) ConsumerGroup() *health.ConsumerGroup {
 	if s.consumerGroup == nil {
 		consumerGroup, err := sarama.NewConsumerGroup(
 			s.cfg.KafkaConsumer.BrokersList(),
@@ -193,7 +496,7 @@ func (s *serviceProvider) ConsumerGroup() sarama.ConsumerGroup {
 			log.Fatalf("failed to create consumer group: %v", err)
 		}
 
-		s.consumerGroup = consumerGroup
+		s.consumerGroup = health.NewConsumerGroup(consumerGroup)
 	}
 
 	return s.consumerGroup
@@ -206,3 +509,74 @@ func (s *serviceProvider) ConsumerGroupHandler() *kafkaConsumer.GroupHandler {
 
 	return s.consumerGroupHandler
 }
+
+// UserSaverLagCollector reports the lag of the user saver consumer group. It reads the offsets
+// through a client of its own, so that scrapes do not go through the consumer.
+func (s *serviceProvider) UserSaverLagCollector() prometheus.Collector {
+	if s.userSaverLagCollector == nil {
+		client, err := sarama.NewClient(s.cfg.KafkaConsumer.BrokersList(), s.cfg.KafkaConsumer.Config())
+		if err != nil {
+			log.Fatalf("failed to create kafka client: %v", err)
+		}
+
+		admin, err := sarama.NewClusterAdminFromClient(client)
+		if err != nil {
+			log.Fatalf("failed to create kafka cluster admin: %v", err)
+		}
+		// Closing the admin closes its client.
+		closer.Add(admin.Close)
+
+		s.userSaverLagCollector = metrics.NewConsumerLagCollector(
+			client,
+			admin,
+			"user_saver",
+			s.cfg.KafkaConsumer.GroupID,
+			strings.Split(s.cfg.KafkaConsumer.UsersCreationTopicName, ","),
+		)
+	}
+
+	return s.userSaverLagCollector
+}
+
+func (s *serviceProvider) OutboxRelay(ctx context.Context) service.OutboxRelayService {
+	if s.outboxRelay == nil {
+		s.outboxRelay = outboxRelay.NewService(
+			s.cfg,
+			s.OutboxRepository(ctx),
+			s.SyncProducer(),
+			s.TxManager(ctx),
+		)
+	}
+
+	return s.outboxRelay
+}
+
+func (s *serviceProvider) SyncProducer() sarama.SyncProducer {
+	if s.syncProducer == nil {
+		syncProducer, err := sarama.NewSyncProducer(
+			s.cfg.KafkaProducer.BrokersList(),
+			s.cfg.KafkaProducer.Config(),
+		)
+		if err != nil {
+			log.Fatalf("failed to create sync producer: %v", err)
+		}
+		closer.Add(syncProducer.Close)
+
+		s.syncProducer = syncProducer
+	}
+
+	return s.syncProducer
+}
+
+func (s *serviceProvider) HealthMonitor(ctx context.Context) *health.Monitor {
+	if s.healthMonitor == nil {
+		s.healthMonitor = health.NewMonitor(
+			s.cfg.Health,
+			health.NewDBChecker(s.DBClient(ctx)),
+			health.NewRedisChecker(s.RedisClient(ctx)),
+			health.NewConsumerChecker("user_saver_consumer", s.ConsumerGroup()),
+		)
+	}
+
+	return s.healthMonitor
+}
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"strconv"
+	"time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+// nullLength marks a NULL column in the encoding of a record, it cannot be the length of a real value.
+const nullLength = ^uint32(0)
+
+// checkpointPrefix versions the signed statement of a checkpoint.
+const checkpointPrefix = "auth-audit-checkpoint:v1"
+
+// ChainHash returns the hash of an audit record chained to the hash of the previous record, nil for the first one.
+//
+// It is SHA-256 over the previous hash and the columns of the record as stored, each one prefixed with
+// its length, so that no two different records share an encoding and NULL differs from an empty value.
+func ChainHash(prevHash []byte, link model.AuditChainLink) []byte {
+	h := sha256.New()
+
+	writeValue(h, prevHash)
+	writeString(h, strconv.FormatInt(link.EventID, 10))
+	writeString(h, link.Action)
+	writeString(h, link.RequestData)
+	writeNullString(h, link.ResponseData)
+	writeNullInt64(h, link.ActorID)
+	writeNullInt64(h, link.TargetUserID)
+	writeNullString(h, link.SourceIP)
+	writeNullString(h, link.UserAgent)
+	writeNullString(h, link.Method)
+	writeString(h, link.StatusCode)
+	writeNullString(h, link.RequestID)
+	writeNullString(h, link.TraceID)
+	writeString(h, link.CreatedAt.UTC().Format(time.RFC3339Nano))
+
+	return h.Sum(nil)
+}
+
+// CheckpointPayload returns the statement signed by a checkpoint:
+//
+//	auth-audit-checkpoint:v1:<record id>:<hex hash of the record>:<RFC 3339 time of the checkpoint in UTC>
+func CheckpointPayload(checkpoint model.AuditCheckpoint) []byte {
+	return []byte(checkpointPrefix +
+		":" + strconv.FormatInt(checkpoint.EventID, 10) +
+		":" + hex.EncodeToString(checkpoint.Hash) +
+		":" + checkpoint.CreatedAt.UTC().Format(time.RFC3339Nano))
+}
+
+// SignCheckpoint returns the Ed25519 signature of the checkpoint.
+func SignCheckpoint(key ed25519.PrivateKey, checkpoint model.AuditCheckpoint) []byte {
+	return ed25519.Sign(key, CheckpointPayload(checkpoint))
+}
+
+// VerifyCheckpoint reports whether the checkpoint was signed by the holder of the private key.
+func VerifyCheckpoint(key ed25519.PublicKey, checkpoint model.AuditCheckpoint) bool {
+	return ed25519.Verify(key, CheckpointPayload(checkpoint), checkpoint.Signature)
+}
+
+func writeValue(h hash.Hash, value []byte) {
+	_ = binary.Write(h, binary.BigEndian, uint32(len(value)))
+	_, _ = h.Write(value)
+}
+
+func writeString(h hash.Hash, value string) {
+	writeValue(h, []byte(value))
+}
+
+func writeNullString(h hash.Hash, value *string) {
+	if value == nil {
+		_ = binary.Write(h, binary.BigEndian, nullLength)
+		return
+	}
+
+	writeString(h, *value)
+}
+
+func writeNullInt64(h hash.Hash, value *int64) {
+	if value == nil {
+		_ = binary.Write(h, binary.BigEndian, nullLength)
+		return
+	}
+
+	writeString(h, strconv.FormatInt(*value, 10))
+}
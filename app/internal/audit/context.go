@@ -0,0 +1,22 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+type metadataContextKey struct{}
+
+// NewContext returns a copy of the context that carries the audit metadata of the call.
+// The metadata is shared by pointer, so that interceptors further down the chain can complete it.
+func NewContext(ctx context.Context, md *model.AuditMetadata) context.Context {
+	return context.WithValue(ctx, metadataContextKey{}, md)
+}
+
+// FromContext returns the audit metadata of the call put into the context by NewContext.
+func FromContext(ctx context.Context) (*model.AuditMetadata, bool) {
+	md, ok := ctx.Value(metadataContextKey{}).(*model.AuditMetadata)
+
+	return md, ok
+}
@@ -0,0 +1,87 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cacheClient "github.com/Prrromanssss/platform_common/pkg/cache"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+	"github.com/Prrromanssss/auth/internal/cache/access/converter"
+	modelCache "github.com/Prrromanssss/auth/internal/cache/access/model"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/tracing"
+)
+
+type accessRedis struct {
+	cache cacheClient.RedisClient
+	pool  *redigo.Pool
+	ttl   time.Duration
+}
+
+// NewCache creates a new instance of accessRedis that keeps access decisions for the given TTL.
+// Decisions are not evicted when the access rules change, so the TTL bounds how long a stale decision is served.
+func NewCache(cache cacheClient.RedisClient, pool *redigo.Pool, ttl time.Duration) cache.AccessCache {
+	return &accessRedis{
+		cache: cache,
+		pool:  pool,
+		ttl:   ttl,
+	}
+}
+
+// Set stores the access decision under a key derived from the role and the endpoint address.
+// The value and its expiry are written by a single command, so a decision is never cached without a TTL.
+func (c *accessRedis) Set(ctx context.Context, params model.AccessDecision) (err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "SET")
+	defer func() { tracing.EndRedisSpan(span, err) }()
+
+	paramsCache := converter.ConvertAccessDecisionFromServiceToCache(params)
+
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Cannot get redis connection")
+	}
+	defer conn.Close()
+
+	_, err = redigo.DoContext(
+		conn,
+		ctx,
+		"SET",
+		decisionKey(paramsCache.EndpointAddress, paramsCache.Role),
+		paramsCache.Allowed,
+		"PX",
+		c.ttl.Milliseconds(),
+	)
+
+	return err
+}
+
+// Get retrieves the access decision for the role and the endpoint address.
+func (c *accessRedis) Get(
+	ctx context.Context,
+	params model.CheckAccessParams,
+) (resp model.AccessDecision, err error) {
+	paramsCache := converter.ConvertCheckAccessParamsFromServiceToCache(params)
+
+	allowed, err := redigo.Bool(c.cache.Get(ctx, decisionKey(paramsCache.EndpointAddress, paramsCache.Role)))
+	if err != nil {
+		if errors.Is(err, redigo.ErrNil) {
+			err = modelCache.ErrAccessDecisionNotFound
+		}
+
+		return
+	}
+
+	return converter.ConvertAccessDecisionFromCacheToService(modelCache.AccessDecision{
+		EndpointAddress: paramsCache.EndpointAddress,
+		Role:            paramsCache.Role,
+		Allowed:         allowed,
+	}), nil
+}
+
+func decisionKey(endpointAddress string, role int64) string {
+	return fmt.Sprintf("access:%d:%s", role, endpointAddress)
+}
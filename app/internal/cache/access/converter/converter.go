@@ -0,0 +1,21 @@
+package converter
+
+import (
+	modelCache "github.com/Prrromanssss/auth/internal/cache/access/model"
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+// ConvertAccessDecisionFromServiceToCache converts an AccessDecision model from the service layer to the cache layer.
+func ConvertAccessDecisionFromServiceToCache(params model.AccessDecision) modelCache.AccessDecision {
+	return modelCache.AccessDecision(params)
+}
+
+// ConvertCheckAccessParamsFromServiceToCache converts CheckAccessParams from the service layer to the cache layer.
+func ConvertCheckAccessParamsFromServiceToCache(params model.CheckAccessParams) modelCache.GetAccessDecisionParams {
+	return modelCache.GetAccessDecisionParams(params)
+}
+
+// ConvertAccessDecisionFromCacheToService converts an AccessDecision model from the cache layer to the service layer.
+func ConvertAccessDecisionFromCacheToService(params modelCache.AccessDecision) model.AccessDecision {
+	return model.AccessDecision(params)
+}
@@ -0,0 +1,5 @@
+package model
+
+import "errors"
+
+var ErrAccessDecisionNotFound = errors.New("access decision not found in cache")
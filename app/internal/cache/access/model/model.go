@@ -0,0 +1,14 @@
+package model
+
+// AccessDecision represents the access decision data structure used for caching.
+type AccessDecision struct {
+	EndpointAddress string
+	Role            int64
+	Allowed         bool
+}
+
+// GetAccessDecisionParams defines the parameters needed to retrieve an access decision.
+type GetAccessDecisionParams struct {
+	EndpointAddress string
+	Role            int64
+}
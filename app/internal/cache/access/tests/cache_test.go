@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/cache/redis"
+	"github.com/alicebob/miniredis/v2"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+
+	accessCache "github.com/Prrromanssss/auth/internal/cache/access"
+	modelCache "github.com/Prrromanssss/auth/internal/cache/access/model"
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+
+	pool := &redigo.Pool{
+		DialContext: func(ctx context.Context) (redigo.Conn, error) {
+			return redigo.DialContext(ctx, "tcp", server.Addr())
+		},
+	}
+	t.Cleanup(func() {
+		require.NoError(t, pool.Close())
+	})
+
+	c := accessCache.NewCache(redis.NewClient(pool, time.Second), pool, time.Minute)
+
+	decision := model.AccessDecision{
+		EndpointAddress: "/user_v1.UserV1/Get",
+		Role:            1,
+		Allowed:         true,
+	}
+	params := model.CheckAccessParams{
+		EndpointAddress: decision.EndpointAddress,
+		Role:            decision.Role,
+	}
+
+	require.NoError(t, c.Set(ctx, decision))
+
+	got, err := c.Get(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, decision, got)
+
+	for _, key := range server.Keys() {
+		require.Equal(t, time.Minute, server.TTL(key))
+	}
+
+	server.FastForward(time.Minute)
+
+	_, err = c.Get(ctx, params)
+	require.ErrorIs(t, err, modelCache.ErrAccessDecisionNotFound)
+}
@@ -10,8 +10,36 @@ import (
 type UserCache interface {
 	// Create adds a new user to the cache.
 	Create(ctx context.Context, params model.User) (err error)
+	// Fill stores a user read from the database after a cache miss.
+	Fill(ctx context.Context, params model.User) (err error)
+	// CreateMissing remembers that the user does not exist.
+	CreateMissing(ctx context.Context, params model.GetUserParams) (err error)
 	// Get retrieves user information from the cache.
 	Get(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error)
 	// Delete removes a user from the cache.
 	Delete(ctx context.Context, params model.DeleteUserParams) (err error)
 }
+
+// AccessCache defines the interface for access decision cache operations.
+type AccessCache interface {
+	// Set stores an access decision in the cache.
+	Set(ctx context.Context, params model.AccessDecision) (err error)
+	// Get retrieves an access decision from the cache.
+	Get(ctx context.Context, params model.CheckAccessParams) (resp model.AccessDecision, err error)
+}
+
+// LocalUserCache defines a user cache kept in process memory in front of a shared UserCache.
+type LocalUserCache interface {
+	UserCache
+	// RunInvalidation evicts users changed by other replicas until the context is done.
+	RunInvalidation(ctx context.Context) (err error)
+}
+
+// UserInvalidationBus defines the interface for broadcasting user cache invalidations to every replica.
+type UserInvalidationBus interface {
+	// Publish broadcasts that the user has changed.
+	Publish(ctx context.Context, userID int64) (err error)
+	// Subscribe calls onSubscribed once the subscription is active and onInvalidate for every changed user.
+	// It blocks until the context is done or the subscription is lost.
+	Subscribe(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) (err error)
+}
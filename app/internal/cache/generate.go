@@ -2,3 +2,6 @@ package cache
 
 //go:generate sh -c "rm -rf mocks && mkdir -p mocks"
 //go:generate minimock -i UserCache -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i AccessCache -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i LocalUserCache -o ./mocks/ -s "_minimock.go"
+//go:generate minimock -i UserInvalidationBus -o ./mocks/ -s "_minimock.go"
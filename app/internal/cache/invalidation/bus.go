@@ -0,0 +1,84 @@
+package invalidation
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2/log"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+)
+
+type redisBus struct {
+	pool    *redigo.Pool
+	channel string
+}
+
+// NewBus creates a new instance of redisBus that broadcasts user ids over the Redis pub/sub channel.
+func NewBus(pool *redigo.Pool, channel string) cache.UserInvalidationBus {
+	return &redisBus{
+		pool:    pool,
+		channel: channel,
+	}
+}
+
+// Publish sends the user id to every subscriber of the channel.
+func (b *redisBus) Publish(ctx context.Context, userID int64) (err error) {
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Cannot get redis connection")
+	}
+	defer conn.Close()
+
+	_, err = redigo.DoContext(conn, ctx, "PUBLISH", b.channel, userID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot publish invalidation of user %d", userID)
+	}
+
+	return nil
+}
+
+// Subscribe listens to the channel on a dedicated connection until the context is done or the connection fails.
+func (b *redisBus) Subscribe(
+	ctx context.Context,
+	onSubscribed func(),
+	onInvalidate func(userID int64),
+) (err error) {
+	conn, err := b.pool.GetContext(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Cannot get redis connection")
+	}
+
+	psc := redigo.PubSubConn{Conn: conn}
+	defer psc.Close()
+
+	err = psc.Subscribe(b.channel)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot subscribe to channel %s", b.channel)
+	}
+
+	for {
+		switch msg := psc.ReceiveContext(ctx).(type) {
+		case redigo.Subscription:
+			if msg.Kind == "subscribe" {
+				onSubscribed()
+			}
+		case redigo.Message:
+			userID, parseErr := strconv.ParseInt(string(msg.Data), 10, 64)
+			if parseErr != nil {
+				log.Warnf("Skipping malformed user cache invalidation, data: %q, err: %v", msg.Data, parseErr)
+				continue
+			}
+
+			onInvalidate(userID)
+		case error:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			return errors.Wrapf(msg, "Cannot receive from channel %s", b.channel)
+		}
+	}
+}
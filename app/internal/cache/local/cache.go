@@ -0,0 +1,151 @@
+package local
+
+import (
+	"context"
+	"expvar"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+const resubscribeDelay = time.Second
+
+// stats counts the outcomes of local lookups. It is published under "user_cache_local" by expvar.
+var stats = expvar.NewMap("user_cache_local")
+
+type localCache struct {
+	next  cache.UserCache
+	bus   cache.UserInvalidationBus
+	users *expirable.LRU[int64, model.GetUserResponse]
+
+	// active is set while the invalidation subscription is up. Without it a replica could miss
+	// invalidations, so the local tier is bypassed.
+	active atomic.Bool
+	// generation changes on every eviction, so that a value read from the next tier
+	// before a concurrent eviction is not stored locally after it.
+	generation atomic.Uint64
+}
+
+// NewCache creates a new instance of localCache that keeps up to size users in memory for the given TTL
+// in front of the next cache. Writes are broadcast over the bus so that every replica evicts its copy.
+func NewCache(
+	next cache.UserCache,
+	bus cache.UserInvalidationBus,
+	size int,
+	ttl time.Duration,
+) cache.LocalUserCache {
+	return &localCache{
+		next:  next,
+		bus:   bus,
+		users: expirable.NewLRU[int64, model.GetUserResponse](size, nil, ttl),
+	}
+}
+
+// Create writes the user to the next cache and tells every replica to drop its copy.
+// The copies are dropped even if the write fails, so that no replica keeps serving the old user.
+func (c *localCache) Create(ctx context.Context, params model.User) (err error) {
+	err = c.next.Create(ctx, params)
+
+	invalidateErr := c.invalidate(ctx, params.UserID)
+	if err != nil {
+		return err
+	}
+
+	return invalidateErr
+}
+
+// Fill writes the user read after a miss to the next cache. The user has not changed,
+// so the other replicas are not told to drop their copies.
+func (c *localCache) Fill(ctx context.Context, params model.User) (err error) {
+	return c.next.Fill(ctx, params)
+}
+
+// CreateMissing remembers in the next cache that the user does not exist.
+func (c *localCache) CreateMissing(ctx context.Context, params model.GetUserParams) (err error) {
+	return c.next.CreateMissing(ctx, params)
+}
+
+// Get retrieves the user from memory and falls back to the next cache.
+func (c *localCache) Get(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error) {
+	if !c.active.Load() {
+		return c.next.Get(ctx, params)
+	}
+
+	resp, ok := c.users.Get(params.UserID)
+	if ok {
+		stats.Add("hits", 1)
+		return resp, nil
+	}
+
+	stats.Add("misses", 1)
+
+	generation := c.generation.Load()
+
+	resp, err = c.next.Get(ctx, params)
+	if err != nil {
+		return model.GetUserResponse{}, err
+	}
+
+	if c.active.Load() && c.generation.Load() == generation {
+		c.users.Add(params.UserID, resp)
+	}
+
+	return resp, nil
+}
+
+// Delete removes the user from the next cache and tells every replica to drop its copy,
+// even if the removal fails.
+func (c *localCache) Delete(ctx context.Context, params model.DeleteUserParams) (err error) {
+	err = c.next.Delete(ctx, params)
+
+	invalidateErr := c.invalidate(ctx, params.UserID)
+	if err != nil {
+		return err
+	}
+
+	return invalidateErr
+}
+
+// RunInvalidation keeps the subscription to the bus up and resubscribes after a delay when it is lost.
+func (c *localCache) RunInvalidation(ctx context.Context) (err error) {
+	for {
+		err = c.bus.Subscribe(ctx, c.activate, c.evict)
+		c.active.Store(false)
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		log.Warnf("User cache invalidation subscription lost, err: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+func (c *localCache) invalidate(ctx context.Context, userID int64) error {
+	c.evict(userID)
+
+	return c.bus.Publish(ctx, userID)
+}
+
+// activate starts serving from memory. Anything stored before may have missed an invalidation.
+func (c *localCache) activate() {
+	c.generation.Add(1)
+	c.users.Purge()
+	c.active.Store(true)
+}
+
+func (c *localCache) evict(userID int64) {
+	c.generation.Add(1)
+	c.users.Remove(userID)
+	stats.Add("evictions", 1)
+}
@@ -0,0 +1,277 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+	localCache "github.com/Prrromanssss/auth/internal/cache/local"
+	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+// subscribedBus returns a bus that subscribes at once and hands out the invalidation callback.
+func subscribedBus(mc *minimock.Controller, invalidate chan<- func(userID int64)) *cacheMocks.UserInvalidationBusMock {
+	mock := cacheMocks.NewUserInvalidationBusMock(mc)
+	mock.SubscribeMock.Set(func(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) error {
+		onSubscribed()
+		invalidate <- onInvalidate
+		<-ctx.Done()
+
+		return ctx.Err()
+	})
+
+	return mock
+}
+
+// runInvalidation starts the invalidation listener and waits until the subscription is active.
+func runInvalidation(t *testing.T, c cache.LocalUserCache, invalidate <-chan func(userID int64)) func(userID int64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- c.RunInvalidation(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+
+	select {
+	case onInvalidate := <-invalidate:
+		return onInvalidate
+	case <-time.After(time.Second):
+		t.Fatal("subscription is not active")
+		return nil
+	}
+}
+
+func TestLocalCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id  = gofakeit.Int64()
+		req = model.GetUserParams{UserID: id}
+
+		resp = model.GetUserResponse{
+			User: model.User{
+				UserID:    id,
+				Name:      gofakeit.Name(),
+				Email:     gofakeit.Email(),
+				Role:      int64(pb.Role_USER),
+				CreatedAt: gofakeit.Date(),
+				UpdatedAt: gofakeit.Date(),
+			},
+		}
+
+		ErrBus  = errors.New("bus error")
+		ErrNext = errors.New("next cache error")
+	)
+
+	t.Run("bypassed until subscribed", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+
+		c := localCache.NewCache(next, cacheMocks.NewUserInvalidationBusMock(mc), 10, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			got, err := c.Get(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, resp, got)
+		}
+
+		require.Equal(t, uint64(2), next.GetAfterCounter())
+	})
+
+	t.Run("served from memory once subscribed", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		invalidate := make(chan func(userID int64), 1)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+
+		c := localCache.NewCache(next, subscribedBus(mc, invalidate), 10, time.Minute)
+		runInvalidation(t, c, invalidate)
+
+		for i := 0; i < 3; i++ {
+			got, err := c.Get(ctx, req)
+			require.NoError(t, err)
+			require.Equal(t, resp, got)
+		}
+
+		require.Equal(t, uint64(1), next.GetAfterCounter())
+	})
+
+	t.Run("invalidation from another replica evicts the user", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		invalidate := make(chan func(userID int64), 1)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+
+		c := localCache.NewCache(next, subscribedBus(mc, invalidate), 10, time.Minute)
+		onInvalidate := runInvalidation(t, c, invalidate)
+
+		_, err := c.Get(ctx, req)
+		require.NoError(t, err)
+
+		onInvalidate(id)
+
+		_, err = c.Get(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), next.GetAfterCounter())
+	})
+
+	t.Run("create evicts and publishes", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		invalidate := make(chan func(userID int64), 1)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+		next.CreateMock.Expect(ctx, resp.User).Return(nil)
+
+		bus := subscribedBus(mc, invalidate)
+		bus.PublishMock.Expect(ctx, id).Return(nil)
+
+		c := localCache.NewCache(next, bus, 10, time.Minute)
+		runInvalidation(t, c, invalidate)
+
+		_, err := c.Get(ctx, req)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Create(ctx, resp.User))
+
+		_, err = c.Get(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), next.GetAfterCounter())
+	})
+
+	t.Run("fill does not publish", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		invalidate := make(chan func(userID int64), 1)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.FillMock.Expect(ctx, resp.User).Return(nil)
+
+		c := localCache.NewCache(next, subscribedBus(mc, invalidate), 10, time.Minute)
+		runInvalidation(t, c, invalidate)
+
+		require.NoError(t, c.Fill(ctx, resp.User))
+	})
+
+	t.Run("failed create still evicts and publishes", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		invalidate := make(chan func(userID int64), 1)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+		next.CreateMock.Expect(ctx, resp.User).Return(ErrNext)
+
+		bus := subscribedBus(mc, invalidate)
+		bus.PublishMock.Expect(ctx, id).Return(nil)
+
+		c := localCache.NewCache(next, bus, 10, time.Minute)
+		runInvalidation(t, c, invalidate)
+
+		_, err := c.Get(ctx, req)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, c.Create(ctx, resp.User), ErrNext)
+
+		_, err = c.Get(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), next.GetAfterCounter())
+	})
+
+	t.Run("delete evicts and publishes", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		invalidate := make(chan func(userID int64), 1)
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+		next.DeleteMock.Expect(ctx, model.DeleteUserParams{UserID: id}).Return(nil)
+
+		bus := subscribedBus(mc, invalidate)
+		bus.PublishMock.Expect(ctx, id).Return(ErrBus)
+
+		c := localCache.NewCache(next, bus, 10, time.Minute)
+		runInvalidation(t, c, invalidate)
+
+		_, err := c.Get(ctx, req)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, c.Delete(ctx, model.DeleteUserParams{UserID: id}), ErrBus)
+
+		_, err = c.Get(ctx, req)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), next.GetAfterCounter())
+	})
+
+	t.Run("lost subscription bypasses memory", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		lost := make(chan struct{})
+
+		next := cacheMocks.NewUserCacheMock(mc)
+		next.GetMock.Expect(ctx, req).Return(resp, nil)
+
+		bus := cacheMocks.NewUserInvalidationBusMock(mc)
+		bus.SubscribeMock.Set(func(ctx context.Context, onSubscribed func(), _ func(userID int64)) error {
+			select {
+			case <-lost:
+				<-ctx.Done()
+				return ctx.Err()
+			default:
+			}
+
+			onSubscribed()
+			close(lost)
+
+			return ErrBus
+		})
+
+		c := localCache.NewCache(next, bus, 10, time.Minute)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		go func() {
+			_ = c.RunInvalidation(runCtx)
+		}()
+
+		<-lost
+		require.Eventually(t, func() bool {
+			_, err := c.Get(ctx, req)
+			require.NoError(t, err)
+
+			return next.GetAfterCounter() >= 3
+		}, time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,727 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/cache.AccessCache -o access_cache_minimock.go -n AccessCacheMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessCacheMock implements cache.AccessCache
+type AccessCacheMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGet          func(ctx context.Context, params model.CheckAccessParams) (resp model.AccessDecision, err error)
+	inspectFuncGet   func(ctx context.Context, params model.CheckAccessParams)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mAccessCacheMockGet
+
+	funcSet          func(ctx context.Context, params model.AccessDecision) (err error)
+	inspectFuncSet   func(ctx context.Context, params model.AccessDecision)
+	afterSetCounter  uint64
+	beforeSetCounter uint64
+	SetMock          mAccessCacheMockSet
+}
+
+// NewAccessCacheMock returns a mock for cache.AccessCache
+func NewAccessCacheMock(t minimock.Tester) *AccessCacheMock {
+	m := &AccessCacheMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetMock = mAccessCacheMockGet{mock: m}
+	m.GetMock.callArgs = []*AccessCacheMockGetParams{}
+
+	m.SetMock = mAccessCacheMockSet{mock: m}
+	m.SetMock.callArgs = []*AccessCacheMockSetParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessCacheMockGet struct {
+	optional           bool
+	mock               *AccessCacheMock
+	defaultExpectation *AccessCacheMockGetExpectation
+	expectations       []*AccessCacheMockGetExpectation
+
+	callArgs []*AccessCacheMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessCacheMockGetExpectation specifies expectation struct of the AccessCache.Get
+type AccessCacheMockGetExpectation struct {
+	mock      *AccessCacheMock
+	params    *AccessCacheMockGetParams
+	paramPtrs *AccessCacheMockGetParamPtrs
+	results   *AccessCacheMockGetResults
+	Counter   uint64
+}
+
+// AccessCacheMockGetParams contains parameters of the AccessCache.Get
+type AccessCacheMockGetParams struct {
+	ctx    context.Context
+	params model.CheckAccessParams
+}
+
+// AccessCacheMockGetParamPtrs contains pointers to parameters of the AccessCache.Get
+type AccessCacheMockGetParamPtrs struct {
+	ctx    *context.Context
+	params *model.CheckAccessParams
+}
+
+// AccessCacheMockGetResults contains results of the AccessCache.Get
+type AccessCacheMockGetResults struct {
+	resp model.AccessDecision
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mAccessCacheMockGet) Optional() *mAccessCacheMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for AccessCache.Get
+func (mmGet *mAccessCacheMockGet) Expect(ctx context.Context, params model.CheckAccessParams) *mAccessCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AccessCacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &AccessCacheMockGetParams{ctx, params}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessCache.Get
+func (mmGet *mAccessCacheMockGet) ExpectCtxParam1(ctx context.Context) *mAccessCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AccessCacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &AccessCacheMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectParamsParam2 sets up expected param params for AccessCache.Get
+func (mmGet *mAccessCacheMockGet) ExpectParamsParam2(params model.CheckAccessParams) *mAccessCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AccessCacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &AccessCacheMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.params = &params
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessCache.Get
+func (mmGet *mAccessCacheMockGet) Inspect(f func(ctx context.Context, params model.CheckAccessParams)) *mAccessCacheMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for AccessCacheMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by AccessCache.Get
+func (mmGet *mAccessCacheMockGet) Return(resp model.AccessDecision, err error) *AccessCacheMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &AccessCacheMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &AccessCacheMockGetResults{resp, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the AccessCache.Get method
+func (mmGet *mAccessCacheMockGet) Set(f func(ctx context.Context, params model.CheckAccessParams) (resp model.AccessDecision, err error)) *AccessCacheMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the AccessCache.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the AccessCache.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the AccessCache.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mAccessCacheMockGet) When(ctx context.Context, params model.CheckAccessParams) *AccessCacheMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("AccessCacheMock.Get mock is already set by Set")
+	}
+
+	expectation := &AccessCacheMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &AccessCacheMockGetParams{ctx, params},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessCache.Get return parameters for the expectation previously defined by the When method
+func (e *AccessCacheMockGetExpectation) Then(resp model.AccessDecision, err error) *AccessCacheMock {
+	e.results = &AccessCacheMockGetResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AccessCache.Get should be invoked
+func (mmGet *mAccessCacheMockGet) Times(n uint64) *mAccessCacheMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of AccessCacheMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mAccessCacheMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements cache.AccessCache
+func (mmGet *AccessCacheMock) Get(ctx context.Context, params model.CheckAccessParams) (resp model.AccessDecision, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, params)
+	}
+
+	mm_params := AccessCacheMockGetParams{ctx, params}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessCacheMockGetParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("AccessCacheMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGet.t.Errorf("AccessCacheMock.Get got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("AccessCacheMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the AccessCacheMock.Get")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, params)
+	}
+	mmGet.t.Fatalf("Unexpected call to AccessCacheMock.Get. %v %v", ctx, params)
+	return
+}
+
+// GetAfterCounter returns a count of finished AccessCacheMock.Get invocations
+func (mmGet *AccessCacheMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of AccessCacheMock.Get invocations
+func (mmGet *AccessCacheMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessCacheMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mAccessCacheMockGet) Calls() []*AccessCacheMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*AccessCacheMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *AccessCacheMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *AccessCacheMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessCacheMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessCacheMock.Get")
+		} else {
+			m.t.Errorf("Expected call to AccessCacheMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to AccessCacheMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessCacheMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mAccessCacheMockSet struct {
+	optional           bool
+	mock               *AccessCacheMock
+	defaultExpectation *AccessCacheMockSetExpectation
+	expectations       []*AccessCacheMockSetExpectation
+
+	callArgs []*AccessCacheMockSetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessCacheMockSetExpectation specifies expectation struct of the AccessCache.Set
+type AccessCacheMockSetExpectation struct {
+	mock      *AccessCacheMock
+	params    *AccessCacheMockSetParams
+	paramPtrs *AccessCacheMockSetParamPtrs
+	results   *AccessCacheMockSetResults
+	Counter   uint64
+}
+
+// AccessCacheMockSetParams contains parameters of the AccessCache.Set
+type AccessCacheMockSetParams struct {
+	ctx    context.Context
+	params model.AccessDecision
+}
+
+// AccessCacheMockSetParamPtrs contains pointers to parameters of the AccessCache.Set
+type AccessCacheMockSetParamPtrs struct {
+	ctx    *context.Context
+	params *model.AccessDecision
+}
+
+// AccessCacheMockSetResults contains results of the AccessCache.Set
+type AccessCacheMockSetResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSet *mAccessCacheMockSet) Optional() *mAccessCacheMockSet {
+	mmSet.optional = true
+	return mmSet
+}
+
+// Expect sets up expected params for AccessCache.Set
+func (mmSet *mAccessCacheMockSet) Expect(ctx context.Context, params model.AccessDecision) *mAccessCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &AccessCacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.paramPtrs != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by ExpectParams functions")
+	}
+
+	mmSet.defaultExpectation.params = &AccessCacheMockSetParams{ctx, params}
+	for _, e := range mmSet.expectations {
+		if minimock.Equal(e.params, mmSet.defaultExpectation.params) {
+			mmSet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSet.defaultExpectation.params)
+		}
+	}
+
+	return mmSet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessCache.Set
+func (mmSet *mAccessCacheMockSet) ExpectCtxParam1(ctx context.Context) *mAccessCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &AccessCacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &AccessCacheMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSet
+}
+
+// ExpectParamsParam2 sets up expected param params for AccessCache.Set
+func (mmSet *mAccessCacheMockSet) ExpectParamsParam2(params model.AccessDecision) *mAccessCacheMockSet {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &AccessCacheMockSetExpectation{}
+	}
+
+	if mmSet.defaultExpectation.params != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Expect")
+	}
+
+	if mmSet.defaultExpectation.paramPtrs == nil {
+		mmSet.defaultExpectation.paramPtrs = &AccessCacheMockSetParamPtrs{}
+	}
+	mmSet.defaultExpectation.paramPtrs.params = &params
+
+	return mmSet
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessCache.Set
+func (mmSet *mAccessCacheMockSet) Inspect(f func(ctx context.Context, params model.AccessDecision)) *mAccessCacheMockSet {
+	if mmSet.mock.inspectFuncSet != nil {
+		mmSet.mock.t.Fatalf("Inspect function is already set for AccessCacheMock.Set")
+	}
+
+	mmSet.mock.inspectFuncSet = f
+
+	return mmSet
+}
+
+// Return sets up results that will be returned by AccessCache.Set
+func (mmSet *mAccessCacheMockSet) Return(err error) *AccessCacheMock {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Set")
+	}
+
+	if mmSet.defaultExpectation == nil {
+		mmSet.defaultExpectation = &AccessCacheMockSetExpectation{mock: mmSet.mock}
+	}
+	mmSet.defaultExpectation.results = &AccessCacheMockSetResults{err}
+	return mmSet.mock
+}
+
+// Set uses given function f to mock the AccessCache.Set method
+func (mmSet *mAccessCacheMockSet) Set(f func(ctx context.Context, params model.AccessDecision) (err error)) *AccessCacheMock {
+	if mmSet.defaultExpectation != nil {
+		mmSet.mock.t.Fatalf("Default expectation is already set for the AccessCache.Set method")
+	}
+
+	if len(mmSet.expectations) > 0 {
+		mmSet.mock.t.Fatalf("Some expectations are already set for the AccessCache.Set method")
+	}
+
+	mmSet.mock.funcSet = f
+	return mmSet.mock
+}
+
+// When sets expectation for the AccessCache.Set which will trigger the result defined by the following
+// Then helper
+func (mmSet *mAccessCacheMockSet) When(ctx context.Context, params model.AccessDecision) *AccessCacheMockSetExpectation {
+	if mmSet.mock.funcSet != nil {
+		mmSet.mock.t.Fatalf("AccessCacheMock.Set mock is already set by Set")
+	}
+
+	expectation := &AccessCacheMockSetExpectation{
+		mock:   mmSet.mock,
+		params: &AccessCacheMockSetParams{ctx, params},
+	}
+	mmSet.expectations = append(mmSet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessCache.Set return parameters for the expectation previously defined by the When method
+func (e *AccessCacheMockSetExpectation) Then(err error) *AccessCacheMock {
+	e.results = &AccessCacheMockSetResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccessCache.Set should be invoked
+func (mmSet *mAccessCacheMockSet) Times(n uint64) *mAccessCacheMockSet {
+	if n == 0 {
+		mmSet.mock.t.Fatalf("Times of AccessCacheMock.Set mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSet.expectedInvocations, n)
+	return mmSet
+}
+
+func (mmSet *mAccessCacheMockSet) invocationsDone() bool {
+	if len(mmSet.expectations) == 0 && mmSet.defaultExpectation == nil && mmSet.mock.funcSet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSet.mock.afterSetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Set implements cache.AccessCache
+func (mmSet *AccessCacheMock) Set(ctx context.Context, params model.AccessDecision) (err error) {
+	mm_atomic.AddUint64(&mmSet.beforeSetCounter, 1)
+	defer mm_atomic.AddUint64(&mmSet.afterSetCounter, 1)
+
+	if mmSet.inspectFuncSet != nil {
+		mmSet.inspectFuncSet(ctx, params)
+	}
+
+	mm_params := AccessCacheMockSetParams{ctx, params}
+
+	// Record call args
+	mmSet.SetMock.mutex.Lock()
+	mmSet.SetMock.callArgs = append(mmSet.SetMock.callArgs, &mm_params)
+	mmSet.SetMock.mutex.Unlock()
+
+	for _, e := range mmSet.SetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSet.SetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSet.SetMock.defaultExpectation.Counter, 1)
+		mm_want := mmSet.SetMock.defaultExpectation.params
+		mm_want_ptrs := mmSet.SetMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessCacheMockSetParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSet.t.Errorf("AccessCacheMock.Set got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmSet.t.Errorf("AccessCacheMock.Set got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSet.t.Errorf("AccessCacheMock.Set got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSet.SetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSet.t.Fatal("No results are set for the AccessCacheMock.Set")
+		}
+		return (*mm_results).err
+	}
+	if mmSet.funcSet != nil {
+		return mmSet.funcSet(ctx, params)
+	}
+	mmSet.t.Fatalf("Unexpected call to AccessCacheMock.Set. %v %v", ctx, params)
+	return
+}
+
+// SetAfterCounter returns a count of finished AccessCacheMock.Set invocations
+func (mmSet *AccessCacheMock) SetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSet.afterSetCounter)
+}
+
+// SetBeforeCounter returns a count of AccessCacheMock.Set invocations
+func (mmSet *AccessCacheMock) SetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSet.beforeSetCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessCacheMock.Set.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSet *mAccessCacheMockSet) Calls() []*AccessCacheMockSetParams {
+	mmSet.mutex.RLock()
+
+	argCopy := make([]*AccessCacheMockSetParams, len(mmSet.callArgs))
+	copy(argCopy, mmSet.callArgs)
+
+	mmSet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetDone returns true if the count of the Set invocations corresponds
+// the number of defined expectations
+func (m *AccessCacheMock) MinimockSetDone() bool {
+	if m.SetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMock.invocationsDone()
+}
+
+// MinimockSetInspect logs each unmet expectation
+func (m *AccessCacheMock) MinimockSetInspect() {
+	for _, e := range m.SetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessCacheMock.Set with params: %#v", *e.params)
+		}
+	}
+
+	afterSetCounter := mm_atomic.LoadUint64(&m.afterSetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMock.defaultExpectation != nil && afterSetCounter < 1 {
+		if m.SetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessCacheMock.Set")
+		} else {
+			m.t.Errorf("Expected call to AccessCacheMock.Set with params: %#v", *m.SetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSet != nil && afterSetCounter < 1 {
+		m.t.Error("Expected call to AccessCacheMock.Set")
+	}
+
+	if !m.SetMock.invocationsDone() && afterSetCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessCacheMock.Set but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMock.expectedInvocations), afterSetCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessCacheMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetInspect()
+
+			m.MinimockSetInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessCacheMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessCacheMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetDone() &&
+		m.MinimockSetDone()
+}
@@ -0,0 +1,2027 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/cache.LocalUserCache -o local_user_cache_minimock.go -n LocalUserCacheMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// LocalUserCacheMock implements cache.LocalUserCache
+type LocalUserCacheMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreate          func(ctx context.Context, params model.User) (err error)
+	inspectFuncCreate   func(ctx context.Context, params model.User)
+	afterCreateCounter  uint64
+	beforeCreateCounter uint64
+	CreateMock          mLocalUserCacheMockCreate
+
+	funcCreateMissing          func(ctx context.Context, params model.GetUserParams) (err error)
+	inspectFuncCreateMissing   func(ctx context.Context, params model.GetUserParams)
+	afterCreateMissingCounter  uint64
+	beforeCreateMissingCounter uint64
+	CreateMissingMock          mLocalUserCacheMockCreateMissing
+
+	funcDelete          func(ctx context.Context, params model.DeleteUserParams) (err error)
+	inspectFuncDelete   func(ctx context.Context, params model.DeleteUserParams)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mLocalUserCacheMockDelete
+
+	funcFill          func(ctx context.Context, params model.User) (err error)
+	inspectFuncFill   func(ctx context.Context, params model.User)
+	afterFillCounter  uint64
+	beforeFillCounter uint64
+	FillMock          mLocalUserCacheMockFill
+
+	funcGet          func(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error)
+	inspectFuncGet   func(ctx context.Context, params model.GetUserParams)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mLocalUserCacheMockGet
+
+	funcRunInvalidation          func(ctx context.Context) (err error)
+	inspectFuncRunInvalidation   func(ctx context.Context)
+	afterRunInvalidationCounter  uint64
+	beforeRunInvalidationCounter uint64
+	RunInvalidationMock          mLocalUserCacheMockRunInvalidation
+}
+
+// NewLocalUserCacheMock returns a mock for cache.LocalUserCache
+func NewLocalUserCacheMock(t minimock.Tester) *LocalUserCacheMock {
+	m := &LocalUserCacheMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateMock = mLocalUserCacheMockCreate{mock: m}
+	m.CreateMock.callArgs = []*LocalUserCacheMockCreateParams{}
+
+	m.CreateMissingMock = mLocalUserCacheMockCreateMissing{mock: m}
+	m.CreateMissingMock.callArgs = []*LocalUserCacheMockCreateMissingParams{}
+
+	m.DeleteMock = mLocalUserCacheMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*LocalUserCacheMockDeleteParams{}
+
+	m.FillMock = mLocalUserCacheMockFill{mock: m}
+	m.FillMock.callArgs = []*LocalUserCacheMockFillParams{}
+
+	m.GetMock = mLocalUserCacheMockGet{mock: m}
+	m.GetMock.callArgs = []*LocalUserCacheMockGetParams{}
+
+	m.RunInvalidationMock = mLocalUserCacheMockRunInvalidation{mock: m}
+	m.RunInvalidationMock.callArgs = []*LocalUserCacheMockRunInvalidationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLocalUserCacheMockCreate struct {
+	optional           bool
+	mock               *LocalUserCacheMock
+	defaultExpectation *LocalUserCacheMockCreateExpectation
+	expectations       []*LocalUserCacheMockCreateExpectation
+
+	callArgs []*LocalUserCacheMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LocalUserCacheMockCreateExpectation specifies expectation struct of the LocalUserCache.Create
+type LocalUserCacheMockCreateExpectation struct {
+	mock      *LocalUserCacheMock
+	params    *LocalUserCacheMockCreateParams
+	paramPtrs *LocalUserCacheMockCreateParamPtrs
+	results   *LocalUserCacheMockCreateResults
+	Counter   uint64
+}
+
+// LocalUserCacheMockCreateParams contains parameters of the LocalUserCache.Create
+type LocalUserCacheMockCreateParams struct {
+	ctx    context.Context
+	params model.User
+}
+
+// LocalUserCacheMockCreateParamPtrs contains pointers to parameters of the LocalUserCache.Create
+type LocalUserCacheMockCreateParamPtrs struct {
+	ctx    *context.Context
+	params *model.User
+}
+
+// LocalUserCacheMockCreateResults contains results of the LocalUserCache.Create
+type LocalUserCacheMockCreateResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mLocalUserCacheMockCreate) Optional() *mLocalUserCacheMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for LocalUserCache.Create
+func (mmCreate *mLocalUserCacheMockCreate) Expect(ctx context.Context, params model.User) *mLocalUserCacheMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &LocalUserCacheMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &LocalUserCacheMockCreateParams{ctx, params}
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LocalUserCache.Create
+func (mmCreate *mLocalUserCacheMockCreate) ExpectCtxParam1(ctx context.Context) *mLocalUserCacheMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &LocalUserCacheMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &LocalUserCacheMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreate
+}
+
+// ExpectParamsParam2 sets up expected param params for LocalUserCache.Create
+func (mmCreate *mLocalUserCacheMockCreate) ExpectParamsParam2(params model.User) *mLocalUserCacheMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &LocalUserCacheMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &LocalUserCacheMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the LocalUserCache.Create
+func (mmCreate *mLocalUserCacheMockCreate) Inspect(f func(ctx context.Context, params model.User)) *mLocalUserCacheMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for LocalUserCacheMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by LocalUserCache.Create
+func (mmCreate *mLocalUserCacheMockCreate) Return(err error) *LocalUserCacheMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &LocalUserCacheMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &LocalUserCacheMockCreateResults{err}
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the LocalUserCache.Create method
+func (mmCreate *mLocalUserCacheMockCreate) Set(f func(ctx context.Context, params model.User) (err error)) *LocalUserCacheMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the LocalUserCache.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the LocalUserCache.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	return mmCreate.mock
+}
+
+// When sets expectation for the LocalUserCache.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mLocalUserCacheMockCreate) When(ctx context.Context, params model.User) *LocalUserCacheMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("LocalUserCacheMock.Create mock is already set by Set")
+	}
+
+	expectation := &LocalUserCacheMockCreateExpectation{
+		mock:   mmCreate.mock,
+		params: &LocalUserCacheMockCreateParams{ctx, params},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LocalUserCache.Create return parameters for the expectation previously defined by the When method
+func (e *LocalUserCacheMockCreateExpectation) Then(err error) *LocalUserCacheMock {
+	e.results = &LocalUserCacheMockCreateResults{err}
+	return e.mock
+}
+
+// Times sets number of times LocalUserCache.Create should be invoked
+func (mmCreate *mLocalUserCacheMockCreate) Times(n uint64) *mLocalUserCacheMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of LocalUserCacheMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	return mmCreate
+}
+
+func (mmCreate *mLocalUserCacheMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements cache.LocalUserCache
+func (mmCreate *LocalUserCacheMock) Create(ctx context.Context, params model.User) (err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, params)
+	}
+
+	mm_params := LocalUserCacheMockCreateParams{ctx, params}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := LocalUserCacheMockCreateParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("LocalUserCacheMock.Create got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreate.t.Errorf("LocalUserCacheMock.Create got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("LocalUserCacheMock.Create got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the LocalUserCacheMock.Create")
+		}
+		return (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, params)
+	}
+	mmCreate.t.Fatalf("Unexpected call to LocalUserCacheMock.Create. %v %v", ctx, params)
+	return
+}
+
+// CreateAfterCounter returns a count of finished LocalUserCacheMock.Create invocations
+func (mmCreate *LocalUserCacheMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of LocalUserCacheMock.Create invocations
+func (mmCreate *LocalUserCacheMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to LocalUserCacheMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mLocalUserCacheMockCreate) Calls() []*LocalUserCacheMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*LocalUserCacheMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *LocalUserCacheMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *LocalUserCacheMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Create with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LocalUserCacheMock.Create")
+		} else {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Create with params: %#v", *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Error("Expected call to LocalUserCacheMock.Create")
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to LocalUserCacheMock.Create but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), afterCreateCounter)
+	}
+}
+
+type mLocalUserCacheMockCreateMissing struct {
+	optional           bool
+	mock               *LocalUserCacheMock
+	defaultExpectation *LocalUserCacheMockCreateMissingExpectation
+	expectations       []*LocalUserCacheMockCreateMissingExpectation
+
+	callArgs []*LocalUserCacheMockCreateMissingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LocalUserCacheMockCreateMissingExpectation specifies expectation struct of the LocalUserCache.CreateMissing
+type LocalUserCacheMockCreateMissingExpectation struct {
+	mock      *LocalUserCacheMock
+	params    *LocalUserCacheMockCreateMissingParams
+	paramPtrs *LocalUserCacheMockCreateMissingParamPtrs
+	results   *LocalUserCacheMockCreateMissingResults
+	Counter   uint64
+}
+
+// LocalUserCacheMockCreateMissingParams contains parameters of the LocalUserCache.CreateMissing
+type LocalUserCacheMockCreateMissingParams struct {
+	ctx    context.Context
+	params model.GetUserParams
+}
+
+// LocalUserCacheMockCreateMissingParamPtrs contains pointers to parameters of the LocalUserCache.CreateMissing
+type LocalUserCacheMockCreateMissingParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetUserParams
+}
+
+// LocalUserCacheMockCreateMissingResults contains results of the LocalUserCache.CreateMissing
+type LocalUserCacheMockCreateMissingResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Optional() *mLocalUserCacheMockCreateMissing {
+	mmCreateMissing.optional = true
+	return mmCreateMissing
+}
+
+// Expect sets up expected params for LocalUserCache.CreateMissing
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Expect(ctx context.Context, params model.GetUserParams) *mLocalUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &LocalUserCacheMockCreateMissingExpectation{}
+	}
+
+	if mmCreateMissing.defaultExpectation.paramPtrs != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by ExpectParams functions")
+	}
+
+	mmCreateMissing.defaultExpectation.params = &LocalUserCacheMockCreateMissingParams{ctx, params}
+	for _, e := range mmCreateMissing.expectations {
+		if minimock.Equal(e.params, mmCreateMissing.defaultExpectation.params) {
+			mmCreateMissing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateMissing.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateMissing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LocalUserCache.CreateMissing
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) ExpectCtxParam1(ctx context.Context) *mLocalUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &LocalUserCacheMockCreateMissingExpectation{}
+	}
+
+	if mmCreateMissing.defaultExpectation.params != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Expect")
+	}
+
+	if mmCreateMissing.defaultExpectation.paramPtrs == nil {
+		mmCreateMissing.defaultExpectation.paramPtrs = &LocalUserCacheMockCreateMissingParamPtrs{}
+	}
+	mmCreateMissing.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateMissing
+}
+
+// ExpectParamsParam2 sets up expected param params for LocalUserCache.CreateMissing
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) ExpectParamsParam2(params model.GetUserParams) *mLocalUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &LocalUserCacheMockCreateMissingExpectation{}
+	}
+
+	if mmCreateMissing.defaultExpectation.params != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Expect")
+	}
+
+	if mmCreateMissing.defaultExpectation.paramPtrs == nil {
+		mmCreateMissing.defaultExpectation.paramPtrs = &LocalUserCacheMockCreateMissingParamPtrs{}
+	}
+	mmCreateMissing.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateMissing
+}
+
+// Inspect accepts an inspector function that has same arguments as the LocalUserCache.CreateMissing
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Inspect(f func(ctx context.Context, params model.GetUserParams)) *mLocalUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.inspectFuncCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("Inspect function is already set for LocalUserCacheMock.CreateMissing")
+	}
+
+	mmCreateMissing.mock.inspectFuncCreateMissing = f
+
+	return mmCreateMissing
+}
+
+// Return sets up results that will be returned by LocalUserCache.CreateMissing
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Return(err error) *LocalUserCacheMock {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &LocalUserCacheMockCreateMissingExpectation{mock: mmCreateMissing.mock}
+	}
+	mmCreateMissing.defaultExpectation.results = &LocalUserCacheMockCreateMissingResults{err}
+	return mmCreateMissing.mock
+}
+
+// Set uses given function f to mock the LocalUserCache.CreateMissing method
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Set(f func(ctx context.Context, params model.GetUserParams) (err error)) *LocalUserCacheMock {
+	if mmCreateMissing.defaultExpectation != nil {
+		mmCreateMissing.mock.t.Fatalf("Default expectation is already set for the LocalUserCache.CreateMissing method")
+	}
+
+	if len(mmCreateMissing.expectations) > 0 {
+		mmCreateMissing.mock.t.Fatalf("Some expectations are already set for the LocalUserCache.CreateMissing method")
+	}
+
+	mmCreateMissing.mock.funcCreateMissing = f
+	return mmCreateMissing.mock
+}
+
+// When sets expectation for the LocalUserCache.CreateMissing which will trigger the result defined by the following
+// Then helper
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) When(ctx context.Context, params model.GetUserParams) *LocalUserCacheMockCreateMissingExpectation {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("LocalUserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	expectation := &LocalUserCacheMockCreateMissingExpectation{
+		mock:   mmCreateMissing.mock,
+		params: &LocalUserCacheMockCreateMissingParams{ctx, params},
+	}
+	mmCreateMissing.expectations = append(mmCreateMissing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LocalUserCache.CreateMissing return parameters for the expectation previously defined by the When method
+func (e *LocalUserCacheMockCreateMissingExpectation) Then(err error) *LocalUserCacheMock {
+	e.results = &LocalUserCacheMockCreateMissingResults{err}
+	return e.mock
+}
+
+// Times sets number of times LocalUserCache.CreateMissing should be invoked
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Times(n uint64) *mLocalUserCacheMockCreateMissing {
+	if n == 0 {
+		mmCreateMissing.mock.t.Fatalf("Times of LocalUserCacheMock.CreateMissing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateMissing.expectedInvocations, n)
+	return mmCreateMissing
+}
+
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) invocationsDone() bool {
+	if len(mmCreateMissing.expectations) == 0 && mmCreateMissing.defaultExpectation == nil && mmCreateMissing.mock.funcCreateMissing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateMissing.mock.afterCreateMissingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateMissing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateMissing implements cache.LocalUserCache
+func (mmCreateMissing *LocalUserCacheMock) CreateMissing(ctx context.Context, params model.GetUserParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateMissing.beforeCreateMissingCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateMissing.afterCreateMissingCounter, 1)
+
+	if mmCreateMissing.inspectFuncCreateMissing != nil {
+		mmCreateMissing.inspectFuncCreateMissing(ctx, params)
+	}
+
+	mm_params := LocalUserCacheMockCreateMissingParams{ctx, params}
+
+	// Record call args
+	mmCreateMissing.CreateMissingMock.mutex.Lock()
+	mmCreateMissing.CreateMissingMock.callArgs = append(mmCreateMissing.CreateMissingMock.callArgs, &mm_params)
+	mmCreateMissing.CreateMissingMock.mutex.Unlock()
+
+	for _, e := range mmCreateMissing.CreateMissingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateMissing.CreateMissingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateMissing.CreateMissingMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateMissing.CreateMissingMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateMissing.CreateMissingMock.defaultExpectation.paramPtrs
+
+		mm_got := LocalUserCacheMockCreateMissingParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateMissing.t.Errorf("LocalUserCacheMock.CreateMissing got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateMissing.t.Errorf("LocalUserCacheMock.CreateMissing got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateMissing.t.Errorf("LocalUserCacheMock.CreateMissing got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateMissing.CreateMissingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateMissing.t.Fatal("No results are set for the LocalUserCacheMock.CreateMissing")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateMissing.funcCreateMissing != nil {
+		return mmCreateMissing.funcCreateMissing(ctx, params)
+	}
+	mmCreateMissing.t.Fatalf("Unexpected call to LocalUserCacheMock.CreateMissing. %v %v", ctx, params)
+	return
+}
+
+// CreateMissingAfterCounter returns a count of finished LocalUserCacheMock.CreateMissing invocations
+func (mmCreateMissing *LocalUserCacheMock) CreateMissingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMissing.afterCreateMissingCounter)
+}
+
+// CreateMissingBeforeCounter returns a count of LocalUserCacheMock.CreateMissing invocations
+func (mmCreateMissing *LocalUserCacheMock) CreateMissingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMissing.beforeCreateMissingCounter)
+}
+
+// Calls returns a list of arguments used in each call to LocalUserCacheMock.CreateMissing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateMissing *mLocalUserCacheMockCreateMissing) Calls() []*LocalUserCacheMockCreateMissingParams {
+	mmCreateMissing.mutex.RLock()
+
+	argCopy := make([]*LocalUserCacheMockCreateMissingParams, len(mmCreateMissing.callArgs))
+	copy(argCopy, mmCreateMissing.callArgs)
+
+	mmCreateMissing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateMissingDone returns true if the count of the CreateMissing invocations corresponds
+// the number of defined expectations
+func (m *LocalUserCacheMock) MinimockCreateMissingDone() bool {
+	if m.CreateMissingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMissingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMissingMock.invocationsDone()
+}
+
+// MinimockCreateMissingInspect logs each unmet expectation
+func (m *LocalUserCacheMock) MinimockCreateMissingInspect() {
+	for _, e := range m.CreateMissingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LocalUserCacheMock.CreateMissing with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateMissingCounter := mm_atomic.LoadUint64(&m.afterCreateMissingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMissingMock.defaultExpectation != nil && afterCreateMissingCounter < 1 {
+		if m.CreateMissingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LocalUserCacheMock.CreateMissing")
+		} else {
+			m.t.Errorf("Expected call to LocalUserCacheMock.CreateMissing with params: %#v", *m.CreateMissingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateMissing != nil && afterCreateMissingCounter < 1 {
+		m.t.Error("Expected call to LocalUserCacheMock.CreateMissing")
+	}
+
+	if !m.CreateMissingMock.invocationsDone() && afterCreateMissingCounter > 0 {
+		m.t.Errorf("Expected %d calls to LocalUserCacheMock.CreateMissing but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMissingMock.expectedInvocations), afterCreateMissingCounter)
+	}
+}
+
+type mLocalUserCacheMockDelete struct {
+	optional           bool
+	mock               *LocalUserCacheMock
+	defaultExpectation *LocalUserCacheMockDeleteExpectation
+	expectations       []*LocalUserCacheMockDeleteExpectation
+
+	callArgs []*LocalUserCacheMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LocalUserCacheMockDeleteExpectation specifies expectation struct of the LocalUserCache.Delete
+type LocalUserCacheMockDeleteExpectation struct {
+	mock      *LocalUserCacheMock
+	params    *LocalUserCacheMockDeleteParams
+	paramPtrs *LocalUserCacheMockDeleteParamPtrs
+	results   *LocalUserCacheMockDeleteResults
+	Counter   uint64
+}
+
+// LocalUserCacheMockDeleteParams contains parameters of the LocalUserCache.Delete
+type LocalUserCacheMockDeleteParams struct {
+	ctx    context.Context
+	params model.DeleteUserParams
+}
+
+// LocalUserCacheMockDeleteParamPtrs contains pointers to parameters of the LocalUserCache.Delete
+type LocalUserCacheMockDeleteParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteUserParams
+}
+
+// LocalUserCacheMockDeleteResults contains results of the LocalUserCache.Delete
+type LocalUserCacheMockDeleteResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mLocalUserCacheMockDelete) Optional() *mLocalUserCacheMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for LocalUserCache.Delete
+func (mmDelete *mLocalUserCacheMockDelete) Expect(ctx context.Context, params model.DeleteUserParams) *mLocalUserCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &LocalUserCacheMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &LocalUserCacheMockDeleteParams{ctx, params}
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LocalUserCache.Delete
+func (mmDelete *mLocalUserCacheMockDelete) ExpectCtxParam1(ctx context.Context) *mLocalUserCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &LocalUserCacheMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &LocalUserCacheMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDelete
+}
+
+// ExpectParamsParam2 sets up expected param params for LocalUserCache.Delete
+func (mmDelete *mLocalUserCacheMockDelete) ExpectParamsParam2(params model.DeleteUserParams) *mLocalUserCacheMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &LocalUserCacheMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &LocalUserCacheMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.params = &params
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the LocalUserCache.Delete
+func (mmDelete *mLocalUserCacheMockDelete) Inspect(f func(ctx context.Context, params model.DeleteUserParams)) *mLocalUserCacheMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for LocalUserCacheMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by LocalUserCache.Delete
+func (mmDelete *mLocalUserCacheMockDelete) Return(err error) *LocalUserCacheMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &LocalUserCacheMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &LocalUserCacheMockDeleteResults{err}
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the LocalUserCache.Delete method
+func (mmDelete *mLocalUserCacheMockDelete) Set(f func(ctx context.Context, params model.DeleteUserParams) (err error)) *LocalUserCacheMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the LocalUserCache.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the LocalUserCache.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	return mmDelete.mock
+}
+
+// When sets expectation for the LocalUserCache.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mLocalUserCacheMockDelete) When(ctx context.Context, params model.DeleteUserParams) *LocalUserCacheMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("LocalUserCacheMock.Delete mock is already set by Set")
+	}
+
+	expectation := &LocalUserCacheMockDeleteExpectation{
+		mock:   mmDelete.mock,
+		params: &LocalUserCacheMockDeleteParams{ctx, params},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LocalUserCache.Delete return parameters for the expectation previously defined by the When method
+func (e *LocalUserCacheMockDeleteExpectation) Then(err error) *LocalUserCacheMock {
+	e.results = &LocalUserCacheMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times LocalUserCache.Delete should be invoked
+func (mmDelete *mLocalUserCacheMockDelete) Times(n uint64) *mLocalUserCacheMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of LocalUserCacheMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	return mmDelete
+}
+
+func (mmDelete *mLocalUserCacheMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements cache.LocalUserCache
+func (mmDelete *LocalUserCacheMock) Delete(ctx context.Context, params model.DeleteUserParams) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, params)
+	}
+
+	mm_params := LocalUserCacheMockDeleteParams{ctx, params}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := LocalUserCacheMockDeleteParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("LocalUserCacheMock.Delete got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDelete.t.Errorf("LocalUserCacheMock.Delete got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("LocalUserCacheMock.Delete got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the LocalUserCacheMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, params)
+	}
+	mmDelete.t.Fatalf("Unexpected call to LocalUserCacheMock.Delete. %v %v", ctx, params)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished LocalUserCacheMock.Delete invocations
+func (mmDelete *LocalUserCacheMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of LocalUserCacheMock.Delete invocations
+func (mmDelete *LocalUserCacheMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to LocalUserCacheMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mLocalUserCacheMockDelete) Calls() []*LocalUserCacheMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*LocalUserCacheMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *LocalUserCacheMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *LocalUserCacheMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Delete with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LocalUserCacheMock.Delete")
+		} else {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Delete with params: %#v", *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Error("Expected call to LocalUserCacheMock.Delete")
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to LocalUserCacheMock.Delete but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), afterDeleteCounter)
+	}
+}
+
+type mLocalUserCacheMockFill struct {
+	optional           bool
+	mock               *LocalUserCacheMock
+	defaultExpectation *LocalUserCacheMockFillExpectation
+	expectations       []*LocalUserCacheMockFillExpectation
+
+	callArgs []*LocalUserCacheMockFillParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LocalUserCacheMockFillExpectation specifies expectation struct of the LocalUserCache.Fill
+type LocalUserCacheMockFillExpectation struct {
+	mock      *LocalUserCacheMock
+	params    *LocalUserCacheMockFillParams
+	paramPtrs *LocalUserCacheMockFillParamPtrs
+	results   *LocalUserCacheMockFillResults
+	Counter   uint64
+}
+
+// LocalUserCacheMockFillParams contains parameters of the LocalUserCache.Fill
+type LocalUserCacheMockFillParams struct {
+	ctx    context.Context
+	params model.User
+}
+
+// LocalUserCacheMockFillParamPtrs contains pointers to parameters of the LocalUserCache.Fill
+type LocalUserCacheMockFillParamPtrs struct {
+	ctx    *context.Context
+	params *model.User
+}
+
+// LocalUserCacheMockFillResults contains results of the LocalUserCache.Fill
+type LocalUserCacheMockFillResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFill *mLocalUserCacheMockFill) Optional() *mLocalUserCacheMockFill {
+	mmFill.optional = true
+	return mmFill
+}
+
+// Expect sets up expected params for LocalUserCache.Fill
+func (mmFill *mLocalUserCacheMockFill) Expect(ctx context.Context, params model.User) *mLocalUserCacheMockFill {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &LocalUserCacheMockFillExpectation{}
+	}
+
+	if mmFill.defaultExpectation.paramPtrs != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by ExpectParams functions")
+	}
+
+	mmFill.defaultExpectation.params = &LocalUserCacheMockFillParams{ctx, params}
+	for _, e := range mmFill.expectations {
+		if minimock.Equal(e.params, mmFill.defaultExpectation.params) {
+			mmFill.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFill.defaultExpectation.params)
+		}
+	}
+
+	return mmFill
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LocalUserCache.Fill
+func (mmFill *mLocalUserCacheMockFill) ExpectCtxParam1(ctx context.Context) *mLocalUserCacheMockFill {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &LocalUserCacheMockFillExpectation{}
+	}
+
+	if mmFill.defaultExpectation.params != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Expect")
+	}
+
+	if mmFill.defaultExpectation.paramPtrs == nil {
+		mmFill.defaultExpectation.paramPtrs = &LocalUserCacheMockFillParamPtrs{}
+	}
+	mmFill.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFill
+}
+
+// ExpectParamsParam2 sets up expected param params for LocalUserCache.Fill
+func (mmFill *mLocalUserCacheMockFill) ExpectParamsParam2(params model.User) *mLocalUserCacheMockFill {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &LocalUserCacheMockFillExpectation{}
+	}
+
+	if mmFill.defaultExpectation.params != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Expect")
+	}
+
+	if mmFill.defaultExpectation.paramPtrs == nil {
+		mmFill.defaultExpectation.paramPtrs = &LocalUserCacheMockFillParamPtrs{}
+	}
+	mmFill.defaultExpectation.paramPtrs.params = &params
+
+	return mmFill
+}
+
+// Inspect accepts an inspector function that has same arguments as the LocalUserCache.Fill
+func (mmFill *mLocalUserCacheMockFill) Inspect(f func(ctx context.Context, params model.User)) *mLocalUserCacheMockFill {
+	if mmFill.mock.inspectFuncFill != nil {
+		mmFill.mock.t.Fatalf("Inspect function is already set for LocalUserCacheMock.Fill")
+	}
+
+	mmFill.mock.inspectFuncFill = f
+
+	return mmFill
+}
+
+// Return sets up results that will be returned by LocalUserCache.Fill
+func (mmFill *mLocalUserCacheMockFill) Return(err error) *LocalUserCacheMock {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &LocalUserCacheMockFillExpectation{mock: mmFill.mock}
+	}
+	mmFill.defaultExpectation.results = &LocalUserCacheMockFillResults{err}
+	return mmFill.mock
+}
+
+// Set uses given function f to mock the LocalUserCache.Fill method
+func (mmFill *mLocalUserCacheMockFill) Set(f func(ctx context.Context, params model.User) (err error)) *LocalUserCacheMock {
+	if mmFill.defaultExpectation != nil {
+		mmFill.mock.t.Fatalf("Default expectation is already set for the LocalUserCache.Fill method")
+	}
+
+	if len(mmFill.expectations) > 0 {
+		mmFill.mock.t.Fatalf("Some expectations are already set for the LocalUserCache.Fill method")
+	}
+
+	mmFill.mock.funcFill = f
+	return mmFill.mock
+}
+
+// When sets expectation for the LocalUserCache.Fill which will trigger the result defined by the following
+// Then helper
+func (mmFill *mLocalUserCacheMockFill) When(ctx context.Context, params model.User) *LocalUserCacheMockFillExpectation {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("LocalUserCacheMock.Fill mock is already set by Set")
+	}
+
+	expectation := &LocalUserCacheMockFillExpectation{
+		mock:   mmFill.mock,
+		params: &LocalUserCacheMockFillParams{ctx, params},
+	}
+	mmFill.expectations = append(mmFill.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LocalUserCache.Fill return parameters for the expectation previously defined by the When method
+func (e *LocalUserCacheMockFillExpectation) Then(err error) *LocalUserCacheMock {
+	e.results = &LocalUserCacheMockFillResults{err}
+	return e.mock
+}
+
+// Times sets number of times LocalUserCache.Fill should be invoked
+func (mmFill *mLocalUserCacheMockFill) Times(n uint64) *mLocalUserCacheMockFill {
+	if n == 0 {
+		mmFill.mock.t.Fatalf("Times of LocalUserCacheMock.Fill mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFill.expectedInvocations, n)
+	return mmFill
+}
+
+func (mmFill *mLocalUserCacheMockFill) invocationsDone() bool {
+	if len(mmFill.expectations) == 0 && mmFill.defaultExpectation == nil && mmFill.mock.funcFill == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFill.mock.afterFillCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFill.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Fill implements cache.LocalUserCache
+func (mmFill *LocalUserCacheMock) Fill(ctx context.Context, params model.User) (err error) {
+	mm_atomic.AddUint64(&mmFill.beforeFillCounter, 1)
+	defer mm_atomic.AddUint64(&mmFill.afterFillCounter, 1)
+
+	if mmFill.inspectFuncFill != nil {
+		mmFill.inspectFuncFill(ctx, params)
+	}
+
+	mm_params := LocalUserCacheMockFillParams{ctx, params}
+
+	// Record call args
+	mmFill.FillMock.mutex.Lock()
+	mmFill.FillMock.callArgs = append(mmFill.FillMock.callArgs, &mm_params)
+	mmFill.FillMock.mutex.Unlock()
+
+	for _, e := range mmFill.FillMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFill.FillMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFill.FillMock.defaultExpectation.Counter, 1)
+		mm_want := mmFill.FillMock.defaultExpectation.params
+		mm_want_ptrs := mmFill.FillMock.defaultExpectation.paramPtrs
+
+		mm_got := LocalUserCacheMockFillParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFill.t.Errorf("LocalUserCacheMock.Fill got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmFill.t.Errorf("LocalUserCacheMock.Fill got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFill.t.Errorf("LocalUserCacheMock.Fill got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFill.FillMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFill.t.Fatal("No results are set for the LocalUserCacheMock.Fill")
+		}
+		return (*mm_results).err
+	}
+	if mmFill.funcFill != nil {
+		return mmFill.funcFill(ctx, params)
+	}
+	mmFill.t.Fatalf("Unexpected call to LocalUserCacheMock.Fill. %v %v", ctx, params)
+	return
+}
+
+// FillAfterCounter returns a count of finished LocalUserCacheMock.Fill invocations
+func (mmFill *LocalUserCacheMock) FillAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFill.afterFillCounter)
+}
+
+// FillBeforeCounter returns a count of LocalUserCacheMock.Fill invocations
+func (mmFill *LocalUserCacheMock) FillBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFill.beforeFillCounter)
+}
+
+// Calls returns a list of arguments used in each call to LocalUserCacheMock.Fill.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFill *mLocalUserCacheMockFill) Calls() []*LocalUserCacheMockFillParams {
+	mmFill.mutex.RLock()
+
+	argCopy := make([]*LocalUserCacheMockFillParams, len(mmFill.callArgs))
+	copy(argCopy, mmFill.callArgs)
+
+	mmFill.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFillDone returns true if the count of the Fill invocations corresponds
+// the number of defined expectations
+func (m *LocalUserCacheMock) MinimockFillDone() bool {
+	if m.FillMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FillMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FillMock.invocationsDone()
+}
+
+// MinimockFillInspect logs each unmet expectation
+func (m *LocalUserCacheMock) MinimockFillInspect() {
+	for _, e := range m.FillMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Fill with params: %#v", *e.params)
+		}
+	}
+
+	afterFillCounter := mm_atomic.LoadUint64(&m.afterFillCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FillMock.defaultExpectation != nil && afterFillCounter < 1 {
+		if m.FillMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LocalUserCacheMock.Fill")
+		} else {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Fill with params: %#v", *m.FillMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFill != nil && afterFillCounter < 1 {
+		m.t.Error("Expected call to LocalUserCacheMock.Fill")
+	}
+
+	if !m.FillMock.invocationsDone() && afterFillCounter > 0 {
+		m.t.Errorf("Expected %d calls to LocalUserCacheMock.Fill but found %d calls",
+			mm_atomic.LoadUint64(&m.FillMock.expectedInvocations), afterFillCounter)
+	}
+}
+
+type mLocalUserCacheMockGet struct {
+	optional           bool
+	mock               *LocalUserCacheMock
+	defaultExpectation *LocalUserCacheMockGetExpectation
+	expectations       []*LocalUserCacheMockGetExpectation
+
+	callArgs []*LocalUserCacheMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LocalUserCacheMockGetExpectation specifies expectation struct of the LocalUserCache.Get
+type LocalUserCacheMockGetExpectation struct {
+	mock      *LocalUserCacheMock
+	params    *LocalUserCacheMockGetParams
+	paramPtrs *LocalUserCacheMockGetParamPtrs
+	results   *LocalUserCacheMockGetResults
+	Counter   uint64
+}
+
+// LocalUserCacheMockGetParams contains parameters of the LocalUserCache.Get
+type LocalUserCacheMockGetParams struct {
+	ctx    context.Context
+	params model.GetUserParams
+}
+
+// LocalUserCacheMockGetParamPtrs contains pointers to parameters of the LocalUserCache.Get
+type LocalUserCacheMockGetParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetUserParams
+}
+
+// LocalUserCacheMockGetResults contains results of the LocalUserCache.Get
+type LocalUserCacheMockGetResults struct {
+	resp model.GetUserResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mLocalUserCacheMockGet) Optional() *mLocalUserCacheMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for LocalUserCache.Get
+func (mmGet *mLocalUserCacheMockGet) Expect(ctx context.Context, params model.GetUserParams) *mLocalUserCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &LocalUserCacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &LocalUserCacheMockGetParams{ctx, params}
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LocalUserCache.Get
+func (mmGet *mLocalUserCacheMockGet) ExpectCtxParam1(ctx context.Context) *mLocalUserCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &LocalUserCacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &LocalUserCacheMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGet
+}
+
+// ExpectParamsParam2 sets up expected param params for LocalUserCache.Get
+func (mmGet *mLocalUserCacheMockGet) ExpectParamsParam2(params model.GetUserParams) *mLocalUserCacheMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &LocalUserCacheMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &LocalUserCacheMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.params = &params
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the LocalUserCache.Get
+func (mmGet *mLocalUserCacheMockGet) Inspect(f func(ctx context.Context, params model.GetUserParams)) *mLocalUserCacheMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for LocalUserCacheMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by LocalUserCache.Get
+func (mmGet *mLocalUserCacheMockGet) Return(resp model.GetUserResponse, err error) *LocalUserCacheMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &LocalUserCacheMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &LocalUserCacheMockGetResults{resp, err}
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the LocalUserCache.Get method
+func (mmGet *mLocalUserCacheMockGet) Set(f func(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error)) *LocalUserCacheMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the LocalUserCache.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the LocalUserCache.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	return mmGet.mock
+}
+
+// When sets expectation for the LocalUserCache.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mLocalUserCacheMockGet) When(ctx context.Context, params model.GetUserParams) *LocalUserCacheMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("LocalUserCacheMock.Get mock is already set by Set")
+	}
+
+	expectation := &LocalUserCacheMockGetExpectation{
+		mock:   mmGet.mock,
+		params: &LocalUserCacheMockGetParams{ctx, params},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LocalUserCache.Get return parameters for the expectation previously defined by the When method
+func (e *LocalUserCacheMockGetExpectation) Then(resp model.GetUserResponse, err error) *LocalUserCacheMock {
+	e.results = &LocalUserCacheMockGetResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LocalUserCache.Get should be invoked
+func (mmGet *mLocalUserCacheMockGet) Times(n uint64) *mLocalUserCacheMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of LocalUserCacheMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	return mmGet
+}
+
+func (mmGet *mLocalUserCacheMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements cache.LocalUserCache
+func (mmGet *LocalUserCacheMock) Get(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, params)
+	}
+
+	mm_params := LocalUserCacheMockGetParams{ctx, params}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := LocalUserCacheMockGetParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("LocalUserCacheMock.Get got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGet.t.Errorf("LocalUserCacheMock.Get got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("LocalUserCacheMock.Get got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the LocalUserCacheMock.Get")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, params)
+	}
+	mmGet.t.Fatalf("Unexpected call to LocalUserCacheMock.Get. %v %v", ctx, params)
+	return
+}
+
+// GetAfterCounter returns a count of finished LocalUserCacheMock.Get invocations
+func (mmGet *LocalUserCacheMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of LocalUserCacheMock.Get invocations
+func (mmGet *LocalUserCacheMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to LocalUserCacheMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mLocalUserCacheMockGet) Calls() []*LocalUserCacheMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*LocalUserCacheMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *LocalUserCacheMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *LocalUserCacheMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Get with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LocalUserCacheMock.Get")
+		} else {
+			m.t.Errorf("Expected call to LocalUserCacheMock.Get with params: %#v", *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Error("Expected call to LocalUserCacheMock.Get")
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to LocalUserCacheMock.Get but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), afterGetCounter)
+	}
+}
+
+type mLocalUserCacheMockRunInvalidation struct {
+	optional           bool
+	mock               *LocalUserCacheMock
+	defaultExpectation *LocalUserCacheMockRunInvalidationExpectation
+	expectations       []*LocalUserCacheMockRunInvalidationExpectation
+
+	callArgs []*LocalUserCacheMockRunInvalidationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LocalUserCacheMockRunInvalidationExpectation specifies expectation struct of the LocalUserCache.RunInvalidation
+type LocalUserCacheMockRunInvalidationExpectation struct {
+	mock      *LocalUserCacheMock
+	params    *LocalUserCacheMockRunInvalidationParams
+	paramPtrs *LocalUserCacheMockRunInvalidationParamPtrs
+	results   *LocalUserCacheMockRunInvalidationResults
+	Counter   uint64
+}
+
+// LocalUserCacheMockRunInvalidationParams contains parameters of the LocalUserCache.RunInvalidation
+type LocalUserCacheMockRunInvalidationParams struct {
+	ctx context.Context
+}
+
+// LocalUserCacheMockRunInvalidationParamPtrs contains pointers to parameters of the LocalUserCache.RunInvalidation
+type LocalUserCacheMockRunInvalidationParamPtrs struct {
+	ctx *context.Context
+}
+
+// LocalUserCacheMockRunInvalidationResults contains results of the LocalUserCache.RunInvalidation
+type LocalUserCacheMockRunInvalidationResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Optional() *mLocalUserCacheMockRunInvalidation {
+	mmRunInvalidation.optional = true
+	return mmRunInvalidation
+}
+
+// Expect sets up expected params for LocalUserCache.RunInvalidation
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Expect(ctx context.Context) *mLocalUserCacheMockRunInvalidation {
+	if mmRunInvalidation.mock.funcRunInvalidation != nil {
+		mmRunInvalidation.mock.t.Fatalf("LocalUserCacheMock.RunInvalidation mock is already set by Set")
+	}
+
+	if mmRunInvalidation.defaultExpectation == nil {
+		mmRunInvalidation.defaultExpectation = &LocalUserCacheMockRunInvalidationExpectation{}
+	}
+
+	if mmRunInvalidation.defaultExpectation.paramPtrs != nil {
+		mmRunInvalidation.mock.t.Fatalf("LocalUserCacheMock.RunInvalidation mock is already set by ExpectParams functions")
+	}
+
+	mmRunInvalidation.defaultExpectation.params = &LocalUserCacheMockRunInvalidationParams{ctx}
+	for _, e := range mmRunInvalidation.expectations {
+		if minimock.Equal(e.params, mmRunInvalidation.defaultExpectation.params) {
+			mmRunInvalidation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRunInvalidation.defaultExpectation.params)
+		}
+	}
+
+	return mmRunInvalidation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LocalUserCache.RunInvalidation
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) ExpectCtxParam1(ctx context.Context) *mLocalUserCacheMockRunInvalidation {
+	if mmRunInvalidation.mock.funcRunInvalidation != nil {
+		mmRunInvalidation.mock.t.Fatalf("LocalUserCacheMock.RunInvalidation mock is already set by Set")
+	}
+
+	if mmRunInvalidation.defaultExpectation == nil {
+		mmRunInvalidation.defaultExpectation = &LocalUserCacheMockRunInvalidationExpectation{}
+	}
+
+	if mmRunInvalidation.defaultExpectation.params != nil {
+		mmRunInvalidation.mock.t.Fatalf("LocalUserCacheMock.RunInvalidation mock is already set by Expect")
+	}
+
+	if mmRunInvalidation.defaultExpectation.paramPtrs == nil {
+		mmRunInvalidation.defaultExpectation.paramPtrs = &LocalUserCacheMockRunInvalidationParamPtrs{}
+	}
+	mmRunInvalidation.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRunInvalidation
+}
+
+// Inspect accepts an inspector function that has same arguments as the LocalUserCache.RunInvalidation
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Inspect(f func(ctx context.Context)) *mLocalUserCacheMockRunInvalidation {
+	if mmRunInvalidation.mock.inspectFuncRunInvalidation != nil {
+		mmRunInvalidation.mock.t.Fatalf("Inspect function is already set for LocalUserCacheMock.RunInvalidation")
+	}
+
+	mmRunInvalidation.mock.inspectFuncRunInvalidation = f
+
+	return mmRunInvalidation
+}
+
+// Return sets up results that will be returned by LocalUserCache.RunInvalidation
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Return(err error) *LocalUserCacheMock {
+	if mmRunInvalidation.mock.funcRunInvalidation != nil {
+		mmRunInvalidation.mock.t.Fatalf("LocalUserCacheMock.RunInvalidation mock is already set by Set")
+	}
+
+	if mmRunInvalidation.defaultExpectation == nil {
+		mmRunInvalidation.defaultExpectation = &LocalUserCacheMockRunInvalidationExpectation{mock: mmRunInvalidation.mock}
+	}
+	mmRunInvalidation.defaultExpectation.results = &LocalUserCacheMockRunInvalidationResults{err}
+	return mmRunInvalidation.mock
+}
+
+// Set uses given function f to mock the LocalUserCache.RunInvalidation method
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Set(f func(ctx context.Context) (err error)) *LocalUserCacheMock {
+	if mmRunInvalidation.defaultExpectation != nil {
+		mmRunInvalidation.mock.t.Fatalf("Default expectation is already set for the LocalUserCache.RunInvalidation method")
+	}
+
+	if len(mmRunInvalidation.expectations) > 0 {
+		mmRunInvalidation.mock.t.Fatalf("Some expectations are already set for the LocalUserCache.RunInvalidation method")
+	}
+
+	mmRunInvalidation.mock.funcRunInvalidation = f
+	return mmRunInvalidation.mock
+}
+
+// When sets expectation for the LocalUserCache.RunInvalidation which will trigger the result defined by the following
+// Then helper
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) When(ctx context.Context) *LocalUserCacheMockRunInvalidationExpectation {
+	if mmRunInvalidation.mock.funcRunInvalidation != nil {
+		mmRunInvalidation.mock.t.Fatalf("LocalUserCacheMock.RunInvalidation mock is already set by Set")
+	}
+
+	expectation := &LocalUserCacheMockRunInvalidationExpectation{
+		mock:   mmRunInvalidation.mock,
+		params: &LocalUserCacheMockRunInvalidationParams{ctx},
+	}
+	mmRunInvalidation.expectations = append(mmRunInvalidation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LocalUserCache.RunInvalidation return parameters for the expectation previously defined by the When method
+func (e *LocalUserCacheMockRunInvalidationExpectation) Then(err error) *LocalUserCacheMock {
+	e.results = &LocalUserCacheMockRunInvalidationResults{err}
+	return e.mock
+}
+
+// Times sets number of times LocalUserCache.RunInvalidation should be invoked
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Times(n uint64) *mLocalUserCacheMockRunInvalidation {
+	if n == 0 {
+		mmRunInvalidation.mock.t.Fatalf("Times of LocalUserCacheMock.RunInvalidation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRunInvalidation.expectedInvocations, n)
+	return mmRunInvalidation
+}
+
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) invocationsDone() bool {
+	if len(mmRunInvalidation.expectations) == 0 && mmRunInvalidation.defaultExpectation == nil && mmRunInvalidation.mock.funcRunInvalidation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRunInvalidation.mock.afterRunInvalidationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRunInvalidation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RunInvalidation implements cache.LocalUserCache
+func (mmRunInvalidation *LocalUserCacheMock) RunInvalidation(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmRunInvalidation.beforeRunInvalidationCounter, 1)
+	defer mm_atomic.AddUint64(&mmRunInvalidation.afterRunInvalidationCounter, 1)
+
+	if mmRunInvalidation.inspectFuncRunInvalidation != nil {
+		mmRunInvalidation.inspectFuncRunInvalidation(ctx)
+	}
+
+	mm_params := LocalUserCacheMockRunInvalidationParams{ctx}
+
+	// Record call args
+	mmRunInvalidation.RunInvalidationMock.mutex.Lock()
+	mmRunInvalidation.RunInvalidationMock.callArgs = append(mmRunInvalidation.RunInvalidationMock.callArgs, &mm_params)
+	mmRunInvalidation.RunInvalidationMock.mutex.Unlock()
+
+	for _, e := range mmRunInvalidation.RunInvalidationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRunInvalidation.RunInvalidationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRunInvalidation.RunInvalidationMock.defaultExpectation.Counter, 1)
+		mm_want := mmRunInvalidation.RunInvalidationMock.defaultExpectation.params
+		mm_want_ptrs := mmRunInvalidation.RunInvalidationMock.defaultExpectation.paramPtrs
+
+		mm_got := LocalUserCacheMockRunInvalidationParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRunInvalidation.t.Errorf("LocalUserCacheMock.RunInvalidation got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRunInvalidation.t.Errorf("LocalUserCacheMock.RunInvalidation got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRunInvalidation.RunInvalidationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRunInvalidation.t.Fatal("No results are set for the LocalUserCacheMock.RunInvalidation")
+		}
+		return (*mm_results).err
+	}
+	if mmRunInvalidation.funcRunInvalidation != nil {
+		return mmRunInvalidation.funcRunInvalidation(ctx)
+	}
+	mmRunInvalidation.t.Fatalf("Unexpected call to LocalUserCacheMock.RunInvalidation. %v", ctx)
+	return
+}
+
+// RunInvalidationAfterCounter returns a count of finished LocalUserCacheMock.RunInvalidation invocations
+func (mmRunInvalidation *LocalUserCacheMock) RunInvalidationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunInvalidation.afterRunInvalidationCounter)
+}
+
+// RunInvalidationBeforeCounter returns a count of LocalUserCacheMock.RunInvalidation invocations
+func (mmRunInvalidation *LocalUserCacheMock) RunInvalidationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunInvalidation.beforeRunInvalidationCounter)
+}
+
+// Calls returns a list of arguments used in each call to LocalUserCacheMock.RunInvalidation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRunInvalidation *mLocalUserCacheMockRunInvalidation) Calls() []*LocalUserCacheMockRunInvalidationParams {
+	mmRunInvalidation.mutex.RLock()
+
+	argCopy := make([]*LocalUserCacheMockRunInvalidationParams, len(mmRunInvalidation.callArgs))
+	copy(argCopy, mmRunInvalidation.callArgs)
+
+	mmRunInvalidation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunInvalidationDone returns true if the count of the RunInvalidation invocations corresponds
+// the number of defined expectations
+func (m *LocalUserCacheMock) MinimockRunInvalidationDone() bool {
+	if m.RunInvalidationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunInvalidationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunInvalidationMock.invocationsDone()
+}
+
+// MinimockRunInvalidationInspect logs each unmet expectation
+func (m *LocalUserCacheMock) MinimockRunInvalidationInspect() {
+	for _, e := range m.RunInvalidationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LocalUserCacheMock.RunInvalidation with params: %#v", *e.params)
+		}
+	}
+
+	afterRunInvalidationCounter := mm_atomic.LoadUint64(&m.afterRunInvalidationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunInvalidationMock.defaultExpectation != nil && afterRunInvalidationCounter < 1 {
+		if m.RunInvalidationMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LocalUserCacheMock.RunInvalidation")
+		} else {
+			m.t.Errorf("Expected call to LocalUserCacheMock.RunInvalidation with params: %#v", *m.RunInvalidationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRunInvalidation != nil && afterRunInvalidationCounter < 1 {
+		m.t.Error("Expected call to LocalUserCacheMock.RunInvalidation")
+	}
+
+	if !m.RunInvalidationMock.invocationsDone() && afterRunInvalidationCounter > 0 {
+		m.t.Errorf("Expected %d calls to LocalUserCacheMock.RunInvalidation but found %d calls",
+			mm_atomic.LoadUint64(&m.RunInvalidationMock.expectedInvocations), afterRunInvalidationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LocalUserCacheMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateInspect()
+
+			m.MinimockCreateMissingInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockFillInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockRunInvalidationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LocalUserCacheMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LocalUserCacheMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateDone() &&
+		m.MinimockCreateMissingDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockFillDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockRunInvalidationDone()
+}
@@ -25,12 +25,24 @@ type UserCacheMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mUserCacheMockCreate
 
+	funcCreateMissing          func(ctx context.Context, params model.GetUserParams) (err error)
+	inspectFuncCreateMissing   func(ctx context.Context, params model.GetUserParams)
+	afterCreateMissingCounter  uint64
+	beforeCreateMissingCounter uint64
+	CreateMissingMock          mUserCacheMockCreateMissing
+
 	funcDelete          func(ctx context.Context, params model.DeleteUserParams) (err error)
 	inspectFuncDelete   func(ctx context.Context, params model.DeleteUserParams)
 	afterDeleteCounter  uint64
 	beforeDeleteCounter uint64
 	DeleteMock          mUserCacheMockDelete
 
+	funcFill          func(ctx context.Context, params model.User) (err error)
+	inspectFuncFill   func(ctx context.Context, params model.User)
+	afterFillCounter  uint64
+	beforeFillCounter uint64
+	FillMock          mUserCacheMockFill
+
 	funcGet          func(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error)
 	inspectFuncGet   func(ctx context.Context, params model.GetUserParams)
 	afterGetCounter  uint64
@@ -49,9 +61,15 @@ func NewUserCacheMock(t minimock.Tester) *UserCacheMock {
 	m.CreateMock = mUserCacheMockCreate{mock: m}
 	m.CreateMock.callArgs = []*UserCacheMockCreateParams{}
 
+	m.CreateMissingMock = mUserCacheMockCreateMissing{mock: m}
+	m.CreateMissingMock.callArgs = []*UserCacheMockCreateMissingParams{}
+
 	m.DeleteMock = mUserCacheMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*UserCacheMockDeleteParams{}
 
+	m.FillMock = mUserCacheMockFill{mock: m}
+	m.FillMock.callArgs = []*UserCacheMockFillParams{}
+
 	m.GetMock = mUserCacheMockGet{mock: m}
 	m.GetMock.callArgs = []*UserCacheMockGetParams{}
 
@@ -380,6 +398,326 @@ func (m *UserCacheMock) MinimockCreateInspect() {
 	}
 }
 
+type mUserCacheMockCreateMissing struct {
+	optional           bool
+	mock               *UserCacheMock
+	defaultExpectation *UserCacheMockCreateMissingExpectation
+	expectations       []*UserCacheMockCreateMissingExpectation
+
+	callArgs []*UserCacheMockCreateMissingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserCacheMockCreateMissingExpectation specifies expectation struct of the UserCache.CreateMissing
+type UserCacheMockCreateMissingExpectation struct {
+	mock      *UserCacheMock
+	params    *UserCacheMockCreateMissingParams
+	paramPtrs *UserCacheMockCreateMissingParamPtrs
+	results   *UserCacheMockCreateMissingResults
+	Counter   uint64
+}
+
+// UserCacheMockCreateMissingParams contains parameters of the UserCache.CreateMissing
+type UserCacheMockCreateMissingParams struct {
+	ctx    context.Context
+	params model.GetUserParams
+}
+
+// UserCacheMockCreateMissingParamPtrs contains pointers to parameters of the UserCache.CreateMissing
+type UserCacheMockCreateMissingParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetUserParams
+}
+
+// UserCacheMockCreateMissingResults contains results of the UserCache.CreateMissing
+type UserCacheMockCreateMissingResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateMissing *mUserCacheMockCreateMissing) Optional() *mUserCacheMockCreateMissing {
+	mmCreateMissing.optional = true
+	return mmCreateMissing
+}
+
+// Expect sets up expected params for UserCache.CreateMissing
+func (mmCreateMissing *mUserCacheMockCreateMissing) Expect(ctx context.Context, params model.GetUserParams) *mUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &UserCacheMockCreateMissingExpectation{}
+	}
+
+	if mmCreateMissing.defaultExpectation.paramPtrs != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by ExpectParams functions")
+	}
+
+	mmCreateMissing.defaultExpectation.params = &UserCacheMockCreateMissingParams{ctx, params}
+	for _, e := range mmCreateMissing.expectations {
+		if minimock.Equal(e.params, mmCreateMissing.defaultExpectation.params) {
+			mmCreateMissing.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateMissing.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateMissing
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserCache.CreateMissing
+func (mmCreateMissing *mUserCacheMockCreateMissing) ExpectCtxParam1(ctx context.Context) *mUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &UserCacheMockCreateMissingExpectation{}
+	}
+
+	if mmCreateMissing.defaultExpectation.params != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Expect")
+	}
+
+	if mmCreateMissing.defaultExpectation.paramPtrs == nil {
+		mmCreateMissing.defaultExpectation.paramPtrs = &UserCacheMockCreateMissingParamPtrs{}
+	}
+	mmCreateMissing.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateMissing
+}
+
+// ExpectParamsParam2 sets up expected param params for UserCache.CreateMissing
+func (mmCreateMissing *mUserCacheMockCreateMissing) ExpectParamsParam2(params model.GetUserParams) *mUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &UserCacheMockCreateMissingExpectation{}
+	}
+
+	if mmCreateMissing.defaultExpectation.params != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Expect")
+	}
+
+	if mmCreateMissing.defaultExpectation.paramPtrs == nil {
+		mmCreateMissing.defaultExpectation.paramPtrs = &UserCacheMockCreateMissingParamPtrs{}
+	}
+	mmCreateMissing.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateMissing
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserCache.CreateMissing
+func (mmCreateMissing *mUserCacheMockCreateMissing) Inspect(f func(ctx context.Context, params model.GetUserParams)) *mUserCacheMockCreateMissing {
+	if mmCreateMissing.mock.inspectFuncCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("Inspect function is already set for UserCacheMock.CreateMissing")
+	}
+
+	mmCreateMissing.mock.inspectFuncCreateMissing = f
+
+	return mmCreateMissing
+}
+
+// Return sets up results that will be returned by UserCache.CreateMissing
+func (mmCreateMissing *mUserCacheMockCreateMissing) Return(err error) *UserCacheMock {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	if mmCreateMissing.defaultExpectation == nil {
+		mmCreateMissing.defaultExpectation = &UserCacheMockCreateMissingExpectation{mock: mmCreateMissing.mock}
+	}
+	mmCreateMissing.defaultExpectation.results = &UserCacheMockCreateMissingResults{err}
+	return mmCreateMissing.mock
+}
+
+// Set uses given function f to mock the UserCache.CreateMissing method
+func (mmCreateMissing *mUserCacheMockCreateMissing) Set(f func(ctx context.Context, params model.GetUserParams) (err error)) *UserCacheMock {
+	if mmCreateMissing.defaultExpectation != nil {
+		mmCreateMissing.mock.t.Fatalf("Default expectation is already set for the UserCache.CreateMissing method")
+	}
+
+	if len(mmCreateMissing.expectations) > 0 {
+		mmCreateMissing.mock.t.Fatalf("Some expectations are already set for the UserCache.CreateMissing method")
+	}
+
+	mmCreateMissing.mock.funcCreateMissing = f
+	return mmCreateMissing.mock
+}
+
+// When sets expectation for the UserCache.CreateMissing which will trigger the result defined by the following
+// Then helper
+func (mmCreateMissing *mUserCacheMockCreateMissing) When(ctx context.Context, params model.GetUserParams) *UserCacheMockCreateMissingExpectation {
+	if mmCreateMissing.mock.funcCreateMissing != nil {
+		mmCreateMissing.mock.t.Fatalf("UserCacheMock.CreateMissing mock is already set by Set")
+	}
+
+	expectation := &UserCacheMockCreateMissingExpectation{
+		mock:   mmCreateMissing.mock,
+		params: &UserCacheMockCreateMissingParams{ctx, params},
+	}
+	mmCreateMissing.expectations = append(mmCreateMissing.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserCache.CreateMissing return parameters for the expectation previously defined by the When method
+func (e *UserCacheMockCreateMissingExpectation) Then(err error) *UserCacheMock {
+	e.results = &UserCacheMockCreateMissingResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserCache.CreateMissing should be invoked
+func (mmCreateMissing *mUserCacheMockCreateMissing) Times(n uint64) *mUserCacheMockCreateMissing {
+	if n == 0 {
+		mmCreateMissing.mock.t.Fatalf("Times of UserCacheMock.CreateMissing mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateMissing.expectedInvocations, n)
+	return mmCreateMissing
+}
+
+func (mmCreateMissing *mUserCacheMockCreateMissing) invocationsDone() bool {
+	if len(mmCreateMissing.expectations) == 0 && mmCreateMissing.defaultExpectation == nil && mmCreateMissing.mock.funcCreateMissing == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateMissing.mock.afterCreateMissingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateMissing.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateMissing implements cache.UserCache
+func (mmCreateMissing *UserCacheMock) CreateMissing(ctx context.Context, params model.GetUserParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateMissing.beforeCreateMissingCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateMissing.afterCreateMissingCounter, 1)
+
+	if mmCreateMissing.inspectFuncCreateMissing != nil {
+		mmCreateMissing.inspectFuncCreateMissing(ctx, params)
+	}
+
+	mm_params := UserCacheMockCreateMissingParams{ctx, params}
+
+	// Record call args
+	mmCreateMissing.CreateMissingMock.mutex.Lock()
+	mmCreateMissing.CreateMissingMock.callArgs = append(mmCreateMissing.CreateMissingMock.callArgs, &mm_params)
+	mmCreateMissing.CreateMissingMock.mutex.Unlock()
+
+	for _, e := range mmCreateMissing.CreateMissingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateMissing.CreateMissingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateMissing.CreateMissingMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateMissing.CreateMissingMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateMissing.CreateMissingMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCacheMockCreateMissingParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateMissing.t.Errorf("UserCacheMock.CreateMissing got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateMissing.t.Errorf("UserCacheMock.CreateMissing got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateMissing.t.Errorf("UserCacheMock.CreateMissing got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateMissing.CreateMissingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateMissing.t.Fatal("No results are set for the UserCacheMock.CreateMissing")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateMissing.funcCreateMissing != nil {
+		return mmCreateMissing.funcCreateMissing(ctx, params)
+	}
+	mmCreateMissing.t.Fatalf("Unexpected call to UserCacheMock.CreateMissing. %v %v", ctx, params)
+	return
+}
+
+// CreateMissingAfterCounter returns a count of finished UserCacheMock.CreateMissing invocations
+func (mmCreateMissing *UserCacheMock) CreateMissingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMissing.afterCreateMissingCounter)
+}
+
+// CreateMissingBeforeCounter returns a count of UserCacheMock.CreateMissing invocations
+func (mmCreateMissing *UserCacheMock) CreateMissingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateMissing.beforeCreateMissingCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCacheMock.CreateMissing.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateMissing *mUserCacheMockCreateMissing) Calls() []*UserCacheMockCreateMissingParams {
+	mmCreateMissing.mutex.RLock()
+
+	argCopy := make([]*UserCacheMockCreateMissingParams, len(mmCreateMissing.callArgs))
+	copy(argCopy, mmCreateMissing.callArgs)
+
+	mmCreateMissing.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateMissingDone returns true if the count of the CreateMissing invocations corresponds
+// the number of defined expectations
+func (m *UserCacheMock) MinimockCreateMissingDone() bool {
+	if m.CreateMissingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMissingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMissingMock.invocationsDone()
+}
+
+// MinimockCreateMissingInspect logs each unmet expectation
+func (m *UserCacheMock) MinimockCreateMissingInspect() {
+	for _, e := range m.CreateMissingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCacheMock.CreateMissing with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateMissingCounter := mm_atomic.LoadUint64(&m.afterCreateMissingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMissingMock.defaultExpectation != nil && afterCreateMissingCounter < 1 {
+		if m.CreateMissingMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserCacheMock.CreateMissing")
+		} else {
+			m.t.Errorf("Expected call to UserCacheMock.CreateMissing with params: %#v", *m.CreateMissingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateMissing != nil && afterCreateMissingCounter < 1 {
+		m.t.Error("Expected call to UserCacheMock.CreateMissing")
+	}
+
+	if !m.CreateMissingMock.invocationsDone() && afterCreateMissingCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCacheMock.CreateMissing but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMissingMock.expectedInvocations), afterCreateMissingCounter)
+	}
+}
+
 type mUserCacheMockDelete struct {
 	optional           bool
 	mock               *UserCacheMock
@@ -700,6 +1038,326 @@ func (m *UserCacheMock) MinimockDeleteInspect() {
 	}
 }
 
+type mUserCacheMockFill struct {
+	optional           bool
+	mock               *UserCacheMock
+	defaultExpectation *UserCacheMockFillExpectation
+	expectations       []*UserCacheMockFillExpectation
+
+	callArgs []*UserCacheMockFillParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserCacheMockFillExpectation specifies expectation struct of the UserCache.Fill
+type UserCacheMockFillExpectation struct {
+	mock      *UserCacheMock
+	params    *UserCacheMockFillParams
+	paramPtrs *UserCacheMockFillParamPtrs
+	results   *UserCacheMockFillResults
+	Counter   uint64
+}
+
+// UserCacheMockFillParams contains parameters of the UserCache.Fill
+type UserCacheMockFillParams struct {
+	ctx    context.Context
+	params model.User
+}
+
+// UserCacheMockFillParamPtrs contains pointers to parameters of the UserCache.Fill
+type UserCacheMockFillParamPtrs struct {
+	ctx    *context.Context
+	params *model.User
+}
+
+// UserCacheMockFillResults contains results of the UserCache.Fill
+type UserCacheMockFillResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFill *mUserCacheMockFill) Optional() *mUserCacheMockFill {
+	mmFill.optional = true
+	return mmFill
+}
+
+// Expect sets up expected params for UserCache.Fill
+func (mmFill *mUserCacheMockFill) Expect(ctx context.Context, params model.User) *mUserCacheMockFill {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &UserCacheMockFillExpectation{}
+	}
+
+	if mmFill.defaultExpectation.paramPtrs != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by ExpectParams functions")
+	}
+
+	mmFill.defaultExpectation.params = &UserCacheMockFillParams{ctx, params}
+	for _, e := range mmFill.expectations {
+		if minimock.Equal(e.params, mmFill.defaultExpectation.params) {
+			mmFill.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFill.defaultExpectation.params)
+		}
+	}
+
+	return mmFill
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserCache.Fill
+func (mmFill *mUserCacheMockFill) ExpectCtxParam1(ctx context.Context) *mUserCacheMockFill {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &UserCacheMockFillExpectation{}
+	}
+
+	if mmFill.defaultExpectation.params != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Expect")
+	}
+
+	if mmFill.defaultExpectation.paramPtrs == nil {
+		mmFill.defaultExpectation.paramPtrs = &UserCacheMockFillParamPtrs{}
+	}
+	mmFill.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmFill
+}
+
+// ExpectParamsParam2 sets up expected param params for UserCache.Fill
+func (mmFill *mUserCacheMockFill) ExpectParamsParam2(params model.User) *mUserCacheMockFill {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &UserCacheMockFillExpectation{}
+	}
+
+	if mmFill.defaultExpectation.params != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Expect")
+	}
+
+	if mmFill.defaultExpectation.paramPtrs == nil {
+		mmFill.defaultExpectation.paramPtrs = &UserCacheMockFillParamPtrs{}
+	}
+	mmFill.defaultExpectation.paramPtrs.params = &params
+
+	return mmFill
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserCache.Fill
+func (mmFill *mUserCacheMockFill) Inspect(f func(ctx context.Context, params model.User)) *mUserCacheMockFill {
+	if mmFill.mock.inspectFuncFill != nil {
+		mmFill.mock.t.Fatalf("Inspect function is already set for UserCacheMock.Fill")
+	}
+
+	mmFill.mock.inspectFuncFill = f
+
+	return mmFill
+}
+
+// Return sets up results that will be returned by UserCache.Fill
+func (mmFill *mUserCacheMockFill) Return(err error) *UserCacheMock {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Set")
+	}
+
+	if mmFill.defaultExpectation == nil {
+		mmFill.defaultExpectation = &UserCacheMockFillExpectation{mock: mmFill.mock}
+	}
+	mmFill.defaultExpectation.results = &UserCacheMockFillResults{err}
+	return mmFill.mock
+}
+
+// Set uses given function f to mock the UserCache.Fill method
+func (mmFill *mUserCacheMockFill) Set(f func(ctx context.Context, params model.User) (err error)) *UserCacheMock {
+	if mmFill.defaultExpectation != nil {
+		mmFill.mock.t.Fatalf("Default expectation is already set for the UserCache.Fill method")
+	}
+
+	if len(mmFill.expectations) > 0 {
+		mmFill.mock.t.Fatalf("Some expectations are already set for the UserCache.Fill method")
+	}
+
+	mmFill.mock.funcFill = f
+	return mmFill.mock
+}
+
+// When sets expectation for the UserCache.Fill which will trigger the result defined by the following
+// Then helper
+func (mmFill *mUserCacheMockFill) When(ctx context.Context, params model.User) *UserCacheMockFillExpectation {
+	if mmFill.mock.funcFill != nil {
+		mmFill.mock.t.Fatalf("UserCacheMock.Fill mock is already set by Set")
+	}
+
+	expectation := &UserCacheMockFillExpectation{
+		mock:   mmFill.mock,
+		params: &UserCacheMockFillParams{ctx, params},
+	}
+	mmFill.expectations = append(mmFill.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserCache.Fill return parameters for the expectation previously defined by the When method
+func (e *UserCacheMockFillExpectation) Then(err error) *UserCacheMock {
+	e.results = &UserCacheMockFillResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserCache.Fill should be invoked
+func (mmFill *mUserCacheMockFill) Times(n uint64) *mUserCacheMockFill {
+	if n == 0 {
+		mmFill.mock.t.Fatalf("Times of UserCacheMock.Fill mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFill.expectedInvocations, n)
+	return mmFill
+}
+
+func (mmFill *mUserCacheMockFill) invocationsDone() bool {
+	if len(mmFill.expectations) == 0 && mmFill.defaultExpectation == nil && mmFill.mock.funcFill == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFill.mock.afterFillCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFill.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Fill implements cache.UserCache
+func (mmFill *UserCacheMock) Fill(ctx context.Context, params model.User) (err error) {
+	mm_atomic.AddUint64(&mmFill.beforeFillCounter, 1)
+	defer mm_atomic.AddUint64(&mmFill.afterFillCounter, 1)
+
+	if mmFill.inspectFuncFill != nil {
+		mmFill.inspectFuncFill(ctx, params)
+	}
+
+	mm_params := UserCacheMockFillParams{ctx, params}
+
+	// Record call args
+	mmFill.FillMock.mutex.Lock()
+	mmFill.FillMock.callArgs = append(mmFill.FillMock.callArgs, &mm_params)
+	mmFill.FillMock.mutex.Unlock()
+
+	for _, e := range mmFill.FillMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmFill.FillMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFill.FillMock.defaultExpectation.Counter, 1)
+		mm_want := mmFill.FillMock.defaultExpectation.params
+		mm_want_ptrs := mmFill.FillMock.defaultExpectation.paramPtrs
+
+		mm_got := UserCacheMockFillParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFill.t.Errorf("UserCacheMock.Fill got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmFill.t.Errorf("UserCacheMock.Fill got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFill.t.Errorf("UserCacheMock.Fill got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFill.FillMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFill.t.Fatal("No results are set for the UserCacheMock.Fill")
+		}
+		return (*mm_results).err
+	}
+	if mmFill.funcFill != nil {
+		return mmFill.funcFill(ctx, params)
+	}
+	mmFill.t.Fatalf("Unexpected call to UserCacheMock.Fill. %v %v", ctx, params)
+	return
+}
+
+// FillAfterCounter returns a count of finished UserCacheMock.Fill invocations
+func (mmFill *UserCacheMock) FillAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFill.afterFillCounter)
+}
+
+// FillBeforeCounter returns a count of UserCacheMock.Fill invocations
+func (mmFill *UserCacheMock) FillBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFill.beforeFillCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserCacheMock.Fill.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFill *mUserCacheMockFill) Calls() []*UserCacheMockFillParams {
+	mmFill.mutex.RLock()
+
+	argCopy := make([]*UserCacheMockFillParams, len(mmFill.callArgs))
+	copy(argCopy, mmFill.callArgs)
+
+	mmFill.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFillDone returns true if the count of the Fill invocations corresponds
+// the number of defined expectations
+func (m *UserCacheMock) MinimockFillDone() bool {
+	if m.FillMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FillMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FillMock.invocationsDone()
+}
+
+// MinimockFillInspect logs each unmet expectation
+func (m *UserCacheMock) MinimockFillInspect() {
+	for _, e := range m.FillMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserCacheMock.Fill with params: %#v", *e.params)
+		}
+	}
+
+	afterFillCounter := mm_atomic.LoadUint64(&m.afterFillCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FillMock.defaultExpectation != nil && afterFillCounter < 1 {
+		if m.FillMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserCacheMock.Fill")
+		} else {
+			m.t.Errorf("Expected call to UserCacheMock.Fill with params: %#v", *m.FillMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFill != nil && afterFillCounter < 1 {
+		m.t.Error("Expected call to UserCacheMock.Fill")
+	}
+
+	if !m.FillMock.invocationsDone() && afterFillCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserCacheMock.Fill but found %d calls",
+			mm_atomic.LoadUint64(&m.FillMock.expectedInvocations), afterFillCounter)
+	}
+}
+
 type mUserCacheMockGet struct {
 	optional           bool
 	mock               *UserCacheMock
@@ -1027,8 +1685,12 @@ func (m *UserCacheMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockCreateInspect()
 
+			m.MinimockCreateMissingInspect()
+
 			m.MinimockDeleteInspect()
 
+			m.MinimockFillInspect()
+
 			m.MinimockGetInspect()
 		}
 	})
@@ -1054,6 +1716,8 @@ func (m *UserCacheMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateDone() &&
+		m.MinimockCreateMissingDone() &&
 		m.MinimockDeleteDone() &&
+		m.MinimockFillDone() &&
 		m.MinimockGetDone()
 }
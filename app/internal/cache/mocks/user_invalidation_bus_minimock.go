@@ -0,0 +1,753 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/cache.UserInvalidationBus -o user_invalidation_bus_minimock.go -n UserInvalidationBusMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// UserInvalidationBusMock implements cache.UserInvalidationBus
+type UserInvalidationBusMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcPublish          func(ctx context.Context, userID int64) (err error)
+	inspectFuncPublish   func(ctx context.Context, userID int64)
+	afterPublishCounter  uint64
+	beforePublishCounter uint64
+	PublishMock          mUserInvalidationBusMockPublish
+
+	funcSubscribe          func(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) (err error)
+	inspectFuncSubscribe   func(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64))
+	afterSubscribeCounter  uint64
+	beforeSubscribeCounter uint64
+	SubscribeMock          mUserInvalidationBusMockSubscribe
+}
+
+// NewUserInvalidationBusMock returns a mock for cache.UserInvalidationBus
+func NewUserInvalidationBusMock(t minimock.Tester) *UserInvalidationBusMock {
+	m := &UserInvalidationBusMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.PublishMock = mUserInvalidationBusMockPublish{mock: m}
+	m.PublishMock.callArgs = []*UserInvalidationBusMockPublishParams{}
+
+	m.SubscribeMock = mUserInvalidationBusMockSubscribe{mock: m}
+	m.SubscribeMock.callArgs = []*UserInvalidationBusMockSubscribeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mUserInvalidationBusMockPublish struct {
+	optional           bool
+	mock               *UserInvalidationBusMock
+	defaultExpectation *UserInvalidationBusMockPublishExpectation
+	expectations       []*UserInvalidationBusMockPublishExpectation
+
+	callArgs []*UserInvalidationBusMockPublishParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserInvalidationBusMockPublishExpectation specifies expectation struct of the UserInvalidationBus.Publish
+type UserInvalidationBusMockPublishExpectation struct {
+	mock      *UserInvalidationBusMock
+	params    *UserInvalidationBusMockPublishParams
+	paramPtrs *UserInvalidationBusMockPublishParamPtrs
+	results   *UserInvalidationBusMockPublishResults
+	Counter   uint64
+}
+
+// UserInvalidationBusMockPublishParams contains parameters of the UserInvalidationBus.Publish
+type UserInvalidationBusMockPublishParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// UserInvalidationBusMockPublishParamPtrs contains pointers to parameters of the UserInvalidationBus.Publish
+type UserInvalidationBusMockPublishParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// UserInvalidationBusMockPublishResults contains results of the UserInvalidationBus.Publish
+type UserInvalidationBusMockPublishResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublish *mUserInvalidationBusMockPublish) Optional() *mUserInvalidationBusMockPublish {
+	mmPublish.optional = true
+	return mmPublish
+}
+
+// Expect sets up expected params for UserInvalidationBus.Publish
+func (mmPublish *mUserInvalidationBusMockPublish) Expect(ctx context.Context, userID int64) *mUserInvalidationBusMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &UserInvalidationBusMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by ExpectParams functions")
+	}
+
+	mmPublish.defaultExpectation.params = &UserInvalidationBusMockPublishParams{ctx, userID}
+	for _, e := range mmPublish.expectations {
+		if minimock.Equal(e.params, mmPublish.defaultExpectation.params) {
+			mmPublish.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublish.defaultExpectation.params)
+		}
+	}
+
+	return mmPublish
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserInvalidationBus.Publish
+func (mmPublish *mUserInvalidationBusMockPublish) ExpectCtxParam1(ctx context.Context) *mUserInvalidationBusMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &UserInvalidationBusMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.params != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Expect")
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs == nil {
+		mmPublish.defaultExpectation.paramPtrs = &UserInvalidationBusMockPublishParamPtrs{}
+	}
+	mmPublish.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmPublish
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UserInvalidationBus.Publish
+func (mmPublish *mUserInvalidationBusMockPublish) ExpectUserIDParam2(userID int64) *mUserInvalidationBusMockPublish {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &UserInvalidationBusMockPublishExpectation{}
+	}
+
+	if mmPublish.defaultExpectation.params != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Expect")
+	}
+
+	if mmPublish.defaultExpectation.paramPtrs == nil {
+		mmPublish.defaultExpectation.paramPtrs = &UserInvalidationBusMockPublishParamPtrs{}
+	}
+	mmPublish.defaultExpectation.paramPtrs.userID = &userID
+
+	return mmPublish
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserInvalidationBus.Publish
+func (mmPublish *mUserInvalidationBusMockPublish) Inspect(f func(ctx context.Context, userID int64)) *mUserInvalidationBusMockPublish {
+	if mmPublish.mock.inspectFuncPublish != nil {
+		mmPublish.mock.t.Fatalf("Inspect function is already set for UserInvalidationBusMock.Publish")
+	}
+
+	mmPublish.mock.inspectFuncPublish = f
+
+	return mmPublish
+}
+
+// Return sets up results that will be returned by UserInvalidationBus.Publish
+func (mmPublish *mUserInvalidationBusMockPublish) Return(err error) *UserInvalidationBusMock {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Set")
+	}
+
+	if mmPublish.defaultExpectation == nil {
+		mmPublish.defaultExpectation = &UserInvalidationBusMockPublishExpectation{mock: mmPublish.mock}
+	}
+	mmPublish.defaultExpectation.results = &UserInvalidationBusMockPublishResults{err}
+	return mmPublish.mock
+}
+
+// Set uses given function f to mock the UserInvalidationBus.Publish method
+func (mmPublish *mUserInvalidationBusMockPublish) Set(f func(ctx context.Context, userID int64) (err error)) *UserInvalidationBusMock {
+	if mmPublish.defaultExpectation != nil {
+		mmPublish.mock.t.Fatalf("Default expectation is already set for the UserInvalidationBus.Publish method")
+	}
+
+	if len(mmPublish.expectations) > 0 {
+		mmPublish.mock.t.Fatalf("Some expectations are already set for the UserInvalidationBus.Publish method")
+	}
+
+	mmPublish.mock.funcPublish = f
+	return mmPublish.mock
+}
+
+// When sets expectation for the UserInvalidationBus.Publish which will trigger the result defined by the following
+// Then helper
+func (mmPublish *mUserInvalidationBusMockPublish) When(ctx context.Context, userID int64) *UserInvalidationBusMockPublishExpectation {
+	if mmPublish.mock.funcPublish != nil {
+		mmPublish.mock.t.Fatalf("UserInvalidationBusMock.Publish mock is already set by Set")
+	}
+
+	expectation := &UserInvalidationBusMockPublishExpectation{
+		mock:   mmPublish.mock,
+		params: &UserInvalidationBusMockPublishParams{ctx, userID},
+	}
+	mmPublish.expectations = append(mmPublish.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserInvalidationBus.Publish return parameters for the expectation previously defined by the When method
+func (e *UserInvalidationBusMockPublishExpectation) Then(err error) *UserInvalidationBusMock {
+	e.results = &UserInvalidationBusMockPublishResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserInvalidationBus.Publish should be invoked
+func (mmPublish *mUserInvalidationBusMockPublish) Times(n uint64) *mUserInvalidationBusMockPublish {
+	if n == 0 {
+		mmPublish.mock.t.Fatalf("Times of UserInvalidationBusMock.Publish mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublish.expectedInvocations, n)
+	return mmPublish
+}
+
+func (mmPublish *mUserInvalidationBusMockPublish) invocationsDone() bool {
+	if len(mmPublish.expectations) == 0 && mmPublish.defaultExpectation == nil && mmPublish.mock.funcPublish == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublish.mock.afterPublishCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublish.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Publish implements cache.UserInvalidationBus
+func (mmPublish *UserInvalidationBusMock) Publish(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmPublish.beforePublishCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublish.afterPublishCounter, 1)
+
+	if mmPublish.inspectFuncPublish != nil {
+		mmPublish.inspectFuncPublish(ctx, userID)
+	}
+
+	mm_params := UserInvalidationBusMockPublishParams{ctx, userID}
+
+	// Record call args
+	mmPublish.PublishMock.mutex.Lock()
+	mmPublish.PublishMock.callArgs = append(mmPublish.PublishMock.callArgs, &mm_params)
+	mmPublish.PublishMock.mutex.Unlock()
+
+	for _, e := range mmPublish.PublishMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPublish.PublishMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublish.PublishMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublish.PublishMock.defaultExpectation.params
+		mm_want_ptrs := mmPublish.PublishMock.defaultExpectation.paramPtrs
+
+		mm_got := UserInvalidationBusMockPublishParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublish.t.Errorf("UserInvalidationBusMock.Publish got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmPublish.t.Errorf("UserInvalidationBusMock.Publish got unexpected parameter userID, want: %#v, got: %#v%s\n", *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublish.t.Errorf("UserInvalidationBusMock.Publish got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPublish.PublishMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPublish.t.Fatal("No results are set for the UserInvalidationBusMock.Publish")
+		}
+		return (*mm_results).err
+	}
+	if mmPublish.funcPublish != nil {
+		return mmPublish.funcPublish(ctx, userID)
+	}
+	mmPublish.t.Fatalf("Unexpected call to UserInvalidationBusMock.Publish. %v %v", ctx, userID)
+	return
+}
+
+// PublishAfterCounter returns a count of finished UserInvalidationBusMock.Publish invocations
+func (mmPublish *UserInvalidationBusMock) PublishAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublish.afterPublishCounter)
+}
+
+// PublishBeforeCounter returns a count of UserInvalidationBusMock.Publish invocations
+func (mmPublish *UserInvalidationBusMock) PublishBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublish.beforePublishCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserInvalidationBusMock.Publish.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublish *mUserInvalidationBusMockPublish) Calls() []*UserInvalidationBusMockPublishParams {
+	mmPublish.mutex.RLock()
+
+	argCopy := make([]*UserInvalidationBusMockPublishParams, len(mmPublish.callArgs))
+	copy(argCopy, mmPublish.callArgs)
+
+	mmPublish.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishDone returns true if the count of the Publish invocations corresponds
+// the number of defined expectations
+func (m *UserInvalidationBusMock) MinimockPublishDone() bool {
+	if m.PublishMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishMock.invocationsDone()
+}
+
+// MinimockPublishInspect logs each unmet expectation
+func (m *UserInvalidationBusMock) MinimockPublishInspect() {
+	for _, e := range m.PublishMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserInvalidationBusMock.Publish with params: %#v", *e.params)
+		}
+	}
+
+	afterPublishCounter := mm_atomic.LoadUint64(&m.afterPublishCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishMock.defaultExpectation != nil && afterPublishCounter < 1 {
+		if m.PublishMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserInvalidationBusMock.Publish")
+		} else {
+			m.t.Errorf("Expected call to UserInvalidationBusMock.Publish with params: %#v", *m.PublishMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublish != nil && afterPublishCounter < 1 {
+		m.t.Error("Expected call to UserInvalidationBusMock.Publish")
+	}
+
+	if !m.PublishMock.invocationsDone() && afterPublishCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserInvalidationBusMock.Publish but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishMock.expectedInvocations), afterPublishCounter)
+	}
+}
+
+type mUserInvalidationBusMockSubscribe struct {
+	optional           bool
+	mock               *UserInvalidationBusMock
+	defaultExpectation *UserInvalidationBusMockSubscribeExpectation
+	expectations       []*UserInvalidationBusMockSubscribeExpectation
+
+	callArgs []*UserInvalidationBusMockSubscribeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserInvalidationBusMockSubscribeExpectation specifies expectation struct of the UserInvalidationBus.Subscribe
+type UserInvalidationBusMockSubscribeExpectation struct {
+	mock      *UserInvalidationBusMock
+	params    *UserInvalidationBusMockSubscribeParams
+	paramPtrs *UserInvalidationBusMockSubscribeParamPtrs
+	results   *UserInvalidationBusMockSubscribeResults
+	Counter   uint64
+}
+
+// UserInvalidationBusMockSubscribeParams contains parameters of the UserInvalidationBus.Subscribe
+type UserInvalidationBusMockSubscribeParams struct {
+	ctx          context.Context
+	onSubscribed func()
+	onInvalidate func(userID int64)
+}
+
+// UserInvalidationBusMockSubscribeParamPtrs contains pointers to parameters of the UserInvalidationBus.Subscribe
+type UserInvalidationBusMockSubscribeParamPtrs struct {
+	ctx          *context.Context
+	onSubscribed *func()
+	onInvalidate *func(userID int64)
+}
+
+// UserInvalidationBusMockSubscribeResults contains results of the UserInvalidationBus.Subscribe
+type UserInvalidationBusMockSubscribeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Optional() *mUserInvalidationBusMockSubscribe {
+	mmSubscribe.optional = true
+	return mmSubscribe
+}
+
+// Expect sets up expected params for UserInvalidationBus.Subscribe
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Expect(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) *mUserInvalidationBusMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &UserInvalidationBusMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by ExpectParams functions")
+	}
+
+	mmSubscribe.defaultExpectation.params = &UserInvalidationBusMockSubscribeParams{ctx, onSubscribed, onInvalidate}
+	for _, e := range mmSubscribe.expectations {
+		if minimock.Equal(e.params, mmSubscribe.defaultExpectation.params) {
+			mmSubscribe.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSubscribe.defaultExpectation.params)
+		}
+	}
+
+	return mmSubscribe
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserInvalidationBus.Subscribe
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) ExpectCtxParam1(ctx context.Context) *mUserInvalidationBusMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &UserInvalidationBusMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &UserInvalidationBusMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmSubscribe
+}
+
+// ExpectOnSubscribedParam2 sets up expected param onSubscribed for UserInvalidationBus.Subscribe
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) ExpectOnSubscribedParam2(onSubscribed func()) *mUserInvalidationBusMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &UserInvalidationBusMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &UserInvalidationBusMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.onSubscribed = &onSubscribed
+
+	return mmSubscribe
+}
+
+// ExpectOnInvalidateParam3 sets up expected param onInvalidate for UserInvalidationBus.Subscribe
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) ExpectOnInvalidateParam3(onInvalidate func(userID int64)) *mUserInvalidationBusMockSubscribe {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &UserInvalidationBusMockSubscribeExpectation{}
+	}
+
+	if mmSubscribe.defaultExpectation.params != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Expect")
+	}
+
+	if mmSubscribe.defaultExpectation.paramPtrs == nil {
+		mmSubscribe.defaultExpectation.paramPtrs = &UserInvalidationBusMockSubscribeParamPtrs{}
+	}
+	mmSubscribe.defaultExpectation.paramPtrs.onInvalidate = &onInvalidate
+
+	return mmSubscribe
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserInvalidationBus.Subscribe
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Inspect(f func(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64))) *mUserInvalidationBusMockSubscribe {
+	if mmSubscribe.mock.inspectFuncSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("Inspect function is already set for UserInvalidationBusMock.Subscribe")
+	}
+
+	mmSubscribe.mock.inspectFuncSubscribe = f
+
+	return mmSubscribe
+}
+
+// Return sets up results that will be returned by UserInvalidationBus.Subscribe
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Return(err error) *UserInvalidationBusMock {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Set")
+	}
+
+	if mmSubscribe.defaultExpectation == nil {
+		mmSubscribe.defaultExpectation = &UserInvalidationBusMockSubscribeExpectation{mock: mmSubscribe.mock}
+	}
+	mmSubscribe.defaultExpectation.results = &UserInvalidationBusMockSubscribeResults{err}
+	return mmSubscribe.mock
+}
+
+// Set uses given function f to mock the UserInvalidationBus.Subscribe method
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Set(f func(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) (err error)) *UserInvalidationBusMock {
+	if mmSubscribe.defaultExpectation != nil {
+		mmSubscribe.mock.t.Fatalf("Default expectation is already set for the UserInvalidationBus.Subscribe method")
+	}
+
+	if len(mmSubscribe.expectations) > 0 {
+		mmSubscribe.mock.t.Fatalf("Some expectations are already set for the UserInvalidationBus.Subscribe method")
+	}
+
+	mmSubscribe.mock.funcSubscribe = f
+	return mmSubscribe.mock
+}
+
+// When sets expectation for the UserInvalidationBus.Subscribe which will trigger the result defined by the following
+// Then helper
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) When(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) *UserInvalidationBusMockSubscribeExpectation {
+	if mmSubscribe.mock.funcSubscribe != nil {
+		mmSubscribe.mock.t.Fatalf("UserInvalidationBusMock.Subscribe mock is already set by Set")
+	}
+
+	expectation := &UserInvalidationBusMockSubscribeExpectation{
+		mock:   mmSubscribe.mock,
+		params: &UserInvalidationBusMockSubscribeParams{ctx, onSubscribed, onInvalidate},
+	}
+	mmSubscribe.expectations = append(mmSubscribe.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserInvalidationBus.Subscribe return parameters for the expectation previously defined by the When method
+func (e *UserInvalidationBusMockSubscribeExpectation) Then(err error) *UserInvalidationBusMock {
+	e.results = &UserInvalidationBusMockSubscribeResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserInvalidationBus.Subscribe should be invoked
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Times(n uint64) *mUserInvalidationBusMockSubscribe {
+	if n == 0 {
+		mmSubscribe.mock.t.Fatalf("Times of UserInvalidationBusMock.Subscribe mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubscribe.expectedInvocations, n)
+	return mmSubscribe
+}
+
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) invocationsDone() bool {
+	if len(mmSubscribe.expectations) == 0 && mmSubscribe.defaultExpectation == nil && mmSubscribe.mock.funcSubscribe == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubscribe.mock.afterSubscribeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubscribe.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Subscribe implements cache.UserInvalidationBus
+func (mmSubscribe *UserInvalidationBusMock) Subscribe(ctx context.Context, onSubscribed func(), onInvalidate func(userID int64)) (err error) {
+	mm_atomic.AddUint64(&mmSubscribe.beforeSubscribeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubscribe.afterSubscribeCounter, 1)
+
+	if mmSubscribe.inspectFuncSubscribe != nil {
+		mmSubscribe.inspectFuncSubscribe(ctx, onSubscribed, onInvalidate)
+	}
+
+	mm_params := UserInvalidationBusMockSubscribeParams{ctx, onSubscribed, onInvalidate}
+
+	// Record call args
+	mmSubscribe.SubscribeMock.mutex.Lock()
+	mmSubscribe.SubscribeMock.callArgs = append(mmSubscribe.SubscribeMock.callArgs, &mm_params)
+	mmSubscribe.SubscribeMock.mutex.Unlock()
+
+	for _, e := range mmSubscribe.SubscribeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSubscribe.SubscribeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubscribe.SubscribeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSubscribe.SubscribeMock.defaultExpectation.params
+		mm_want_ptrs := mmSubscribe.SubscribeMock.defaultExpectation.paramPtrs
+
+		mm_got := UserInvalidationBusMockSubscribeParams{ctx, onSubscribed, onInvalidate}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSubscribe.t.Errorf("UserInvalidationBusMock.Subscribe got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.onSubscribed != nil && !minimock.Equal(*mm_want_ptrs.onSubscribed, mm_got.onSubscribed) {
+				mmSubscribe.t.Errorf("UserInvalidationBusMock.Subscribe got unexpected parameter onSubscribed, want: %#v, got: %#v%s\n", *mm_want_ptrs.onSubscribed, mm_got.onSubscribed, minimock.Diff(*mm_want_ptrs.onSubscribed, mm_got.onSubscribed))
+			}
+
+			if mm_want_ptrs.onInvalidate != nil && !minimock.Equal(*mm_want_ptrs.onInvalidate, mm_got.onInvalidate) {
+				mmSubscribe.t.Errorf("UserInvalidationBusMock.Subscribe got unexpected parameter onInvalidate, want: %#v, got: %#v%s\n", *mm_want_ptrs.onInvalidate, mm_got.onInvalidate, minimock.Diff(*mm_want_ptrs.onInvalidate, mm_got.onInvalidate))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSubscribe.t.Errorf("UserInvalidationBusMock.Subscribe got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSubscribe.SubscribeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubscribe.t.Fatal("No results are set for the UserInvalidationBusMock.Subscribe")
+		}
+		return (*mm_results).err
+	}
+	if mmSubscribe.funcSubscribe != nil {
+		return mmSubscribe.funcSubscribe(ctx, onSubscribed, onInvalidate)
+	}
+	mmSubscribe.t.Fatalf("Unexpected call to UserInvalidationBusMock.Subscribe. %v %v %v", ctx, onSubscribed, onInvalidate)
+	return
+}
+
+// SubscribeAfterCounter returns a count of finished UserInvalidationBusMock.Subscribe invocations
+func (mmSubscribe *UserInvalidationBusMock) SubscribeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.afterSubscribeCounter)
+}
+
+// SubscribeBeforeCounter returns a count of UserInvalidationBusMock.Subscribe invocations
+func (mmSubscribe *UserInvalidationBusMock) SubscribeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubscribe.beforeSubscribeCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserInvalidationBusMock.Subscribe.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSubscribe *mUserInvalidationBusMockSubscribe) Calls() []*UserInvalidationBusMockSubscribeParams {
+	mmSubscribe.mutex.RLock()
+
+	argCopy := make([]*UserInvalidationBusMockSubscribeParams, len(mmSubscribe.callArgs))
+	copy(argCopy, mmSubscribe.callArgs)
+
+	mmSubscribe.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSubscribeDone returns true if the count of the Subscribe invocations corresponds
+// the number of defined expectations
+func (m *UserInvalidationBusMock) MinimockSubscribeDone() bool {
+	if m.SubscribeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubscribeMock.invocationsDone()
+}
+
+// MinimockSubscribeInspect logs each unmet expectation
+func (m *UserInvalidationBusMock) MinimockSubscribeInspect() {
+	for _, e := range m.SubscribeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserInvalidationBusMock.Subscribe with params: %#v", *e.params)
+		}
+	}
+
+	afterSubscribeCounter := mm_atomic.LoadUint64(&m.afterSubscribeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubscribeMock.defaultExpectation != nil && afterSubscribeCounter < 1 {
+		if m.SubscribeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserInvalidationBusMock.Subscribe")
+		} else {
+			m.t.Errorf("Expected call to UserInvalidationBusMock.Subscribe with params: %#v", *m.SubscribeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubscribe != nil && afterSubscribeCounter < 1 {
+		m.t.Error("Expected call to UserInvalidationBusMock.Subscribe")
+	}
+
+	if !m.SubscribeMock.invocationsDone() && afterSubscribeCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserInvalidationBusMock.Subscribe but found %d calls",
+			mm_atomic.LoadUint64(&m.SubscribeMock.expectedInvocations), afterSubscribeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *UserInvalidationBusMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockPublishInspect()
+
+			m.MinimockSubscribeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *UserInvalidationBusMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *UserInvalidationBusMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockPublishDone() &&
+		m.MinimockSubscribeDone()
+}
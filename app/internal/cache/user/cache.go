@@ -2,60 +2,118 @@ package user
 
 import (
 	"context"
+	"math/rand/v2"
 	"strconv"
+	"time"
 
 	cacheClient "github.com/Prrromanssss/platform_common/pkg/cache"
 	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
 
 	"github.com/Prrromanssss/auth/internal/cache"
 	"github.com/Prrromanssss/auth/internal/cache/user/converter"
 	modelCache "github.com/Prrromanssss/auth/internal/cache/user/model"
 	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/tracing"
 )
 
 type userRedis struct {
-	cache cacheClient.RedisClient
+	cache        cacheClient.RedisClient
+	pool         *redigo.Pool
+	ttl          time.Duration
+	ttlJitter    time.Duration
+	negativeTTL  time.Duration
+	tombstoneTTL time.Duration
 }
 
-func NewCache(cache cacheClient.RedisClient) cache.UserCache {
+// NewCache creates a new instance of userRedis.
+// Users are kept for the TTL plus a random jitter, so that entries written together do not expire together,
+// ids that do not exist are remembered for the negative TTL and deleted users for the tombstone TTL.
+// Writes run on connections from the pool, users are written by compare-and-set scripts.
+func NewCache(
+	cache cacheClient.RedisClient,
+	pool *redigo.Pool,
+	ttl time.Duration,
+	ttlJitter time.Duration,
+	negativeTTL time.Duration,
+	tombstoneTTL time.Duration,
+) cache.UserCache {
 	return &userRedis{
-		cache: cache,
+		cache:        cache,
+		pool:         pool,
+		ttl:          ttl,
+		ttlJitter:    ttlJitter,
+		negativeTTL:  negativeTTL,
+		tombstoneTTL: tombstoneTTL,
 	}
 }
 
+// Create stores the user unless the cache already holds the same or a newer version of it,
+// or the user has been deleted. A stale write is not an error.
 func (c *userRedis) Create(ctx context.Context, params model.User) (err error) {
+	defer countError(&err)
+
 	paramsCache := converter.ConvertUserFromServiceToCache(params)
-	userIDString := strconv.FormatInt(paramsCache.UserID, 10)
-	err = c.cache.HashSet(ctx, userIDString, paramsCache)
+
+	written, err := c.runScript(
+		ctx,
+		setUserScript,
+		redigo.Args{
+			userKey(paramsCache.UserID),
+			missingKey(paramsCache.UserID),
+			paramsCache.Version,
+			c.jitteredTTL().Milliseconds(),
+		}.AddFlat(paramsCache)...,
+	)
 	if err != nil {
 		return err
 	}
 
+	if !written {
+		count(statStaleWrites)
+	}
+
 	return nil
 }
 
-func (c *userRedis) Get(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error) {
-	paramsCache := converter.ConvertGetUserParamsFromServiceToCache(params)
+// Fill stores the user read after a miss with the same compare-and-set as Create.
+func (c *userRedis) Fill(ctx context.Context, params model.User) (err error) {
+	return c.Create(ctx, params)
+}
 
-	userIDString := strconv.FormatInt(paramsCache.UserID, 10)
+// CreateMissing remembers that the user does not exist for the negative TTL.
+// The entry and its expiry are written by a single command, so it is never left without a TTL.
+func (c *userRedis) CreateMissing(ctx context.Context, params model.GetUserParams) (err error) {
+	defer countError(&err)
 
-	exists, err := c.cache.Exists(ctx, userIDString)
+	ctx, span := tracing.StartRedisSpan(ctx, "SET")
+	defer func() { tracing.EndRedisSpan(span, err) }()
+
+	paramsCache := converter.ConvertGetUserParamsFromServiceToCache(params)
+
+	conn, err := c.pool.GetContext(ctx)
 	if err != nil {
-		return
+		return errors.Wrap(err, "Cannot get redis connection")
 	}
+	defer conn.Close()
 
-	if !exists {
-		err = modelCache.ErrUserNotFound
-		return
-	}
+	_, err = redigo.DoContext(conn, ctx, "SET", missingKey(paramsCache.UserID), 1, "PX", c.negativeTTL.Milliseconds())
 
-	values, err := c.cache.HGetAll(ctx, userIDString)
+	return err
+}
+
+func (c *userRedis) Get(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error) {
+	defer countError(&err)
+
+	paramsCache := converter.ConvertGetUserParamsFromServiceToCache(params)
+
+	values, err := c.cache.HGetAll(ctx, userKey(paramsCache.UserID))
 	if err != nil {
 		return
 	}
 
 	if len(values) == 0 {
-		err = modelCache.ErrUserNotFound
+		err = c.missingOrNotFound(ctx, paramsCache.UserID)
 		return
 	}
 
@@ -65,16 +123,85 @@ func (c *userRedis) Get(ctx context.Context, params model.GetUserParams) (resp m
 		return
 	}
 
+	if user.Deleted {
+		count(statNegativeHits)
+		err = modelCache.ErrUserMissing
+		return
+	}
+
+	count(statHits)
+
 	return converter.ConvertGetUserResponseFromCacheToService(user), nil
 }
 
+// Delete replaces the user with a tombstone, so that a read of the user that raced the delete
+// cannot put it back into the cache.
 func (c *userRedis) Delete(ctx context.Context, params model.DeleteUserParams) (err error) {
+	defer countError(&err)
+
 	paramsCache := converter.ConvertDeleteUserParamsFromServiceToCache(params)
-	userIDString := strconv.FormatInt(paramsCache.UserID, 10)
-	err = c.cache.Del(ctx, userIDString)
+
+	_, err = c.runScript(ctx, deleteUserScript, userKey(paramsCache.UserID), c.tombstoneTTL.Milliseconds())
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (c *userRedis) runScript(
+	ctx context.Context,
+	script *redigo.Script,
+	keysAndArgs ...interface{},
+) (_ bool, err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "EVALSHA")
+	defer func() { tracing.EndRedisSpan(span, err) }()
+
+	conn, err := c.pool.GetContext(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot get redis connection")
+	}
+	defer conn.Close()
+
+	return redigo.Bool(script.DoContext(ctx, conn, keysAndArgs...))
+}
+
+// missingOrNotFound tells a negative entry apart from a plain cache miss.
+func (c *userRedis) missingOrNotFound(ctx context.Context, userID int64) error {
+	missing, err := c.cache.Exists(ctx, missingKey(userID))
+	if err != nil {
+		return err
+	}
+
+	if missing {
+		count(statNegativeHits)
+		return modelCache.ErrUserMissing
+	}
+
+	count(statMisses)
+
+	return modelCache.ErrUserNotFound
+}
+
+func (c *userRedis) jitteredTTL() time.Duration {
+	if c.ttlJitter <= 0 {
+		return c.ttl
+	}
+
+	return c.ttl + rand.N(c.ttlJitter) //nolint:gosec // Jitter does not need a cryptographic source.
+}
+
+func userKey(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
+
+func missingKey(userID int64) string {
+	return "user:missing:" + strconv.FormatInt(userID, 10)
+}
+
+// countError counts failed calls to Redis. Cache misses are counted separately and are not errors.
+func countError(err *error) {
+	if *err != nil && !errors.Is(*err, modelCache.ErrUserNotFound) && !errors.Is(*err, modelCache.ErrUserMissing) {
+		count(statErrors)
+	}
+}
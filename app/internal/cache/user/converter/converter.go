@@ -14,8 +14,9 @@ func ConvertUserFromServiceToCache(params model.User) modelCache.User {
 		Name:      params.Name,
 		Email:     params.Email,
 		Role:      params.Role,
-		CreatedAt: params.CreatedAt.Unix(),
-		UpdatedAt: params.UpdatedAt.Unix(),
+		CreatedAt: params.CreatedAt.UnixMilli(),
+		UpdatedAt: params.UpdatedAt.UnixMilli(),
+		Version:   params.Version,
 	}
 }
 
@@ -39,6 +40,7 @@ func ConvertGetUserResponseFromCacheToService(params modelCache.User) model.GetU
 			Role:      params.Role,
 			CreatedAt: time.UnixMilli(params.CreatedAt),
 			UpdatedAt: time.UnixMilli(params.UpdatedAt),
+			Version:   params.Version,
 		},
 	}
 }
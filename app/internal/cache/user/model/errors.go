@@ -2,4 +2,8 @@ package model
 
 import "errors"
 
-var ErrUserNotFound = errors.New("user not found in cache")
+var (
+	ErrUserNotFound = errors.New("user not found in cache")
+	// ErrUserMissing is returned when the cache remembers that the user does not exist.
+	ErrUserMissing = errors.New("user is cached as missing")
+)
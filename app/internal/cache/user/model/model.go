@@ -1,6 +1,7 @@
 package model
 
 // User represents the user data structure used for caching.
+// A deleted user is kept as a tombstone that has only the Deleted field set.
 type User struct {
 	UserID    int64  `redis:"id"`
 	Name      string `redis:"name"`
@@ -8,6 +9,8 @@ type User struct {
 	Role      int64  `redis:"role"`
 	CreatedAt int64  `redis:"created_at"`
 	UpdatedAt int64  `redis:"updated_at"`
+	Version   int64  `redis:"version"`
+	Deleted   bool   `redis:"deleted,omitempty"`
 }
 
 // GetUserParams defines the parameters needed to retrieve user information.
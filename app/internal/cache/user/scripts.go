@@ -0,0 +1,37 @@
+package user
+
+import redigo "github.com/gomodule/redigo/redis"
+
+// setUserScript replaces the cached user only if the new version is greater than the cached one
+// and the user has not been deleted. It returns 1 if the user was written and 0 if the write was stale.
+//
+// KEYS[1] - user key, KEYS[2] - missing user key.
+// ARGV[1] - version, ARGV[2] - TTL in milliseconds, ARGV[3:] - field and value pairs.
+var setUserScript = redigo.NewScript(2, `
+local current = redis.call('HMGET', KEYS[1], 'version', 'deleted')
+if current[2] then
+	return 0
+end
+if current[1] and tonumber(current[1]) >= tonumber(ARGV[1]) then
+	return 0
+end
+
+redis.call('DEL', KEYS[1])
+redis.call('HSET', KEYS[1], unpack(ARGV, 3))
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+redis.call('DEL', KEYS[2])
+
+return 1
+`)
+
+// deleteUserScript replaces the cached user with a tombstone that rejects every later write.
+//
+// KEYS[1] - user key.
+// ARGV[1] - tombstone TTL in milliseconds.
+var deleteUserScript = redigo.NewScript(1, `
+redis.call('DEL', KEYS[1])
+redis.call('HSET', KEYS[1], 'deleted', 1)
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+
+return 1
+`)
@@ -0,0 +1,36 @@
+package user
+
+import (
+	"expvar"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/Prrromanssss/auth/internal/metrics"
+)
+
+// stats counts the outcomes of user cache operations. It is published under "user_cache" by expvar
+// and as auth_user_cache_results_total by Prometheus.
+var (
+	stats = expvar.NewMap("user_cache")
+
+	results = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "user_cache",
+		Name:      "results_total",
+		Help:      "Outcomes of the user cache operations in Redis.",
+	}, []string{"result"})
+)
+
+const (
+	statHits         = "hits"
+	statNegativeHits = "negative_hits"
+	statMisses       = "misses"
+	statErrors       = "errors"
+	statStaleWrites  = "stale_writes"
+)
+
+func count(stat string) {
+	stats.Add(stat, 1)
+	results.WithLabelValues(stat).Inc()
+}
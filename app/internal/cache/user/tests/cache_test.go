@@ -0,0 +1,202 @@
+package tests
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/cache/redis"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v6"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+	userCache "github.com/Prrromanssss/auth/internal/cache/user"
+	modelCache "github.com/Prrromanssss/auth/internal/cache/user/model"
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func newCache(t *testing.T) cache.UserCache {
+	c, _ := newCacheWithServer(t)
+
+	return c
+}
+
+func newCacheWithServer(t *testing.T) (cache.UserCache, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+
+	pool := &redigo.Pool{
+		DialContext: func(ctx context.Context) (redigo.Conn, error) {
+			return redigo.DialContext(ctx, "tcp", server.Addr())
+		},
+	}
+	t.Cleanup(func() {
+		require.NoError(t, pool.Close())
+	})
+
+	return userCache.NewCache(redis.NewClient(pool, time.Second), pool, time.Minute, time.Second, time.Minute, time.Minute), server
+}
+
+func newUser(version int64) model.User {
+	return model.User{
+		UserID:    gofakeit.Int64(),
+		Name:      gofakeit.Name(),
+		Email:     gofakeit.Email(),
+		Role:      int64(pb.Role_USER),
+		CreatedAt: time.UnixMilli(gofakeit.Date().UnixMilli()),
+		UpdatedAt: time.UnixMilli(gofakeit.Date().UnixMilli()),
+		Version:   version,
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	var ctx = context.Background()
+
+	user := newUser(2)
+
+	older := user
+	older.Name = gofakeit.Name()
+	older.Version = 1
+
+	newer := user
+	newer.Name = gofakeit.Name()
+	newer.Version = 3
+
+	tests := []struct {
+		name   string
+		writes []model.User
+		want   model.User
+	}{
+		{
+			name:   "first write",
+			writes: []model.User{user},
+			want:   user,
+		},
+		{
+			name:   "newer version replaces cached one",
+			writes: []model.User{user, newer},
+			want:   newer,
+		},
+		{
+			name:   "older version is rejected",
+			writes: []model.User{user, older},
+			want:   user,
+		},
+		{
+			name:   "same version is rejected",
+			writes: []model.User{newer, {UserID: user.UserID, Version: newer.Version}},
+			want:   newer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newCache(t)
+
+			for _, write := range tt.writes {
+				require.NoError(t, c.Create(ctx, write))
+			}
+
+			resp, err := c.Get(ctx, model.GetUserParams{UserID: user.UserID})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, resp.User)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		user = newUser(1)
+		req  = model.GetUserParams{UserID: user.UserID}
+	)
+
+	c := newCache(t)
+
+	require.NoError(t, c.Create(ctx, user))
+	require.NoError(t, c.Delete(ctx, model.DeleteUserParams{UserID: user.UserID}))
+
+	_, err := c.Get(ctx, req)
+	require.Equal(t, modelCache.ErrUserMissing, err)
+
+	// A read that started before the delete must not bring the user back.
+	user.Version = 2
+	require.NoError(t, c.Create(ctx, user))
+
+	_, err = c.Get(ctx, req)
+	require.Equal(t, modelCache.ErrUserMissing, err)
+}
+
+func TestCreateMissing(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		user = newUser(1)
+		req  = model.GetUserParams{UserID: user.UserID}
+	)
+
+	c, server := newCacheWithServer(t)
+
+	_, err := c.Get(ctx, req)
+	require.Equal(t, modelCache.ErrUserNotFound, err)
+
+	require.NoError(t, c.CreateMissing(ctx, req))
+
+	for _, key := range server.Keys() {
+		require.Equal(t, time.Minute, server.TTL(key))
+	}
+
+	_, err = c.Get(ctx, req)
+	require.Equal(t, modelCache.ErrUserMissing, err)
+
+	require.NoError(t, c.Create(ctx, user))
+
+	resp, err := c.Get(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, user, resp.User)
+}
+
+func TestConcurrentCreate(t *testing.T) {
+	t.Parallel()
+
+	const versions = 50
+
+	var (
+		ctx  = context.Background()
+		user = newUser(0)
+	)
+
+	c := newCache(t)
+
+	var wg sync.WaitGroup
+
+	for _, version := range rand.Perm(versions) {
+		wg.Add(1)
+
+		go func(version int64) {
+			defer wg.Done()
+
+			write := user
+			write.Version = version
+
+			require.NoError(t, c.Create(ctx, write))
+		}(int64(version) + 1)
+	}
+
+	wg.Wait()
+
+	resp, err := c.Get(ctx, model.GetUserParams{UserID: user.UserID})
+	require.NoError(t, err)
+	require.Equal(t, int64(versions), resp.User.Version)
+}
@@ -0,0 +1,99 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// ServerConfig returns the TLS configuration of a server that presents the certificate of the store.
+// When the store has CAs, clients must present a certificate issued by one of them.
+func ServerConfig(store *Store, minVersion uint16) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: minVersion,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return certificate(store)
+		},
+	}
+
+	if store.CAs() != nil {
+		// The client certificate is verified against the current CAs of the store rather than
+		// a pool fixed in the configuration, so that the CAs can be rotated too.
+		cfg.ClientAuth = tls.RequireAnyClientCert
+		cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verify(rawCerts, x509.VerifyOptions{
+				Roots:     store.CAs(),
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			})
+		}
+	}
+
+	return cfg
+}
+
+// ClientConfig returns the TLS configuration of a client of the server with the given name. The client
+// presents the certificate of the store, if any, and verifies the server against the CAs of the store,
+// or against the CAs of the system when the store has none.
+func ClientConfig(store *Store, serverName string, minVersion uint16) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: minVersion,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := store.Certificate(); cert != nil {
+				return cert, nil
+			}
+
+			return &tls.Certificate{}, nil
+		},
+	}
+
+	if store.CAs() != nil {
+		// The default verification is replaced by the same one against the current CAs of the store.
+		cfg.InsecureSkipVerify = true //nolint:gosec // The server certificate is verified by VerifyPeerCertificate.
+		cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verify(rawCerts, x509.VerifyOptions{
+				Roots:     store.CAs(),
+				DNSName:   serverName,
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			})
+		}
+	}
+
+	return cfg
+}
+
+func certificate(store *Store) (*tls.Certificate, error) {
+	cert := store.Certificate()
+	if cert == nil {
+		return nil, errors.New("No certificate loaded")
+	}
+
+	return cert, nil
+}
+
+// verify verifies the chain presented by the peer, the leaf first.
+func verify(rawCerts [][]byte, opts x509.VerifyOptions) error {
+	if len(rawCerts) == 0 {
+		return errors.New("No certificate presented")
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return errors.Wrap(err, "Cannot parse peer certificate")
+		}
+
+		certs[i] = cert
+	}
+
+	opts.Intermediates = x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(opts)
+
+	return errors.Wrap(err, "Cannot verify peer certificate")
+}
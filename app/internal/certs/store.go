@@ -0,0 +1,154 @@
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Files are the PEM files of a certificate and of the CAs trusted along with it. Any of them may be empty.
+type Files struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// Store keeps a certificate and a pool of CAs loaded from their files and reloads them when the files change,
+// so that rotated certificates are picked up by the next handshakes without a restart.
+type Store struct {
+	files    Files
+	interval time.Duration
+
+	mu      sync.Mutex
+	version string
+
+	cert atomic.Pointer[tls.Certificate]
+	cas  atomic.Pointer[x509.CertPool]
+}
+
+// NewStore creates a new instance of Store that checks the files for changes every interval.
+func NewStore(files Files, interval time.Duration) (*Store, error) {
+	s := &Store{
+		files:    files,
+		interval: interval,
+	}
+
+	_, err := s.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Certificate returns the certificate, nil if the store has none.
+func (s *Store) Certificate() *tls.Certificate {
+	return s.cert.Load()
+}
+
+// CAs returns the pool of CAs, nil if the store has none.
+func (s *Store) CAs() *x509.CertPool {
+	return s.cas.Load()
+}
+
+// RunReload reloads the files every interval until the context is done. A file that cannot be loaded,
+// such as a key written before its certificate, is tried again on the next tick while the previous
+// certificate stays in use.
+func (s *Store) RunReload(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		reloaded, err := s.Reload()
+		if err != nil {
+			log.Warnf("Failed to reload certificates, err: %v", err)
+			continue
+		}
+
+		if reloaded {
+			log.Infof("Certificates reloaded, files: %+v", s.files)
+		}
+	}
+}
+
+// Reload loads the files if they changed since they were last loaded and reports whether they did.
+func (s *Store) Reload() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	version, err := s.fileVersion()
+	if err != nil {
+		return false, err
+	}
+
+	if version == s.version {
+		return false, nil
+	}
+
+	var cert *tls.Certificate
+
+	if s.files.CertFile != "" {
+		pair, err := tls.LoadX509KeyPair(s.files.CertFile, s.files.KeyFile)
+		if err != nil {
+			return false, errors.Wrapf(err, "Cannot load certificate(cert: %s, key: %s)", s.files.CertFile, s.files.KeyFile)
+		}
+
+		cert = &pair
+	}
+
+	var cas *x509.CertPool
+
+	if s.files.CAFile != "" {
+		pem, err := os.ReadFile(s.files.CAFile)
+		if err != nil {
+			return false, errors.Wrapf(err, "Cannot read CAs(file: %s)", s.files.CAFile)
+		}
+
+		cas = x509.NewCertPool()
+		if !cas.AppendCertsFromPEM(pem) {
+			return false, errors.Errorf("No CA certificate found(file: %s)", s.files.CAFile)
+		}
+	}
+
+	s.cert.Store(cert)
+	s.cas.Store(cas)
+	s.version = version
+
+	return true, nil
+}
+
+// fileVersion identifies the content of the files by their modification times and sizes.
+// The files are followed through symlinks, which is how mounted secrets are swapped.
+func (s *Store) fileVersion() (string, error) {
+	var b strings.Builder
+
+	for _, path := range []string{s.files.CertFile, s.files.KeyFile, s.files.CAFile} {
+		if path == "" {
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", errors.Wrapf(err, "Cannot stat file(path: %s)", path)
+		}
+
+		fmt.Fprintf(&b, "%s:%d:%d;", path, info.ModTime().UnixNano(), info.Size())
+	}
+
+	return b.String(), nil
+}
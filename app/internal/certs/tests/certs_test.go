@@ -0,0 +1,293 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/certs"
+)
+
+const serverName = "auth.local"
+
+// authority is a CA generated for the tests.
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newAuthority(t *testing.T) *authority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &authority{cert: cert, key: key}
+}
+
+// issue writes a certificate issued by the CA and its key to the directory and returns their files.
+func (a *authority) issue(t *testing.T, dir string, serial int64, usage x509.ExtKeyUsage) certs.Files {
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: serverName},
+		DNSNames:     []string{serverName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, a.cert, &key.PublicKey, a.key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	files := certs.Files{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+	}
+
+	writePEM(t, files.CertFile, "CERTIFICATE", der)
+	writePEM(t, files.KeyFile, "EC PRIVATE KEY", keyDER)
+
+	return files
+}
+
+// writeCA writes the certificate of the CA to the file.
+func (a *authority) writeCA(t *testing.T, path string) {
+	writePEM(t, path, "CERTIFICATE", a.cert.Raw)
+}
+
+// writePEM writes the file with a modification time after the previous one, so that it is seen as changed
+// even on file systems with a coarse clock.
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	modTime := time.Now()
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+// handshake connects the client to the server and returns the certificate presented by the server.
+func handshake(t *testing.T, serverConfig, clientConfig *tls.Config) (*x509.Certificate, error) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	serverErr := make(chan error, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	// With TLS 1.3 the server verifies the client after the client has finished its handshake.
+	err = <-serverErr
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.ConnectionState().PeerCertificates[0], nil
+}
+
+func TestMutualTLS(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ca      = newAuthority(t)
+		otherCA = newAuthority(t)
+		dir     = t.TempDir()
+		caFile  = filepath.Join(dir, "ca.crt")
+	)
+
+	ca.writeCA(t, caFile)
+
+	serverFiles := ca.issue(t, filepath.Join(dir, "server"), 2, x509.ExtKeyUsageServerAuth)
+	serverFiles.CAFile = caFile
+
+	serverStore, err := certs.NewStore(serverFiles, time.Minute)
+	require.NoError(t, err)
+
+	serverConfig := certs.ServerConfig(serverStore, tls.VersionTLS12)
+
+	tests := []struct {
+		name       string
+		files      func(t *testing.T, dir string) certs.Files
+		serverName string
+		wantErr    bool
+	}{
+		{
+			name: "success case",
+			files: func(t *testing.T, dir string) certs.Files {
+				files := ca.issue(t, dir, 3, x509.ExtKeyUsageClientAuth)
+				files.CAFile = caFile
+
+				return files
+			},
+			serverName: serverName,
+		},
+		{
+			name: "no client certificate",
+			files: func(_ *testing.T, _ string) certs.Files {
+				return certs.Files{CAFile: caFile}
+			},
+			serverName: serverName,
+			wantErr:    true,
+		},
+		{
+			name: "client certificate of another CA",
+			files: func(t *testing.T, dir string) certs.Files {
+				files := otherCA.issue(t, dir, 4, x509.ExtKeyUsageClientAuth)
+				files.CAFile = caFile
+
+				return files
+			},
+			serverName: serverName,
+			wantErr:    true,
+		},
+		{
+			name: "server certificate of another name",
+			files: func(t *testing.T, dir string) certs.Files {
+				files := ca.issue(t, dir, 5, x509.ExtKeyUsageClientAuth)
+				files.CAFile = caFile
+
+				return files
+			},
+			serverName: "other.local",
+			wantErr:    true,
+		},
+		{
+			name: "server certificate of an untrusted CA",
+			files: func(t *testing.T, dir string) certs.Files {
+				otherCA.writeCA(t, filepath.Join(dir, "other-ca.crt"))
+
+				files := ca.issue(t, dir, 6, x509.ExtKeyUsageClientAuth)
+				files.CAFile = filepath.Join(dir, "other-ca.crt")
+
+				return files
+			},
+			serverName: serverName,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clientStore, err := certs.NewStore(tt.files(t, t.TempDir()), time.Minute)
+			require.NoError(t, err)
+
+			_, err = handshake(t, serverConfig, certs.ClientConfig(clientStore, tt.serverName, tls.VersionTLS12))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ca     = newAuthority(t)
+		dir    = t.TempDir()
+		caFile = filepath.Join(dir, "ca.crt")
+	)
+
+	ca.writeCA(t, caFile)
+
+	serverStore, err := certs.NewStore(ca.issue(t, dir, 10, x509.ExtKeyUsageServerAuth), time.Minute)
+	require.NoError(t, err)
+
+	clientStore, err := certs.NewStore(certs.Files{CAFile: caFile}, time.Minute)
+	require.NoError(t, err)
+
+	serverConfig := certs.ServerConfig(serverStore, tls.VersionTLS13)
+	clientConfig := certs.ClientConfig(clientStore, serverName, tls.VersionTLS13)
+
+	cert, err := handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), cert.SerialNumber.Int64())
+
+	reloaded, err := serverStore.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded, "unchanged files must not be reloaded")
+
+	ca.issue(t, dir, 11, x509.ExtKeyUsageServerAuth)
+
+	reloaded, err = serverStore.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+
+	cert, err = handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), cert.SerialNumber.Int64())
+
+	// A key that does not match its certificate, as while the files are being replaced, keeps the previous pair.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tls.key"), []byte("not a key"), 0o600))
+
+	_, err = serverStore.Reload()
+	require.Error(t, err)
+
+	cert, err = handshake(t, serverConfig, clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), cert.SerialNumber.Int64())
+
+	// The CAs of the client are rotated too.
+	newAuthority(t).writeCA(t, caFile)
+
+	_, err = clientStore.Reload()
+	require.NoError(t, err)
+
+	_, err = handshake(t, serverConfig, clientConfig)
+	require.Error(t, err)
+}
@@ -0,0 +1,15 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/access_v1"
+)
+
+// ConvertCheckRequestFromHandlerToService converts a CheckRequest and the bearer token from the request metadata
+// to a CheckParams from the api layer to the service layer.
+func ConvertCheckRequestFromHandlerToService(params *pb.CheckRequest, accessToken string) model.CheckParams {
+	return model.CheckParams{
+		AccessToken:     accessToken,
+		EndpointAddress: params.EndpointAddress,
+	}
+}
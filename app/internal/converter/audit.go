@@ -0,0 +1,62 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+// ConvertListAuditEventsRequestFromHandlerToService converts a gRPC ListAuditEventsRequest
+// to a ListAuditEventsParams model used by the service layer.
+func ConvertListAuditEventsRequestFromHandlerToService(params *pb.ListAuditEventsRequest) model.ListAuditEventsParams {
+	filter := model.ListAuditEventsFilter{
+		ActorID:      params.ActorId,
+		TargetUserID: params.TargetUserId,
+		Action:       params.Action,
+	}
+
+	if params.From != nil {
+		from := params.From.AsTime()
+		filter.From = &from
+	}
+
+	if params.To != nil {
+		to := params.To.AsTime()
+		filter.To = &to
+	}
+
+	return model.ListAuditEventsParams{
+		PageSize:  params.PageSize,
+		PageToken: params.PageToken,
+		Filter:    filter,
+	}
+}
+
+// ConvertListAuditEventsResponseFromServiceToHandler converts a ListAuditEventsResponse model
+// from the service layer to the api layer.
+func ConvertListAuditEventsResponseFromServiceToHandler(
+	params model.ListAuditEventsResponse,
+) *pb.ListAuditEventsResponse {
+	events := make([]*pb.AuditEvent, 0, len(params.Events))
+	for _, event := range params.Events {
+		events = append(events, &pb.AuditEvent{
+			Id:           event.EventID,
+			Action:       event.Action,
+			ActorId:      event.ActorID,
+			TargetUserId: event.TargetUserID,
+			SourceIp:     event.SourceIP,
+			UserAgent:    event.UserAgent,
+			Method:       event.Method,
+			StatusCode:   event.StatusCode,
+			RequestId:    event.RequestID,
+			TraceId:      event.TraceID,
+			CreatedAt:    timestamppb.New(event.CreatedAt),
+		})
+	}
+
+	return &pb.ListAuditEventsResponse{
+		Events:        events,
+		NextPageToken: params.NextPageToken,
+	}
+}
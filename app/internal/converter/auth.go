@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/auth_v1"
+)
+
+// ConvertLoginRequestFromHandlerToService converts a LoginRequest to a LoginParams from the api layer to the service layer.
+func ConvertLoginRequestFromHandlerToService(params *pb.LoginRequest) model.LoginParams {
+	return model.LoginParams{
+		Email:    params.Email,
+		Password: params.Password,
+	}
+}
+
+// ConvertLoginResponseFromServiceToHandler converts a LoginResponse model from the service layer to the api layer.
+func ConvertLoginResponseFromServiceToHandler(params model.LoginResponse) *pb.LoginResponse {
+	return &pb.LoginResponse{
+		AccessToken:  params.AccessToken,
+		RefreshToken: params.RefreshToken,
+	}
+}
+
+// ConvertRefreshTokenRequestFromHandlerToService converts a RefreshTokenRequest to a RefreshTokenParams
+// from the api layer to the service layer.
+func ConvertRefreshTokenRequestFromHandlerToService(params *pb.RefreshTokenRequest) model.RefreshTokenParams {
+	return model.RefreshTokenParams{
+		RefreshToken: params.RefreshToken,
+	}
+}
+
+// ConvertRefreshTokenResponseFromServiceToHandler converts a RefreshTokenResponse model from the service layer
+// to the api layer.
+func ConvertRefreshTokenResponseFromServiceToHandler(params model.RefreshTokenResponse) *pb.RefreshTokenResponse {
+	return &pb.RefreshTokenResponse{
+		AccessToken:  params.AccessToken,
+		RefreshToken: params.RefreshToken,
+	}
+}
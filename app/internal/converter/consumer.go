@@ -1,13 +1,32 @@
 package converter
 
-import "github.com/Prrromanssss/auth/internal/model"
+import (
+	"github.com/Prrromanssss/auth/internal/model"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
 
-// ConvertCreateRequestFromHandlerToService converts a CreateRequest to a CreateUserParams the api layer to the service layer.
-func ConvertCreateUserKafkaParamsFromConsumerServiceToUserService(params model.CreateUserKafkaParams) model.CreateUserParams {
+// ConvertCreateUserKafkaParamsFromConsumerServiceToHandler converts a CreateUserKafkaParams to a CreateRequest,
+// so that messages are validated by the same rules as the api layer.
+func ConvertCreateUserKafkaParamsFromConsumerServiceToHandler(params model.CreateUserKafkaParams) *pb.CreateRequest {
+	return &pb.CreateRequest{
+		Name:            params.Name,
+		Email:           params.Email,
+		Password:        params.Password,
+		PasswordConfirm: params.Password,
+		Role:            pb.Role(params.Role),
+	}
+}
+
+// ConvertCreateUserKafkaParamsFromConsumerServiceToUserService converts a CreateUserKafkaParams with the hashed
+// password to a CreateUserParams from the consumer service to the user service.
+func ConvertCreateUserKafkaParamsFromConsumerServiceToUserService(
+	params model.CreateUserKafkaParams,
+	hashedPassword string,
+) model.CreateUserParams {
 	return model.CreateUserParams{
 		Name:           params.Name,
 		Email:          params.Email,
-		HashedPassword: params.Password,
-		Role:           int64(params.Role),
+		HashedPassword: hashedPassword,
+		Role:           params.Role,
 	}
 }
@@ -64,3 +64,60 @@ func ConvertDeleteRequestFromHandlerToService(params *pb.DeleteRequest) model.De
 		UserID: params.Id,
 	}
 }
+
+// ConvertUnlockRequestFromHandlerToService converts a gRPC UnlockRequest to an UnlockUserParams model used by the service layer.
+func ConvertUnlockRequestFromHandlerToService(params *pb.UnlockRequest) model.UnlockUserParams {
+	return model.UnlockUserParams{
+		UserID: params.Id,
+	}
+}
+
+// ConvertListUsersRequestFromHandlerToService converts a gRPC ListUsersRequest to a ListUsersParams model
+// used by the service layer.
+func ConvertListUsersRequestFromHandlerToService(params *pb.ListUsersRequest) model.ListUsersParams {
+	filter := model.ListUsersFilter{
+		EmailPrefix: params.EmailPrefix,
+	}
+
+	if params.Role != nil {
+		role := int64(*params.Role)
+		filter.Role = &role
+	}
+
+	if params.CreatedFrom != nil {
+		createdFrom := params.CreatedFrom.AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+
+	if params.CreatedTo != nil {
+		createdTo := params.CreatedTo.AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	return model.ListUsersParams{
+		PageSize:  params.PageSize,
+		PageToken: params.PageToken,
+		Filter:    filter,
+		SortOrder: model.SortOrder(params.SortOrder),
+	}
+}
+
+// ConvertListUsersResponseFromServiceToHandler converts a ListUsersResponse model from the service layer to the api layer.
+func ConvertListUsersResponseFromServiceToHandler(params model.ListUsersResponse) *pb.ListUsersResponse {
+	users := make([]*pb.User, 0, len(params.Users))
+	for _, user := range params.Users {
+		users = append(users, &pb.User{
+			Id:        user.UserID,
+			Name:      user.Name,
+			Email:     user.Email,
+			Role:      pb.Role(user.Role),
+			CreatedAt: timestamppb.New(user.CreatedAt),
+			UpdatedAt: timestamppb.New(user.UpdatedAt),
+		})
+	}
+
+	return &pb.ListUsersResponse{
+		Users:         users,
+		NextPageToken: params.NextPageToken,
+	}
+}
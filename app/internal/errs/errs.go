@@ -0,0 +1,127 @@
+package errs
+
+import "time"
+
+// Kind classifies a domain error so that transports can map it to their own status codes.
+type Kind uint8
+
+const (
+	// KindNotFound means the requested resource does not exist.
+	KindNotFound Kind = iota + 1
+	// KindAlreadyExists means the resource being created already exists.
+	KindAlreadyExists
+	// KindInvalidArgument means the request is malformed regardless of the state of the system.
+	KindInvalidArgument
+	// KindUnauthenticated means the caller could not be identified.
+	KindUnauthenticated
+	// KindPermissionDenied means the caller is identified but may not perform the operation.
+	KindPermissionDenied
+	// KindConflict means the operation conflicts with the current state of the resource.
+	KindConflict
+	// KindRateLimited means the caller has made too many attempts and has to wait before trying again.
+	KindRateLimited
+)
+
+// FieldViolation describes a single invalid field of a request.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error that carries its kind and the details transports need to describe it to clients.
+type Error struct {
+	Kind         Kind
+	Message      string
+	ResourceType string
+	ResourceName string
+	Violations   []FieldViolation
+	// Reason is a stable identifier of the error that clients can rely on instead of the message.
+	Reason string
+	// RetryAfter is how long the caller has to wait before trying again. Zero means it is not known.
+	RetryAfter time.Duration
+
+	cause error
+}
+
+// NewNotFound creates a new error of KindNotFound.
+func NewNotFound(message string) *Error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+// NewAlreadyExists creates a new error of KindAlreadyExists.
+func NewAlreadyExists(message string) *Error {
+	return &Error{Kind: KindAlreadyExists, Message: message}
+}
+
+// NewInvalidArgument creates a new error of KindInvalidArgument with the given field violations.
+func NewInvalidArgument(message string, violations ...FieldViolation) *Error {
+	return &Error{Kind: KindInvalidArgument, Message: message, Violations: violations}
+}
+
+// NewUnauthenticated creates a new error of KindUnauthenticated.
+func NewUnauthenticated(message string) *Error {
+	return &Error{Kind: KindUnauthenticated, Message: message}
+}
+
+// NewPermissionDenied creates a new error of KindPermissionDenied.
+func NewPermissionDenied(message string) *Error {
+	return &Error{Kind: KindPermissionDenied, Message: message}
+}
+
+// NewConflict creates a new error of KindConflict.
+func NewConflict(message string) *Error {
+	return &Error{Kind: KindConflict, Message: message}
+}
+
+// NewRateLimited creates a new error of KindRateLimited with the given reason.
+func NewRateLimited(message, reason string) *Error {
+	return &Error{Kind: KindRateLimited, Message: message, Reason: reason}
+}
+
+// Error returns the message of the error.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error this one was derived from, so that errors.Is matches the original sentinel.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// WithResource returns a copy of the error that names the affected resource and wraps the original error.
+func (e *Error) WithResource(resourceType, resourceName string) *Error {
+	derived := e.derive()
+	derived.ResourceType = resourceType
+	derived.ResourceName = resourceName
+
+	return derived
+}
+
+// WithViolations returns a copy of the error with additional field violations that wraps the original error.
+func (e *Error) WithViolations(violations ...FieldViolation) *Error {
+	derived := e.derive()
+	derived.Violations = append(append([]FieldViolation{}, e.Violations...), violations...)
+
+	return derived
+}
+
+// WithRetryAfter returns a copy of the error that tells how long to wait before a retry and wraps the original error.
+func (e *Error) WithRetryAfter(retryAfter time.Duration) *Error {
+	derived := e.derive()
+	derived.RetryAfter = retryAfter
+
+	return derived
+}
+
+func (e *Error) derive() *Error {
+	return &Error{
+		Kind:         e.Kind,
+		Message:      e.Message,
+		ResourceType: e.ResourceType,
+		ResourceName: e.ResourceName,
+		Violations:   e.Violations,
+		Reason:       e.Reason,
+		RetryAfter:   e.RetryAfter,
+		cause:        e,
+	}
+}
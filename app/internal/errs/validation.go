@@ -0,0 +1,32 @@
+package errs
+
+type multiError interface {
+	AllErrors() []error
+}
+
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// NewInvalidArgumentFromValidation creates a new error of KindInvalidArgument from the error returned by
+// the ValidateAll method of the generated validators, with a field violation for every invalid field.
+func NewInvalidArgumentFromValidation(err error) *Error {
+	errList := []error{err}
+	if multiErr, ok := err.(multiError); ok {
+		errList = multiErr.AllErrors()
+	}
+
+	violations := make([]FieldViolation, 0, len(errList))
+
+	for _, e := range errList {
+		if fieldErr, ok := e.(fieldError); ok {
+			violations = append(violations, FieldViolation{
+				Field:       fieldErr.Field(),
+				Description: fieldErr.Reason(),
+			})
+		}
+	}
+
+	return NewInvalidArgument(err.Error(), violations...)
+}
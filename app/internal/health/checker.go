@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/cache"
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/pkg/errors"
+)
+
+// Checker probes a dependency of the service.
+type Checker interface {
+	// Name returns the name the dependency is reported under.
+	Name() string
+
+	// Check returns an error when the dependency cannot serve requests.
+	Check(ctx context.Context) error
+}
+
+type checker struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (c *checker) Name() string {
+	return c.name
+}
+
+func (c *checker) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// NewDBChecker creates a checker that pings Postgres.
+func NewDBChecker(client db.Client) Checker {
+	return &checker{
+		name: "postgres",
+		check: func(ctx context.Context) error {
+			return errors.Wrap(client.DB().Ping(ctx), "Cannot ping postgres")
+		},
+	}
+}
+
+// NewRedisChecker creates a checker that pings Redis.
+func NewRedisChecker(client cache.RedisClient) Checker {
+	return &checker{
+		name: "redis",
+		check: func(ctx context.Context) error {
+			return errors.Wrap(client.Ping(ctx), "Cannot ping redis")
+		},
+	}
+}
+
+// NewConsumerChecker creates a checker that reports whether the consumer group has joined the group
+// and consumes its claims. It is not serving while the group rebalances.
+func NewConsumerChecker(name string, group *ConsumerGroup) Checker {
+	return &checker{
+		name: name,
+		check: func(_ context.Context) error {
+			if !group.InSession() {
+				return errors.New("consumer group has no active session")
+			}
+
+			return nil
+		},
+	}
+}
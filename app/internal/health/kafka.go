@@ -0,0 +1,52 @@
+package health
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/IBM/sarama"
+)
+
+// ConsumerGroup is a consumer group that keeps track of its session, from the setup of the session
+// to its cleanup, so that the consumer can be checked without access to its group handler.
+type ConsumerGroup struct {
+	sarama.ConsumerGroup
+	inSession atomic.Bool
+}
+
+// NewConsumerGroup wraps the consumer group.
+func NewConsumerGroup(group sarama.ConsumerGroup) *ConsumerGroup {
+	return &ConsumerGroup{ConsumerGroup: group}
+}
+
+// Consume joins the group and consumes the topics with the handler until the session ends.
+func (g *ConsumerGroup) Consume(ctx context.Context, topics []string, handler sarama.ConsumerGroupHandler) error {
+	return g.ConsumerGroup.Consume(ctx, topics, &sessionHandler{ConsumerGroupHandler: handler, group: g})
+}
+
+// InSession reports whether the group has an active session.
+func (g *ConsumerGroup) InSession() bool {
+	return g.inSession.Load()
+}
+
+type sessionHandler struct {
+	sarama.ConsumerGroupHandler
+	group *ConsumerGroup
+}
+
+func (h *sessionHandler) Setup(session sarama.ConsumerGroupSession) error {
+	err := h.ConsumerGroupHandler.Setup(session)
+	if err != nil {
+		return err
+	}
+
+	h.group.inSession.Store(true)
+
+	return nil
+}
+
+func (h *sessionHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	h.group.inSession.Store(false)
+
+	return h.ConsumerGroupHandler.Cleanup(session)
+}
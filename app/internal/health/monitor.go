@@ -0,0 +1,211 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"google.golang.org/grpc"
+	grpcHealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/redact"
+)
+
+// Monitor runs the checkers of the dependencies and reports the readiness of the service through
+// the grpc.health.v1 service and the readiness endpoint. The service is ready when every dependency
+// passed its last check and the shutdown has not started.
+type Monitor struct {
+	cfg      yaml.Health
+	checkers []Checker
+	server   *grpcHealth.Server
+
+	mu       sync.RWMutex
+	services []string
+	results  map[string]error
+	checked  bool
+
+	shuttingDown atomic.Bool
+}
+
+// NewMonitor creates a new instance of Monitor. The service is not serving until the first checks pass.
+func NewMonitor(cfg yaml.Health, checkers ...Checker) *Monitor {
+	server := grpcHealth.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &Monitor{
+		cfg:      cfg,
+		checkers: checkers,
+		server:   server,
+		results:  make(map[string]error, len(checkers)),
+	}
+}
+
+// Register registers the health service on the gRPC server. The services registered on the server
+// before it are reported with the readiness of the whole service.
+func (m *Monitor) Register(s *grpc.Server) {
+	m.mu.Lock()
+	for name := range s.GetServiceInfo() {
+		m.services = append(m.services, name)
+		m.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	m.mu.Unlock()
+
+	healthpb.RegisterHealthServer(s, m.server)
+}
+
+// RunChecks checks the dependencies every check interval until the context is done.
+func (m *Monitor) RunChecks(ctx context.Context) error {
+	ticker := time.NewTicker(m.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check runs the checkers concurrently, each within the check timeout, and updates the serving status.
+func (m *Monitor) Check(ctx context.Context) {
+	results := make(map[string]error, len(m.checkers))
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+
+	for _, c := range m.checkers {
+		wg.Add(1)
+
+		go func(c Checker) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, m.cfg.CheckTimeout)
+			defer cancel()
+
+			err := c.Check(checkCtx)
+
+			mu.Lock()
+			results[c.Name()] = err
+			mu.Unlock()
+		}(c)
+	}
+
+	wg.Wait()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, err := range results {
+		if err != nil && m.results[name] == nil {
+			log.Warnf("Dependency is not serving, name: %s, err: %v", name, err)
+		}
+
+		if err == nil && m.results[name] != nil {
+			log.Infof("Dependency is serving again, name: %s", name)
+		}
+	}
+
+	m.results = results
+	m.checked = true
+
+	m.setStatus(m.status())
+}
+
+// Shutdown reports the service as not serving from now on, so that load balancers stop sending it requests
+// while the servers drain.
+func (m *Monitor) Shutdown() {
+	m.shuttingDown.Store(true)
+	m.server.Shutdown()
+}
+
+// Ready reports whether the service is ready to serve requests.
+func (m *Monitor) Ready() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.status() == healthpb.HealthCheckResponse_SERVING
+}
+
+// status must be called with the mutex held.
+func (m *Monitor) status() healthpb.HealthCheckResponse_ServingStatus {
+	if !m.checked || m.shuttingDown.Load() {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	for _, err := range m.results {
+		if err != nil {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
+
+// setStatus must be called with the mutex held. The status is ignored by the health server after its shutdown.
+func (m *Monitor) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	m.server.SetServingStatus("", status)
+
+	for _, name := range m.services {
+		m.server.SetServingStatus(name, status)
+	}
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// LivenessHandler answers as long as the process is able to serve HTTP requests.
+func (m *Monitor) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte("ok"))
+	})
+}
+
+// ReadinessHandler answers with the result of the last check of every dependency,
+// with the status 503 Service Unavailable when the service is not ready.
+func (m *Monitor) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		m.mu.RLock()
+
+		status := m.status()
+		body := readiness{
+			Status: status.String(),
+			Checks: make(map[string]string, len(m.results)),
+		}
+
+		for name, err := range m.results {
+			body.Checks[name] = "ok"
+			if err != nil {
+				body.Checks[name] = redact.Text(err.Error())
+			}
+		}
+
+		m.mu.RUnlock()
+
+		code := http.StatusOK
+		if status != healthpb.HealthCheckResponse_SERVING {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+
+		err := json.NewEncoder(w).Encode(body)
+		if err != nil {
+			log.Warnf("Cannot write readiness, err: %v", err)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/health"
+)
+
+type checker struct {
+	name string
+	err  error
+}
+
+func (c checker) Name() string {
+	return c.name
+}
+
+func (c checker) Check(_ context.Context) error {
+	return c.err
+}
+
+var cfg = yaml.Health{
+	CheckInterval: time.Second,
+	CheckTimeout:  time.Second,
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func TestReadinessHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		checkers   []health.Checker
+		check      bool
+		shutdown   bool
+		wantCode   int
+		wantStatus string
+		wantChecks map[string]string
+	}{
+		{
+			name:       "success case",
+			checkers:   []health.Checker{checker{name: "postgres"}, checker{name: "redis"}},
+			check:      true,
+			wantCode:   http.StatusOK,
+			wantStatus: "SERVING",
+			wantChecks: map[string]string{"postgres": "ok", "redis": "ok"},
+		},
+		{
+			name:       "not checked yet",
+			checkers:   []health.Checker{checker{name: "postgres"}},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "NOT_SERVING",
+			wantChecks: map[string]string{},
+		},
+		{
+			name: "dependency not serving",
+			checkers: []health.Checker{
+				checker{name: "postgres"},
+				checker{name: "redis", err: errors.New("connection refused")},
+			},
+			check:      true,
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "NOT_SERVING",
+			wantChecks: map[string]string{"postgres": "ok", "redis": "connection refused"},
+		},
+		{
+			name:       "shutting down",
+			checkers:   []health.Checker{checker{name: "postgres"}},
+			check:      true,
+			shutdown:   true,
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "NOT_SERVING",
+			wantChecks: map[string]string{"postgres": "ok"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			monitor := health.NewMonitor(cfg, tt.checkers...)
+			if tt.check {
+				monitor.Check(context.Background())
+			}
+			if tt.shutdown {
+				monitor.Shutdown()
+			}
+
+			rec := httptest.NewRecorder()
+			monitor.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			require.Equal(t, tt.wantCode, rec.Code)
+
+			var got readiness
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tt.wantStatus, got.Status)
+			require.Equal(t, tt.wantChecks, got.Checks)
+		})
+	}
+}
+
+func TestGRPCHealth(t *testing.T) {
+	t.Parallel()
+
+	listener := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer()
+
+	monitor := health.NewMonitor(cfg, checker{name: "postgres"})
+	monitor.Register(server)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+
+	status := func() healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+
+		return resp.GetStatus()
+	}
+
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status())
+
+	monitor.Check(context.Background())
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status())
+
+	monitor.Shutdown()
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status())
+
+	monitor.Check(context.Background())
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status())
+}
@@ -0,0 +1,183 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+)
+
+const (
+	requestIDHeader     = "x-request-id"
+	traceparentHeader   = "traceparent"
+	forwardedForHeader  = "x-forwarded-for"
+	userAgentHeader     = "user-agent"
+	gatewayAgentHeader  = "grpcgateway-user-agent"
+	traceIDLength       = 32
+	maxRequestIDLength  = 64
+	traceparentSegments = 4
+)
+
+// AuditInterceptor collects the audit metadata of every call and records the failed calls of audited methods.
+// It must run before AuthInterceptor, which completes the metadata with the caller.
+type AuditInterceptor struct {
+	logRepository repository.LogRepository
+	methods       map[string]struct{}
+}
+
+// NewAuditInterceptor creates a new instance of AuditInterceptor that records failures of the given methods.
+func NewAuditInterceptor(logRepository repository.LogRepository, methods []string) *AuditInterceptor {
+	audited := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		audited[method] = struct{}{}
+	}
+
+	return &AuditInterceptor{
+		logRepository: logRepository,
+		methods:       audited,
+	}
+}
+
+// Unary puts the audit metadata of the call into the context of the handler, returns the request id
+// to the client and records the call in the audit log if it failed.
+func (i *AuditInterceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	md := newAuditMetadata(ctx, info.FullMethod)
+	ctx = audit.NewContext(ctx, md)
+
+	err := grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, md.RequestID))
+	if err != nil {
+		log.Warnf("Failed to set request id header, method: %s, err: %v", info.FullMethod, err)
+	}
+
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+
+	// The calls rejected by the rate limits are not recorded, so that flooding a method
+	// does not turn into as many writes to the audit log.
+	if _, ok := i.methods[info.FullMethod]; ok && codeOf(err) != codes.ResourceExhausted {
+		i.recordFailure(ctx, req, info.FullMethod, err)
+	}
+
+	return resp, err
+}
+
+// recordFailure writes the failed call outside of any transaction, since the transaction of the handler,
+// if any, has been rolled back. The request itself is not recorded, because it may hold secrets.
+func (i *AuditInterceptor) recordFailure(ctx context.Context, req interface{}, method string, err error) {
+	params := model.CreateAPILogParams{
+		Method:     method[strings.LastIndex(method, "/")+1:],
+		StatusCode: codeOf(err).String(),
+	}
+
+	if target, ok := req.(interface{ GetId() int64 }); ok {
+		targetUserID := target.GetId()
+		params.TargetUserID = &targetUserID
+	}
+
+	// The record is still written when the client has gone away.
+	logErr := i.logRepository.CreateAPILog(context.WithoutCancel(ctx), params)
+	if logErr != nil {
+		log.Errorf("Failed to record failed call in audit log, method: %s, err: %v", method, logErr)
+	}
+}
+
+func newAuditMetadata(ctx context.Context, method string) *model.AuditMetadata {
+	md := &model.AuditMetadata{
+		Method:    method,
+		RequestID: uuid.NewString(),
+	}
+
+	var trustForwarded bool
+
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		md.SourceIP = p.Addr.String()
+
+		host, _, err := net.SplitHostPort(md.SourceIP)
+		if err == nil {
+			md.SourceIP = host
+		}
+
+		// Only the HTTP gateway of this process, which dials over loopback, may speak for the real client.
+		ip := net.ParseIP(md.SourceIP)
+		trustForwarded = ip != nil && ip.IsLoopback()
+	}
+
+	// The trace of the call, continued from the caller or started by the server.
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+		md.TraceID = spanContext.TraceID().String()
+	}
+
+	incoming, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return md
+	}
+
+	// The gateway appends the address it was called from to the header sent by the client and adds
+	// the header after any metadata of the client, so only the last entry of the last value can be trusted.
+	if trustForwarded {
+		if forwarded := lastValue(incoming, forwardedForHeader); forwarded != "" {
+			md.SourceIP = strings.TrimSpace(forwarded[strings.LastIndex(forwarded, ",")+1:])
+		}
+	}
+
+	md.UserAgent = firstValue(incoming, gatewayAgentHeader)
+	if md.UserAgent == "" {
+		md.UserAgent = firstValue(incoming, userAgentHeader)
+	}
+
+	if requestID := firstValue(incoming, requestIDHeader); requestID != "" && len(requestID) <= maxRequestIDLength {
+		md.RequestID = requestID
+	}
+
+	if md.TraceID == "" {
+		md.TraceID = traceIDFromTraceparent(firstValue(incoming, traceparentHeader))
+	}
+
+	return md
+}
+
+// traceIDFromTraceparent extracts the trace id from a W3C traceparent header: version-traceid-parentid-flags.
+func traceIDFromTraceparent(traceparent string) string {
+	segments := strings.Split(traceparent, "-")
+	if len(segments) != traceparentSegments || len(segments[1]) != traceIDLength {
+		return ""
+	}
+
+	return segments[1]
+}
+
+func firstValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func lastValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[len(values)-1]
+}
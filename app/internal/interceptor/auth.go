@@ -0,0 +1,109 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/token"
+)
+
+type claimsContextKey struct{}
+
+// AuthorizeFunc checks whether the caller described by the claims may perform the request.
+// Claims are nil when an anonymous caller invokes a public method.
+type AuthorizeFunc func(claims *model.UserClaims, req interface{}) error
+
+// MethodPolicy describes how calls to a single gRPC method are authenticated and authorized.
+type MethodPolicy struct {
+	// Public allows calling the method without an access token.
+	Public bool
+	// Authorize is called after authentication. A nil Authorize lets every authenticated caller through.
+	Authorize AuthorizeFunc
+}
+
+// AuthInterceptor validates bearer tokens and enforces per-method policies.
+type AuthInterceptor struct {
+	tokenManager token.TokenManager
+	policies     map[string]MethodPolicy
+}
+
+// NewAuthInterceptor creates a new instance of AuthInterceptor with policies keyed by full gRPC method name.
+// Methods without a policy are not guarded by the interceptor.
+func NewAuthInterceptor(tokenManager token.TokenManager, policies map[string]MethodPolicy) *AuthInterceptor {
+	return &AuthInterceptor{
+		tokenManager: tokenManager,
+		policies:     policies,
+	}
+}
+
+// Unary authenticates the caller of a guarded method, checks the method policy
+// and puts the claims of the caller into the context of the handler.
+func (i *AuthInterceptor) Unary(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	policy, ok := i.policies[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	claims, err := i.authenticate(ctx, policy.Public)
+	if err != nil {
+		log.Warnf("Failed to authenticate caller, method: %s, err: %v", info.FullMethod, err)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if md, ok := audit.FromContext(ctx); ok && claims != nil {
+		md.ActorID = &claims.UserID
+	}
+
+	if policy.Authorize != nil {
+		err = policy.Authorize(claims, req)
+		if err != nil {
+			log.Warnf("Permission denied, method: %s, err: %v", info.FullMethod, err)
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
+	if claims != nil {
+		ctx = context.WithValue(ctx, claimsContextKey{}, claims)
+	}
+
+	return handler(ctx, req)
+}
+
+// authenticate verifies the bearer token of the caller. For public methods a missing token is not an error,
+// but a token that is present must still be valid.
+func (i *AuthInterceptor) authenticate(ctx context.Context, public bool) (*model.UserClaims, error) {
+	accessToken, err := token.BearerFromContext(ctx)
+	if err != nil {
+		if public && !errors.Is(err, token.ErrInvalidAuthorizationHeader) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	claims, err := i.tokenManager.VerifyAccessToken(accessToken)
+	if err != nil {
+		return nil, model.ErrInvalidAccessToken
+	}
+
+	return claims, nil
+}
+
+// ClaimsFromContext returns the claims of the authenticated caller put into the context by AuthInterceptor.
+func ClaimsFromContext(ctx context.Context) (*model.UserClaims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(*model.UserClaims)
+
+	return claims, ok
+}
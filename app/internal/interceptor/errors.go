@@ -0,0 +1,129 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Prrromanssss/auth/internal/errs"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details of the errors reported by the service.
+const ErrorDomain = "auth"
+
+var kindCodes = map[errs.Kind]codes.Code{
+	errs.KindNotFound:         codes.NotFound,
+	errs.KindAlreadyExists:    codes.AlreadyExists,
+	errs.KindInvalidArgument:  codes.InvalidArgument,
+	errs.KindUnauthenticated:  codes.Unauthenticated,
+	errs.KindPermissionDenied: codes.PermissionDenied,
+	errs.KindConflict:         codes.Aborted,
+	errs.KindRateLimited:      codes.ResourceExhausted,
+}
+
+// ErrorsInterceptor converts domain errors returned by handlers into gRPC statuses with error details.
+// Errors that already carry a status pass through, any other error is reported as codes.Internal
+// without exposing its text to the client. Errors that tell how long to wait before a retry
+// also set the retry-after metadata of the call.
+func ErrorsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return resp, err
+	}
+
+	var domainErr *errs.Error
+	if !errors.As(err, &domainErr) {
+		log.Errorf("Internal error, method: %s, err: %+v", info.FullMethod, err)
+		return resp, status.Error(codes.Internal, "internal error")
+	}
+
+	if domainErr.RetryAfter > 0 {
+		setRetryAfter(ctx, info.FullMethod, domainErr.RetryAfter)
+	}
+
+	return resp, toStatus(domainErr).Err()
+}
+
+// codeOf returns the gRPC code that ErrorsInterceptor reports for the error.
+func codeOf(err error) codes.Code {
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+
+	var domainErr *errs.Error
+	if !errors.As(err, &domainErr) {
+		return codes.Internal
+	}
+
+	code, ok := kindCodes[domainErr.Kind]
+	if !ok {
+		return codes.Unknown
+	}
+
+	return code
+}
+
+func toStatus(err *errs.Error) *status.Status {
+	code, ok := kindCodes[err.Kind]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	st := status.New(code, err.Message)
+
+	var details []protoadapt.MessageV1
+
+	if len(err.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range err.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+
+		details = append(details, badRequest)
+	}
+
+	if err.ResourceType != "" {
+		details = append(details, &errdetails.ResourceInfo{
+			ResourceType: err.ResourceType,
+			ResourceName: err.ResourceName,
+			Description:  err.Message,
+		})
+	}
+
+	if err.Reason != "" {
+		details = append(details, &errdetails.ErrorInfo{
+			Reason: err.Reason,
+			Domain: ErrorDomain,
+		})
+	}
+
+	if err.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(err.RetryAfter)})
+	}
+
+	if len(details) == 0 {
+		return st
+	}
+
+	stWithDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		log.Warnf("Failed to attach error details, err: %v", detailsErr)
+		return st
+	}
+
+	return stWithDetails
+}
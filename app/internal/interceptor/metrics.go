@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/Prrromanssss/auth/internal/metrics"
+)
+
+var (
+	grpcHandled = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "RPCs handled by the gRPC server by method and code.",
+	}, []string{"grpc_method", "grpc_code"})
+
+	grpcHandlingDuration = promauto.With(metrics.Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Duration of the RPCs handled by the gRPC server by method and code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"grpc_method", "grpc_code"})
+)
+
+// MetricsInterceptor counts the RPCs and observes their duration by method and code. It must come first
+// in the chain, so that it sees the code the client receives once ErrorsInterceptor has converted the error.
+func MetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	code := codes.OK
+	if err != nil {
+		code = codeOf(err)
+	}
+
+	grpcHandled.WithLabelValues(info.FullMethod, code.String()).Inc()
+	grpcHandlingDuration.WithLabelValues(info.FullMethod, code.String()).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
@@ -0,0 +1,180 @@
+package interceptor
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/metrics"
+	"github.com/Prrromanssss/auth/internal/ratelimit"
+)
+
+// RetryAfterHeader is the metadata of a rejected call with the number of seconds to wait before a retry.
+const RetryAfterHeader = "retry-after"
+
+const anyMethod = "*"
+
+var rateLimited = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "grpc_server",
+	Name:      "rate_limited_total",
+	Help:      "RPCs rejected by the rate limits by method and key.",
+}, []string{"grpc_method", "key"})
+
+// RateLimitInterceptor rejects the calls that exceed the rate limits of their method with codes.ResourceExhausted.
+// The rules counted by client address and by method are applied by UnaryByClient, the rules counted
+// by authenticated user by UnaryByUser.
+type RateLimitInterceptor struct {
+	limiter ratelimit.Limiter
+	rules   map[string][]yaml.RateLimitRule
+}
+
+// NewRateLimitInterceptor creates a new instance of RateLimitInterceptor with the rules of the configuration.
+func NewRateLimitInterceptor(limiter ratelimit.Limiter, rules []yaml.RateLimitRule) *RateLimitInterceptor {
+	byMethod := make(map[string][]yaml.RateLimitRule, len(rules))
+	for _, rule := range rules {
+		byMethod[rule.Method] = append(byMethod[rule.Method], rule)
+	}
+
+	return &RateLimitInterceptor{
+		limiter: limiter,
+		rules:   byMethod,
+	}
+}
+
+// UnaryByClient applies the rules counted by client address and by method. It must run after AuditInterceptor,
+// which identifies the client address, and before AuthInterceptor, so that the calls that fail
+// authentication are limited too.
+func (i *RateLimitInterceptor) UnaryByClient(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	err := i.limit(ctx, info.FullMethod, yaml.RateLimitKeyIP, yaml.RateLimitKeyMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// UnaryByUser applies the rules counted by authenticated user. It must run after AuthInterceptor,
+// which identifies the caller.
+func (i *RateLimitInterceptor) UnaryByUser(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	err := i.limit(ctx, info.FullMethod, yaml.RateLimitKeyUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// limit takes a token from the bucket of every rule of the method with one of the keys, the rules
+// for every method included. The call is rejected by the first empty bucket, whose wait is returned
+// as retry-after metadata, and the buckets of the rules after it are left untouched.
+func (i *RateLimitInterceptor) limit(ctx context.Context, method string, keys ...string) error {
+	for _, rule := range i.methodRules(method) {
+		if !slices.Contains(keys, rule.Key) {
+			continue
+		}
+
+		key, ok := bucketKey(ctx, method, rule)
+		if !ok {
+			continue
+		}
+
+		result, err := i.limiter.Allow(ctx, key, ratelimit.Limit{Rate: rule.Rate, Burst: rule.Burst})
+		if err != nil {
+			log.Warnf("Failed to check rate limit, method: %s, err: %v", method, err)
+			continue
+		}
+
+		if !result.Allowed {
+			rateLimited.WithLabelValues(method, rule.Key).Inc()
+			return rateLimitError(ctx, method, result.RetryAfter)
+		}
+	}
+
+	return nil
+}
+
+func (i *RateLimitInterceptor) methodRules(method string) []yaml.RateLimitRule {
+	rules := i.rules[method]
+	if method == anyMethod {
+		return rules
+	}
+
+	return append(rules[:len(rules):len(rules)], i.rules[anyMethod]...)
+}
+
+// bucketKey returns the key of the bucket of the call, false if the rule does not apply to the call.
+func bucketKey(ctx context.Context, method string, rule yaml.RateLimitRule) (string, bool) {
+	prefix := rule.Method + ":" + rule.Key + ":"
+
+	switch rule.Key {
+	case yaml.RateLimitKeyIP:
+		md, ok := audit.FromContext(ctx)
+		if !ok || md.SourceIP == "" {
+			return "", false
+		}
+
+		return prefix + md.SourceIP, true
+	case yaml.RateLimitKeyUser:
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok {
+			return "", false
+		}
+
+		return prefix + strconv.FormatInt(claims.UserID, 10), true
+	case yaml.RateLimitKeyMethod:
+		return prefix + method, true
+	default:
+		return "", false
+	}
+}
+
+// rateLimitError returns the status of a rejected call and sets its retry-after metadata.
+func rateLimitError(ctx context.Context, method string, retryAfter time.Duration) error {
+	setRetryAfter(ctx, method, retryAfter)
+
+	st := status.New(codes.ResourceExhausted, "rate limit exceeded")
+
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		log.Warnf("Failed to attach error details, err: %v", err)
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// setRetryAfter sets the retry-after metadata of the call, which the gateway passes on as the Retry-After header
+// of the 429 Too Many Requests response.
+func setRetryAfter(ctx context.Context, method string, retryAfter time.Duration) {
+	seconds := int64(math.Ceil(retryAfter.Seconds()))
+
+	err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.FormatInt(seconds, 10)))
+	if err != nil {
+		log.Warnf("Failed to set retry-after header, method: %s, err: %v", method, err)
+	}
+}
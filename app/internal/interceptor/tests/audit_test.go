@@ -0,0 +1,303 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	userAPI "github.com/Prrromanssss/auth/internal/api/grpc/user"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type logRepositoryMockFunc func(mc *minimock.Controller) repository.LogRepository
+
+	type args struct {
+		ctx    context.Context
+		req    interface{}
+		method string
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID    = gofakeit.Int64()
+		requestID = gofakeit.UUID()
+		userAgent = gofakeit.UserAgent()
+		clientIP  = gofakeit.IPv4Address()
+		traceID   = "4bf92f3577b34da6a3ce929d0e0e4736"
+
+		spanContext = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x0a, 0xf7, 0x65, 0x19, 0x16, 0xcd, 0x43, 0xdd, 0x84, 0x48, 0xeb, 0x21, 0x1c, 0x80, 0x31, 0x9c},
+			SpanID:     trace.SpanID{0xb7, 0xad, 0x6b, 0x71, 0x69, 0x20, 0x33, 0x31},
+			TraceFlags: trace.FlagsSampled,
+			Remote:     true,
+		})
+
+		withPeer = func(ip string, pairs ...string) context.Context {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50051},
+			})
+
+			return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+		}
+
+		withoutLog = func(mc *minimock.Controller) repository.LogRepository {
+			return repositoryMocks.NewLogRepositoryMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name          string
+		args          args
+		handlerErr    error
+		wantMetadata  model.AuditMetadata
+		logRepository logRepositoryMockFunc
+	}{
+		{
+			name: "gateway call",
+			args: args{
+				ctx: withPeer(
+					"127.0.0.1",
+					"x-forwarded-for", "10.0.0.1, "+clientIP,
+					"grpcgateway-user-agent", userAgent,
+					"user-agent", "grpc-go",
+					"x-request-id", requestID,
+					"traceparent", "00-"+traceID+"-00f067aa0ba902b7-01",
+				),
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  clientIP,
+				UserAgent: userAgent,
+				Method:    "/user_v1.UserV1/Get",
+				RequestID: requestID,
+				TraceID:   traceID,
+			},
+			logRepository: withoutLog,
+		},
+		{
+			name: "trace of the span",
+			args: args{
+				ctx: trace.ContextWithRemoteSpanContext(
+					withPeer(
+						"10.0.0.7",
+						"x-request-id", requestID,
+						"traceparent", "00-"+traceID+"-00f067aa0ba902b7-01",
+					),
+					spanContext,
+				),
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  "10.0.0.7",
+				Method:    "/user_v1.UserV1/Get",
+				RequestID: requestID,
+				TraceID:   spanContext.TraceID().String(),
+			},
+			logRepository: withoutLog,
+		},
+		{
+			name: "forwarded for sent by the client is not trusted",
+			args: args{
+				ctx: withPeer(
+					"127.0.0.1",
+					"x-forwarded-for", "203.0.113.9",
+					"x-forwarded-for", "203.0.113.9, "+clientIP,
+					"x-request-id", requestID,
+				),
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  clientIP,
+				Method:    "/user_v1.UserV1/Get",
+				RequestID: requestID,
+			},
+			logRepository: withoutLog,
+		},
+		{
+			name: "forwarded for is ignored from remote peer",
+			args: args{
+				ctx: withPeer(
+					"10.0.0.7",
+					"x-forwarded-for", clientIP,
+					"user-agent", userAgent,
+					"x-request-id", requestID,
+					"traceparent", "malformed",
+				),
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  "10.0.0.7",
+				UserAgent: userAgent,
+				Method:    "/user_v1.UserV1/Get",
+				RequestID: requestID,
+			},
+			logRepository: withoutLog,
+		},
+		{
+			name: "rate limited audited call is not recorded",
+			args: args{
+				ctx:    withPeer("10.0.0.7", "x-request-id", requestID),
+				req:    &pb.DeleteRequest{Id: userID},
+				method: "/user_v1.UserV1/Delete",
+			},
+			handlerErr: status.Error(codes.ResourceExhausted, "rate limit exceeded"),
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  "10.0.0.7",
+				Method:    "/user_v1.UserV1/Delete",
+				RequestID: requestID,
+			},
+			logRepository: withoutLog,
+		},
+		{
+			name: "failed audited call is recorded",
+			args: args{
+				ctx:    withPeer("10.0.0.7", "x-request-id", requestID),
+				req:    &pb.DeleteRequest{Id: userID},
+				method: "/user_v1.UserV1/Delete",
+			},
+			handlerErr: model.ErrAccessDenied,
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  "10.0.0.7",
+				Method:    "/user_v1.UserV1/Delete",
+				RequestID: requestID,
+			},
+			logRepository: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Set(func(ctx context.Context, params model.CreateAPILogParams) error {
+					md, ok := audit.FromContext(ctx)
+					require.True(t, ok)
+					require.Equal(t, requestID, md.RequestID)
+					require.Equal(t, model.CreateAPILogParams{
+						Method:       "Delete",
+						TargetUserID: &userID,
+						StatusCode:   codes.PermissionDenied.String(),
+					}, params)
+
+					return nil
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "failed call of not audited method is not recorded",
+			args: args{
+				ctx:    withPeer("10.0.0.7", "x-request-id", requestID),
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			handlerErr: model.ErrUserNotFound,
+			wantMetadata: model.AuditMetadata{
+				SourceIP:  "10.0.0.7",
+				Method:    "/user_v1.UserV1/Get",
+				RequestID: requestID,
+			},
+			logRepository: withoutLog,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			auditInterceptor := interceptor.NewAuditInterceptor(tt.logRepository(mc), userAPI.AuditedMethods())
+
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				md, ok := audit.FromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, tt.wantMetadata, *md)
+
+				return nil, tt.handlerErr
+			}
+
+			_, err := auditInterceptor.Unary(
+				tt.args.ctx,
+				tt.args.req,
+				&grpc.UnaryServerInfo{FullMethod: tt.args.method},
+				handler,
+			)
+			require.Equal(t, tt.handlerErr, err)
+		})
+	}
+
+	t.Run("request id is generated", func(t *testing.T) {
+		t.Parallel()
+
+		auditInterceptor := interceptor.NewAuditInterceptor(withoutLog(mc), userAPI.AuditedMethods())
+
+		handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+			md, ok := audit.FromContext(ctx)
+			require.True(t, ok)
+			require.NotEmpty(t, md.RequestID)
+			require.Empty(t, md.SourceIP)
+
+			return nil, nil
+		}
+
+		_, err := auditInterceptor.Unary(
+			context.Background(),
+			nil,
+			&grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/Get"},
+			handler,
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("actor is filled by auth interceptor", func(t *testing.T) {
+		t.Parallel()
+
+		adminClaims := &model.UserClaims{UserID: userID, Role: int64(pb.Role_ADMIN)}
+		accessToken := gofakeit.UUID()
+
+		tokenManager := tokenMocks.NewTokenManagerMock(mc)
+		tokenManager.VerifyAccessTokenMock.Expect(accessToken).Return(adminClaims, nil)
+
+		auditInterceptor := interceptor.NewAuditInterceptor(withoutLog(mc), userAPI.AuditedMethods())
+		authInterceptor := interceptor.NewAuthInterceptor(tokenManager, userAPI.Policies())
+
+		handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+			md, ok := audit.FromContext(ctx)
+			require.True(t, ok)
+			require.Equal(t, &userID, md.ActorID)
+
+			return nil, nil
+		}
+
+		_, err := auditInterceptor.Unary(
+			metadata.NewIncomingContext(
+				context.Background(),
+				metadata.Pairs("authorization", "Bearer "+accessToken),
+			),
+			&pb.DeleteRequest{Id: userID + 1},
+			&grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/Delete"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return authInterceptor.Unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/Delete"}, handler)
+			},
+		)
+		require.NoError(t, err)
+	})
+}
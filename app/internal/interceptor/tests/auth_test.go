@@ -0,0 +1,283 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	userAPI "github.com/Prrromanssss/auth/internal/api/grpc/user"
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/token"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	type tokenManagerMockFunc func(mc *minimock.Controller) token.TokenManager
+
+	type args struct {
+		ctx    context.Context
+		req    interface{}
+		method string
+	}
+
+	var (
+		mc = minimock.NewController(t)
+
+		userID      = gofakeit.Int64()
+		otherUserID = userID + 1
+		accessToken = gofakeit.UUID()
+
+		ErrTokenManager = errors.New("token manager error")
+
+		ctx          = context.Background()
+		ctxWithToken = metadata.NewIncomingContext(
+			context.Background(),
+			metadata.Pairs("authorization", "Bearer "+accessToken),
+		)
+
+		userClaims = &model.UserClaims{
+			UserID: userID,
+			Role:   int64(pb.Role_USER),
+		}
+
+		adminClaims = &model.UserClaims{
+			UserID: userID,
+			Role:   int64(pb.Role_ADMIN),
+		}
+
+		handlerResp = gofakeit.UUID()
+
+		withClaims = func(claims *model.UserClaims) tokenManagerMockFunc {
+			return func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyAccessTokenMock.Expect(accessToken).Return(claims, nil)
+
+				return mock
+			}
+		}
+
+		withoutToken = func(mc *minimock.Controller) token.TokenManager {
+			return tokenMocks.NewTokenManagerMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name             string
+		args             args
+		want             interface{}
+		err              error
+		wantClaims       *model.UserClaims
+		tokenManagerMock tokenManagerMockFunc
+	}{
+		{
+			name: "unguarded method",
+			args: args{
+				ctx:    ctx,
+				req:    nil,
+				method: "/auth_v1.AuthV1/Login",
+			},
+			want:             handlerResp,
+			tokenManagerMock: withoutToken,
+		},
+		{
+			name: "create anonymous user",
+			args: args{
+				ctx:    ctx,
+				req:    &pb.CreateRequest{Role: pb.Role_USER},
+				method: "/user_v1.UserV1/Create",
+			},
+			want:             handlerResp,
+			tokenManagerMock: withoutToken,
+		},
+		{
+			name: "create anonymous admin",
+			args: args{
+				ctx:    ctx,
+				req:    &pb.CreateRequest{Role: pb.Role_ADMIN},
+				method: "/user_v1.UserV1/Create",
+			},
+			err:              status.Error(codes.PermissionDenied, model.ErrAdminRoleNotAllowed.Error()),
+			tokenManagerMock: withoutToken,
+		},
+		{
+			name: "create admin by user",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.CreateRequest{Role: pb.Role_ADMIN},
+				method: "/user_v1.UserV1/Create",
+			},
+			err:              status.Error(codes.PermissionDenied, model.ErrAdminRoleNotAllowed.Error()),
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "create admin by admin",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.CreateRequest{Role: pb.Role_ADMIN},
+				method: "/user_v1.UserV1/Create",
+			},
+			want:             handlerResp,
+			wantClaims:       adminClaims,
+			tokenManagerMock: withClaims(adminClaims),
+		},
+		{
+			name: "create with invalid token",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.CreateRequest{Role: pb.Role_USER},
+				method: "/user_v1.UserV1/Create",
+			},
+			err: status.Error(codes.Unauthenticated, model.ErrInvalidAccessToken.Error()),
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyAccessTokenMock.Expect(accessToken).Return(nil, ErrTokenManager)
+
+				return mock
+			},
+		},
+		{
+			name: "get without token",
+			args: args{
+				ctx:    ctx,
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			err:              status.Error(codes.Unauthenticated, token.ErrMetadataNotProvided.Error()),
+			tokenManagerMock: withoutToken,
+		},
+		{
+			name: "get self",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.GetRequest{Id: userID},
+				method: "/user_v1.UserV1/Get",
+			},
+			want:             handlerResp,
+			wantClaims:       userClaims,
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "get other user",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.GetRequest{Id: otherUserID},
+				method: "/user_v1.UserV1/Get",
+			},
+			err:              status.Error(codes.PermissionDenied, model.ErrAccessDenied.Error()),
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "get other user by admin",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.GetRequest{Id: otherUserID},
+				method: "/user_v1.UserV1/Get",
+			},
+			want:             handlerResp,
+			wantClaims:       adminClaims,
+			tokenManagerMock: withClaims(adminClaims),
+		},
+		{
+			name: "update self",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.UpdateRequest{Id: userID, Role: pb.Role_USER},
+				method: "/user_v1.UserV1/Update",
+			},
+			want:             handlerResp,
+			wantClaims:       userClaims,
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "update self to admin",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.UpdateRequest{Id: userID, Role: pb.Role_ADMIN},
+				method: "/user_v1.UserV1/Update",
+			},
+			err:              status.Error(codes.PermissionDenied, model.ErrAdminRoleNotAllowed.Error()),
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "update other user",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.UpdateRequest{Id: otherUserID, Role: pb.Role_USER},
+				method: "/user_v1.UserV1/Update",
+			},
+			err:              status.Error(codes.PermissionDenied, model.ErrAccessDenied.Error()),
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "delete by user",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.DeleteRequest{Id: userID},
+				method: "/user_v1.UserV1/Delete",
+			},
+			err:              status.Error(codes.PermissionDenied, model.ErrAccessDenied.Error()),
+			tokenManagerMock: withClaims(userClaims),
+		},
+		{
+			name: "delete by admin",
+			args: args{
+				ctx:    ctxWithToken,
+				req:    &pb.DeleteRequest{Id: otherUserID},
+				method: "/user_v1.UserV1/Delete",
+			},
+			want:             handlerResp,
+			wantClaims:       adminClaims,
+			tokenManagerMock: withClaims(adminClaims),
+		},
+		{
+			name: "delete with malformed authorization header",
+			args: args{
+				ctx: metadata.NewIncomingContext(
+					context.Background(),
+					metadata.Pairs("authorization", accessToken),
+				),
+				req:    &pb.DeleteRequest{Id: otherUserID},
+				method: "/user_v1.UserV1/Delete",
+			},
+			err:              status.Error(codes.Unauthenticated, token.ErrInvalidAuthorizationHeader.Error()),
+			tokenManagerMock: withoutToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			authInterceptor := interceptor.NewAuthInterceptor(tt.tokenManagerMock(mc), userAPI.Policies())
+
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				claims, ok := interceptor.ClaimsFromContext(ctx)
+				require.Equal(t, tt.wantClaims != nil, ok)
+				require.Equal(t, tt.wantClaims, claims)
+
+				return handlerResp, nil
+			}
+
+			resp, err := authInterceptor.Unary(
+				tt.args.ctx,
+				tt.args.req,
+				&grpc.UnaryServerInfo{FullMethod: tt.args.method},
+				handler,
+			)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pkgErrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/Prrromanssss/auth/internal/errs"
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+func TestErrorsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		info = &grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/Get"}
+	)
+
+	tests := []struct {
+		name        string
+		handlerErr  error
+		wantCode    codes.Code
+		wantMessage string
+		wantDetails []proto.Message
+	}{
+		{
+			name:        "not found with resource",
+			handlerErr:  model.ErrUserNotFound.WithResource("user", "42"),
+			wantCode:    codes.NotFound,
+			wantMessage: model.ErrUserNotFound.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ResourceInfo{
+					ResourceType: "user",
+					ResourceName: "42",
+					Description:  model.ErrUserNotFound.Error(),
+				},
+			},
+		},
+		{
+			name:        "already exists wrapped by transaction",
+			handlerErr:  pkgErrors.Wrap(model.ErrUserAlreadyExists.WithResource("user", "user@example.com"), "tx failed"),
+			wantCode:    codes.AlreadyExists,
+			wantMessage: model.ErrUserAlreadyExists.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ResourceInfo{
+					ResourceType: "user",
+					ResourceName: "user@example.com",
+					Description:  model.ErrUserAlreadyExists.Error(),
+				},
+			},
+		},
+		{
+			name:        "invalid argument",
+			handlerErr:  model.ErrPasswordsMismatch,
+			wantCode:    codes.InvalidArgument,
+			wantMessage: model.ErrPasswordsMismatch.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{
+						{Field: "password_confirm", Description: "must match password"},
+					},
+				},
+			},
+		},
+		{
+			name:        "unauthenticated",
+			handlerErr:  model.ErrInvalidCredentials,
+			wantCode:    codes.Unauthenticated,
+			wantMessage: model.ErrInvalidCredentials.Error(),
+		},
+		{
+			name:        "permission denied",
+			handlerErr:  model.ErrAccessDenied,
+			wantCode:    codes.PermissionDenied,
+			wantMessage: model.ErrAccessDenied.Error(),
+		},
+		{
+			name:        "account locked",
+			handlerErr:  model.ErrAccountLocked.WithRetryAfter(30 * time.Second),
+			wantCode:    codes.ResourceExhausted,
+			wantMessage: model.ErrAccountLocked.Error(),
+			wantDetails: []proto.Message{
+				&errdetails.ErrorInfo{
+					Reason: model.ReasonAccountLocked,
+					Domain: interceptor.ErrorDomain,
+				},
+				&errdetails.RetryInfo{RetryDelay: durationpb.New(30 * time.Second)},
+			},
+		},
+		{
+			name:        "conflict",
+			handlerErr:  errs.NewConflict("version mismatch"),
+			wantCode:    codes.Aborted,
+			wantMessage: "version mismatch",
+		},
+		{
+			name:        "status error passes through",
+			handlerErr:  status.Error(codes.Unavailable, "unavailable"),
+			wantCode:    codes.Unavailable,
+			wantMessage: "unavailable",
+		},
+		{
+			name:        "unknown error is hidden",
+			handlerErr:  errors.New("connection refused"),
+			wantCode:    codes.Internal,
+			wantMessage: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				return nil, tt.handlerErr
+			}
+
+			_, err := interceptor.ErrorsInterceptor(ctx, nil, info, handler)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok)
+			require.Equal(t, tt.wantCode, st.Code())
+			require.Equal(t, tt.wantMessage, st.Message())
+
+			details := st.Details()
+			require.Len(t, details, len(tt.wantDetails))
+
+			for i, want := range tt.wantDetails {
+				got, ok := details[i].(proto.Message)
+				require.True(t, ok)
+				require.True(t, proto.Equal(want, got))
+			}
+		})
+	}
+
+	t.Run("success case", func(t *testing.T) {
+		t.Parallel()
+
+		handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+
+		resp, err := interceptor.ErrorsInterceptor(ctx, nil, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, "ok", resp)
+	})
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/metrics"
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = context.Background()
+		info = &grpc.UnaryServerInfo{FullMethod: "/user_v1.UserV1/MetricsTest"}
+	)
+
+	handlers := []grpc.UnaryHandler{
+		func(context.Context, interface{}) (interface{}, error) { return "ok", nil },
+		func(context.Context, interface{}) (interface{}, error) { return nil, model.ErrUserNotFound },
+		func(context.Context, interface{}) (interface{}, error) { return nil, model.ErrUserNotFound },
+	}
+
+	// Only this test goes through the interceptor, so the registry holds no other RPCs.
+	for _, handler := range handlers {
+		_, _ = interceptor.MetricsInterceptor(ctx, nil, info, handler)
+	}
+
+	expected := `
+		# HELP auth_grpc_server_handled_total RPCs handled by the gRPC server by method and code.
+		# TYPE auth_grpc_server_handled_total counter
+		auth_grpc_server_handled_total{grpc_code="NotFound",grpc_method="/user_v1.UserV1/MetricsTest"} 2
+		auth_grpc_server_handled_total{grpc_code="OK",grpc_method="/user_v1.UserV1/MetricsTest"} 1
+	`
+	require.NoError(t, testutil.GatherAndCompare(
+		metrics.Registry,
+		strings.NewReader(expected),
+		"auth_grpc_server_handled_total",
+	))
+}
@@ -0,0 +1,214 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/Prrromanssss/auth/config/yaml"
+	userAPI "github.com/Prrromanssss/auth/internal/api/grpc/user"
+	"github.com/Prrromanssss/auth/internal/interceptor"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/ratelimit"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+// transportStream records the headers set by the interceptors.
+type transportStream struct {
+	header metadata.MD
+}
+
+func (s *transportStream) Method() string {
+	return ""
+}
+
+func (s *transportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *transportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *transportStream) SetTrailer(_ metadata.MD) error {
+	return nil
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		createMethod = "/user_v1.UserV1/Create"
+		getMethod    = "/user_v1.UserV1/Get"
+	)
+
+	type call struct {
+		ip       string
+		token    bool
+		method   string
+		wantCode codes.Code
+	}
+
+	var (
+		userID      = gofakeit.Int64()
+		accessToken = gofakeit.UUID()
+		clientIP    = gofakeit.IPv4Address()
+		otherIP     = "10.0.0.7"
+		slow        = 1.0 / 3600
+	)
+
+	tests := []struct {
+		name  string
+		rules []yaml.RateLimitRule
+		calls []call
+	}{
+		{
+			name:  "success case",
+			rules: []yaml.RateLimitRule{{Method: createMethod, Key: yaml.RateLimitKeyIP, Rate: slow, Burst: 2}},
+			calls: []call{
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+			},
+		},
+		{
+			name:  "ip limit exceeded",
+			rules: []yaml.RateLimitRule{{Method: createMethod, Key: yaml.RateLimitKeyIP, Rate: slow, Burst: 2}},
+			calls: []call{
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: createMethod, wantCode: codes.ResourceExhausted},
+				{ip: otherIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: getMethod, token: true, wantCode: codes.OK},
+			},
+		},
+		{
+			name:  "user limit exceeded",
+			rules: []yaml.RateLimitRule{{Method: getMethod, Key: yaml.RateLimitKeyUser, Rate: slow, Burst: 1}},
+			calls: []call{
+				{ip: clientIP, method: getMethod, token: true, wantCode: codes.OK},
+				{ip: otherIP, method: getMethod, token: true, wantCode: codes.ResourceExhausted},
+			},
+		},
+		{
+			name:  "anonymous calls out of user limit",
+			rules: []yaml.RateLimitRule{{Method: createMethod, Key: yaml.RateLimitKeyUser, Rate: slow, Burst: 1}},
+			calls: []call{
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+			},
+		},
+		{
+			name:  "method limit exceeded",
+			rules: []yaml.RateLimitRule{{Method: createMethod, Key: yaml.RateLimitKeyMethod, Rate: slow, Burst: 1}},
+			calls: []call{
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: otherIP, method: createMethod, wantCode: codes.ResourceExhausted},
+			},
+		},
+		{
+			name:  "unauthenticated calls are limited",
+			rules: []yaml.RateLimitRule{{Method: getMethod, Key: yaml.RateLimitKeyIP, Rate: slow, Burst: 1}},
+			calls: []call{
+				{ip: clientIP, method: getMethod, wantCode: codes.Unauthenticated},
+				{ip: clientIP, method: getMethod, wantCode: codes.ResourceExhausted},
+			},
+		},
+		{
+			name: "rejection stops at the first empty bucket",
+			rules: []yaml.RateLimitRule{
+				{Method: createMethod, Key: yaml.RateLimitKeyIP, Rate: slow, Burst: 1},
+				{Method: createMethod, Key: yaml.RateLimitKeyMethod, Rate: slow, Burst: 2},
+			},
+			calls: []call{
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: createMethod, wantCode: codes.ResourceExhausted},
+				{ip: otherIP, method: createMethod, wantCode: codes.OK},
+			},
+		},
+		{
+			name:  "limit of every method",
+			rules: []yaml.RateLimitRule{{Method: "*", Key: yaml.RateLimitKeyIP, Rate: slow, Burst: 1}},
+			calls: []call{
+				{ip: clientIP, method: createMethod, wantCode: codes.OK},
+				{ip: clientIP, method: getMethod, token: true, wantCode: codes.ResourceExhausted},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+
+			// Only the calls with a token reach the token manager.
+			tokenManagerMock := tokenMocks.NewTokenManagerMock(mc)
+			tokenManagerMock.VerifyAccessTokenMock.
+				Optional().
+				Expect(accessToken).
+				Return(&model.UserClaims{UserID: userID, Role: int64(pb.Role_USER)}, nil)
+
+			var (
+				auditInterceptor     = interceptor.NewAuditInterceptor(nil, nil)
+				authInterceptor      = interceptor.NewAuthInterceptor(tokenManagerMock, userAPI.Policies())
+				rateLimitInterceptor = interceptor.NewRateLimitInterceptor(ratelimit.NewLocalLimiter(), tt.rules)
+			)
+
+			for i, c := range tt.calls {
+				ctx := peer.NewContext(context.Background(), &peer.Peer{
+					Addr: &net.TCPAddr{IP: net.ParseIP(c.ip), Port: 50051},
+				})
+
+				var req interface{} = &pb.CreateRequest{}
+				if c.token {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+					req = &pb.GetRequest{Id: userID}
+				}
+
+				stream := &transportStream{}
+				ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+
+				info := &grpc.UnaryServerInfo{FullMethod: c.method}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return rateLimitInterceptor.UnaryByClient(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+						return authInterceptor.Unary(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+							return rateLimitInterceptor.UnaryByUser(ctx, req, info, func(_ context.Context, _ interface{}) (interface{}, error) {
+								return "ok", nil
+							})
+						})
+					})
+				}
+
+				_, err := auditInterceptor.Unary(ctx, req, info, handler)
+
+				st, _ := status.FromError(err)
+				require.Equal(t, c.wantCode, st.Code(), "call %d", i)
+
+				if c.wantCode != codes.ResourceExhausted {
+					require.Empty(t, stream.header.Get(interceptor.RetryAfterHeader))
+					continue
+				}
+
+				require.Equal(t, []string{"3600"}, stream.header.Get(interceptor.RetryAfterHeader))
+				require.Len(t, st.Details(), 1)
+
+				retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+				require.True(t, ok)
+				require.InDelta(t, time.Hour.Seconds(), retryInfo.GetRetryDelay().AsDuration().Seconds(), 1)
+			}
+		})
+	}
+}
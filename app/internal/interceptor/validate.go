@@ -4,16 +4,18 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+
+	"github.com/Prrromanssss/auth/internal/errs"
 )
 
 type validator interface {
-	Validate() error
+	ValidateAll() error
 }
 
 func ValidateInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	if val, ok := req.(validator); ok {
-		if err := val.Validate(); err != nil {
-			return nil, err
+		if err := val.ValidateAll(); err != nil {
+			return nil, errs.NewInvalidArgumentFromValidation(err)
 		}
 	}
 
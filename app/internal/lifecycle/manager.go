@@ -0,0 +1,115 @@
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+)
+
+// Component is a part of the application that runs until it is stopped, such as a server or a consumer.
+type Component struct {
+	// Name identifies the component in the logs and errors.
+	Name string
+	// Run blocks until the context is done or the component fails. A component that stops because
+	// its context is done returns nil or context.Canceled.
+	Run func(ctx context.Context) error
+	// Stop makes Run return once the work in progress is done, giving up when the context is done.
+	// It is optional: the context of Run is cancelled after Stop returns, which is enough for most components.
+	Stop func(ctx context.Context) error
+}
+
+// Manager runs the components of the application and shuts them down in the reverse order they were added.
+type Manager struct {
+	shutdownTimeout time.Duration
+	components      []Component
+}
+
+// NewManager creates a new instance of Manager that gives the components shutdownTimeout to stop.
+func NewManager(shutdownTimeout time.Duration) *Manager {
+	return &Manager{shutdownTimeout: shutdownTimeout}
+}
+
+// Add adds components, which are stopped before the components added earlier.
+func (m *Manager) Add(components ...Component) {
+	m.components = append(m.components, components...)
+}
+
+type running struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Run runs the components until the context is done or one of them fails, such as a server
+// that cannot bind its port, then stops them all and returns the first failure.
+// The shutdown does not depend on the context, which is usually done by then.
+func (m *Manager) Run(ctx context.Context) error {
+	failures := make(chan error, len(m.components))
+	runs := make([]running, len(m.components))
+
+	for i, c := range m.components {
+		runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		runs[i] = running{cancel: cancel, done: make(chan struct{})}
+
+		go func(c Component, done chan struct{}) {
+			defer close(done)
+
+			err := c.Run(runCtx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				failures <- errors.Wrapf(err, "Component %s failed", c.Name)
+			}
+		}(c, runs[i].done)
+	}
+
+	var err error
+
+	select {
+	case <-ctx.Done():
+		log.Info("Shutting down: context done")
+	case err = <-failures:
+		log.Errorf("Shutting down: %v", err)
+	}
+
+	stopErr := m.stop(ctx, runs)
+
+	// The components left running past the deadline may still fail, so the channel is not closed.
+	for len(failures) > 0 {
+		log.Warnf("%v", <-failures)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return stopErr
+}
+
+// stop stops the components one by one in the reverse order. Once the shutdown deadline is exceeded
+// the remaining components are still cancelled, but are no longer waited for.
+func (m *Manager) stop(ctx context.Context, runs []running) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), m.shutdownTimeout)
+	defer cancel()
+
+	for i := len(m.components) - 1; i >= 0; i-- {
+		c := m.components[i]
+
+		if c.Stop != nil {
+			err := c.Stop(ctx)
+			if err != nil {
+				log.Warnf("Cannot stop %s gracefully, err: %v", c.Name, err)
+			}
+		}
+
+		runs[i].cancel()
+
+		select {
+		case <-runs[i].done:
+			log.Infof("Stopped %s", c.Name)
+		case <-ctx.Done():
+			log.Warnf("Gave up waiting for %s to stop", c.Name)
+		}
+	}
+
+	return errors.Wrap(ctx.Err(), "Shutdown deadline exceeded")
+}
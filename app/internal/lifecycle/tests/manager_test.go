@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/lifecycle"
+)
+
+// recorder records the order the components stop in.
+type recorder struct {
+	mu      sync.Mutex
+	stopped []string
+}
+
+func (r *recorder) record(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stopped = append(r.stopped, name)
+}
+
+// untilDone is a component that runs until its context is done.
+func (r *recorder) untilDone(name string) lifecycle.Component {
+	return lifecycle.Component{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			r.record(name)
+
+			return ctx.Err()
+		},
+	}
+}
+
+func TestManagerRun(t *testing.T) {
+	t.Parallel()
+
+	errListen := errors.New("address already in use")
+
+	tests := []struct {
+		name        string
+		cancel      bool
+		components  func(r *recorder) []lifecycle.Component
+		wantErr     error
+		wantStopped []string
+	}{
+		{
+			name:   "success case",
+			cancel: true,
+			components: func(r *recorder) []lifecycle.Component {
+				return []lifecycle.Component{r.untilDone("writer"), r.untilDone("consumer"), r.untilDone("server")}
+			},
+			wantStopped: []string{"server", "consumer", "writer"},
+		},
+		{
+			name: "component failed",
+			components: func(r *recorder) []lifecycle.Component {
+				return []lifecycle.Component{
+					r.untilDone("writer"),
+					{
+						Name: "server",
+						Run: func(_ context.Context) error {
+							return errListen
+						},
+					},
+					r.untilDone("swagger"),
+				}
+			},
+			wantErr:     errListen,
+			wantStopped: []string{"swagger", "writer"},
+		},
+		{
+			name:   "stop before cancel",
+			cancel: true,
+			components: func(r *recorder) []lifecycle.Component {
+				stopped := make(chan struct{})
+
+				return []lifecycle.Component{
+					r.untilDone("writer"),
+					{
+						Name: "server",
+						Run: func(_ context.Context) error {
+							<-stopped
+							r.record("server")
+
+							return nil
+						},
+						Stop: func(_ context.Context) error {
+							r.record("server stop")
+							close(stopped)
+
+							return nil
+						},
+					},
+				}
+			},
+			wantStopped: []string{"server stop", "server", "writer"},
+		},
+		{
+			name:   "shutdown deadline exceeded",
+			cancel: true,
+			components: func(r *recorder) []lifecycle.Component {
+				return []lifecycle.Component{
+					{
+						Name: "stuck",
+						Run: func(_ context.Context) error {
+							select {}
+						},
+					},
+				}
+			},
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			r := &recorder{}
+
+			manager := lifecycle.NewManager(50 * time.Millisecond)
+			manager.Add(tt.components(r)...)
+
+			if tt.cancel {
+				cancel()
+			}
+
+			err := manager.Run(ctx)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			require.Equal(t, tt.wantStopped, r.stopped)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// batchQueryName labels the batches, which have no query name.
+const batchQueryName = "batch"
+
+var queryDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: Namespace,
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "Duration of the database queries by query name and outcome.",
+	Buckets:   []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+}, []string{"query", "outcome"})
+
+type dbClient struct {
+	db.Client
+	db db.DB
+}
+
+// NewDBClient wraps the database client, so that the duration of every query is observed
+// under the name of its db.Query, such as "userPGRepo.GetUser".
+func NewDBClient(client db.Client) db.Client {
+	return &dbClient{
+		Client: client,
+		db:     &instrumentedDB{DB: client.DB()},
+	}
+}
+
+func (c *dbClient) DB() db.DB {
+	return c.db
+}
+
+type instrumentedDB struct {
+	db.DB
+}
+
+func (d *instrumentedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	start := time.Now()
+
+	err := d.DB.ScanOneContext(ctx, dest, q, args...)
+	observeQuery(q.Name, start, err)
+
+	return err
+}
+
+func (d *instrumentedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) error {
+	start := time.Now()
+
+	err := d.DB.ScanAllContext(ctx, dest, q, args...)
+	observeQuery(q.Name, start, err)
+
+	return err
+}
+
+func (d *instrumentedDB) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (pgconn.CommandTag, error) {
+	start := time.Now()
+
+	tag, err := d.DB.ExecContext(ctx, q, args...)
+	observeQuery(q.Name, start, err)
+
+	return tag, err
+}
+
+// QueryContext observes the time until the rows are ready to be read.
+func (d *instrumentedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (pgx.Rows, error) {
+	start := time.Now()
+
+	rows, err := d.DB.QueryContext(ctx, q, args...)
+	observeQuery(q.Name, start, err)
+
+	return rows, err
+}
+
+// QueryRowContext observes the time until the row is ready to be scanned, errors surface on the scan.
+func (d *instrumentedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	start := time.Now()
+
+	row := d.DB.QueryRowContext(ctx, q, args...)
+	observeQuery(q.Name, start, nil)
+
+	return row
+}
+
+func (d *instrumentedDB) SendBatchContext(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	start := time.Now()
+
+	results := d.DB.SendBatchContext(ctx, b)
+	observeQuery(batchQueryName, start, nil)
+
+	return results
+}
+
+func observeQuery(name string, start time.Time, err error) {
+	queryDuration.WithLabelValues(name, outcome(err)).Observe(time.Since(start).Seconds())
+}
+
+// outcome tells the failed queries apart. A query that finds no row has not failed.
+func outcome(err error) string {
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "error"
+	}
+
+	return "ok"
+}
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// consumerLagCollector reports the lag of a consumer group per partition of its topics when it is scraped:
+// the number of messages between the offset committed by the group and the newest offset of the partition.
+type consumerLagCollector struct {
+	client sarama.Client
+	admin  sarama.ClusterAdmin
+	group  string
+	topics []string
+	lag    *prometheus.Desc
+}
+
+// NewConsumerLagCollector creates a collector of the lag of the consumer group on the topics.
+// The client is only used to read offsets, it must not be shared with the consumer.
+func NewConsumerLagCollector(
+	client sarama.Client,
+	admin sarama.ClusterAdmin,
+	name string,
+	group string,
+	topics []string,
+) prometheus.Collector {
+	return &consumerLagCollector{
+		client: client,
+		admin:  admin,
+		group:  group,
+		topics: topics,
+		lag: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, name, "consumer_lag"),
+			"Messages of the partition not yet committed by the consumer group.",
+			[]string{"topic", "partition"},
+			prometheus.Labels{"group": group},
+		),
+	}
+}
+
+func (c *consumerLagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.lag
+}
+
+func (c *consumerLagCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, topic := range c.topics {
+		err := c.collectTopic(ch, topic)
+		if err != nil {
+			ch <- prometheus.NewInvalidMetric(c.lag, err)
+		}
+	}
+}
+
+func (c *consumerLagCollector) collectTopic(ch chan<- prometheus.Metric, topic string) error {
+	partitions, err := c.client.Partitions(topic)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot get partitions(topic: %s)", topic)
+	}
+
+	committed, err := c.admin.ListConsumerGroupOffsets(c.group, map[string][]int32{topic: partitions})
+	if err != nil {
+		return errors.Wrapf(err, "Cannot get committed offsets(topic: %s)", topic)
+	}
+
+	for _, partition := range partitions {
+		newest, offsetErr := c.client.GetOffset(topic, partition, sarama.OffsetNewest)
+		if offsetErr != nil {
+			return errors.Wrapf(offsetErr, "Cannot get newest offset(topic: %s, partition: %d)", topic, partition)
+		}
+
+		// A group that has not committed yet starts from the oldest message.
+		offset := int64(-1)
+		if block := committed.GetBlock(topic, partition); block != nil {
+			offset = block.Offset
+		}
+
+		if offset < 0 {
+			offset, offsetErr = c.client.GetOffset(topic, partition, sarama.OffsetOldest)
+			if offsetErr != nil {
+				return errors.Wrapf(offsetErr, "Cannot get oldest offset(topic: %s, partition: %d)", topic, partition)
+			}
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.lag,
+			prometheus.GaugeValue,
+			float64(max(newest-offset, 0)),
+			topic,
+			strconv.FormatInt(int64(partition), 10),
+		)
+	}
+
+	return nil
+}
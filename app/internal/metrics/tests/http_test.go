@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/metrics"
+)
+
+// counterValue returns the value of the counter of the registry with the given labels, 0 if there is none.
+func counterValue(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			if hasLabels(metric, labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	matched := 0
+
+	for _, pair := range metric.GetLabel() {
+		if value, ok := labels[pair.GetName()]; ok && value == pair.GetValue() {
+			matched++
+		}
+	}
+
+	return matched == len(labels)
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	t.Parallel()
+
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(metrics.HTTPMiddleware))
+
+	err := mux.HandlePath(http.MethodGet, "/metrics-test/v1/{id}", func(w http.ResponseWriter, _ *http.Request, params map[string]string) {
+		if params["id"] == "0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	})
+	require.NoError(t, err)
+
+	for _, path := range []string{"/metrics-test/v1/1", "/metrics-test/v1/2", "/metrics-test/v1/0"} {
+		mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	require.Equal(t, float64(2), counterValue(t, "auth_http_requests_total", map[string]string{
+		"method": http.MethodGet,
+		"route":  "/metrics-test/v1/{id=*}",
+		"code":   "200",
+	}))
+	require.Equal(t, float64(1), counterValue(t, "auth_http_requests_total", map[string]string{
+		"method": http.MethodGet,
+		"route":  "/metrics-test/v1/{id=*}",
+		"code":   "404",
+	}))
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `auth_http_request_duration_seconds_count{code="200",method="GET",route="/metrics-test/v1/{id=*}"} 2`)
+	require.Contains(t, rec.Body.String(), "go_goroutines")
+}
@@ -0,0 +1,20 @@
+package model
+
+// CheckParams holds the access token and the endpoint the token holder wants to call.
+type CheckParams struct {
+	AccessToken     string `redact:"secret"`
+	EndpointAddress string
+}
+
+// CheckAccessParams holds the parameters for evaluating whether a role may call an endpoint.
+type CheckAccessParams struct {
+	EndpointAddress string
+	Role            int64
+}
+
+// AccessDecision represents the result of evaluating a role against the access rules of an endpoint.
+type AccessDecision struct {
+	EndpointAddress string
+	Role            int64
+	Allowed         bool
+}
@@ -0,0 +1,202 @@
+package model
+
+import "time"
+
+// AuditStatusOK is the outcome of a call that succeeded.
+const AuditStatusOK = "OK"
+
+// CreateAPILogParams holds the parameters for recording an API action in the audit log.
+// The caller of the action is taken from the AuditMetadata of the context.
+type CreateAPILogParams struct {
+	Method       string
+	TargetUserID *int64
+	// StatusCode is the outcome of the action. Empty means AuditStatusOK.
+	StatusCode   string
+	RequestData  interface{}
+	ResponseData interface{}
+}
+
+// APILogRecord is an audit record captured during a call and written after it.
+type APILogRecord struct {
+	Params   CreateAPILogParams
+	Metadata *AuditMetadata
+	// CreatedAt is the time of the action rather than the time the record is written.
+	CreatedAt time.Time
+}
+
+// AuditMetadata describes the call that caused an audit record.
+type AuditMetadata struct {
+	ActorID   *int64
+	SourceIP  string
+	UserAgent string
+	Method    string
+	RequestID string
+	TraceID   string
+}
+
+// AuditEvent represents a record of the audit log.
+type AuditEvent struct {
+	EventID      int64
+	Action       string
+	ActorID      *int64
+	TargetUserID *int64
+	SourceIP     string
+	UserAgent    string
+	Method       string
+	StatusCode   string
+	RequestID    string
+	TraceID      string
+	CreatedAt    time.Time
+}
+
+// ListAuditEventsFilter holds the optional filters for listing audit events.
+type ListAuditEventsFilter struct {
+	ActorID      *int64
+	TargetUserID *int64
+	Action       string
+	From         *time.Time
+	To           *time.Time
+}
+
+// ListAuditEventsParams holds the parameters for listing a page of audit events, newest first.
+type ListAuditEventsParams struct {
+	PageSize  int64
+	PageToken string
+	Filter    ListAuditEventsFilter
+}
+
+// ListAuditEventsResponse represents a page of audit events and the token of the next page.
+type ListAuditEventsResponse struct {
+	Events        []AuditEvent
+	NextPageToken string
+}
+
+// AuditEventCursor represents the keyset position of an audit event in a listing ordered by (created_at, id).
+type AuditEventCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	EventID   int64     `json:"id"`
+}
+
+// ListAuditEventsPageParams holds the parameters for retrieving a page of audit events after the cursor.
+type ListAuditEventsPageParams struct {
+	Filter ListAuditEventsFilter
+	After  *AuditEventCursor
+	Limit  int64
+}
+
+// ListAuditEventsPageResponse represents a page of audit events retrieved from the database.
+type ListAuditEventsPageResponse struct {
+	Events []AuditEvent
+}
+
+// AuditChainLink represents an audit record as stored, together with the hashes that chain it
+// to the previous record.
+type AuditChainLink struct {
+	EventID      int64
+	Action       string
+	RequestData  string
+	ResponseData *string
+	ActorID      *int64
+	TargetUserID *int64
+	SourceIP     *string
+	UserAgent    *string
+	Method       *string
+	StatusCode   string
+	RequestID    *string
+	TraceID      *string
+	CreatedAt    time.Time
+	// PrevHash is nil for the first record of the chain. Both hashes are nil for the records
+	// written before the chain was introduced.
+	PrevHash []byte
+	Hash     []byte
+}
+
+// ListAuditChainParams holds the parameters for retrieving the audit records after a record, in chain order.
+type ListAuditChainParams struct {
+	AfterEventID int64
+	Limit        int64
+}
+
+// AuditChainHead identifies the last record of the audit chain.
+type AuditChainHead struct {
+	EventID int64
+	Hash    []byte
+}
+
+// AuditCheckpoint is a signed statement of the head of the audit chain at a point in time.
+type AuditCheckpoint struct {
+	CheckpointID int64
+	EventID      int64
+	Hash         []byte
+	Signature    []byte
+	CreatedAt    time.Time
+}
+
+// ListAuditCheckpointsParams holds the parameters for retrieving the checkpoints after a checkpoint, oldest first.
+type ListAuditCheckpointsParams struct {
+	AfterCheckpointID int64
+	Limit             int64
+}
+
+// AuditChainBreak describes the first record at which the audit chain does not verify.
+type AuditChainBreak struct {
+	EventID int64
+	Reason  string
+}
+
+// VerifyAuditChainResponse represents the outcome of walking the audit chain.
+type VerifyAuditChainResponse struct {
+	// Records is the number of chained records that verified.
+	Records int64
+	// Unchained is the number of records written before the chain was introduced.
+	Unchained int64
+	// Checkpoints is the number of checkpoints that verified.
+	Checkpoints int64
+	// Break is nil if the whole chain verified.
+	Break *AuditChainBreak
+}
+
+// AuditPartition represents a monthly partition of the audit log that holds the records from From to To.
+type AuditPartition struct {
+	Name string
+	From time.Time
+	To   time.Time
+}
+
+// ListAuditPartitionRecordsParams holds the parameters for retrieving the records of a partition after a record,
+// in chain order.
+type ListAuditPartitionRecordsParams struct {
+	Partition    string
+	AfterEventID int64
+	Limit        int64
+}
+
+// AuditArchive represents a partition of the audit log exported to the archive before it was removed from the log.
+type AuditArchive struct {
+	ArchiveID int64
+	Partition AuditPartition
+	FileName  string
+	Records   int64
+	// MaxEventID is nil if the partition had no records.
+	MaxEventID *int64
+}
+
+// CreateAuditChainSeamsParams holds the parameters for recording the records of the log that are chained
+// to a record of a partition about to be archived.
+type CreateAuditChainSeamsParams struct {
+	ArchiveID int64
+	Partition string
+}
+
+// AuditChainSeam represents a record of the log whose previous record in the chain was archived.
+type AuditChainSeam struct {
+	EventID  int64
+	PrevHash []byte
+}
+
+// DropAuditPartitionParams holds the parameters for removing a partition from the audit log.
+type DropAuditPartitionParams struct {
+	Partition string
+	// DetachOnly keeps the partition as a standalone table.
+	DetachOnly bool
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LoginParams holds the credentials used to authenticate a user.
+type LoginParams struct {
+	Email    string `redact:"pii"`
+	Password string `redact:"secret"`
+}
+
+// LoginResponse represents the pair of tokens issued after a successful login.
+type LoginResponse struct {
+	AccessToken  string `redact:"secret"`
+	RefreshToken string `redact:"secret"`
+}
+
+// RefreshTokenParams holds the refresh token presented for rotation.
+type RefreshTokenParams struct {
+	RefreshToken string `redact:"secret"`
+}
+
+// RefreshTokenResponse represents the new pair of tokens issued after a refresh token rotation.
+type RefreshTokenResponse struct {
+	AccessToken  string `redact:"secret"`
+	RefreshToken string `redact:"secret"`
+}
+
+// UserClaims represents the claims embedded into access and refresh tokens.
+type UserClaims struct {
+	jwt.RegisteredClaims
+	UserID int64 `json:"user_id"`
+	Role   int64 `json:"role"`
+}
+
+// RefreshToken represents a refresh token persisted in the token store.
+type RefreshToken struct {
+	TokenID   string
+	FamilyID  string
+	UserID    int64
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// CreateRefreshTokenParams holds the parameters for persisting a newly issued refresh token.
+type CreateRefreshTokenParams struct {
+	TokenID   string
+	FamilyID  string
+	UserID    int64
+	ExpiresAt time.Time
+}
+
+// GetRefreshTokenParams holds the parameters for retrieving a refresh token by ID.
+type GetRefreshTokenParams struct {
+	TokenID string
+}
+
+// MarkRefreshTokenUsedParams holds the parameters for marking a refresh token as used.
+type MarkRefreshTokenUsedParams struct {
+	TokenID string
+}
+
+// RevokeRefreshTokenFamilyParams holds the parameters for revoking every refresh token of a family.
+type RevokeRefreshTokenFamilyParams struct {
+	FamilyID string
+}
+
+// Scopes the failed logins are counted in.
+const (
+	// LoginFailureScopeAccount counts the failed logins for an email, whether a user with it exists or not.
+	LoginFailureScopeAccount = "account"
+	// LoginFailureScopeIP counts the failed logins from a client address.
+	LoginFailureScopeIP = "ip"
+)
+
+// LoginFailureKey identifies a counter of failed logins within its scope.
+type LoginFailureKey struct {
+	Scope string
+	Key   string `redact:"pii"`
+}
+
+// LoginFailure represents the failed logins counted for a key since the counter was last reset.
+type LoginFailure struct {
+	Scope        string
+	Key          string `redact:"pii"`
+	Failures     int64
+	LastFailedAt time.Time
+	// LockedUntil is nil if no login for the key has to wait.
+	LockedUntil *time.Time
+}
+
+// LockLoginFailuresParams holds the parameters for locking and retrieving the counters of failed logins by key.
+type LockLoginFailuresParams struct {
+	Keys []LoginFailureKey
+}
+
+// RecordLoginFailureParams holds the parameters for counting a failed login.
+type RecordLoginFailureParams struct {
+	LoginFailureKey
+	FailedAt time.Time
+	// ResetBefore restarts the count if the previous failure happened before it.
+	ResetBefore time.Time
+}
+
+// LockLoginParams holds the parameters for making the logins for a key wait until a point in time.
+type LockLoginParams struct {
+	LoginFailureKey
+	LockedUntil time.Time
+}
+
+// DeleteLoginFailuresParams holds the parameters for resetting the counters of failed logins by key.
+type DeleteLoginFailuresParams struct {
+	Keys []LoginFailureKey
+}
+
+// LoginLockout is the audit record of a key locked out after too many failed logins.
+type LoginLockout struct {
+	Scope       string
+	Key         string `redact:"pii"`
+	Failures    int64
+	LockedUntil time.Time
+}
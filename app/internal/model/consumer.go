@@ -3,7 +3,7 @@ package model
 // CreateUserParams holds the parameters for creating a new user.
 type CreateUserKafkaParams struct {
 	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email    string `json:"email" redact:"pii"`
+	Password string `json:"password" redact:"secret"`
 	Role     int64  `json:"role"`
 }
@@ -0,0 +1,40 @@
+package model
+
+import "github.com/Prrromanssss/auth/internal/errs"
+
+// ReasonAccountLocked is the reason of ErrAccountLocked in the error details.
+const ReasonAccountLocked = "account_locked"
+
+var (
+	// ErrUserNotFound is returned when the requested user does not exist.
+	ErrUserNotFound = errs.NewNotFound("user not found")
+	// ErrUserAlreadyExists is returned when a user with the same email already exists.
+	ErrUserAlreadyExists = errs.NewAlreadyExists("user already exists")
+	// ErrPasswordsMismatch is returned when the password confirmation differs from the password.
+	ErrPasswordsMismatch = errs.NewInvalidArgument(
+		"passwords don't match",
+		errs.FieldViolation{Field: "password_confirm", Description: "must match password"},
+	)
+	// ErrInvalidPageToken is returned when a page token is malformed, tampered with or issued for another query.
+	ErrInvalidPageToken = errs.NewInvalidArgument(
+		"invalid page token",
+		errs.FieldViolation{Field: "page_token", Description: "must be a token returned by the previous page"},
+	)
+	// ErrInvalidCredentials is returned when the email or password is wrong.
+	ErrInvalidCredentials = errs.NewUnauthenticated("invalid email or password")
+	// ErrAccountLocked is returned when logins for the email or from the client address have to wait after
+	// too many failures. It is returned whether a user with the email exists or not.
+	ErrAccountLocked = errs.NewRateLimited("too many failed login attempts, try again later", ReasonAccountLocked)
+	// ErrRefreshTokenNotFound is returned when the refresh token is missing from the token store.
+	ErrRefreshTokenNotFound = errs.NewNotFound("refresh token not found")
+	// ErrInvalidRefreshToken is returned when the refresh token is malformed, expired or revoked.
+	ErrInvalidRefreshToken = errs.NewUnauthenticated("invalid refresh token")
+	// ErrRefreshTokenReused is returned when an already used refresh token is presented again.
+	ErrRefreshTokenReused = errs.NewUnauthenticated("refresh token reuse detected")
+	// ErrInvalidAccessToken is returned when the access token is missing, malformed or expired.
+	ErrInvalidAccessToken = errs.NewUnauthenticated("invalid access token")
+	// ErrAccessDenied is returned when the role of the token holder may not call the endpoint.
+	ErrAccessDenied = errs.NewPermissionDenied("access denied")
+	// ErrAdminRoleNotAllowed is returned when a caller who is not an admin tries to assign the admin role.
+	ErrAdminRoleNotAllowed = errs.NewPermissionDenied("only admins can assign the admin role")
+)
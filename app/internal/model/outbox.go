@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// OutboxEventType identifies the kind of change an outbox event describes.
+type OutboxEventType string
+
+const (
+	// OutboxEventUserCreated is recorded when a user is created.
+	OutboxEventUserCreated OutboxEventType = "user.created"
+	// OutboxEventUserUpdated is recorded when a user is updated.
+	OutboxEventUserUpdated OutboxEventType = "user.updated"
+	// OutboxEventUserDeleted is recorded when a user is deleted.
+	OutboxEventUserDeleted OutboxEventType = "user.deleted"
+)
+
+// UserEvent is the payload of the user lifecycle events published to Kafka.
+type UserEvent struct {
+	UserID    int64      `json:"id"`
+	Name      string     `json:"name,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	Role      int64      `json:"role,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CreateOutboxEventParams holds the parameters for recording an event in the outbox.
+type CreateOutboxEventParams struct {
+	AggregateID int64
+	EventType   OutboxEventType
+	Payload     interface{}
+}
+
+// OutboxEvent represents an event recorded in the outbox.
+type OutboxEvent struct {
+	EventID     int64
+	AggregateID int64
+	EventType   OutboxEventType
+	Payload     []byte
+	CreatedAt   time.Time
+}
+
+// ListPendingOutboxEventsParams holds the parameters for retrieving the events that are not sent yet.
+type ListPendingOutboxEventsParams struct {
+	Limit int64
+}
+
+// MarkOutboxEventsSentParams holds the parameters for marking outbox events as sent.
+type MarkOutboxEventsSentParams struct {
+	EventIDs []int64
+}
@@ -7,17 +7,19 @@ import (
 type User struct {
 	UserID    int64
 	Name      string
-	Email     string
+	Email     string `redact:"pii"`
 	Role      int64
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// Version is incremented on every update of the user.
+	Version int64
 }
 
 // CreateUserParams holds the parameters for creating a new user.
 type CreateUserParams struct {
 	Name           string
-	Email          string
-	HashedPassword string
+	Email          string `redact:"pii"`
+	HashedPassword string `redact:"secret"`
 	Role           int64
 }
 
@@ -36,6 +38,17 @@ type GetUserResponse struct {
 	User
 }
 
+// GetUserByEmailParams holds the parameters for retrieving a user by email.
+type GetUserByEmailParams struct {
+	Email string `redact:"pii"`
+}
+
+// GetUserByEmailResponse represents the details of a user retrieved by email, including the password hash.
+type GetUserByEmailResponse struct {
+	User
+	HashedPassword string `redact:"secret"`
+}
+
 // UpdateUserParams holds the parameters for updating an existing user.
 type UpdateUserParams struct {
 	UserID int64
@@ -48,14 +61,69 @@ type UpdateUserResponse struct {
 	User
 }
 
+// UpdateUserPasswordParams holds the parameters for replacing a user's password hash.
+type UpdateUserPasswordParams struct {
+	UserID         int64
+	HashedPassword string `redact:"secret"`
+}
+
 // DeleteUserParams holds the parameters for deleting a user by ID.
 type DeleteUserParams struct {
 	UserID int64
 }
 
-// CreateAPILogParams holds the parameters for logging API actions related to user creation.
-type CreateAPILogParams struct {
-	Method       string
-	RequestData  interface{}
-	ResponseData interface{}
+// UnlockUserParams holds the parameters for lifting the login lockout of a user by ID.
+type UnlockUserParams struct {
+	UserID int64
+}
+
+// SortOrder defines the direction in which users are listed by creation time.
+type SortOrder int32
+
+const (
+	// SortOrderDesc lists the newest users first.
+	SortOrderDesc SortOrder = iota
+	// SortOrderAsc lists the oldest users first.
+	SortOrderAsc
+)
+
+// ListUsersFilter holds the optional filters for listing users.
+type ListUsersFilter struct {
+	Role        *int64
+	EmailPrefix string `redact:"pii"`
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+// ListUsersParams holds the parameters for listing a page of users.
+type ListUsersParams struct {
+	PageSize  int64
+	PageToken string
+	Filter    ListUsersFilter
+	SortOrder SortOrder
+}
+
+// ListUsersResponse represents a page of users and the token of the next page.
+type ListUsersResponse struct {
+	Users         []User
+	NextPageToken string
+}
+
+// UserCursor represents the keyset position of a user in a listing ordered by (created_at, id).
+type UserCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	UserID    int64     `json:"id"`
+}
+
+// ListUsersPageParams holds the parameters for retrieving a page of users after the cursor.
+type ListUsersPageParams struct {
+	Filter    ListUsersFilter
+	SortOrder SortOrder
+	After     *UserCursor
+	Limit     int64
+}
+
+// ListUsersPageResponse represents a page of users retrieved from the database.
+type ListUsersPageResponse struct {
+	Users []User
 }
@@ -0,0 +1,4 @@
+package pagination
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i PageTokenCodec -o ./mocks/ -s "_minimock.go"
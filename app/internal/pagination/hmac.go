@@ -0,0 +1,76 @@
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+const tokenSeparator = "."
+
+type hmacCodec struct {
+	secretKey []byte
+}
+
+// NewPageTokenCodec creates a new PageTokenCodec that signs page tokens with HMAC-SHA256.
+func NewPageTokenCodec(secretKey string) PageTokenCodec {
+	return &hmacCodec{
+		secretKey: []byte(secretKey),
+	}
+}
+
+// Encode serializes the cursor to JSON and appends a signature over the payload and the scope.
+func (c *hmacCodec) Encode(cursor interface{}, scope string) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", errors.Wrap(err, "Cannot marshal page cursor")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) +
+		tokenSeparator +
+		base64.RawURLEncoding.EncodeToString(c.sign(payload, scope)), nil
+}
+
+// Decode checks the signature of the page token against the scope and unmarshals the payload into the cursor.
+func (c *hmacCodec) Decode(token string, scope string, cursor interface{}) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, tokenSeparator)
+	if !ok {
+		return model.ErrInvalidPageToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return model.ErrInvalidPageToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return model.ErrInvalidPageToken
+	}
+
+	if !hmac.Equal(signature, c.sign(payload, scope)) {
+		return model.ErrInvalidPageToken
+	}
+
+	err = json.Unmarshal(payload, cursor)
+	if err != nil {
+		return model.ErrInvalidPageToken
+	}
+
+	return nil
+}
+
+func (c *hmacCodec) sign(payload []byte, scope string) []byte {
+	mac := hmac.New(sha256.New, c.secretKey)
+	mac.Write([]byte(scope))
+	mac.Write([]byte{0})
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,753 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/pagination.PageTokenCodec -o page_token_codec_minimock.go -n PageTokenCodecMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PageTokenCodecMock implements pagination.PageTokenCodec
+type PageTokenCodecMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDecode          func(token string, scope string, cursor interface{}) (err error)
+	inspectFuncDecode   func(token string, scope string, cursor interface{})
+	afterDecodeCounter  uint64
+	beforeDecodeCounter uint64
+	DecodeMock          mPageTokenCodecMockDecode
+
+	funcEncode          func(cursor interface{}, scope string) (token string, err error)
+	inspectFuncEncode   func(cursor interface{}, scope string)
+	afterEncodeCounter  uint64
+	beforeEncodeCounter uint64
+	EncodeMock          mPageTokenCodecMockEncode
+}
+
+// NewPageTokenCodecMock returns a mock for pagination.PageTokenCodec
+func NewPageTokenCodecMock(t minimock.Tester) *PageTokenCodecMock {
+	m := &PageTokenCodecMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DecodeMock = mPageTokenCodecMockDecode{mock: m}
+	m.DecodeMock.callArgs = []*PageTokenCodecMockDecodeParams{}
+
+	m.EncodeMock = mPageTokenCodecMockEncode{mock: m}
+	m.EncodeMock.callArgs = []*PageTokenCodecMockEncodeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPageTokenCodecMockDecode struct {
+	optional           bool
+	mock               *PageTokenCodecMock
+	defaultExpectation *PageTokenCodecMockDecodeExpectation
+	expectations       []*PageTokenCodecMockDecodeExpectation
+
+	callArgs []*PageTokenCodecMockDecodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PageTokenCodecMockDecodeExpectation specifies expectation struct of the PageTokenCodec.Decode
+type PageTokenCodecMockDecodeExpectation struct {
+	mock      *PageTokenCodecMock
+	params    *PageTokenCodecMockDecodeParams
+	paramPtrs *PageTokenCodecMockDecodeParamPtrs
+	results   *PageTokenCodecMockDecodeResults
+	Counter   uint64
+}
+
+// PageTokenCodecMockDecodeParams contains parameters of the PageTokenCodec.Decode
+type PageTokenCodecMockDecodeParams struct {
+	token  string
+	scope  string
+	cursor interface{}
+}
+
+// PageTokenCodecMockDecodeParamPtrs contains pointers to parameters of the PageTokenCodec.Decode
+type PageTokenCodecMockDecodeParamPtrs struct {
+	token  *string
+	scope  *string
+	cursor *interface{}
+}
+
+// PageTokenCodecMockDecodeResults contains results of the PageTokenCodec.Decode
+type PageTokenCodecMockDecodeResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDecode *mPageTokenCodecMockDecode) Optional() *mPageTokenCodecMockDecode {
+	mmDecode.optional = true
+	return mmDecode
+}
+
+// Expect sets up expected params for PageTokenCodec.Decode
+func (mmDecode *mPageTokenCodecMockDecode) Expect(token string, scope string, cursor interface{}) *mPageTokenCodecMockDecode {
+	if mmDecode.mock.funcDecode != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Set")
+	}
+
+	if mmDecode.defaultExpectation == nil {
+		mmDecode.defaultExpectation = &PageTokenCodecMockDecodeExpectation{}
+	}
+
+	if mmDecode.defaultExpectation.paramPtrs != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by ExpectParams functions")
+	}
+
+	mmDecode.defaultExpectation.params = &PageTokenCodecMockDecodeParams{token, scope, cursor}
+	for _, e := range mmDecode.expectations {
+		if minimock.Equal(e.params, mmDecode.defaultExpectation.params) {
+			mmDecode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDecode.defaultExpectation.params)
+		}
+	}
+
+	return mmDecode
+}
+
+// ExpectTokenParam1 sets up expected param token for PageTokenCodec.Decode
+func (mmDecode *mPageTokenCodecMockDecode) ExpectTokenParam1(token string) *mPageTokenCodecMockDecode {
+	if mmDecode.mock.funcDecode != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Set")
+	}
+
+	if mmDecode.defaultExpectation == nil {
+		mmDecode.defaultExpectation = &PageTokenCodecMockDecodeExpectation{}
+	}
+
+	if mmDecode.defaultExpectation.params != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Expect")
+	}
+
+	if mmDecode.defaultExpectation.paramPtrs == nil {
+		mmDecode.defaultExpectation.paramPtrs = &PageTokenCodecMockDecodeParamPtrs{}
+	}
+	mmDecode.defaultExpectation.paramPtrs.token = &token
+
+	return mmDecode
+}
+
+// ExpectScopeParam2 sets up expected param scope for PageTokenCodec.Decode
+func (mmDecode *mPageTokenCodecMockDecode) ExpectScopeParam2(scope string) *mPageTokenCodecMockDecode {
+	if mmDecode.mock.funcDecode != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Set")
+	}
+
+	if mmDecode.defaultExpectation == nil {
+		mmDecode.defaultExpectation = &PageTokenCodecMockDecodeExpectation{}
+	}
+
+	if mmDecode.defaultExpectation.params != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Expect")
+	}
+
+	if mmDecode.defaultExpectation.paramPtrs == nil {
+		mmDecode.defaultExpectation.paramPtrs = &PageTokenCodecMockDecodeParamPtrs{}
+	}
+	mmDecode.defaultExpectation.paramPtrs.scope = &scope
+
+	return mmDecode
+}
+
+// ExpectCursorParam3 sets up expected param cursor for PageTokenCodec.Decode
+func (mmDecode *mPageTokenCodecMockDecode) ExpectCursorParam3(cursor interface{}) *mPageTokenCodecMockDecode {
+	if mmDecode.mock.funcDecode != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Set")
+	}
+
+	if mmDecode.defaultExpectation == nil {
+		mmDecode.defaultExpectation = &PageTokenCodecMockDecodeExpectation{}
+	}
+
+	if mmDecode.defaultExpectation.params != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Expect")
+	}
+
+	if mmDecode.defaultExpectation.paramPtrs == nil {
+		mmDecode.defaultExpectation.paramPtrs = &PageTokenCodecMockDecodeParamPtrs{}
+	}
+	mmDecode.defaultExpectation.paramPtrs.cursor = &cursor
+
+	return mmDecode
+}
+
+// Inspect accepts an inspector function that has same arguments as the PageTokenCodec.Decode
+func (mmDecode *mPageTokenCodecMockDecode) Inspect(f func(token string, scope string, cursor interface{})) *mPageTokenCodecMockDecode {
+	if mmDecode.mock.inspectFuncDecode != nil {
+		mmDecode.mock.t.Fatalf("Inspect function is already set for PageTokenCodecMock.Decode")
+	}
+
+	mmDecode.mock.inspectFuncDecode = f
+
+	return mmDecode
+}
+
+// Return sets up results that will be returned by PageTokenCodec.Decode
+func (mmDecode *mPageTokenCodecMockDecode) Return(err error) *PageTokenCodecMock {
+	if mmDecode.mock.funcDecode != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Set")
+	}
+
+	if mmDecode.defaultExpectation == nil {
+		mmDecode.defaultExpectation = &PageTokenCodecMockDecodeExpectation{mock: mmDecode.mock}
+	}
+	mmDecode.defaultExpectation.results = &PageTokenCodecMockDecodeResults{err}
+	return mmDecode.mock
+}
+
+// Set uses given function f to mock the PageTokenCodec.Decode method
+func (mmDecode *mPageTokenCodecMockDecode) Set(f func(token string, scope string, cursor interface{}) (err error)) *PageTokenCodecMock {
+	if mmDecode.defaultExpectation != nil {
+		mmDecode.mock.t.Fatalf("Default expectation is already set for the PageTokenCodec.Decode method")
+	}
+
+	if len(mmDecode.expectations) > 0 {
+		mmDecode.mock.t.Fatalf("Some expectations are already set for the PageTokenCodec.Decode method")
+	}
+
+	mmDecode.mock.funcDecode = f
+	return mmDecode.mock
+}
+
+// When sets expectation for the PageTokenCodec.Decode which will trigger the result defined by the following
+// Then helper
+func (mmDecode *mPageTokenCodecMockDecode) When(token string, scope string, cursor interface{}) *PageTokenCodecMockDecodeExpectation {
+	if mmDecode.mock.funcDecode != nil {
+		mmDecode.mock.t.Fatalf("PageTokenCodecMock.Decode mock is already set by Set")
+	}
+
+	expectation := &PageTokenCodecMockDecodeExpectation{
+		mock:   mmDecode.mock,
+		params: &PageTokenCodecMockDecodeParams{token, scope, cursor},
+	}
+	mmDecode.expectations = append(mmDecode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PageTokenCodec.Decode return parameters for the expectation previously defined by the When method
+func (e *PageTokenCodecMockDecodeExpectation) Then(err error) *PageTokenCodecMock {
+	e.results = &PageTokenCodecMockDecodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times PageTokenCodec.Decode should be invoked
+func (mmDecode *mPageTokenCodecMockDecode) Times(n uint64) *mPageTokenCodecMockDecode {
+	if n == 0 {
+		mmDecode.mock.t.Fatalf("Times of PageTokenCodecMock.Decode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDecode.expectedInvocations, n)
+	return mmDecode
+}
+
+func (mmDecode *mPageTokenCodecMockDecode) invocationsDone() bool {
+	if len(mmDecode.expectations) == 0 && mmDecode.defaultExpectation == nil && mmDecode.mock.funcDecode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDecode.mock.afterDecodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDecode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Decode implements pagination.PageTokenCodec
+func (mmDecode *PageTokenCodecMock) Decode(token string, scope string, cursor interface{}) (err error) {
+	mm_atomic.AddUint64(&mmDecode.beforeDecodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmDecode.afterDecodeCounter, 1)
+
+	if mmDecode.inspectFuncDecode != nil {
+		mmDecode.inspectFuncDecode(token, scope, cursor)
+	}
+
+	mm_params := PageTokenCodecMockDecodeParams{token, scope, cursor}
+
+	// Record call args
+	mmDecode.DecodeMock.mutex.Lock()
+	mmDecode.DecodeMock.callArgs = append(mmDecode.DecodeMock.callArgs, &mm_params)
+	mmDecode.DecodeMock.mutex.Unlock()
+
+	for _, e := range mmDecode.DecodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDecode.DecodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDecode.DecodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmDecode.DecodeMock.defaultExpectation.params
+		mm_want_ptrs := mmDecode.DecodeMock.defaultExpectation.paramPtrs
+
+		mm_got := PageTokenCodecMockDecodeParams{token, scope, cursor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmDecode.t.Errorf("PageTokenCodecMock.Decode got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmDecode.t.Errorf("PageTokenCodecMock.Decode got unexpected parameter scope, want: %#v, got: %#v%s\n", *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+			if mm_want_ptrs.cursor != nil && !minimock.Equal(*mm_want_ptrs.cursor, mm_got.cursor) {
+				mmDecode.t.Errorf("PageTokenCodecMock.Decode got unexpected parameter cursor, want: %#v, got: %#v%s\n", *mm_want_ptrs.cursor, mm_got.cursor, minimock.Diff(*mm_want_ptrs.cursor, mm_got.cursor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDecode.t.Errorf("PageTokenCodecMock.Decode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDecode.DecodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDecode.t.Fatal("No results are set for the PageTokenCodecMock.Decode")
+		}
+		return (*mm_results).err
+	}
+	if mmDecode.funcDecode != nil {
+		return mmDecode.funcDecode(token, scope, cursor)
+	}
+	mmDecode.t.Fatalf("Unexpected call to PageTokenCodecMock.Decode. %v %v %v", token, scope, cursor)
+	return
+}
+
+// DecodeAfterCounter returns a count of finished PageTokenCodecMock.Decode invocations
+func (mmDecode *PageTokenCodecMock) DecodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecode.afterDecodeCounter)
+}
+
+// DecodeBeforeCounter returns a count of PageTokenCodecMock.Decode invocations
+func (mmDecode *PageTokenCodecMock) DecodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecode.beforeDecodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to PageTokenCodecMock.Decode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDecode *mPageTokenCodecMockDecode) Calls() []*PageTokenCodecMockDecodeParams {
+	mmDecode.mutex.RLock()
+
+	argCopy := make([]*PageTokenCodecMockDecodeParams, len(mmDecode.callArgs))
+	copy(argCopy, mmDecode.callArgs)
+
+	mmDecode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDecodeDone returns true if the count of the Decode invocations corresponds
+// the number of defined expectations
+func (m *PageTokenCodecMock) MinimockDecodeDone() bool {
+	if m.DecodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DecodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DecodeMock.invocationsDone()
+}
+
+// MinimockDecodeInspect logs each unmet expectation
+func (m *PageTokenCodecMock) MinimockDecodeInspect() {
+	for _, e := range m.DecodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PageTokenCodecMock.Decode with params: %#v", *e.params)
+		}
+	}
+
+	afterDecodeCounter := mm_atomic.LoadUint64(&m.afterDecodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DecodeMock.defaultExpectation != nil && afterDecodeCounter < 1 {
+		if m.DecodeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PageTokenCodecMock.Decode")
+		} else {
+			m.t.Errorf("Expected call to PageTokenCodecMock.Decode with params: %#v", *m.DecodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDecode != nil && afterDecodeCounter < 1 {
+		m.t.Error("Expected call to PageTokenCodecMock.Decode")
+	}
+
+	if !m.DecodeMock.invocationsDone() && afterDecodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to PageTokenCodecMock.Decode but found %d calls",
+			mm_atomic.LoadUint64(&m.DecodeMock.expectedInvocations), afterDecodeCounter)
+	}
+}
+
+type mPageTokenCodecMockEncode struct {
+	optional           bool
+	mock               *PageTokenCodecMock
+	defaultExpectation *PageTokenCodecMockEncodeExpectation
+	expectations       []*PageTokenCodecMockEncodeExpectation
+
+	callArgs []*PageTokenCodecMockEncodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PageTokenCodecMockEncodeExpectation specifies expectation struct of the PageTokenCodec.Encode
+type PageTokenCodecMockEncodeExpectation struct {
+	mock      *PageTokenCodecMock
+	params    *PageTokenCodecMockEncodeParams
+	paramPtrs *PageTokenCodecMockEncodeParamPtrs
+	results   *PageTokenCodecMockEncodeResults
+	Counter   uint64
+}
+
+// PageTokenCodecMockEncodeParams contains parameters of the PageTokenCodec.Encode
+type PageTokenCodecMockEncodeParams struct {
+	cursor interface{}
+	scope  string
+}
+
+// PageTokenCodecMockEncodeParamPtrs contains pointers to parameters of the PageTokenCodec.Encode
+type PageTokenCodecMockEncodeParamPtrs struct {
+	cursor *interface{}
+	scope  *string
+}
+
+// PageTokenCodecMockEncodeResults contains results of the PageTokenCodec.Encode
+type PageTokenCodecMockEncodeResults struct {
+	token string
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEncode *mPageTokenCodecMockEncode) Optional() *mPageTokenCodecMockEncode {
+	mmEncode.optional = true
+	return mmEncode
+}
+
+// Expect sets up expected params for PageTokenCodec.Encode
+func (mmEncode *mPageTokenCodecMockEncode) Expect(cursor interface{}, scope string) *mPageTokenCodecMockEncode {
+	if mmEncode.mock.funcEncode != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Set")
+	}
+
+	if mmEncode.defaultExpectation == nil {
+		mmEncode.defaultExpectation = &PageTokenCodecMockEncodeExpectation{}
+	}
+
+	if mmEncode.defaultExpectation.paramPtrs != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by ExpectParams functions")
+	}
+
+	mmEncode.defaultExpectation.params = &PageTokenCodecMockEncodeParams{cursor, scope}
+	for _, e := range mmEncode.expectations {
+		if minimock.Equal(e.params, mmEncode.defaultExpectation.params) {
+			mmEncode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEncode.defaultExpectation.params)
+		}
+	}
+
+	return mmEncode
+}
+
+// ExpectCursorParam1 sets up expected param cursor for PageTokenCodec.Encode
+func (mmEncode *mPageTokenCodecMockEncode) ExpectCursorParam1(cursor interface{}) *mPageTokenCodecMockEncode {
+	if mmEncode.mock.funcEncode != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Set")
+	}
+
+	if mmEncode.defaultExpectation == nil {
+		mmEncode.defaultExpectation = &PageTokenCodecMockEncodeExpectation{}
+	}
+
+	if mmEncode.defaultExpectation.params != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Expect")
+	}
+
+	if mmEncode.defaultExpectation.paramPtrs == nil {
+		mmEncode.defaultExpectation.paramPtrs = &PageTokenCodecMockEncodeParamPtrs{}
+	}
+	mmEncode.defaultExpectation.paramPtrs.cursor = &cursor
+
+	return mmEncode
+}
+
+// ExpectScopeParam2 sets up expected param scope for PageTokenCodec.Encode
+func (mmEncode *mPageTokenCodecMockEncode) ExpectScopeParam2(scope string) *mPageTokenCodecMockEncode {
+	if mmEncode.mock.funcEncode != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Set")
+	}
+
+	if mmEncode.defaultExpectation == nil {
+		mmEncode.defaultExpectation = &PageTokenCodecMockEncodeExpectation{}
+	}
+
+	if mmEncode.defaultExpectation.params != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Expect")
+	}
+
+	if mmEncode.defaultExpectation.paramPtrs == nil {
+		mmEncode.defaultExpectation.paramPtrs = &PageTokenCodecMockEncodeParamPtrs{}
+	}
+	mmEncode.defaultExpectation.paramPtrs.scope = &scope
+
+	return mmEncode
+}
+
+// Inspect accepts an inspector function that has same arguments as the PageTokenCodec.Encode
+func (mmEncode *mPageTokenCodecMockEncode) Inspect(f func(cursor interface{}, scope string)) *mPageTokenCodecMockEncode {
+	if mmEncode.mock.inspectFuncEncode != nil {
+		mmEncode.mock.t.Fatalf("Inspect function is already set for PageTokenCodecMock.Encode")
+	}
+
+	mmEncode.mock.inspectFuncEncode = f
+
+	return mmEncode
+}
+
+// Return sets up results that will be returned by PageTokenCodec.Encode
+func (mmEncode *mPageTokenCodecMockEncode) Return(token string, err error) *PageTokenCodecMock {
+	if mmEncode.mock.funcEncode != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Set")
+	}
+
+	if mmEncode.defaultExpectation == nil {
+		mmEncode.defaultExpectation = &PageTokenCodecMockEncodeExpectation{mock: mmEncode.mock}
+	}
+	mmEncode.defaultExpectation.results = &PageTokenCodecMockEncodeResults{token, err}
+	return mmEncode.mock
+}
+
+// Set uses given function f to mock the PageTokenCodec.Encode method
+func (mmEncode *mPageTokenCodecMockEncode) Set(f func(cursor interface{}, scope string) (token string, err error)) *PageTokenCodecMock {
+	if mmEncode.defaultExpectation != nil {
+		mmEncode.mock.t.Fatalf("Default expectation is already set for the PageTokenCodec.Encode method")
+	}
+
+	if len(mmEncode.expectations) > 0 {
+		mmEncode.mock.t.Fatalf("Some expectations are already set for the PageTokenCodec.Encode method")
+	}
+
+	mmEncode.mock.funcEncode = f
+	return mmEncode.mock
+}
+
+// When sets expectation for the PageTokenCodec.Encode which will trigger the result defined by the following
+// Then helper
+func (mmEncode *mPageTokenCodecMockEncode) When(cursor interface{}, scope string) *PageTokenCodecMockEncodeExpectation {
+	if mmEncode.mock.funcEncode != nil {
+		mmEncode.mock.t.Fatalf("PageTokenCodecMock.Encode mock is already set by Set")
+	}
+
+	expectation := &PageTokenCodecMockEncodeExpectation{
+		mock:   mmEncode.mock,
+		params: &PageTokenCodecMockEncodeParams{cursor, scope},
+	}
+	mmEncode.expectations = append(mmEncode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PageTokenCodec.Encode return parameters for the expectation previously defined by the When method
+func (e *PageTokenCodecMockEncodeExpectation) Then(token string, err error) *PageTokenCodecMock {
+	e.results = &PageTokenCodecMockEncodeResults{token, err}
+	return e.mock
+}
+
+// Times sets number of times PageTokenCodec.Encode should be invoked
+func (mmEncode *mPageTokenCodecMockEncode) Times(n uint64) *mPageTokenCodecMockEncode {
+	if n == 0 {
+		mmEncode.mock.t.Fatalf("Times of PageTokenCodecMock.Encode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEncode.expectedInvocations, n)
+	return mmEncode
+}
+
+func (mmEncode *mPageTokenCodecMockEncode) invocationsDone() bool {
+	if len(mmEncode.expectations) == 0 && mmEncode.defaultExpectation == nil && mmEncode.mock.funcEncode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEncode.mock.afterEncodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEncode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Encode implements pagination.PageTokenCodec
+func (mmEncode *PageTokenCodecMock) Encode(cursor interface{}, scope string) (token string, err error) {
+	mm_atomic.AddUint64(&mmEncode.beforeEncodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmEncode.afterEncodeCounter, 1)
+
+	if mmEncode.inspectFuncEncode != nil {
+		mmEncode.inspectFuncEncode(cursor, scope)
+	}
+
+	mm_params := PageTokenCodecMockEncodeParams{cursor, scope}
+
+	// Record call args
+	mmEncode.EncodeMock.mutex.Lock()
+	mmEncode.EncodeMock.callArgs = append(mmEncode.EncodeMock.callArgs, &mm_params)
+	mmEncode.EncodeMock.mutex.Unlock()
+
+	for _, e := range mmEncode.EncodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.token, e.results.err
+		}
+	}
+
+	if mmEncode.EncodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEncode.EncodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmEncode.EncodeMock.defaultExpectation.params
+		mm_want_ptrs := mmEncode.EncodeMock.defaultExpectation.paramPtrs
+
+		mm_got := PageTokenCodecMockEncodeParams{cursor, scope}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.cursor != nil && !minimock.Equal(*mm_want_ptrs.cursor, mm_got.cursor) {
+				mmEncode.t.Errorf("PageTokenCodecMock.Encode got unexpected parameter cursor, want: %#v, got: %#v%s\n", *mm_want_ptrs.cursor, mm_got.cursor, minimock.Diff(*mm_want_ptrs.cursor, mm_got.cursor))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmEncode.t.Errorf("PageTokenCodecMock.Encode got unexpected parameter scope, want: %#v, got: %#v%s\n", *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEncode.t.Errorf("PageTokenCodecMock.Encode got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEncode.EncodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEncode.t.Fatal("No results are set for the PageTokenCodecMock.Encode")
+		}
+		return (*mm_results).token, (*mm_results).err
+	}
+	if mmEncode.funcEncode != nil {
+		return mmEncode.funcEncode(cursor, scope)
+	}
+	mmEncode.t.Fatalf("Unexpected call to PageTokenCodecMock.Encode. %v %v", cursor, scope)
+	return
+}
+
+// EncodeAfterCounter returns a count of finished PageTokenCodecMock.Encode invocations
+func (mmEncode *PageTokenCodecMock) EncodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEncode.afterEncodeCounter)
+}
+
+// EncodeBeforeCounter returns a count of PageTokenCodecMock.Encode invocations
+func (mmEncode *PageTokenCodecMock) EncodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEncode.beforeEncodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to PageTokenCodecMock.Encode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEncode *mPageTokenCodecMockEncode) Calls() []*PageTokenCodecMockEncodeParams {
+	mmEncode.mutex.RLock()
+
+	argCopy := make([]*PageTokenCodecMockEncodeParams, len(mmEncode.callArgs))
+	copy(argCopy, mmEncode.callArgs)
+
+	mmEncode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEncodeDone returns true if the count of the Encode invocations corresponds
+// the number of defined expectations
+func (m *PageTokenCodecMock) MinimockEncodeDone() bool {
+	if m.EncodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EncodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EncodeMock.invocationsDone()
+}
+
+// MinimockEncodeInspect logs each unmet expectation
+func (m *PageTokenCodecMock) MinimockEncodeInspect() {
+	for _, e := range m.EncodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PageTokenCodecMock.Encode with params: %#v", *e.params)
+		}
+	}
+
+	afterEncodeCounter := mm_atomic.LoadUint64(&m.afterEncodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EncodeMock.defaultExpectation != nil && afterEncodeCounter < 1 {
+		if m.EncodeMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PageTokenCodecMock.Encode")
+		} else {
+			m.t.Errorf("Expected call to PageTokenCodecMock.Encode with params: %#v", *m.EncodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEncode != nil && afterEncodeCounter < 1 {
+		m.t.Error("Expected call to PageTokenCodecMock.Encode")
+	}
+
+	if !m.EncodeMock.invocationsDone() && afterEncodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to PageTokenCodecMock.Encode but found %d calls",
+			mm_atomic.LoadUint64(&m.EncodeMock.expectedInvocations), afterEncodeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PageTokenCodecMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDecodeInspect()
+
+			m.MinimockEncodeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PageTokenCodecMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PageTokenCodecMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDecodeDone() &&
+		m.MinimockEncodeDone()
+}
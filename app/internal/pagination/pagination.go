@@ -0,0 +1,11 @@
+package pagination
+
+// PageTokenCodec encodes keyset cursors into opaque page tokens and decodes them back.
+// A token is bound to a scope, e.g. the filters and sort order of the listing,
+// so a token issued for one query cannot be replayed against another.
+type PageTokenCodec interface {
+	// Encode serializes the cursor into a signed page token.
+	Encode(cursor interface{}, scope string) (token string, err error)
+	// Decode verifies the page token and deserializes it into the cursor.
+	Decode(token string, scope string, cursor interface{}) (err error)
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/pagination"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	t.Parallel()
+
+	var (
+		codec  = pagination.NewPageTokenCodec(gofakeit.UUID())
+		scope  = "users:role=1"
+		cursor = model.UserCursor{
+			CreatedAt: time.Date(2024, 9, 8, 10, 0, 0, 123000, time.UTC),
+			UserID:    gofakeit.Int64(),
+		}
+	)
+
+	token, err := codec.Encode(cursor, scope)
+	require.NoError(t, err)
+
+	payload, signature, ok := strings.Cut(token, ".")
+	require.True(t, ok)
+
+	tests := []struct {
+		name  string
+		token string
+		scope string
+		codec pagination.PageTokenCodec
+		want  model.UserCursor
+		err   error
+	}{
+		{
+			name:  "success case",
+			token: token,
+			scope: scope,
+			codec: codec,
+			want:  cursor,
+		},
+		{
+			name:  "another scope",
+			token: token,
+			scope: "users:role=2",
+			codec: codec,
+			err:   model.ErrInvalidPageToken,
+		},
+		{
+			name:  "another secret key",
+			token: token,
+			scope: scope,
+			codec: pagination.NewPageTokenCodec(gofakeit.UUID()),
+			err:   model.ErrInvalidPageToken,
+		},
+		{
+			name:  "tampered payload",
+			token: "eyJpZCI6MX0." + signature,
+			scope: scope,
+			codec: codec,
+			err:   model.ErrInvalidPageToken,
+		},
+		{
+			name:  "missing signature",
+			token: payload,
+			scope: scope,
+			codec: codec,
+			err:   model.ErrInvalidPageToken,
+		},
+		{
+			name:  "malformed token",
+			token: "!!!.???",
+			scope: scope,
+			codec: codec,
+			err:   model.ErrInvalidPageToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got model.UserCursor
+
+			err := tt.codec.Decode(tt.token, tt.scope, &got)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
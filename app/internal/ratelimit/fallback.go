@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+)
+
+type fallbackLimiter struct {
+	primary       Limiter
+	fallback      Limiter
+	retryInterval time.Duration
+	failing       atomic.Bool
+	// retryAt is the time in Unix nanoseconds until which the primary limiter is not tried after it failed.
+	retryAt atomic.Int64
+}
+
+// NewFallbackLimiter creates a limiter that takes the tokens from the primary limiter and, while it fails,
+// from the fallback one. A call is never rejected because a limiter is unavailable.
+// After a failure the primary limiter is left alone for the retry interval, so that the calls
+// do not wait for it to time out one after another.
+func NewFallbackLimiter(primary, fallback Limiter, retryInterval time.Duration) Limiter {
+	return &fallbackLimiter{
+		primary:       primary,
+		fallback:      fallback,
+		retryInterval: retryInterval,
+	}
+}
+
+func (l *fallbackLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now()
+	if now.UnixNano() < l.retryAt.Load() {
+		return l.fallback.Allow(ctx, key, limit)
+	}
+
+	result, err := l.primary.Allow(ctx, key, limit)
+	if err == nil {
+		if l.failing.CompareAndSwap(true, false) {
+			log.Infof("Rate limiter recovered")
+		}
+
+		return result, nil
+	}
+
+	l.retryAt.Store(now.Add(l.retryInterval).UnixNano())
+
+	// Logged once per outage rather than once per call.
+	if l.failing.CompareAndSwap(false, true) {
+		log.Warnf("Rate limiter failed, falling back to local buckets, err: %v", err)
+	}
+
+	return l.fallback.Allow(ctx, key, limit)
+}
@@ -0,0 +1,31 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit is a token bucket: it holds up to Burst tokens and refills with Rate tokens per second.
+// Every call takes a token, a call that finds the bucket empty is rejected.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Result is the decision on a call.
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long a rejected caller has to wait for the next token.
+	RetryAfter time.Duration
+}
+
+// Limiter takes tokens from the buckets.
+type Limiter interface {
+	// Allow takes a token from the bucket with the given key, if there is one.
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// retryAfter returns the time the bucket needs to refill the missing part of a token.
+func retryAfter(tokens float64, limit Limit) time.Duration {
+	return time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+}
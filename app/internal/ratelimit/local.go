@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepEvery is the number of calls between two sweeps of the buckets that refilled.
+const sweepEvery = 1024
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	// refillTime is the time the bucket takes to refill from empty.
+	refillTime time.Duration
+}
+
+type localLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	calls   int
+}
+
+// NewLocalLimiter creates a limiter that keeps the buckets in memory, so the limits only hold per replica.
+func NewLocalLimiter() Limiter {
+	return &localLimiter{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+func (l *localLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	l.calls++
+	if l.calls%sweepEvery == 0 {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{
+			tokens:     float64(limit.Burst),
+			updated:    now,
+			refillTime: time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second)),
+		}
+		l.buckets[key] = b
+	}
+
+	b.tokens = refill(b.tokens, now.Sub(b.updated), limit)
+	b.updated = now
+
+	if b.tokens < 1 {
+		return Result{RetryAfter: retryAfter(b.tokens, limit)}, nil
+	}
+
+	b.tokens--
+
+	return Result{Allowed: true}, nil
+}
+
+// sweep forgets the buckets that have had the time to refill, which is the state of a bucket never used.
+func (l *localLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if now.Sub(b.updated) > b.refillTime {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func refill(tokens float64, elapsed time.Duration, limit Limit) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Min(float64(limit.Burst), tokens+elapsed.Seconds()*limit.Rate)
+}
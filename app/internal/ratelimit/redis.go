@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/tracing"
+)
+
+const keyPrefix = "rate_limit:"
+
+type redisLimiter struct {
+	pool *redigo.Pool
+}
+
+// NewRedisLimiter creates a limiter that keeps the buckets in Redis, shared by the replicas.
+func NewRedisLimiter(pool *redigo.Pool) Limiter {
+	return &redisLimiter{pool: pool}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string, limit Limit) (_ Result, err error) {
+	ctx, span := tracing.StartRedisSpan(ctx, "EVALSHA")
+	defer func() { tracing.EndRedisSpan(span, err) }()
+
+	conn, err := l.pool.GetContext(ctx)
+	if err != nil {
+		return Result{}, errors.Wrap(err, "Cannot get redis connection")
+	}
+	defer conn.Close()
+
+	reply, err := redigo.Int64s(takeTokenScript.DoContext(
+		ctx,
+		conn,
+		keyPrefix+key,
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64),
+		limit.Burst,
+	))
+	if err != nil {
+		return Result{}, errors.Wrapf(err, "Cannot take token(key: %s)", key)
+	}
+
+	if len(reply) != 2 {
+		return Result{}, errors.Errorf("Unexpected reply of the token script: %v", reply)
+	}
+
+	return Result{
+		Allowed:    reply[0] == 1,
+		RetryAfter: time.Duration(reply[1]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package ratelimit
+
+import redigo "github.com/gomodule/redigo/redis"
+
+// takeTokenScript takes a token from the bucket, refilled for the time elapsed since its last call,
+// on the clock of Redis so that the replicas agree on it. It returns 1 and 0 if the call is allowed,
+// 0 and the milliseconds until the next token otherwise. The bucket expires once it has refilled.
+//
+// KEYS[1] - bucket key.
+// ARGV[1] - rate in tokens per second, ARGV[2] - burst.
+var takeTokenScript = redigo.NewScript(1, `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(bucket[1])
+local updated = tonumber(bucket[2])
+if tokens == nil or updated == nil then
+	tokens = burst
+	updated = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated) / 1000 * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000))
+
+return {allowed, retry}
+`)
@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/ratelimit"
+)
+
+func newPool(t *testing.T, addr string) *redigo.Pool {
+	pool := &redigo.Pool{
+		DialContext: func(ctx context.Context) (redigo.Conn, error) {
+			return redigo.DialContext(ctx, "tcp", addr)
+		},
+	}
+	t.Cleanup(func() {
+		require.NoError(t, pool.Close())
+	})
+
+	return pool
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiters := []struct {
+		name    string
+		limiter func(t *testing.T) ratelimit.Limiter
+	}{
+		{
+			name: "local",
+			limiter: func(_ *testing.T) ratelimit.Limiter {
+				return ratelimit.NewLocalLimiter()
+			},
+		},
+		{
+			name: "redis",
+			limiter: func(t *testing.T) ratelimit.Limiter {
+				return ratelimit.NewRedisLimiter(newPool(t, miniredis.RunT(t).Addr()))
+			},
+		},
+	}
+
+	var (
+		ctx   = context.Background()
+		limit = ratelimit.Limit{Rate: 1.0 / 60, Burst: 3}
+	)
+
+	for _, l := range limiters {
+		t.Run(l.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := l.limiter(t)
+
+			for i := 0; i < limit.Burst; i++ {
+				result, err := limiter.Allow(ctx, "login:ip:10.0.0.7", limit)
+				require.NoError(t, err)
+				require.True(t, result.Allowed, "call %d", i)
+			}
+
+			result, err := limiter.Allow(ctx, "login:ip:10.0.0.7", limit)
+			require.NoError(t, err)
+			require.False(t, result.Allowed)
+			require.InDelta(t, time.Minute.Seconds(), result.RetryAfter.Seconds(), 1)
+
+			result, err = limiter.Allow(ctx, "login:ip:10.0.0.8", limit)
+			require.NoError(t, err)
+			require.True(t, result.Allowed, "the buckets of other keys are not affected")
+		})
+	}
+}
+
+func TestLimiterRefill(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx   = context.Background()
+		limit = ratelimit.Limit{Rate: 50, Burst: 1}
+	)
+
+	limiter := ratelimit.NewRedisLimiter(newPool(t, miniredis.RunT(t).Addr()))
+
+	result, err := limiter.Allow(ctx, "get:user:42", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	result, err = limiter.Allow(ctx, "get:user:42", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+
+	time.Sleep(result.RetryAfter + 10*time.Millisecond)
+
+	result, err = limiter.Allow(ctx, "get:user:42", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+}
+
+func TestFallbackLimiter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = context.Background()
+		limit  = ratelimit.Limit{Rate: 1.0 / 60, Burst: 1}
+		server = miniredis.RunT(t)
+	)
+
+	limiter := ratelimit.NewFallbackLimiter(
+		ratelimit.NewRedisLimiter(newPool(t, server.Addr())),
+		ratelimit.NewLocalLimiter(),
+		time.Hour,
+	)
+
+	result, err := limiter.Allow(ctx, "login:ip:10.0.0.7", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	server.Close()
+
+	// The local bucket is used while Redis is down, it is still full.
+	result, err = limiter.Allow(ctx, "login:ip:10.0.0.7", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	result, err = limiter.Allow(ctx, "login:ip:10.0.0.7", limit)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+
+	// Redis is not tried again until the retry interval has passed.
+	require.NoError(t, server.Restart())
+
+	result, err = limiter.Allow(ctx, "login:ip:10.0.0.8", limit)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	require.Len(t, server.Keys(), 1)
+}
@@ -0,0 +1,223 @@
+package redact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	fiberLog "github.com/gofiber/fiber/v2/log"
+)
+
+// callDepth is the number of frames between the caller of the package level fiber log functions
+// and the output of the logger.
+const callDepth = 4
+
+var levelPrefixes = map[fiberLog.Level]string{
+	fiberLog.LevelTrace: "[Trace] ",
+	fiberLog.LevelDebug: "[Debug] ",
+	fiberLog.LevelInfo:  "[Info] ",
+	fiberLog.LevelWarn:  "[Warn] ",
+	fiberLog.LevelError: "[Error] ",
+	fiberLog.LevelFatal: "[Fatal] ",
+	fiberLog.LevelPanic: "[Panic] ",
+}
+
+// Logger is a fiber logger that redacts every argument before formatting it, so that secrets reach
+// neither our logs nor the logs of the libraries that log through fiber. Its output matches
+// the default fiber logger.
+type Logger struct {
+	stdlog *log.Logger
+	level  fiberLog.Level
+	depth  int
+}
+
+var _ fiberLog.AllLogger = (*Logger)(nil)
+
+// NewLogger creates a new instance of Logger that writes to w.
+func NewLogger(w io.Writer) *Logger {
+	return &Logger{
+		stdlog: log.New(w, "", log.LstdFlags|log.Lshortfile|log.Lmicroseconds),
+		depth:  callDepth,
+	}
+}
+
+func (l *Logger) log(level fiberLog.Level, v []interface{}) {
+	if l.level > level {
+		return
+	}
+
+	_ = l.stdlog.Output(l.depth, levelPrefixes[level]+fmt.Sprint(redactArgs(v)...))
+	exitOnFatal(level)
+}
+
+func (l *Logger) logf(level fiberLog.Level, format string, v []interface{}) {
+	if l.level > level {
+		return
+	}
+
+	message := format
+	if len(v) > 0 {
+		message = fmt.Sprintf(format, redactArgs(v)...)
+	}
+
+	_ = l.stdlog.Output(l.depth, levelPrefixes[level]+message)
+	exitOnFatal(level)
+}
+
+func (l *Logger) logw(level fiberLog.Level, msg string, keysAndValues []interface{}) {
+	if l.level > level {
+		return
+	}
+
+	if len(keysAndValues)%2 == 1 {
+		keysAndValues = append(keysAndValues, "KEYVALS UNPAIRED")
+	}
+
+	keysAndValues = redactArgs(keysAndValues)
+
+	var b strings.Builder
+
+	b.WriteString(levelPrefixes[level])
+	b.WriteString(msg)
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i > 0 || msg != "" {
+			b.WriteString(" ")
+		}
+
+		fmt.Fprintf(&b, "%s=%v", keysAndValues[i], keysAndValues[i+1])
+	}
+
+	_ = l.stdlog.Output(l.depth, b.String())
+	exitOnFatal(level)
+}
+
+func exitOnFatal(level fiberLog.Level) {
+	if level == fiberLog.LevelFatal {
+		os.Exit(1)
+	}
+}
+
+// redactArgs redacts the values, the text of the strings and the messages of the errors to be formatted.
+func redactArgs(v []interface{}) []interface{} {
+	redacted := make([]interface{}, len(v))
+
+	for i, arg := range v {
+		switch typed := arg.(type) {
+		case string:
+			redacted[i] = Text(typed)
+		case []byte:
+			redacted[i] = Text(string(typed))
+		case error:
+			redacted[i] = redactedError{err: typed}
+		default:
+			redacted[i] = Value(arg)
+		}
+	}
+
+	return redacted
+}
+
+// redactedError formats the error with the verb it is logged with, such as %+v for a stack trace,
+// and masks the result.
+type redactedError struct {
+	err error
+}
+
+func (e redactedError) Error() string {
+	return Text(e.err.Error())
+}
+
+func (e redactedError) Format(s fmt.State, verb rune) {
+	format := "%" + string(verb)
+	if s.Flag('+') {
+		format = "%+" + string(verb)
+	}
+
+	_, _ = io.WriteString(s, Text(fmt.Sprintf(format, e.err)))
+}
+
+// Trace logs at the trace level.
+func (l *Logger) Trace(v ...interface{}) { l.log(fiberLog.LevelTrace, v) }
+
+// Debug logs at the debug level.
+func (l *Logger) Debug(v ...interface{}) { l.log(fiberLog.LevelDebug, v) }
+
+// Info logs at the info level.
+func (l *Logger) Info(v ...interface{}) { l.log(fiberLog.LevelInfo, v) }
+
+// Warn logs at the warn level.
+func (l *Logger) Warn(v ...interface{}) { l.log(fiberLog.LevelWarn, v) }
+
+// Error logs at the error level.
+func (l *Logger) Error(v ...interface{}) { l.log(fiberLog.LevelError, v) }
+
+// Fatal logs at the fatal level and exits.
+func (l *Logger) Fatal(v ...interface{}) { l.log(fiberLog.LevelFatal, v) }
+
+// Panic logs at the panic level.
+func (l *Logger) Panic(v ...interface{}) { l.log(fiberLog.LevelPanic, v) }
+
+// Tracef logs a formatted message at the trace level.
+func (l *Logger) Tracef(format string, v ...interface{}) { l.logf(fiberLog.LevelTrace, format, v) }
+
+// Debugf logs a formatted message at the debug level.
+func (l *Logger) Debugf(format string, v ...interface{}) { l.logf(fiberLog.LevelDebug, format, v) }
+
+// Infof logs a formatted message at the info level.
+func (l *Logger) Infof(format string, v ...interface{}) { l.logf(fiberLog.LevelInfo, format, v) }
+
+// Warnf logs a formatted message at the warn level.
+func (l *Logger) Warnf(format string, v ...interface{}) { l.logf(fiberLog.LevelWarn, format, v) }
+
+// Errorf logs a formatted message at the error level.
+func (l *Logger) Errorf(format string, v ...interface{}) { l.logf(fiberLog.LevelError, format, v) }
+
+// Fatalf logs a formatted message at the fatal level and exits.
+func (l *Logger) Fatalf(format string, v ...interface{}) { l.logf(fiberLog.LevelFatal, format, v) }
+
+// Panicf logs a formatted message at the panic level.
+func (l *Logger) Panicf(format string, v ...interface{}) { l.logf(fiberLog.LevelPanic, format, v) }
+
+// Tracew logs a message with key-value pairs at the trace level.
+func (l *Logger) Tracew(msg string, kv ...interface{}) { l.logw(fiberLog.LevelTrace, msg, kv) }
+
+// Debugw logs a message with key-value pairs at the debug level.
+func (l *Logger) Debugw(msg string, kv ...interface{}) { l.logw(fiberLog.LevelDebug, msg, kv) }
+
+// Infow logs a message with key-value pairs at the info level.
+func (l *Logger) Infow(msg string, kv ...interface{}) { l.logw(fiberLog.LevelInfo, msg, kv) }
+
+// Warnw logs a message with key-value pairs at the warn level.
+func (l *Logger) Warnw(msg string, kv ...interface{}) { l.logw(fiberLog.LevelWarn, msg, kv) }
+
+// Errorw logs a message with key-value pairs at the error level.
+func (l *Logger) Errorw(msg string, kv ...interface{}) { l.logw(fiberLog.LevelError, msg, kv) }
+
+// Fatalw logs a message with key-value pairs at the fatal level and exits.
+func (l *Logger) Fatalw(msg string, kv ...interface{}) { l.logw(fiberLog.LevelFatal, msg, kv) }
+
+// Panicw logs a message with key-value pairs at the panic level.
+func (l *Logger) Panicw(msg string, kv ...interface{}) { l.logw(fiberLog.LevelPanic, msg, kv) }
+
+// SetLevel sets the level below which messages are dropped.
+func (l *Logger) SetLevel(level fiberLog.Level) {
+	l.level = level
+}
+
+// SetOutput sets the writer of the logger.
+func (l *Logger) SetOutput(w io.Writer) {
+	l.stdlog.SetOutput(w)
+}
+
+// WithContext returns a logger for direct use by the caller, one frame closer to the output.
+func (l *Logger) WithContext(_ context.Context) fiberLog.CommonLogger {
+	return &Logger{
+		stdlog: l.stdlog,
+		level:  l.level,
+		depth:  l.depth - 1,
+	}
+}
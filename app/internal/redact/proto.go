@@ -0,0 +1,81 @@
+package redact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	redactpb "github.com/Prrromanssss/auth/pkg/redact_v1"
+)
+
+// redactMessage returns a copy of the message with the fields marked by the (redact_v1.sensitivity)
+// option masked. Strings keep the same masks as tagged struct fields, any other field is cleared.
+func redactMessage(m proto.Message) proto.Message {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return m
+	}
+
+	redacted := proto.Clone(m)
+	redactReflectMessage(redacted.ProtoReflect())
+
+	return redacted
+}
+
+func redactReflectMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch optionSensitivity(fd) {
+		case Secret:
+			maskProtoField(m, fd, v, Secret)
+		case PII:
+			if piiEnabled.Load() {
+				maskProtoField(m, fd, v, PII)
+			}
+		default:
+			redactNested(fd, v)
+		}
+
+		return true
+	})
+}
+
+func redactNested(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch {
+	case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			redactReflectMessage(list.Get(i).Message())
+		}
+	case fd.IsMap() && fd.MapValue().Kind() == protoreflect.MessageKind:
+		v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+			redactReflectMessage(value.Message())
+			return true
+		})
+	case !fd.IsList() && !fd.IsMap() && fd.Kind() == protoreflect.MessageKind:
+		redactReflectMessage(v.Message())
+	}
+}
+
+func maskProtoField(m protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value, sensitivity Sensitivity) {
+	if fd.Kind() != protoreflect.StringKind || fd.IsList() || fd.IsMap() {
+		m.Clear(fd)
+		return
+	}
+
+	m.Set(fd, protoreflect.ValueOfString(mask(v.String(), sensitivity)))
+}
+
+func optionSensitivity(fd protoreflect.FieldDescriptor) Sensitivity {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return None
+	}
+
+	switch proto.GetExtension(opts, redactpb.E_Sensitivity).(redactpb.Sensitivity) {
+	case redactpb.Sensitivity_SECRET:
+		return Secret
+	case redactpb.Sensitivity_PII:
+		return PII
+	default:
+		return None
+	}
+}
@@ -0,0 +1,250 @@
+package redact
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Mask replaces the value of a sensitive field.
+const Mask = "[REDACTED]"
+
+const (
+	tagName   = "redact"
+	tagSecret = "secret"
+	tagPII    = "pii"
+)
+
+// Sensitivity tells how a value must be masked.
+type Sensitivity uint8
+
+const (
+	// None means the value is not sensitive.
+	None Sensitivity = iota
+	// Secret values, such as passwords, password hashes and tokens, are always masked.
+	Secret
+	// PII values, such as emails, are masked only when personal data redaction is enabled.
+	PII
+)
+
+var (
+	piiEnabled atomic.Bool
+
+	protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+
+	// sensitiveTypes caches whether a type holds sensitive fields, so that values without them are not copied.
+	sensitiveTypes sync.Map
+)
+
+// SetPII enables or disables the masking of personal data. Secrets are masked regardless.
+func SetPII(enabled bool) {
+	piiEnabled.Store(enabled)
+}
+
+// Value returns a copy of v that is safe to log or record: the fields of structs tagged `redact:"secret"`
+// and the fields of proto messages with the SECRET sensitivity option are masked, as are the PII ones
+// when personal data redaction is enabled. Values without sensitive fields are returned as is.
+func Value(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	if !isSensitiveType(rv.Type()) {
+		return v
+	}
+
+	return redactValue(rv).Interface()
+}
+
+func redactValue(rv reflect.Value) reflect.Value {
+	if !isSensitiveType(rv.Type()) {
+		return rv
+	}
+
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return rv
+		}
+
+		if rv.Type().Implements(protoMessageType) {
+			return reflect.ValueOf(redactMessage(rv.Interface().(proto.Message)))
+		}
+
+		redacted := reflect.New(rv.Type().Elem())
+		redacted.Elem().Set(redactValue(rv.Elem()))
+
+		return redacted
+	case reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+
+		redacted := reflect.New(rv.Type()).Elem()
+		redacted.Set(redactValue(rv.Elem()))
+
+		return redacted
+	case reflect.Struct:
+		return redactStruct(rv)
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+
+		redacted := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			redacted.Index(i).Set(redactValue(rv.Index(i)))
+		}
+
+		return redacted
+	case reflect.Array:
+		redacted := reflect.New(rv.Type()).Elem()
+		for i := 0; i < rv.Len(); i++ {
+			redacted.Index(i).Set(redactValue(rv.Index(i)))
+		}
+
+		return redacted
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+
+		redacted := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		for iter := rv.MapRange(); iter.Next(); {
+			redacted.SetMapIndex(iter.Key(), redactValue(iter.Value()))
+		}
+
+		return redacted
+	default:
+		return rv
+	}
+}
+
+func redactStruct(rv reflect.Value) reflect.Value {
+	redacted := reflect.New(rv.Type()).Elem()
+	redacted.Set(rv)
+
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		switch fieldSensitivity(field) {
+		case Secret:
+			maskField(redacted.Field(i), Secret)
+		case PII:
+			if piiEnabled.Load() {
+				maskField(redacted.Field(i), PII)
+			}
+		default:
+			redacted.Field(i).Set(redactValue(rv.Field(i)))
+		}
+	}
+
+	return redacted
+}
+
+// maskField replaces a string with its mask and any other value with its zero value.
+func maskField(field reflect.Value, sensitivity Sensitivity) {
+	switch {
+	case field.Kind() == reflect.String:
+		field.SetString(mask(field.String(), sensitivity))
+	case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.String && !field.IsNil():
+		masked := reflect.New(field.Type().Elem())
+		masked.Elem().SetString(mask(field.Elem().String(), sensitivity))
+		field.Set(masked)
+	default:
+		field.Set(reflect.Zero(field.Type()))
+	}
+}
+
+// mask returns the masked form of a sensitive string. Emails keep their first letter and domain,
+// so that logs can still be correlated with a user.
+func mask(value string, sensitivity Sensitivity) string {
+	if value == "" {
+		return ""
+	}
+
+	if sensitivity == PII {
+		return maskEmail(value)
+	}
+
+	return Mask
+}
+
+func maskEmail(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return Mask
+	}
+
+	return email[:1] + "***" + email[at:]
+}
+
+func fieldSensitivity(field reflect.StructField) Sensitivity {
+	switch field.Tag.Get(tagName) {
+	case tagSecret:
+		return Secret
+	case tagPII:
+		return PII
+	default:
+		return None
+	}
+}
+
+// isSensitiveType reports whether values of the type may hold sensitive fields.
+func isSensitiveType(t reflect.Type) bool {
+	if cached, ok := sensitiveTypes.Load(t); ok {
+		return cached.(bool)
+	}
+
+	sensitive := inspectType(t, map[reflect.Type]struct{}{})
+	sensitiveTypes.Store(t, sensitive)
+
+	return sensitive
+}
+
+// inspectType walks the type; visiting holds the types being inspected, so that recursive types terminate.
+func inspectType(t reflect.Type, visiting map[reflect.Type]struct{}) bool {
+	if cached, ok := sensitiveTypes.Load(t); ok {
+		return cached.(bool)
+	}
+
+	if _, ok := visiting[t]; ok {
+		return false
+	}
+
+	visiting[t] = struct{}{}
+	defer delete(visiting, t)
+
+	if t.Implements(protoMessageType) {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return inspectType(t.Elem(), visiting)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			if fieldSensitivity(field) != None || inspectType(field.Type, visiting) {
+				return true
+			}
+		}
+
+		return false
+	default:
+		return false
+	}
+}
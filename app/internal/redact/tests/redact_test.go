@@ -0,0 +1,246 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	fiberLog "github.com/gofiber/fiber/v2/log"
+	pkgErrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
+	"github.com/Prrromanssss/auth/internal/repository/log/converter"
+	authPb "github.com/Prrromanssss/auth/pkg/auth_v1"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+// The tests of this package are not parallel, because personal data redaction is a process wide setting.
+
+func TestValue(t *testing.T) {
+	var (
+		name           = gofakeit.Name()
+		email          = "john.doe@example.com"
+		maskedEmail    = "j***@example.com"
+		password       = gofakeit.Password(true, true, true, true, false, 12)
+		hashedPassword = "$argon2id$v=19$m=65536,t=3,p=2$" + gofakeit.UUID()
+		accessToken    = gofakeit.UUID()
+		refreshToken   = gofakeit.UUID()
+	)
+
+	tests := []struct {
+		name  string
+		pii   bool
+		value interface{}
+		want  interface{}
+	}{
+		{
+			name: "tagged struct",
+			value: model.CreateUserParams{
+				Name:           name,
+				Email:          email,
+				HashedPassword: hashedPassword,
+			},
+			want: model.CreateUserParams{
+				Name:           name,
+				Email:          email,
+				HashedPassword: redact.Mask,
+			},
+		},
+		{
+			name: "tagged struct with personal data redaction",
+			pii:  true,
+			value: &model.LoginParams{
+				Email:    email,
+				Password: password,
+			},
+			want: &model.LoginParams{
+				Email:    maskedEmail,
+				Password: redact.Mask,
+			},
+		},
+		{
+			name: "embedded struct",
+			pii:  true,
+			value: model.GetUserByEmailResponse{
+				User:           model.User{Name: name, Email: email},
+				HashedPassword: hashedPassword,
+			},
+			want: model.GetUserByEmailResponse{
+				User:           model.User{Name: name, Email: maskedEmail},
+				HashedPassword: redact.Mask,
+			},
+		},
+		{
+			name: "struct in interface and slice",
+			value: model.CreateAPILogParams{
+				Method: "Create",
+				RequestData: []interface{}{
+					model.CreateUserParams{Name: name, HashedPassword: hashedPassword},
+				},
+			},
+			want: model.CreateAPILogParams{
+				Method: "Create",
+				RequestData: []interface{}{
+					model.CreateUserParams{Name: name, HashedPassword: redact.Mask},
+				},
+			},
+		},
+		{
+			name: "proto message",
+			value: &pb.CreateRequest{
+				Name:            name,
+				Email:           email,
+				Password:        password,
+				PasswordConfirm: password,
+				Role:            pb.Role_USER,
+			},
+			want: &pb.CreateRequest{
+				Name:            name,
+				Email:           email,
+				Password:        redact.Mask,
+				PasswordConfirm: redact.Mask,
+				Role:            pb.Role_USER,
+			},
+		},
+		{
+			name: "nested proto message with personal data redaction",
+			pii:  true,
+			value: &pb.ListUsersResponse{
+				Users: []*pb.User{{Name: name, Email: email}},
+			},
+			want: &pb.ListUsersResponse{
+				Users: []*pb.User{{Name: name, Email: maskedEmail}},
+			},
+		},
+		{
+			name: "tokens",
+			value: &authPb.LoginResponse{
+				AccessToken:  accessToken,
+				RefreshToken: refreshToken,
+			},
+			want: &authPb.LoginResponse{
+				AccessToken:  redact.Mask,
+				RefreshToken: redact.Mask,
+			},
+		},
+		{
+			name:  "value without sensitive fields",
+			value: model.GetUserParams{UserID: 42},
+			want:  model.GetUserParams{UserID: 42},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redact.SetPII(tt.pii)
+			defer redact.SetPII(false)
+
+			original := fmt.Sprintf("%+v", tt.value)
+
+			got := redact.Value(tt.value)
+			if want, ok := tt.want.(proto.Message); ok {
+				require.True(t, proto.Equal(want, got.(proto.Message)))
+			} else {
+				require.Equal(t, tt.want, got)
+			}
+
+			require.Equal(t, original, fmt.Sprintf("%+v", tt.value), "the value must not be modified")
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	redact.RegisterJSON(model.CreateUserKafkaParams{})
+
+	var (
+		email    = "john.doe@example.com"
+		password = gofakeit.Password(true, true, true, true, false, 12)
+		payload  = fmt.Sprintf(`{"name":"John","email":%q,"password":%q,"role":1}`, email, password)
+	)
+
+	t.Run("secrets", func(t *testing.T) {
+		got := redact.Text(payload)
+		require.Equal(t, fmt.Sprintf(`{"name":"John","email":%q,"password":"[REDACTED]","role":1}`, email), got)
+	})
+
+	t.Run("secrets and personal data", func(t *testing.T) {
+		redact.SetPII(true)
+		defer redact.SetPII(false)
+
+		got := redact.Text(payload + " sent by " + email)
+		require.Equal(t, `{"name":"John","email":"j***@example.com","password":"[REDACTED]","role":1} sent by j***@example.com`, got)
+	})
+}
+
+func TestLogger(t *testing.T) {
+	redact.RegisterJSON(model.CreateUserKafkaParams{})
+	redact.SetPII(true)
+	defer redact.SetPII(false)
+
+	var (
+		buf            bytes.Buffer
+		email          = "john.doe@example.com"
+		password       = gofakeit.Password(true, true, true, true, false, 12)
+		hashedPassword = "$argon2id$v=19$m=65536,t=3,p=2$" + gofakeit.UUID()
+		refreshToken   = gofakeit.UUID()
+	)
+
+	logger := redact.NewLogger(&buf)
+
+	logger.Infof("rpc Create, request: %+v", &pb.CreateRequest{Email: email, Password: password, PasswordConfirm: password})
+	logger.Infof("userService.CreateUser, params: %+v", model.CreateUserParams{Email: email, HashedPassword: hashedPassword})
+	logger.Infof("message claimed: value = %s", fmt.Sprintf(`{"email":%q,"password":%q}`, email, password))
+	logger.Errorf("Internal error, err: %+v", pkgErrors.Wrapf(pkgErrors.New("user not saved"), "email %s", email))
+	logger.Infow("token rotated", "params", model.RefreshTokenParams{RefreshToken: refreshToken})
+	logger.WithContext(context.Background()).Warn("login failed for ", email)
+
+	out := buf.String()
+	for _, secret := range []string{email, password, hashedPassword, refreshToken} {
+		require.NotContains(t, out, secret)
+	}
+
+	require.Contains(t, out, "[Info] rpc Create")
+	require.Contains(t, out, "j***@example.com")
+	require.Contains(t, out, redact.Mask)
+	require.Contains(t, out, "redact_test.go")
+}
+
+func TestLoggerLevel(t *testing.T) {
+	var buf bytes.Buffer
+
+	logger := redact.NewLogger(&buf)
+	logger.SetLevel(fiberLog.LevelWarn)
+
+	logger.Info("dropped")
+	logger.Warnf("kept %d", 1)
+
+	require.NotContains(t, buf.String(), "dropped")
+	require.Contains(t, buf.String(), "[Warn] kept 1")
+}
+
+func TestAuditRequestData(t *testing.T) {
+	var (
+		email          = "john.doe@example.com"
+		hashedPassword = "$argon2id$v=19$m=65536,t=3,p=2$" + gofakeit.UUID()
+	)
+
+	params, err := converter.ConvertCreateAPILogParamsFromServiceToRepo(model.CreateAPILogParams{
+		Method:       "Create",
+		RequestData:  model.CreateUserParams{Email: email, HashedPassword: hashedPassword},
+		ResponseData: model.CreateUserResponse{User: model.User{UserID: 1, Email: email}},
+	}, nil)
+	require.NoError(t, err)
+
+	require.NotContains(t, params.RequestData, hashedPassword)
+	require.Contains(t, params.RequestData, email)
+
+	var requestData model.CreateUserParams
+	require.NoError(t, json.Unmarshal([]byte(params.RequestData), &requestData))
+	require.Equal(t, redact.Mask, requestData.HashedPassword)
+}
@@ -0,0 +1,97 @@
+package redact
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+	jsonMu         sync.RWMutex
+	jsonKeys       = map[string]Sensitivity{}
+	jsonKeyPattern *regexp.Regexp
+)
+
+// RegisterJSON registers the JSON payloads that may appear in free text, such as Kafka messages
+// logged by the consumer. The JSON keys of their fields tagged with `redact` are masked by Text.
+func RegisterJSON(samples ...interface{}) {
+	jsonMu.Lock()
+	defer jsonMu.Unlock()
+
+	for _, sample := range samples {
+		collectJSONKeys(reflect.TypeOf(sample))
+	}
+
+	keys := make([]string, 0, len(jsonKeys))
+	for key := range jsonKeys {
+		keys = append(keys, regexp.QuoteMeta(key))
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	jsonKeyPattern = regexp.MustCompile(`"(` + strings.Join(keys, "|") + `)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+}
+
+func collectJSONKeys(t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		sensitivity := fieldSensitivity(field)
+		if sensitivity == None {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			name = field.Name
+		}
+
+		jsonKeys[name] = sensitivity
+	}
+}
+
+// Text masks the sensitive values found in free text, such as error messages and raw payloads:
+// the values of registered JSON keys and, when personal data redaction is enabled, any email.
+func Text(s string) string {
+	jsonMu.RLock()
+	pattern := jsonKeyPattern
+	jsonMu.RUnlock()
+
+	if pattern != nil {
+		s = pattern.ReplaceAllStringFunc(s, func(match string) string {
+			groups := pattern.FindStringSubmatch(match)
+			key, separator := groups[1], groups[2]
+
+			jsonMu.RLock()
+			sensitivity := jsonKeys[key]
+			jsonMu.RUnlock()
+
+			if sensitivity == PII && !piiEnabled.Load() {
+				return match
+			}
+
+			value := strings.TrimPrefix(match, `"`+key+`"`+separator)
+
+			return `"` + key + `"` + separator + `"` + mask(strings.Trim(value, `"`), sensitivity) + `"`
+		})
+	}
+
+	if piiEnabled.Load() {
+		s = emailPattern.ReplaceAllStringFunc(s, maskEmail)
+	}
+
+	return s
+}
@@ -0,0 +1,11 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/auth/internal/model"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/access/model"
+)
+
+// ConvertCheckAccessParamsFromServiceToRepo converts CheckAccessParams from the service layer to the repository layer.
+func ConvertCheckAccessParamsFromServiceToRepo(params model.CheckAccessParams) modelRepo.CheckAccessParams {
+	return modelRepo.CheckAccessParams(params)
+}
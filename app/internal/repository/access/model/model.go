@@ -0,0 +1,7 @@
+package model
+
+// CheckAccessParams holds the parameters for evaluating whether a role may call an endpoint.
+type CheckAccessParams struct {
+	EndpointAddress string `db:"endpoint_address"`
+	Role            int64  `db:"role_id"`
+}
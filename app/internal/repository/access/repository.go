@@ -0,0 +1,49 @@
+package access
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/repository/access/converter"
+)
+
+type accessPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of accessPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.AccessRepository {
+	return &accessPGRepo{db: db}
+}
+
+// CheckAccess looks up an access rule that allows the role to call the endpoint.
+func (p *accessPGRepo) CheckAccess(
+	ctx context.Context,
+	params model.CheckAccessParams,
+) (allowed bool, err error) {
+	log.Infof("accessPGRepo.CheckAccess, params: %+v", params)
+
+	paramsRepo := converter.ConvertCheckAccessParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "accessPGRepo.CheckAccess",
+		QueryRaw: queryCheckAccess,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &allowed, q, paramsRepo.EndpointAddress, paramsRepo.Role)
+	if err != nil {
+		return false, errors.Wrapf(
+			err,
+			"Cannot check access(endpoint: %s, role: %d)",
+			paramsRepo.EndpointAddress,
+			paramsRepo.Role,
+		)
+	}
+
+	return allowed, nil
+}
@@ -0,0 +1,11 @@
+package access
+
+const (
+	queryCheckAccess = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM users.access_rule
+			WHERE endpoint_address = $1 AND role_id = $2
+		);
+	`
+)
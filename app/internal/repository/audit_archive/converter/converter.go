@@ -0,0 +1,82 @@
+package converter
+
+import (
+	"database/sql"
+	"regexp"
+	"time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/audit_archive/model"
+)
+
+const partitionMonthLayout = "2006_01"
+
+// partitionNamePattern matches the names of the monthly partitions, the default partition does not match.
+var partitionNamePattern = regexp.MustCompile(`^api_user_log_(\d{4}_\d{2})$`)
+
+// PartitionName returns the name of the partition of the audit log that holds the month of the given time.
+func PartitionName(month time.Time) string {
+	return "api_user_log_" + month.UTC().Format(partitionMonthLayout)
+}
+
+// IsPartitionName reports whether the name is the name of a monthly partition of the audit log.
+func IsPartitionName(name string) bool {
+	return partitionNamePattern.MatchString(name)
+}
+
+// ConvertAuditPartitionsFromRepoToService converts the partitions of the audit log from the repository layer
+// to the service layer. Only the monthly partitions are kept, their range is taken from their name.
+func ConvertAuditPartitionsFromRepoToService(params []modelRepo.AuditPartition) []model.AuditPartition {
+	partitions := make([]model.AuditPartition, 0, len(params))
+	for _, partition := range params {
+		match := partitionNamePattern.FindStringSubmatch(partition.Name)
+		if match == nil {
+			continue
+		}
+
+		from, err := time.Parse(partitionMonthLayout, match[1])
+		if err != nil {
+			continue
+		}
+
+		partitions = append(partitions, model.AuditPartition{
+			Name: partition.Name,
+			From: from,
+			To:   from.AddDate(0, 1, 0),
+		})
+	}
+
+	return partitions
+}
+
+// ConvertCreateAuditArchiveParamsFromServiceToRepo converts an archived partition from the service layer
+// to the repository layer.
+func ConvertCreateAuditArchiveParamsFromServiceToRepo(params model.AuditArchive) modelRepo.CreateAuditArchiveParams {
+	paramsRepo := modelRepo.CreateAuditArchiveParams{
+		PartitionName: params.Partition.Name,
+		RangeFrom:     params.Partition.From,
+		RangeTo:       params.Partition.To,
+		FileName:      params.FileName,
+		Records:       params.Records,
+	}
+
+	if params.MaxEventID != nil {
+		paramsRepo.MaxEventID = sql.NullInt64{Int64: *params.MaxEventID, Valid: true}
+	}
+
+	return paramsRepo
+}
+
+// ConvertAuditChainSeamsFromRepoToService converts the seams of the audit chain from the repository layer
+// to the service layer.
+func ConvertAuditChainSeamsFromRepoToService(params []modelRepo.AuditChainSeam) []model.AuditChainSeam {
+	seams := make([]model.AuditChainSeam, 0, len(params))
+	for _, seam := range params {
+		seams = append(seams, model.AuditChainSeam{
+			EventID:  seam.EventID,
+			PrevHash: seam.PrevHash,
+		})
+	}
+
+	return seams
+}
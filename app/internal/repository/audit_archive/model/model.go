@@ -0,0 +1,27 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// AuditPartition represents a partition of the audit log retrieved from the catalog.
+type AuditPartition struct {
+	Name string `db:"name"`
+}
+
+// CreateAuditArchiveParams holds the parameters for recording an archived partition of the audit log.
+type CreateAuditArchiveParams struct {
+	PartitionName string        `db:"partition_name"`
+	RangeFrom     time.Time     `db:"range_from"`
+	RangeTo       time.Time     `db:"range_to"`
+	FileName      string        `db:"file_name"`
+	Records       int64         `db:"records"`
+	MaxEventID    sql.NullInt64 `db:"max_log_id"`
+}
+
+// AuditChainSeam represents a record whose previous record in the chain was archived.
+type AuditChainSeam struct {
+	EventID  int64  `db:"log_id"`
+	PrevHash []byte `db:"prev_hash"`
+}
@@ -0,0 +1,268 @@
+package auditarchive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/repository/audit_archive/converter"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/audit_archive/model"
+	logConverter "github.com/Prrromanssss/auth/internal/repository/log/converter"
+	logModelRepo "github.com/Prrromanssss/auth/internal/repository/log/model"
+)
+
+const (
+	schemaName      = "users"
+	partitionBounds = "2006-01-02"
+)
+
+type auditArchivePGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of auditArchivePGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.AuditArchiveRepository {
+	return &auditArchivePGRepo{db: db}
+}
+
+// AcquireRetentionLock takes the retention advisory lock until the end of the transaction if no one else holds it.
+func (p *auditArchivePGRepo) AcquireRetentionLock(ctx context.Context) (acquired bool, err error) {
+	q := db.Query{
+		Name:     "auditArchivePGRepo.AcquireRetentionLock",
+		QueryRaw: queryAcquireRetentionLock,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &acquired, q, auditRetentionLockKey)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot acquire audit retention lock")
+	}
+
+	return acquired, nil
+}
+
+// GetCurrentAuditMonth retrieves the start of the current month in the local time of the database,
+// which the partition bounds are in.
+func (p *auditArchivePGRepo) GetCurrentAuditMonth(ctx context.Context) (month time.Time, err error) {
+	q := db.Query{
+		Name:     "auditArchivePGRepo.GetCurrentAuditMonth",
+		QueryRaw: queryGetCurrentAuditMonth,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &month, q)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "Cannot get current audit month")
+	}
+
+	return month.UTC(), nil
+}
+
+// ListAuditPartitions retrieves the monthly partitions of the audit log.
+func (p *auditArchivePGRepo) ListAuditPartitions(ctx context.Context) (resp []model.AuditPartition, err error) {
+	var partitions []modelRepo.AuditPartition
+
+	q := db.Query{
+		Name:     "auditArchivePGRepo.ListAuditPartitions",
+		QueryRaw: queryListAuditPartitions,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &partitions, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list audit partitions")
+	}
+
+	return converter.ConvertAuditPartitionsFromRepoToService(partitions), nil
+}
+
+// CreateAuditPartition creates the partition of the audit log that holds the month of the given time
+// unless it exists.
+func (p *auditArchivePGRepo) CreateAuditPartition(ctx context.Context, month time.Time) (err error) {
+	month = month.UTC()
+	from := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	partition := converter.PartitionName(from)
+
+	name, err := partitionIdentifier(partition)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name: "auditArchivePGRepo.CreateAuditPartition",
+		QueryRaw: fmt.Sprintf(
+			queryCreateAuditPartition,
+			name,
+			from.Format(partitionBounds),
+			from.AddDate(0, 1, 0).Format(partitionBounds),
+		),
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create audit partition(partition: %s)", partition)
+	}
+
+	return nil
+}
+
+// ListAuditPartitionRecords retrieves the records of a partition after a record with their hashes, in chain order.
+func (p *auditArchivePGRepo) ListAuditPartitionRecords(
+	ctx context.Context,
+	params model.ListAuditPartitionRecordsParams,
+) (resp []model.AuditChainLink, err error) {
+	name, err := partitionIdentifier(params.Partition)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []logModelRepo.AuditChainLink
+
+	q := db.Query{
+		Name:     "auditArchivePGRepo.ListAuditPartitionRecords",
+		QueryRaw: fmt.Sprintf(queryListAuditPartitionRecords, name),
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &links, q, params.AfterEventID, params.Limit)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err,
+			"Cannot list audit partition records(partition: %s, afterEventID: %d)",
+			params.Partition,
+			params.AfterEventID,
+		)
+	}
+
+	return logConverter.ConvertAuditChainLinksFromRepoToService(links), nil
+}
+
+// CreateAuditArchive records a partition exported to the archive and returns the id of the record.
+func (p *auditArchivePGRepo) CreateAuditArchive(
+	ctx context.Context,
+	params model.AuditArchive,
+) (archiveID int64, err error) {
+	log.Infof("auditArchivePGRepo.CreateAuditArchive, partition: %s, file: %s", params.Partition.Name, params.FileName)
+
+	paramsRepo := converter.ConvertCreateAuditArchiveParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "auditArchivePGRepo.CreateAuditArchive",
+		QueryRaw: queryCreateAuditArchive,
+	}
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&archiveID,
+		q,
+		paramsRepo.PartitionName,
+		paramsRepo.RangeFrom,
+		paramsRepo.RangeTo,
+		paramsRepo.FileName,
+		paramsRepo.Records,
+		paramsRepo.MaxEventID,
+	)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot create audit archive(partition: %s)", params.Partition.Name)
+	}
+
+	return archiveID, nil
+}
+
+// CreateAuditChainSeams records the records left in the log that are chained to a record of the partition,
+// so that the chain can still be verified once the partition is gone.
+func (p *auditArchivePGRepo) CreateAuditChainSeams(
+	ctx context.Context,
+	params model.CreateAuditChainSeamsParams,
+) (err error) {
+	name, err := partitionIdentifier(params.Partition)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "auditArchivePGRepo.CreateAuditChainSeams",
+		QueryRaw: fmt.Sprintf(queryCreateAuditChainSeams, name),
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, params.ArchiveID)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create audit chain seams(partition: %s)", params.Partition)
+	}
+
+	return nil
+}
+
+// DropAuditPartition removes the partition from the audit log, dropping it unless it is only to be detached.
+func (p *auditArchivePGRepo) DropAuditPartition(ctx context.Context, params model.DropAuditPartitionParams) (err error) {
+	log.Infof("auditArchivePGRepo.DropAuditPartition, partition: %s, detachOnly: %t", params.Partition, params.DetachOnly)
+
+	name, err := partitionIdentifier(params.Partition)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "auditArchivePGRepo.DropAuditPartition",
+		QueryRaw: fmt.Sprintf(queryDropAuditPartition, name),
+	}
+
+	if params.DetachOnly {
+		q = db.Query{
+			Name:     "auditArchivePGRepo.DetachAuditPartition",
+			QueryRaw: fmt.Sprintf(queryDetachAuditPartition, name),
+		}
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot drop audit partition(partition: %s)", params.Partition)
+	}
+
+	return nil
+}
+
+// ListAuditChainSeams retrieves the records whose previous record in the chain was archived.
+func (p *auditArchivePGRepo) ListAuditChainSeams(ctx context.Context) (resp []model.AuditChainSeam, err error) {
+	var seams []modelRepo.AuditChainSeam
+
+	q := db.Query{
+		Name:     "auditArchivePGRepo.ListAuditChainSeams",
+		QueryRaw: queryListAuditChainSeams,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &seams, q)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list audit chain seams")
+	}
+
+	return converter.ConvertAuditChainSeamsFromRepoToService(seams), nil
+}
+
+// GetMaxArchivedEventID retrieves the greatest id of the archived records, 0 if nothing was archived.
+func (p *auditArchivePGRepo) GetMaxArchivedEventID(ctx context.Context) (eventID int64, err error) {
+	q := db.Query{
+		Name:     "auditArchivePGRepo.GetMaxArchivedEventID",
+		QueryRaw: queryGetMaxArchivedEventID,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &eventID, q)
+	if err != nil {
+		return 0, errors.Wrap(err, "Cannot get max archived event id")
+	}
+
+	return eventID, nil
+}
+
+// partitionIdentifier returns the quoted name of a monthly partition. Any other name is rejected,
+// since the name is formatted into the query.
+func partitionIdentifier(partition string) (string, error) {
+	if !converter.IsPartitionName(partition) {
+		return "", errors.Errorf("Invalid audit partition name: %q", partition)
+	}
+
+	return pgx.Identifier{schemaName, partition}.Sanitize(), nil
+}
@@ -0,0 +1,97 @@
+package auditarchive
+
+const (
+	// auditRetentionLockKey is the advisory lock key that lets only one instance manage the partitions at a time.
+	auditRetentionLockKey = 7400192
+
+	queryAcquireRetentionLock = `
+		SELECT pg_try_advisory_xact_lock($1);
+	`
+
+	// The log timestamps default to now() in the time zone of the database and the partition bounds
+	// are in the same time zone, so the current month is taken from the local time of the database.
+	queryGetCurrentAuditMonth = `
+		SELECT date_trunc('month', localtimestamp);
+	`
+
+	queryListAuditPartitions = `
+		SELECT
+			c.relname AS name
+		FROM pg_inherits AS i
+		JOIN pg_class AS c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'users.api_user_log'::regclass
+		ORDER BY c.relname;
+	`
+
+	// The partition queries below are formatted with the quoted name of the partition,
+	// DDL takes the bounds as literals rather than parameters.
+
+	queryCreateAuditPartition = `
+		CREATE TABLE IF NOT EXISTS %s
+		PARTITION OF users.api_user_log
+		FOR VALUES FROM ('%s') TO ('%s');
+	`
+
+	queryListAuditPartitionRecords = `
+		SELECT
+			id
+			, action_type
+			, request_data::text
+			, response_data::text
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, timestamp
+			, prev_hash
+			, hash
+		FROM %s
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2;
+	`
+
+	queryCreateAuditChainSeams = `
+		INSERT INTO users.api_user_log_chain_seam
+			(log_id, prev_hash, archive_id)
+		SELECT l.id, l.prev_hash, $1
+		FROM users.api_user_log AS l
+		JOIN %s AS p ON p.hash = l.prev_hash
+		WHERE l.tableoid <> p.tableoid
+		ON CONFLICT (log_id) DO NOTHING;
+	`
+
+	queryDetachAuditPartition = `
+		ALTER TABLE users.api_user_log DETACH PARTITION %s;
+	`
+
+	queryDropAuditPartition = `
+		DROP TABLE %s;
+	`
+
+	queryCreateAuditArchive = `
+		INSERT INTO users.api_user_log_archive
+			(partition_name, range_from, range_to, file_name, records, max_log_id)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING id;
+	`
+
+	queryListAuditChainSeams = `
+		SELECT
+			log_id
+			, prev_hash
+		FROM users.api_user_log_chain_seam
+		ORDER BY log_id;
+	`
+
+	queryGetMaxArchivedEventID = `
+		SELECT
+			COALESCE(MAX(max_log_id), 0)
+		FROM users.api_user_log_archive;
+	`
+)
@@ -3,15 +3,21 @@ package converter
 import (
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
 	modelRepo "github.com/Prrromanssss/auth/internal/repository/log/model"
 )
 
-// ConvertCreateAPILogParamsFromServiceToRepo converts CreateAPILogParams from the service layer
-// to the repository layer format.
-func ConvertCreateAPILogParamsFromServiceToRepo(params model.CreateAPILogParams) (modelRepo.CreateAPILogParams, error) {
-	requestDataBytes, err := json.Marshal(params.RequestData)
+// ConvertCreateAPILogParamsFromServiceToRepo converts CreateAPILogParams and the audit metadata of the call
+// from the service layer to the repository layer format. The metadata is nil outside of a gRPC call.
+// The request and response payloads are redacted before they are stored.
+func ConvertCreateAPILogParamsFromServiceToRepo(
+	params model.CreateAPILogParams,
+	md *model.AuditMetadata,
+) (modelRepo.CreateAPILogParams, error) {
+	requestDataBytes, err := json.Marshal(redact.Value(params.RequestData))
 	if err != nil {
 		return modelRepo.CreateAPILogParams{}, err
 	}
@@ -19,7 +25,7 @@ func ConvertCreateAPILogParamsFromServiceToRepo(params model.CreateAPILogParams)
 
 	var responseData sql.NullString
 	if params.ResponseData != nil {
-		responseDataBytes, err := json.Marshal(params.ResponseData)
+		responseDataBytes, err := json.Marshal(redact.Value(params.ResponseData))
 		if err != nil {
 			return modelRepo.CreateAPILogParams{}, err
 		}
@@ -29,9 +35,195 @@ func ConvertCreateAPILogParamsFromServiceToRepo(params model.CreateAPILogParams)
 		responseData.Valid = false
 	}
 
-	return modelRepo.CreateAPILogParams{
+	paramsRepo := modelRepo.CreateAPILogParams{
 		Method:       params.Method,
 		RequestData:  requestData,
 		ResponseData: responseData,
-	}, nil
+		TargetUserID: nullInt64(params.TargetUserID),
+		StatusCode:   params.StatusCode,
+	}
+
+	if paramsRepo.StatusCode == "" {
+		paramsRepo.StatusCode = model.AuditStatusOK
+	}
+
+	if md != nil {
+		paramsRepo.ActorID = nullInt64(md.ActorID)
+		paramsRepo.SourceIP = nullString(md.SourceIP)
+		paramsRepo.UserAgent = nullString(md.UserAgent)
+		paramsRepo.GRPCMethod = nullString(md.Method)
+		paramsRepo.RequestID = nullString(md.RequestID)
+		paramsRepo.TraceID = nullString(md.TraceID)
+	}
+
+	return paramsRepo, nil
+}
+
+// ConvertAPILogRecordsFromServiceToRepo converts audit records captured during their calls
+// from the service layer to the columns of a multi-row insert.
+func ConvertAPILogRecordsFromServiceToRepo(records []model.APILogRecord) (modelRepo.CreateAPILogsParams, error) {
+	paramsRepo := modelRepo.CreateAPILogsParams{
+		Methods:       make([]string, 0, len(records)),
+		RequestData:   make([]string, 0, len(records)),
+		ResponseData:  make([]*string, 0, len(records)),
+		ActorIDs:      make([]*int64, 0, len(records)),
+		TargetUserIDs: make([]*int64, 0, len(records)),
+		SourceIPs:     make([]*string, 0, len(records)),
+		UserAgents:    make([]*string, 0, len(records)),
+		GRPCMethods:   make([]*string, 0, len(records)),
+		StatusCodes:   make([]string, 0, len(records)),
+		RequestIDs:    make([]*string, 0, len(records)),
+		TraceIDs:      make([]*string, 0, len(records)),
+		CreatedAt:     make([]time.Time, 0, len(records)),
+	}
+
+	for _, record := range records {
+		row, err := ConvertCreateAPILogParamsFromServiceToRepo(record.Params, record.Metadata)
+		if err != nil {
+			return modelRepo.CreateAPILogsParams{}, err
+		}
+
+		paramsRepo.Methods = append(paramsRepo.Methods, row.Method)
+		paramsRepo.RequestData = append(paramsRepo.RequestData, row.RequestData)
+		paramsRepo.ResponseData = append(paramsRepo.ResponseData, stringPtr(row.ResponseData))
+		paramsRepo.ActorIDs = append(paramsRepo.ActorIDs, int64Ptr(row.ActorID))
+		paramsRepo.TargetUserIDs = append(paramsRepo.TargetUserIDs, int64Ptr(row.TargetUserID))
+		paramsRepo.SourceIPs = append(paramsRepo.SourceIPs, stringPtr(row.SourceIP))
+		paramsRepo.UserAgents = append(paramsRepo.UserAgents, stringPtr(row.UserAgent))
+		paramsRepo.GRPCMethods = append(paramsRepo.GRPCMethods, stringPtr(row.GRPCMethod))
+		paramsRepo.StatusCodes = append(paramsRepo.StatusCodes, row.StatusCode)
+		paramsRepo.RequestIDs = append(paramsRepo.RequestIDs, stringPtr(row.RequestID))
+		paramsRepo.TraceIDs = append(paramsRepo.TraceIDs, stringPtr(row.TraceID))
+		// The timestamp column has no time zone, the database writes it in UTC.
+		paramsRepo.CreatedAt = append(paramsRepo.CreatedAt, record.CreatedAt.UTC())
+	}
+
+	return paramsRepo, nil
+}
+
+// ConvertListAuditEventsPageParamsFromServiceToRepo converts ListAuditEventsPageParams from the service layer
+// to the repository layer.
+func ConvertListAuditEventsPageParamsFromServiceToRepo(
+	params model.ListAuditEventsPageParams,
+) modelRepo.ListAuditEventsPageParams {
+	paramsRepo := modelRepo.ListAuditEventsPageParams{
+		ActorID:      nullInt64(params.Filter.ActorID),
+		TargetUserID: nullInt64(params.Filter.TargetUserID),
+		Action:       params.Filter.Action,
+		Limit:        params.Limit,
+	}
+
+	if params.Filter.From != nil {
+		paramsRepo.From = sql.NullTime{Time: *params.Filter.From, Valid: true}
+	}
+
+	if params.Filter.To != nil {
+		paramsRepo.To = sql.NullTime{Time: *params.Filter.To, Valid: true}
+	}
+
+	if params.After != nil {
+		paramsRepo.AfterCreatedAt = sql.NullTime{Time: params.After.CreatedAt, Valid: true}
+		paramsRepo.AfterEventID = params.After.EventID
+	}
+
+	return paramsRepo
+}
+
+// ConvertListAuditEventsPageResponseFromRepoToService converts a page of audit events from the repository layer
+// to the service layer.
+func ConvertListAuditEventsPageResponseFromRepoToService(
+	params []modelRepo.AuditEvent,
+) model.ListAuditEventsPageResponse {
+	events := make([]model.AuditEvent, 0, len(params))
+	for _, event := range params {
+		events = append(events, model.AuditEvent{
+			EventID:      event.EventID,
+			Action:       event.Action,
+			ActorID:      int64Ptr(event.ActorID),
+			TargetUserID: int64Ptr(event.TargetUserID),
+			SourceIP:     event.SourceIP.String,
+			UserAgent:    event.UserAgent.String,
+			Method:       event.GRPCMethod.String,
+			StatusCode:   event.StatusCode,
+			RequestID:    event.RequestID.String,
+			TraceID:      event.TraceID.String,
+			CreatedAt:    event.CreatedAt,
+		})
+	}
+
+	return model.ListAuditEventsPageResponse{
+		Events: events,
+	}
+}
+
+// ConvertAuditChainLinksFromRepoToService converts audit log rows with their hashes from the repository layer
+// to the service layer.
+func ConvertAuditChainLinksFromRepoToService(params []modelRepo.AuditChainLink) []model.AuditChainLink {
+	links := make([]model.AuditChainLink, 0, len(params))
+	for _, link := range params {
+		links = append(links, model.AuditChainLink{
+			EventID:      link.EventID,
+			Action:       link.Action,
+			RequestData:  link.RequestData,
+			ResponseData: stringPtr(link.ResponseData),
+			ActorID:      int64Ptr(link.ActorID),
+			TargetUserID: int64Ptr(link.TargetUserID),
+			SourceIP:     stringPtr(link.SourceIP),
+			UserAgent:    stringPtr(link.UserAgent),
+			Method:       stringPtr(link.GRPCMethod),
+			StatusCode:   link.StatusCode,
+			RequestID:    stringPtr(link.RequestID),
+			TraceID:      stringPtr(link.TraceID),
+			CreatedAt:    link.CreatedAt,
+			PrevHash:     link.PrevHash,
+			Hash:         link.Hash,
+		})
+	}
+
+	return links
+}
+
+// ConvertAuditCheckpointsFromRepoToService converts checkpoints of the audit chain from the repository layer
+// to the service layer.
+func ConvertAuditCheckpointsFromRepoToService(params []modelRepo.AuditCheckpoint) []model.AuditCheckpoint {
+	checkpoints := make([]model.AuditCheckpoint, 0, len(params))
+	for _, checkpoint := range params {
+		checkpoints = append(checkpoints, model.AuditCheckpoint{
+			CheckpointID: checkpoint.CheckpointID,
+			EventID:      checkpoint.EventID,
+			Hash:         checkpoint.Hash,
+			Signature:    checkpoint.Signature,
+			CreatedAt:    checkpoint.CreatedAt,
+		})
+	}
+
+	return checkpoints
+}
+
+func nullInt64(value *int64) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: *value, Valid: true}
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func int64Ptr(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Int64
+}
+
+func stringPtr(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.String
 }
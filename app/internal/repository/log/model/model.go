@@ -1,10 +1,106 @@
 package model
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
-// CreateAPILogParams holds the parameters for logging API actions related to user creation.
+// CreateAPILogParams holds the parameters for recording an API action in the audit log.
 type CreateAPILogParams struct {
 	Method       string         `db:"action_type"`
 	RequestData  string         `db:"request_data"`
 	ResponseData sql.NullString `db:"response_data"`
+	ActorID      sql.NullInt64  `db:"actor_id"`
+	TargetUserID sql.NullInt64  `db:"target_user_id"`
+	SourceIP     sql.NullString `db:"source_ip"`
+	UserAgent    sql.NullString `db:"user_agent"`
+	GRPCMethod   sql.NullString `db:"grpc_method"`
+	StatusCode   string         `db:"status_code"`
+	RequestID    sql.NullString `db:"request_id"`
+	TraceID      sql.NullString `db:"trace_id"`
+}
+
+// CreateAPILogsParams holds the columns of several audit records, one array per column,
+// for a multi-row insert.
+type CreateAPILogsParams struct {
+	Methods       []string
+	RequestData   []string
+	ResponseData  []*string
+	ActorIDs      []*int64
+	TargetUserIDs []*int64
+	SourceIPs     []*string
+	UserAgents    []*string
+	GRPCMethods   []*string
+	StatusCodes   []string
+	RequestIDs    []*string
+	TraceIDs      []*string
+	CreatedAt     []time.Time
+}
+
+// ListAuditEventsPageParams holds the parameters for retrieving a page of audit events after the cursor.
+type ListAuditEventsPageParams struct {
+	ActorID        sql.NullInt64 `db:"actor_id"`
+	TargetUserID   sql.NullInt64 `db:"target_user_id"`
+	Action         string        `db:"action_type"`
+	From           sql.NullTime  `db:"from"`
+	To             sql.NullTime  `db:"to"`
+	AfterCreatedAt sql.NullTime  `db:"after_timestamp"`
+	AfterEventID   int64         `db:"after_id"`
+	Limit          int64         `db:"limit"`
+}
+
+// AuditEvent represents an audit log row retrieved from the database.
+type AuditEvent struct {
+	EventID      int64          `db:"id"`
+	Action       string         `db:"action_type"`
+	ActorID      sql.NullInt64  `db:"actor_id"`
+	TargetUserID sql.NullInt64  `db:"target_user_id"`
+	SourceIP     sql.NullString `db:"source_ip"`
+	UserAgent    sql.NullString `db:"user_agent"`
+	GRPCMethod   sql.NullString `db:"grpc_method"`
+	StatusCode   string         `db:"status_code"`
+	RequestID    sql.NullString `db:"request_id"`
+	TraceID      sql.NullString `db:"trace_id"`
+	CreatedAt    time.Time      `db:"timestamp"`
+}
+
+// AuditChainLink represents an audit log row as stored, with its hashes, retrieved from the database.
+type AuditChainLink struct {
+	EventID      int64          `db:"id"`
+	Action       string         `db:"action_type"`
+	RequestData  string         `db:"request_data"`
+	ResponseData sql.NullString `db:"response_data"`
+	ActorID      sql.NullInt64  `db:"actor_id"`
+	TargetUserID sql.NullInt64  `db:"target_user_id"`
+	SourceIP     sql.NullString `db:"source_ip"`
+	UserAgent    sql.NullString `db:"user_agent"`
+	GRPCMethod   sql.NullString `db:"grpc_method"`
+	StatusCode   string         `db:"status_code"`
+	RequestID    sql.NullString `db:"request_id"`
+	TraceID      sql.NullString `db:"trace_id"`
+	CreatedAt    time.Time      `db:"timestamp"`
+	PrevHash     []byte         `db:"prev_hash"`
+	Hash         []byte         `db:"hash"`
+}
+
+// AuditChainHead represents the last hashed audit log row retrieved from the database.
+type AuditChainHead struct {
+	EventID int64  `db:"id"`
+	Hash    []byte `db:"hash"`
+}
+
+// SetAuditChainHashesParams holds the hashes of several audit log rows, one array per column.
+type SetAuditChainHashesParams struct {
+	EventIDs   []int64
+	PrevHashes [][]byte
+	Hashes     [][]byte
+}
+
+// AuditCheckpoint represents a checkpoint of the audit chain retrieved from the database.
+type AuditCheckpoint struct {
+	CheckpointID int64     `db:"id"`
+	EventID      int64     `db:"log_id"`
+	Hash         []byte    `db:"hash"`
+	Signature    []byte    `db:"signature"`
+	CreatedAt    time.Time `db:"created_at"`
 }
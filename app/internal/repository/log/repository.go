@@ -2,33 +2,45 @@ package log
 
 import (
 	"context"
+	"sort"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
 	"github.com/gofiber/fiber/v2/log"
 	"github.com/pkg/errors"
 
+	"github.com/Prrromanssss/auth/internal/audit"
 	"github.com/Prrromanssss/auth/internal/model"
 	"github.com/Prrromanssss/auth/internal/repository"
 	"github.com/Prrromanssss/auth/internal/repository/log/converter"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/log/model"
 )
 
 type logPGRepo struct {
-	db db.Client
+	db        db.Client
+	txManager db.TxManager
 }
 
 // NewRepository creates a new instance of logPGRepo with the provided database connection.
-func NewRepository(db db.Client) repository.LogRepository {
-	return &logPGRepo{db: db}
+// The transaction manager lets the records written outside of a transaction be chained atomically.
+func NewRepository(db db.Client, txManager db.TxManager) repository.LogRepository {
+	return &logPGRepo{
+		db:        db,
+		txManager: txManager,
+	}
 }
 
 // CreateAPILog creates log in database of every api action.
+// The caller of the action is taken from the audit metadata of the context.
+// The record is chained to the previous one, see appendToChain.
 func (p *logPGRepo) CreateAPILog(
 	ctx context.Context,
 	params model.CreateAPILogParams,
 ) (err error) {
 	log.Infof("logPGRepo.CreateAPILog, params: %+v", params)
 
-	paramsRepo, err := converter.ConvertCreateAPILogParamsFromServiceToRepo(params)
+	md, _ := audit.FromContext(ctx)
+
+	paramsRepo, err := converter.ConvertCreateAPILogParamsFromServiceToRepo(params, md)
 	if err != nil {
 		return err
 	}
@@ -38,7 +50,28 @@ func (p *logPGRepo) CreateAPILog(
 		QueryRaw: queryCreateAPILog,
 	}
 
-	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.Method, paramsRepo.RequestData, paramsRepo.ResponseData)
+	err = p.appendToChain(ctx, func(ctx context.Context) ([]modelRepo.AuditChainLink, error) {
+		var link modelRepo.AuditChainLink
+
+		insertErr := p.db.DB().ScanOneContext(
+			ctx,
+			&link,
+			q,
+			paramsRepo.Method,
+			paramsRepo.RequestData,
+			paramsRepo.ResponseData,
+			paramsRepo.ActorID,
+			paramsRepo.TargetUserID,
+			paramsRepo.SourceIP,
+			paramsRepo.UserAgent,
+			paramsRepo.GRPCMethod,
+			paramsRepo.StatusCode,
+			paramsRepo.RequestID,
+			paramsRepo.TraceID,
+		)
+
+		return []modelRepo.AuditChainLink{link}, insertErr
+	})
 	if err != nil {
 		return errors.Wrapf(
 			err,
@@ -48,3 +81,246 @@ func (p *logPGRepo) CreateAPILog(
 
 	return nil
 }
+
+// CreateAPILogs creates the audit records captured during earlier calls with a single multi-row insert.
+// The records are chained in the order they are given.
+func (p *logPGRepo) CreateAPILogs(
+	ctx context.Context,
+	records []model.APILogRecord,
+) (err error) {
+	log.Infof("logPGRepo.CreateAPILogs, records: %d", len(records))
+
+	if len(records) == 0 {
+		return nil
+	}
+
+	paramsRepo, err := converter.ConvertAPILogRecordsFromServiceToRepo(records)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "logPGRepo.CreateAPILogs",
+		QueryRaw: queryCreateAPILogs,
+	}
+
+	err = p.appendToChain(ctx, func(ctx context.Context) ([]modelRepo.AuditChainLink, error) {
+		var links []modelRepo.AuditChainLink
+
+		insertErr := p.db.DB().ScanAllContext(
+			ctx,
+			&links,
+			q,
+			paramsRepo.Methods,
+			paramsRepo.RequestData,
+			paramsRepo.ResponseData,
+			paramsRepo.ActorIDs,
+			paramsRepo.TargetUserIDs,
+			paramsRepo.SourceIPs,
+			paramsRepo.UserAgents,
+			paramsRepo.GRPCMethods,
+			paramsRepo.StatusCodes,
+			paramsRepo.RequestIDs,
+			paramsRepo.TraceIDs,
+			paramsRepo.CreatedAt,
+		)
+
+		return links, insertErr
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Cannot create api logs(records: %d)", len(records))
+	}
+
+	return nil
+}
+
+// appendToChain inserts audit rows and stores their hashes, each one over the row as stored and the hash
+// of the row before it.
+//
+// Writers take the chain lock until the end of the transaction, the one of the caller if there is any,
+// so the head read under the lock is the last committed row and ids grow in chain order.
+func (p *logPGRepo) appendToChain(
+	ctx context.Context,
+	insert func(ctx context.Context) ([]modelRepo.AuditChainLink, error),
+) error {
+	return p.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, txErr := p.db.DB().ExecContext(ctx, db.Query{
+			Name:     "logPGRepo.LockAuditChain",
+			QueryRaw: queryLockAuditChain,
+		}, auditChainLockKey)
+		if txErr != nil {
+			return errors.Wrap(txErr, "Cannot lock audit chain")
+		}
+
+		head, txErr := p.getAuditChainHead(ctx)
+		if txErr != nil {
+			return txErr
+		}
+
+		links, txErr := insert(ctx)
+		if txErr != nil {
+			return txErr
+		}
+
+		sort.Slice(links, func(i, j int) bool {
+			return links[i].EventID < links[j].EventID
+		})
+
+		paramsRepo := modelRepo.SetAuditChainHashesParams{
+			EventIDs:   make([]int64, 0, len(links)),
+			PrevHashes: make([][]byte, 0, len(links)),
+			Hashes:     make([][]byte, 0, len(links)),
+		}
+
+		prevHash := head.Hash
+		for _, link := range converter.ConvertAuditChainLinksFromRepoToService(links) {
+			hash := audit.ChainHash(prevHash, link)
+
+			paramsRepo.EventIDs = append(paramsRepo.EventIDs, link.EventID)
+			paramsRepo.PrevHashes = append(paramsRepo.PrevHashes, prevHash)
+			paramsRepo.Hashes = append(paramsRepo.Hashes, hash)
+
+			prevHash = hash
+		}
+
+		_, txErr = p.db.DB().ExecContext(ctx, db.Query{
+			Name:     "logPGRepo.SetAuditChainHashes",
+			QueryRaw: querySetAuditChainHashes,
+		}, paramsRepo.EventIDs, paramsRepo.PrevHashes, paramsRepo.Hashes)
+		if txErr != nil {
+			return errors.Wrap(txErr, "Cannot set audit chain hashes")
+		}
+
+		return nil
+	})
+}
+
+// GetAuditChainHead retrieves the last record of the audit chain. It is empty if the chain has no records.
+func (p *logPGRepo) GetAuditChainHead(ctx context.Context) (resp model.AuditChainHead, err error) {
+	head, err := p.getAuditChainHead(ctx)
+	if err != nil {
+		return model.AuditChainHead{}, err
+	}
+
+	return model.AuditChainHead{
+		EventID: head.EventID,
+		Hash:    head.Hash,
+	}, nil
+}
+
+func (p *logPGRepo) getAuditChainHead(ctx context.Context) (modelRepo.AuditChainHead, error) {
+	var heads []modelRepo.AuditChainHead
+
+	q := db.Query{
+		Name:     "logPGRepo.GetAuditChainHead",
+		QueryRaw: queryGetAuditChainHead,
+	}
+
+	err := p.db.DB().ScanAllContext(ctx, &heads, q)
+	if err != nil {
+		return modelRepo.AuditChainHead{}, errors.Wrap(err, "Cannot get audit chain head")
+	}
+
+	if len(heads) == 0 {
+		return modelRepo.AuditChainHead{}, nil
+	}
+
+	return heads[0], nil
+}
+
+// ListAuditChain retrieves the audit records after a record with their hashes, in chain order.
+func (p *logPGRepo) ListAuditChain(
+	ctx context.Context,
+	params model.ListAuditChainParams,
+) (resp []model.AuditChainLink, err error) {
+	var links []modelRepo.AuditChainLink
+
+	q := db.Query{
+		Name:     "logPGRepo.ListAuditChain",
+		QueryRaw: queryListAuditChain,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &links, q, params.AfterEventID, params.Limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list audit chain(afterEventID: %d)", params.AfterEventID)
+	}
+
+	return converter.ConvertAuditChainLinksFromRepoToService(links), nil
+}
+
+// CreateAuditCheckpoint stores a checkpoint of the audit chain unless there already is one
+// at or after its record, and reports whether it was stored.
+func (p *logPGRepo) CreateAuditCheckpoint(
+	ctx context.Context,
+	params model.AuditCheckpoint,
+) (created bool, err error) {
+	var ids []int64
+
+	q := db.Query{
+		Name:     "logPGRepo.CreateAuditCheckpoint",
+		QueryRaw: queryCreateAuditCheckpoint,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &ids, q, params.EventID, params.Hash, params.Signature, params.CreatedAt)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot create audit checkpoint(eventID: %d)", params.EventID)
+	}
+
+	return len(ids) > 0, nil
+}
+
+// ListAuditCheckpoints retrieves the checkpoints of the audit chain after a checkpoint, oldest first.
+func (p *logPGRepo) ListAuditCheckpoints(
+	ctx context.Context,
+	params model.ListAuditCheckpointsParams,
+) (resp []model.AuditCheckpoint, err error) {
+	var checkpoints []modelRepo.AuditCheckpoint
+
+	q := db.Query{
+		Name:     "logPGRepo.ListAuditCheckpoints",
+		QueryRaw: queryListAuditCheckpoints,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &checkpoints, q, params.AfterCheckpointID, params.Limit)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Cannot list audit checkpoints(afterCheckpointID: %d)", params.AfterCheckpointID)
+	}
+
+	return converter.ConvertAuditCheckpointsFromRepoToService(checkpoints), nil
+}
+
+// ListAuditEvents retrieves a page of audit events after the cursor, newest first.
+func (p *logPGRepo) ListAuditEvents(
+	ctx context.Context,
+	params model.ListAuditEventsPageParams,
+) (resp model.ListAuditEventsPageResponse, err error) {
+	log.Infof("logPGRepo.ListAuditEvents, params: %+v", params)
+
+	paramsRepo := converter.ConvertListAuditEventsPageParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "logPGRepo.ListAuditEvents",
+		QueryRaw: queryListAuditEvents,
+	}
+
+	var respRepo []modelRepo.AuditEvent
+
+	err = p.db.DB().ScanAllContext(
+		ctx,
+		&respRepo,
+		q,
+		paramsRepo.ActorID,
+		paramsRepo.TargetUserID,
+		paramsRepo.Action,
+		paramsRepo.From,
+		paramsRepo.To,
+		paramsRepo.AfterCreatedAt,
+		paramsRepo.AfterEventID,
+		paramsRepo.Limit,
+	)
+	if err != nil {
+		return resp, errors.Wrap(err, "Cannot list audit events")
+	}
+
+	return converter.ConvertListAuditEventsPageResponseFromRepoToService(respRepo), nil
+}
@@ -1,10 +1,209 @@
 package log
 
 const (
+	// auditChainLockKey is the advisory lock key that serializes the writers of the audit log,
+	// so that every record is chained to the record committed right before it.
+	auditChainLockKey = 7400191
+
 	queryCreateAPILog = `
 		INSERT INTO users.api_user_log
-			(action_type, request_data, response_data)
+			(
+				action_type
+				, request_data
+				, response_data
+				, actor_id
+				, target_user_id
+				, source_ip
+				, user_agent
+				, grpc_method
+				, status_code
+				, request_id
+				, trace_id
+			)
 		VALUES
-			($1, $2, $3);
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING
+			id
+			, action_type
+			, request_data::text
+			, response_data::text
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, timestamp;
+	`
+
+	// queryCreateAPILogs inserts one row per element of the column arrays.
+	queryCreateAPILogs = `
+		INSERT INTO users.api_user_log
+			(
+				action_type
+				, request_data
+				, response_data
+				, actor_id
+				, target_user_id
+				, source_ip
+				, user_agent
+				, grpc_method
+				, status_code
+				, request_id
+				, trace_id
+				, timestamp
+			)
+		SELECT
+			action_type
+			, request_data::jsonb
+			, response_data::jsonb
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, created_at
+		FROM unnest(
+			$1::text[]
+			, $2::text[]
+			, $3::text[]
+			, $4::bigint[]
+			, $5::bigint[]
+			, $6::text[]
+			, $7::text[]
+			, $8::text[]
+			, $9::text[]
+			, $10::text[]
+			, $11::text[]
+			, $12::timestamp[]
+		) AS records (
+			action_type
+			, request_data
+			, response_data
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, created_at
+		)
+		RETURNING
+			id
+			, action_type
+			, request_data::text
+			, response_data::text
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, timestamp;
+	`
+
+	queryLockAuditChain = `
+		SELECT pg_advisory_xact_lock($1);
+	`
+
+	queryGetAuditChainHead = `
+		SELECT
+			id
+			, hash
+		FROM users.api_user_log
+		WHERE hash IS NOT NULL
+		ORDER BY id DESC
+		LIMIT 1;
+	`
+
+	querySetAuditChainHashes = `
+		UPDATE users.api_user_log AS l
+		SET
+			prev_hash = h.prev_hash
+			, hash = h.hash
+		FROM unnest($1::bigint[], $2::bytea[], $3::bytea[]) AS h (id, prev_hash, hash)
+		WHERE l.id = h.id;
+	`
+
+	queryListAuditChain = `
+		SELECT
+			id
+			, action_type
+			, request_data::text
+			, response_data::text
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, timestamp
+			, prev_hash
+			, hash
+		FROM users.api_user_log
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2;
+	`
+
+	// queryCreateAuditCheckpoint skips the checkpoint if the head has not moved since the last one.
+	queryCreateAuditCheckpoint = `
+		INSERT INTO users.api_user_log_checkpoint
+			(log_id, hash, signature, created_at)
+		SELECT $1, $2, $3, $4
+		WHERE NOT EXISTS (
+			SELECT 1
+			FROM users.api_user_log_checkpoint
+			WHERE log_id >= $1
+		)
+		RETURNING id;
+	`
+
+	queryListAuditCheckpoints = `
+		SELECT
+			id
+			, log_id
+			, hash
+			, signature
+			, created_at
+		FROM users.api_user_log_checkpoint
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2;
+	`
+
+	queryListAuditEvents = `
+		SELECT
+			id
+			, action_type
+			, actor_id
+			, target_user_id
+			, source_ip
+			, user_agent
+			, grpc_method
+			, status_code
+			, request_id
+			, trace_id
+			, timestamp
+		FROM users.api_user_log
+		WHERE ($1::bigint IS NULL OR actor_id = $1)
+			AND ($2::bigint IS NULL OR target_user_id = $2)
+			AND ($3::text = '' OR action_type = $3)
+			AND ($4::timestamp IS NULL OR timestamp >= $4)
+			AND ($5::timestamp IS NULL OR timestamp < $5)
+			AND ($6::timestamp IS NULL OR (timestamp, id) < ($6, $7))
+		ORDER BY timestamp DESC, id DESC
+		LIMIT $8;
 	`
 )
@@ -0,0 +1,71 @@
+package converter
+
+import (
+	"github.com/Prrromanssss/auth/internal/model"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/login_failure/model"
+)
+
+// ConvertLoginFailureKeysFromServiceToRepo converts the keys of the counters of failed logins from the service layer
+// to the repository layer.
+func ConvertLoginFailureKeysFromServiceToRepo(keys []model.LoginFailureKey) modelRepo.LoginFailureKeys {
+	keysRepo := modelRepo.LoginFailureKeys{
+		Scopes: make([]string, 0, len(keys)),
+		Keys:   make([]string, 0, len(keys)),
+	}
+
+	for _, key := range keys {
+		keysRepo.Scopes = append(keysRepo.Scopes, key.Scope)
+		keysRepo.Keys = append(keysRepo.Keys, key.Key)
+	}
+
+	return keysRepo
+}
+
+// ConvertRecordLoginFailureParamsFromServiceToRepo converts RecordLoginFailureParams from the service layer
+// to the repository layer.
+func ConvertRecordLoginFailureParamsFromServiceToRepo(
+	params model.RecordLoginFailureParams,
+) modelRepo.RecordLoginFailureParams {
+	return modelRepo.RecordLoginFailureParams{
+		Scope:       params.Scope,
+		Key:         params.Key,
+		FailedAt:    params.FailedAt,
+		ResetBefore: params.ResetBefore,
+	}
+}
+
+// ConvertLockLoginParamsFromServiceToRepo converts LockLoginParams from the service layer to the repository layer.
+func ConvertLockLoginParamsFromServiceToRepo(params model.LockLoginParams) modelRepo.LockLoginParams {
+	return modelRepo.LockLoginParams{
+		Scope:       params.Scope,
+		Key:         params.Key,
+		LockedUntil: params.LockedUntil,
+	}
+}
+
+// ConvertLoginFailureFromRepoToService converts LoginFailure from the repository layer to the service layer.
+func ConvertLoginFailureFromRepoToService(failure modelRepo.LoginFailure) model.LoginFailure {
+	resp := model.LoginFailure{
+		Scope:        failure.Scope,
+		Key:          failure.Key,
+		Failures:     failure.Failures,
+		LastFailedAt: failure.LastFailedAt,
+	}
+
+	if failure.LockedUntil.Valid {
+		resp.LockedUntil = &failure.LockedUntil.Time
+	}
+
+	return resp
+}
+
+// ConvertLoginFailuresFromRepoToService converts a list of LoginFailure from the repository layer
+// to the service layer.
+func ConvertLoginFailuresFromRepoToService(failures []modelRepo.LoginFailure) []model.LoginFailure {
+	resp := make([]model.LoginFailure, 0, len(failures))
+	for _, failure := range failures {
+		resp = append(resp, ConvertLoginFailureFromRepoToService(failure))
+	}
+
+	return resp
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// LoginFailure represents the failed logins counted for a key stored in the database.
+type LoginFailure struct {
+	Scope        string       `db:"scope"`
+	Key          string       `db:"key"`
+	Failures     int64        `db:"failures"`
+	LastFailedAt time.Time    `db:"last_failed_at"`
+	LockedUntil  sql.NullTime `db:"locked_until"`
+}
+
+// LoginFailureKeys holds the keys of the counters of failed logins as parallel arrays of scopes and keys.
+type LoginFailureKeys struct {
+	Scopes []string
+	Keys   []string
+}
+
+// RecordLoginFailureParams holds the parameters for counting a failed login.
+type RecordLoginFailureParams struct {
+	Scope       string    `db:"scope"`
+	Key         string    `db:"key"`
+	FailedAt    time.Time `db:"last_failed_at"`
+	ResetBefore time.Time
+}
+
+// LockLoginParams holds the parameters for making the logins for a key wait until a point in time.
+type LockLoginParams struct {
+	Scope       string    `db:"scope"`
+	Key         string    `db:"key"`
+	LockedUntil time.Time `db:"locked_until"`
+}
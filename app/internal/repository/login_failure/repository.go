@@ -0,0 +1,136 @@
+package loginfailure
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/repository/login_failure/converter"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/login_failure/model"
+)
+
+type loginFailurePGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of loginFailurePGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.LoginFailureRepository {
+	return &loginFailurePGRepo{db: db}
+}
+
+// LockLoginFailures locks the counters of failed logins of the given keys until the end of the transaction
+// and retrieves them from the database. The keys are locked whether their counters exist or not, and the counters
+// are read after the locks are taken, so they include the failures committed by the logins that held the locks before.
+func (p *loginFailurePGRepo) LockLoginFailures(
+	ctx context.Context,
+	params model.LockLoginFailuresParams,
+) (resp []model.LoginFailure, err error) {
+	log.Infof("loginFailurePGRepo.LockLoginFailures, params: %+v", params)
+
+	keysRepo := converter.ConvertLoginFailureKeysFromServiceToRepo(params.Keys)
+
+	q := db.Query{
+		Name:     "loginFailurePGRepo.LockLoginFailureKeys",
+		QueryRaw: queryLockLoginFailureKeys,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, keysRepo.Scopes, keysRepo.Keys, loginFailureLockSeed)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot lock login failures")
+	}
+
+	var respRepo []modelRepo.LoginFailure
+
+	q = db.Query{
+		Name:     "loginFailurePGRepo.ListLoginFailures",
+		QueryRaw: queryListLoginFailures,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &respRepo, q, keysRepo.Scopes, keysRepo.Keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list login failures")
+	}
+
+	return converter.ConvertLoginFailuresFromRepoToService(respRepo), nil
+}
+
+// RecordLoginFailure increments the counter of failed logins of a key, restarting a counter whose last failure
+// is too old, and returns the counter.
+func (p *loginFailurePGRepo) RecordLoginFailure(
+	ctx context.Context,
+	params model.RecordLoginFailureParams,
+) (resp model.LoginFailure, err error) {
+	log.Infof("loginFailurePGRepo.RecordLoginFailure, params: %+v", params)
+
+	paramsRepo := converter.ConvertRecordLoginFailureParamsFromServiceToRepo(params)
+
+	var respRepo modelRepo.LoginFailure
+
+	q := db.Query{
+		Name:     "loginFailurePGRepo.RecordLoginFailure",
+		QueryRaw: queryRecordLoginFailure,
+	}
+
+	err = p.db.DB().ScanOneContext(
+		ctx,
+		&respRepo,
+		q,
+		paramsRepo.Scope,
+		paramsRepo.Key,
+		paramsRepo.FailedAt,
+		paramsRepo.ResetBefore,
+	)
+	if err != nil {
+		return resp, errors.Wrapf(err, "Cannot record login failure(scope: %s)", paramsRepo.Scope)
+	}
+
+	return converter.ConvertLoginFailureFromRepoToService(respRepo), nil
+}
+
+// LockLogin sets the time until which the logins for a key have to wait.
+func (p *loginFailurePGRepo) LockLogin(
+	ctx context.Context,
+	params model.LockLoginParams,
+) (err error) {
+	log.Infof("loginFailurePGRepo.LockLogin, params: %+v", params)
+
+	paramsRepo := converter.ConvertLockLoginParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "loginFailurePGRepo.LockLogin",
+		QueryRaw: queryLockLogin,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.Scope, paramsRepo.Key, paramsRepo.LockedUntil)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot lock login(scope: %s)", paramsRepo.Scope)
+	}
+
+	return nil
+}
+
+// DeleteLoginFailures removes the counters of failed logins of the given keys from the database.
+func (p *loginFailurePGRepo) DeleteLoginFailures(
+	ctx context.Context,
+	params model.DeleteLoginFailuresParams,
+) (err error) {
+	log.Infof("loginFailurePGRepo.DeleteLoginFailures, params: %+v", params)
+
+	keysRepo := converter.ConvertLoginFailureKeysFromServiceToRepo(params.Keys)
+
+	q := db.Query{
+		Name:     "loginFailurePGRepo.DeleteLoginFailures",
+		QueryRaw: queryDeleteLoginFailures,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, keysRepo.Scopes, keysRepo.Keys)
+	if err != nil {
+		return errors.Wrap(err, "Cannot delete login failures")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package loginfailure
+
+const (
+	// loginFailureLockSeed is the seed of the hashes of the keys that the advisory locks of the counters are taken on.
+	loginFailureLockSeed = 7400193
+
+	// The locks are taken in a fixed order, so that logins locking the same keys cannot deadlock.
+	queryLockLoginFailureKeys = `
+		SELECT pg_advisory_xact_lock(l.id)
+		FROM (
+			SELECT DISTINCT hashtextextended(k.scope || ':' || k.key, $3) AS id
+			FROM unnest($1::varchar[], $2::varchar[]) AS k(scope, key)
+			ORDER BY id
+		) AS l;
+	`
+
+	queryListLoginFailures = `
+		SELECT
+			scope
+			, key
+			, failures
+			, last_failed_at
+			, locked_until
+		FROM users.login_failure
+		WHERE (scope, key) IN (SELECT * FROM unnest($1::varchar[], $2::varchar[]));
+	`
+
+	queryRecordLoginFailure = `
+		INSERT INTO users.login_failure AS f
+			(scope, key, failures, last_failed_at)
+		VALUES
+			($1, $2, 1, $3)
+		ON CONFLICT (scope, key) DO UPDATE
+		SET
+			failures = CASE WHEN f.last_failed_at < $4 THEN 1 ELSE f.failures + 1 END
+			, last_failed_at = EXCLUDED.last_failed_at
+			, locked_until = CASE WHEN f.last_failed_at < $4 THEN NULL ELSE f.locked_until END
+		RETURNING
+			scope
+			, key
+			, failures
+			, last_failed_at
+			, locked_until;
+	`
+
+	queryLockLogin = `
+		UPDATE users.login_failure
+		SET locked_until = $3
+		WHERE scope = $1 AND key = $2;
+	`
+
+	queryDeleteLoginFailures = `
+		DELETE FROM users.login_failure
+		WHERE (scope, key) IN (SELECT * FROM unnest($1::varchar[], $2::varchar[]));
+	`
+)
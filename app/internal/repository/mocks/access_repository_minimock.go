@@ -0,0 +1,395 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/repository.AccessRepository -o access_repository_minimock.go -n AccessRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccessRepositoryMock implements repository.AccessRepository
+type AccessRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheckAccess          func(ctx context.Context, params model.CheckAccessParams) (allowed bool, err error)
+	inspectFuncCheckAccess   func(ctx context.Context, params model.CheckAccessParams)
+	afterCheckAccessCounter  uint64
+	beforeCheckAccessCounter uint64
+	CheckAccessMock          mAccessRepositoryMockCheckAccess
+}
+
+// NewAccessRepositoryMock returns a mock for repository.AccessRepository
+func NewAccessRepositoryMock(t minimock.Tester) *AccessRepositoryMock {
+	m := &AccessRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckAccessMock = mAccessRepositoryMockCheckAccess{mock: m}
+	m.CheckAccessMock.callArgs = []*AccessRepositoryMockCheckAccessParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccessRepositoryMockCheckAccess struct {
+	optional           bool
+	mock               *AccessRepositoryMock
+	defaultExpectation *AccessRepositoryMockCheckAccessExpectation
+	expectations       []*AccessRepositoryMockCheckAccessExpectation
+
+	callArgs []*AccessRepositoryMockCheckAccessParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AccessRepositoryMockCheckAccessExpectation specifies expectation struct of the AccessRepository.CheckAccess
+type AccessRepositoryMockCheckAccessExpectation struct {
+	mock      *AccessRepositoryMock
+	params    *AccessRepositoryMockCheckAccessParams
+	paramPtrs *AccessRepositoryMockCheckAccessParamPtrs
+	results   *AccessRepositoryMockCheckAccessResults
+	Counter   uint64
+}
+
+// AccessRepositoryMockCheckAccessParams contains parameters of the AccessRepository.CheckAccess
+type AccessRepositoryMockCheckAccessParams struct {
+	ctx    context.Context
+	params model.CheckAccessParams
+}
+
+// AccessRepositoryMockCheckAccessParamPtrs contains pointers to parameters of the AccessRepository.CheckAccess
+type AccessRepositoryMockCheckAccessParamPtrs struct {
+	ctx    *context.Context
+	params *model.CheckAccessParams
+}
+
+// AccessRepositoryMockCheckAccessResults contains results of the AccessRepository.CheckAccess
+type AccessRepositoryMockCheckAccessResults struct {
+	allowed bool
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Optional() *mAccessRepositoryMockCheckAccess {
+	mmCheckAccess.optional = true
+	return mmCheckAccess
+}
+
+// Expect sets up expected params for AccessRepository.CheckAccess
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Expect(ctx context.Context, params model.CheckAccessParams) *mAccessRepositoryMockCheckAccess {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AccessRepositoryMockCheckAccessExpectation{}
+	}
+
+	if mmCheckAccess.defaultExpectation.paramPtrs != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by ExpectParams functions")
+	}
+
+	mmCheckAccess.defaultExpectation.params = &AccessRepositoryMockCheckAccessParams{ctx, params}
+	for _, e := range mmCheckAccess.expectations {
+		if minimock.Equal(e.params, mmCheckAccess.defaultExpectation.params) {
+			mmCheckAccess.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckAccess.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckAccess
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccessRepository.CheckAccess
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) ExpectCtxParam1(ctx context.Context) *mAccessRepositoryMockCheckAccess {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AccessRepositoryMockCheckAccessExpectation{}
+	}
+
+	if mmCheckAccess.defaultExpectation.params != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Expect")
+	}
+
+	if mmCheckAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckAccess.defaultExpectation.paramPtrs = &AccessRepositoryMockCheckAccessParamPtrs{}
+	}
+	mmCheckAccess.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCheckAccess
+}
+
+// ExpectParamsParam2 sets up expected param params for AccessRepository.CheckAccess
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) ExpectParamsParam2(params model.CheckAccessParams) *mAccessRepositoryMockCheckAccess {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AccessRepositoryMockCheckAccessExpectation{}
+	}
+
+	if mmCheckAccess.defaultExpectation.params != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Expect")
+	}
+
+	if mmCheckAccess.defaultExpectation.paramPtrs == nil {
+		mmCheckAccess.defaultExpectation.paramPtrs = &AccessRepositoryMockCheckAccessParamPtrs{}
+	}
+	mmCheckAccess.defaultExpectation.paramPtrs.params = &params
+
+	return mmCheckAccess
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccessRepository.CheckAccess
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Inspect(f func(ctx context.Context, params model.CheckAccessParams)) *mAccessRepositoryMockCheckAccess {
+	if mmCheckAccess.mock.inspectFuncCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("Inspect function is already set for AccessRepositoryMock.CheckAccess")
+	}
+
+	mmCheckAccess.mock.inspectFuncCheckAccess = f
+
+	return mmCheckAccess
+}
+
+// Return sets up results that will be returned by AccessRepository.CheckAccess
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Return(allowed bool, err error) *AccessRepositoryMock {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Set")
+	}
+
+	if mmCheckAccess.defaultExpectation == nil {
+		mmCheckAccess.defaultExpectation = &AccessRepositoryMockCheckAccessExpectation{mock: mmCheckAccess.mock}
+	}
+	mmCheckAccess.defaultExpectation.results = &AccessRepositoryMockCheckAccessResults{allowed, err}
+	return mmCheckAccess.mock
+}
+
+// Set uses given function f to mock the AccessRepository.CheckAccess method
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Set(f func(ctx context.Context, params model.CheckAccessParams) (allowed bool, err error)) *AccessRepositoryMock {
+	if mmCheckAccess.defaultExpectation != nil {
+		mmCheckAccess.mock.t.Fatalf("Default expectation is already set for the AccessRepository.CheckAccess method")
+	}
+
+	if len(mmCheckAccess.expectations) > 0 {
+		mmCheckAccess.mock.t.Fatalf("Some expectations are already set for the AccessRepository.CheckAccess method")
+	}
+
+	mmCheckAccess.mock.funcCheckAccess = f
+	return mmCheckAccess.mock
+}
+
+// When sets expectation for the AccessRepository.CheckAccess which will trigger the result defined by the following
+// Then helper
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) When(ctx context.Context, params model.CheckAccessParams) *AccessRepositoryMockCheckAccessExpectation {
+	if mmCheckAccess.mock.funcCheckAccess != nil {
+		mmCheckAccess.mock.t.Fatalf("AccessRepositoryMock.CheckAccess mock is already set by Set")
+	}
+
+	expectation := &AccessRepositoryMockCheckAccessExpectation{
+		mock:   mmCheckAccess.mock,
+		params: &AccessRepositoryMockCheckAccessParams{ctx, params},
+	}
+	mmCheckAccess.expectations = append(mmCheckAccess.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccessRepository.CheckAccess return parameters for the expectation previously defined by the When method
+func (e *AccessRepositoryMockCheckAccessExpectation) Then(allowed bool, err error) *AccessRepositoryMock {
+	e.results = &AccessRepositoryMockCheckAccessResults{allowed, err}
+	return e.mock
+}
+
+// Times sets number of times AccessRepository.CheckAccess should be invoked
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Times(n uint64) *mAccessRepositoryMockCheckAccess {
+	if n == 0 {
+		mmCheckAccess.mock.t.Fatalf("Times of AccessRepositoryMock.CheckAccess mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckAccess.expectedInvocations, n)
+	return mmCheckAccess
+}
+
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) invocationsDone() bool {
+	if len(mmCheckAccess.expectations) == 0 && mmCheckAccess.defaultExpectation == nil && mmCheckAccess.mock.funcCheckAccess == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckAccess.mock.afterCheckAccessCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckAccess.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CheckAccess implements repository.AccessRepository
+func (mmCheckAccess *AccessRepositoryMock) CheckAccess(ctx context.Context, params model.CheckAccessParams) (allowed bool, err error) {
+	mm_atomic.AddUint64(&mmCheckAccess.beforeCheckAccessCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckAccess.afterCheckAccessCounter, 1)
+
+	if mmCheckAccess.inspectFuncCheckAccess != nil {
+		mmCheckAccess.inspectFuncCheckAccess(ctx, params)
+	}
+
+	mm_params := AccessRepositoryMockCheckAccessParams{ctx, params}
+
+	// Record call args
+	mmCheckAccess.CheckAccessMock.mutex.Lock()
+	mmCheckAccess.CheckAccessMock.callArgs = append(mmCheckAccess.CheckAccessMock.callArgs, &mm_params)
+	mmCheckAccess.CheckAccessMock.mutex.Unlock()
+
+	for _, e := range mmCheckAccess.CheckAccessMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.allowed, e.results.err
+		}
+	}
+
+	if mmCheckAccess.CheckAccessMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckAccess.CheckAccessMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckAccess.CheckAccessMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckAccess.CheckAccessMock.defaultExpectation.paramPtrs
+
+		mm_got := AccessRepositoryMockCheckAccessParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckAccess.t.Errorf("AccessRepositoryMock.CheckAccess got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCheckAccess.t.Errorf("AccessRepositoryMock.CheckAccess got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckAccess.t.Errorf("AccessRepositoryMock.CheckAccess got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckAccess.CheckAccessMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckAccess.t.Fatal("No results are set for the AccessRepositoryMock.CheckAccess")
+		}
+		return (*mm_results).allowed, (*mm_results).err
+	}
+	if mmCheckAccess.funcCheckAccess != nil {
+		return mmCheckAccess.funcCheckAccess(ctx, params)
+	}
+	mmCheckAccess.t.Fatalf("Unexpected call to AccessRepositoryMock.CheckAccess. %v %v", ctx, params)
+	return
+}
+
+// CheckAccessAfterCounter returns a count of finished AccessRepositoryMock.CheckAccess invocations
+func (mmCheckAccess *AccessRepositoryMock) CheckAccessAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAccess.afterCheckAccessCounter)
+}
+
+// CheckAccessBeforeCounter returns a count of AccessRepositoryMock.CheckAccess invocations
+func (mmCheckAccess *AccessRepositoryMock) CheckAccessBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckAccess.beforeCheckAccessCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccessRepositoryMock.CheckAccess.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckAccess *mAccessRepositoryMockCheckAccess) Calls() []*AccessRepositoryMockCheckAccessParams {
+	mmCheckAccess.mutex.RLock()
+
+	argCopy := make([]*AccessRepositoryMockCheckAccessParams, len(mmCheckAccess.callArgs))
+	copy(argCopy, mmCheckAccess.callArgs)
+
+	mmCheckAccess.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckAccessDone returns true if the count of the CheckAccess invocations corresponds
+// the number of defined expectations
+func (m *AccessRepositoryMock) MinimockCheckAccessDone() bool {
+	if m.CheckAccessMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckAccessMock.invocationsDone()
+}
+
+// MinimockCheckAccessInspect logs each unmet expectation
+func (m *AccessRepositoryMock) MinimockCheckAccessInspect() {
+	for _, e := range m.CheckAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CheckAccess with params: %#v", *e.params)
+		}
+	}
+
+	afterCheckAccessCounter := mm_atomic.LoadUint64(&m.afterCheckAccessCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckAccessMock.defaultExpectation != nil && afterCheckAccessCounter < 1 {
+		if m.CheckAccessMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AccessRepositoryMock.CheckAccess")
+		} else {
+			m.t.Errorf("Expected call to AccessRepositoryMock.CheckAccess with params: %#v", *m.CheckAccessMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckAccess != nil && afterCheckAccessCounter < 1 {
+		m.t.Error("Expected call to AccessRepositoryMock.CheckAccess")
+	}
+
+	if !m.CheckAccessMock.invocationsDone() && afterCheckAccessCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccessRepositoryMock.CheckAccess but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckAccessMock.expectedInvocations), afterCheckAccessCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccessRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckAccessInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccessRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccessRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckAccessDone()
+}
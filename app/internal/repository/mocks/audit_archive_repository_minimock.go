@@ -0,0 +1,3250 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/repository.AuditArchiveRepository -o audit_archive_repository_minimock.go -n AuditArchiveRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditArchiveRepositoryMock implements repository.AuditArchiveRepository
+type AuditArchiveRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAcquireRetentionLock          func(ctx context.Context) (acquired bool, err error)
+	inspectFuncAcquireRetentionLock   func(ctx context.Context)
+	afterAcquireRetentionLockCounter  uint64
+	beforeAcquireRetentionLockCounter uint64
+	AcquireRetentionLockMock          mAuditArchiveRepositoryMockAcquireRetentionLock
+
+	funcCreateAuditArchive          func(ctx context.Context, params model.AuditArchive) (archiveID int64, err error)
+	inspectFuncCreateAuditArchive   func(ctx context.Context, params model.AuditArchive)
+	afterCreateAuditArchiveCounter  uint64
+	beforeCreateAuditArchiveCounter uint64
+	CreateAuditArchiveMock          mAuditArchiveRepositoryMockCreateAuditArchive
+
+	funcCreateAuditChainSeams          func(ctx context.Context, params model.CreateAuditChainSeamsParams) (err error)
+	inspectFuncCreateAuditChainSeams   func(ctx context.Context, params model.CreateAuditChainSeamsParams)
+	afterCreateAuditChainSeamsCounter  uint64
+	beforeCreateAuditChainSeamsCounter uint64
+	CreateAuditChainSeamsMock          mAuditArchiveRepositoryMockCreateAuditChainSeams
+
+	funcCreateAuditPartition          func(ctx context.Context, month time.Time) (err error)
+	inspectFuncCreateAuditPartition   func(ctx context.Context, month time.Time)
+	afterCreateAuditPartitionCounter  uint64
+	beforeCreateAuditPartitionCounter uint64
+	CreateAuditPartitionMock          mAuditArchiveRepositoryMockCreateAuditPartition
+
+	funcDropAuditPartition          func(ctx context.Context, params model.DropAuditPartitionParams) (err error)
+	inspectFuncDropAuditPartition   func(ctx context.Context, params model.DropAuditPartitionParams)
+	afterDropAuditPartitionCounter  uint64
+	beforeDropAuditPartitionCounter uint64
+	DropAuditPartitionMock          mAuditArchiveRepositoryMockDropAuditPartition
+
+	funcGetCurrentAuditMonth          func(ctx context.Context) (month time.Time, err error)
+	inspectFuncGetCurrentAuditMonth   func(ctx context.Context)
+	afterGetCurrentAuditMonthCounter  uint64
+	beforeGetCurrentAuditMonthCounter uint64
+	GetCurrentAuditMonthMock          mAuditArchiveRepositoryMockGetCurrentAuditMonth
+
+	funcGetMaxArchivedEventID          func(ctx context.Context) (eventID int64, err error)
+	inspectFuncGetMaxArchivedEventID   func(ctx context.Context)
+	afterGetMaxArchivedEventIDCounter  uint64
+	beforeGetMaxArchivedEventIDCounter uint64
+	GetMaxArchivedEventIDMock          mAuditArchiveRepositoryMockGetMaxArchivedEventID
+
+	funcListAuditChainSeams          func(ctx context.Context) (resp []model.AuditChainSeam, err error)
+	inspectFuncListAuditChainSeams   func(ctx context.Context)
+	afterListAuditChainSeamsCounter  uint64
+	beforeListAuditChainSeamsCounter uint64
+	ListAuditChainSeamsMock          mAuditArchiveRepositoryMockListAuditChainSeams
+
+	funcListAuditPartitionRecords          func(ctx context.Context, params model.ListAuditPartitionRecordsParams) (resp []model.AuditChainLink, err error)
+	inspectFuncListAuditPartitionRecords   func(ctx context.Context, params model.ListAuditPartitionRecordsParams)
+	afterListAuditPartitionRecordsCounter  uint64
+	beforeListAuditPartitionRecordsCounter uint64
+	ListAuditPartitionRecordsMock          mAuditArchiveRepositoryMockListAuditPartitionRecords
+
+	funcListAuditPartitions          func(ctx context.Context) (resp []model.AuditPartition, err error)
+	inspectFuncListAuditPartitions   func(ctx context.Context)
+	afterListAuditPartitionsCounter  uint64
+	beforeListAuditPartitionsCounter uint64
+	ListAuditPartitionsMock          mAuditArchiveRepositoryMockListAuditPartitions
+}
+
+// NewAuditArchiveRepositoryMock returns a mock for repository.AuditArchiveRepository
+func NewAuditArchiveRepositoryMock(t minimock.Tester) *AuditArchiveRepositoryMock {
+	m := &AuditArchiveRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcquireRetentionLockMock = mAuditArchiveRepositoryMockAcquireRetentionLock{mock: m}
+	m.AcquireRetentionLockMock.callArgs = []*AuditArchiveRepositoryMockAcquireRetentionLockParams{}
+
+	m.CreateAuditArchiveMock = mAuditArchiveRepositoryMockCreateAuditArchive{mock: m}
+	m.CreateAuditArchiveMock.callArgs = []*AuditArchiveRepositoryMockCreateAuditArchiveParams{}
+
+	m.CreateAuditChainSeamsMock = mAuditArchiveRepositoryMockCreateAuditChainSeams{mock: m}
+	m.CreateAuditChainSeamsMock.callArgs = []*AuditArchiveRepositoryMockCreateAuditChainSeamsParams{}
+
+	m.CreateAuditPartitionMock = mAuditArchiveRepositoryMockCreateAuditPartition{mock: m}
+	m.CreateAuditPartitionMock.callArgs = []*AuditArchiveRepositoryMockCreateAuditPartitionParams{}
+
+	m.DropAuditPartitionMock = mAuditArchiveRepositoryMockDropAuditPartition{mock: m}
+	m.DropAuditPartitionMock.callArgs = []*AuditArchiveRepositoryMockDropAuditPartitionParams{}
+
+	m.GetCurrentAuditMonthMock = mAuditArchiveRepositoryMockGetCurrentAuditMonth{mock: m}
+	m.GetCurrentAuditMonthMock.callArgs = []*AuditArchiveRepositoryMockGetCurrentAuditMonthParams{}
+
+	m.GetMaxArchivedEventIDMock = mAuditArchiveRepositoryMockGetMaxArchivedEventID{mock: m}
+	m.GetMaxArchivedEventIDMock.callArgs = []*AuditArchiveRepositoryMockGetMaxArchivedEventIDParams{}
+
+	m.ListAuditChainSeamsMock = mAuditArchiveRepositoryMockListAuditChainSeams{mock: m}
+	m.ListAuditChainSeamsMock.callArgs = []*AuditArchiveRepositoryMockListAuditChainSeamsParams{}
+
+	m.ListAuditPartitionRecordsMock = mAuditArchiveRepositoryMockListAuditPartitionRecords{mock: m}
+	m.ListAuditPartitionRecordsMock.callArgs = []*AuditArchiveRepositoryMockListAuditPartitionRecordsParams{}
+
+	m.ListAuditPartitionsMock = mAuditArchiveRepositoryMockListAuditPartitions{mock: m}
+	m.ListAuditPartitionsMock.callArgs = []*AuditArchiveRepositoryMockListAuditPartitionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditArchiveRepositoryMockAcquireRetentionLock struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockAcquireRetentionLockExpectation
+	expectations       []*AuditArchiveRepositoryMockAcquireRetentionLockExpectation
+
+	callArgs []*AuditArchiveRepositoryMockAcquireRetentionLockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockAcquireRetentionLockExpectation specifies expectation struct of the AuditArchiveRepository.AcquireRetentionLock
+type AuditArchiveRepositoryMockAcquireRetentionLockExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockAcquireRetentionLockParams
+	paramPtrs *AuditArchiveRepositoryMockAcquireRetentionLockParamPtrs
+	results   *AuditArchiveRepositoryMockAcquireRetentionLockResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockAcquireRetentionLockParams contains parameters of the AuditArchiveRepository.AcquireRetentionLock
+type AuditArchiveRepositoryMockAcquireRetentionLockParams struct {
+	ctx context.Context
+}
+
+// AuditArchiveRepositoryMockAcquireRetentionLockParamPtrs contains pointers to parameters of the AuditArchiveRepository.AcquireRetentionLock
+type AuditArchiveRepositoryMockAcquireRetentionLockParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditArchiveRepositoryMockAcquireRetentionLockResults contains results of the AuditArchiveRepository.AcquireRetentionLock
+type AuditArchiveRepositoryMockAcquireRetentionLockResults struct {
+	acquired bool
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Optional() *mAuditArchiveRepositoryMockAcquireRetentionLock {
+	mmAcquireRetentionLock.optional = true
+	return mmAcquireRetentionLock
+}
+
+// Expect sets up expected params for AuditArchiveRepository.AcquireRetentionLock
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Expect(ctx context.Context) *mAuditArchiveRepositoryMockAcquireRetentionLock {
+	if mmAcquireRetentionLock.mock.funcAcquireRetentionLock != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("AuditArchiveRepositoryMock.AcquireRetentionLock mock is already set by Set")
+	}
+
+	if mmAcquireRetentionLock.defaultExpectation == nil {
+		mmAcquireRetentionLock.defaultExpectation = &AuditArchiveRepositoryMockAcquireRetentionLockExpectation{}
+	}
+
+	if mmAcquireRetentionLock.defaultExpectation.paramPtrs != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("AuditArchiveRepositoryMock.AcquireRetentionLock mock is already set by ExpectParams functions")
+	}
+
+	mmAcquireRetentionLock.defaultExpectation.params = &AuditArchiveRepositoryMockAcquireRetentionLockParams{ctx}
+	for _, e := range mmAcquireRetentionLock.expectations {
+		if minimock.Equal(e.params, mmAcquireRetentionLock.defaultExpectation.params) {
+			mmAcquireRetentionLock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAcquireRetentionLock.defaultExpectation.params)
+		}
+	}
+
+	return mmAcquireRetentionLock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.AcquireRetentionLock
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockAcquireRetentionLock {
+	if mmAcquireRetentionLock.mock.funcAcquireRetentionLock != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("AuditArchiveRepositoryMock.AcquireRetentionLock mock is already set by Set")
+	}
+
+	if mmAcquireRetentionLock.defaultExpectation == nil {
+		mmAcquireRetentionLock.defaultExpectation = &AuditArchiveRepositoryMockAcquireRetentionLockExpectation{}
+	}
+
+	if mmAcquireRetentionLock.defaultExpectation.params != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("AuditArchiveRepositoryMock.AcquireRetentionLock mock is already set by Expect")
+	}
+
+	if mmAcquireRetentionLock.defaultExpectation.paramPtrs == nil {
+		mmAcquireRetentionLock.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockAcquireRetentionLockParamPtrs{}
+	}
+	mmAcquireRetentionLock.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAcquireRetentionLock
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.AcquireRetentionLock
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Inspect(f func(ctx context.Context)) *mAuditArchiveRepositoryMockAcquireRetentionLock {
+	if mmAcquireRetentionLock.mock.inspectFuncAcquireRetentionLock != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.AcquireRetentionLock")
+	}
+
+	mmAcquireRetentionLock.mock.inspectFuncAcquireRetentionLock = f
+
+	return mmAcquireRetentionLock
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.AcquireRetentionLock
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Return(acquired bool, err error) *AuditArchiveRepositoryMock {
+	if mmAcquireRetentionLock.mock.funcAcquireRetentionLock != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("AuditArchiveRepositoryMock.AcquireRetentionLock mock is already set by Set")
+	}
+
+	if mmAcquireRetentionLock.defaultExpectation == nil {
+		mmAcquireRetentionLock.defaultExpectation = &AuditArchiveRepositoryMockAcquireRetentionLockExpectation{mock: mmAcquireRetentionLock.mock}
+	}
+	mmAcquireRetentionLock.defaultExpectation.results = &AuditArchiveRepositoryMockAcquireRetentionLockResults{acquired, err}
+	return mmAcquireRetentionLock.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.AcquireRetentionLock method
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Set(f func(ctx context.Context) (acquired bool, err error)) *AuditArchiveRepositoryMock {
+	if mmAcquireRetentionLock.defaultExpectation != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.AcquireRetentionLock method")
+	}
+
+	if len(mmAcquireRetentionLock.expectations) > 0 {
+		mmAcquireRetentionLock.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.AcquireRetentionLock method")
+	}
+
+	mmAcquireRetentionLock.mock.funcAcquireRetentionLock = f
+	return mmAcquireRetentionLock.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.AcquireRetentionLock which will trigger the result defined by the following
+// Then helper
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) When(ctx context.Context) *AuditArchiveRepositoryMockAcquireRetentionLockExpectation {
+	if mmAcquireRetentionLock.mock.funcAcquireRetentionLock != nil {
+		mmAcquireRetentionLock.mock.t.Fatalf("AuditArchiveRepositoryMock.AcquireRetentionLock mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockAcquireRetentionLockExpectation{
+		mock:   mmAcquireRetentionLock.mock,
+		params: &AuditArchiveRepositoryMockAcquireRetentionLockParams{ctx},
+	}
+	mmAcquireRetentionLock.expectations = append(mmAcquireRetentionLock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.AcquireRetentionLock return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockAcquireRetentionLockExpectation) Then(acquired bool, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockAcquireRetentionLockResults{acquired, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.AcquireRetentionLock should be invoked
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Times(n uint64) *mAuditArchiveRepositoryMockAcquireRetentionLock {
+	if n == 0 {
+		mmAcquireRetentionLock.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.AcquireRetentionLock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAcquireRetentionLock.expectedInvocations, n)
+	return mmAcquireRetentionLock
+}
+
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) invocationsDone() bool {
+	if len(mmAcquireRetentionLock.expectations) == 0 && mmAcquireRetentionLock.defaultExpectation == nil && mmAcquireRetentionLock.mock.funcAcquireRetentionLock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAcquireRetentionLock.mock.afterAcquireRetentionLockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAcquireRetentionLock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AcquireRetentionLock implements repository.AuditArchiveRepository
+func (mmAcquireRetentionLock *AuditArchiveRepositoryMock) AcquireRetentionLock(ctx context.Context) (acquired bool, err error) {
+	mm_atomic.AddUint64(&mmAcquireRetentionLock.beforeAcquireRetentionLockCounter, 1)
+	defer mm_atomic.AddUint64(&mmAcquireRetentionLock.afterAcquireRetentionLockCounter, 1)
+
+	if mmAcquireRetentionLock.inspectFuncAcquireRetentionLock != nil {
+		mmAcquireRetentionLock.inspectFuncAcquireRetentionLock(ctx)
+	}
+
+	mm_params := AuditArchiveRepositoryMockAcquireRetentionLockParams{ctx}
+
+	// Record call args
+	mmAcquireRetentionLock.AcquireRetentionLockMock.mutex.Lock()
+	mmAcquireRetentionLock.AcquireRetentionLockMock.callArgs = append(mmAcquireRetentionLock.AcquireRetentionLockMock.callArgs, &mm_params)
+	mmAcquireRetentionLock.AcquireRetentionLockMock.mutex.Unlock()
+
+	for _, e := range mmAcquireRetentionLock.AcquireRetentionLockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.acquired, e.results.err
+		}
+	}
+
+	if mmAcquireRetentionLock.AcquireRetentionLockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAcquireRetentionLock.AcquireRetentionLockMock.defaultExpectation.Counter, 1)
+		mm_want := mmAcquireRetentionLock.AcquireRetentionLockMock.defaultExpectation.params
+		mm_want_ptrs := mmAcquireRetentionLock.AcquireRetentionLockMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockAcquireRetentionLockParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAcquireRetentionLock.t.Errorf("AuditArchiveRepositoryMock.AcquireRetentionLock got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAcquireRetentionLock.t.Errorf("AuditArchiveRepositoryMock.AcquireRetentionLock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAcquireRetentionLock.AcquireRetentionLockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAcquireRetentionLock.t.Fatal("No results are set for the AuditArchiveRepositoryMock.AcquireRetentionLock")
+		}
+		return (*mm_results).acquired, (*mm_results).err
+	}
+	if mmAcquireRetentionLock.funcAcquireRetentionLock != nil {
+		return mmAcquireRetentionLock.funcAcquireRetentionLock(ctx)
+	}
+	mmAcquireRetentionLock.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.AcquireRetentionLock. %v", ctx)
+	return
+}
+
+// AcquireRetentionLockAfterCounter returns a count of finished AuditArchiveRepositoryMock.AcquireRetentionLock invocations
+func (mmAcquireRetentionLock *AuditArchiveRepositoryMock) AcquireRetentionLockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAcquireRetentionLock.afterAcquireRetentionLockCounter)
+}
+
+// AcquireRetentionLockBeforeCounter returns a count of AuditArchiveRepositoryMock.AcquireRetentionLock invocations
+func (mmAcquireRetentionLock *AuditArchiveRepositoryMock) AcquireRetentionLockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAcquireRetentionLock.beforeAcquireRetentionLockCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.AcquireRetentionLock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAcquireRetentionLock *mAuditArchiveRepositoryMockAcquireRetentionLock) Calls() []*AuditArchiveRepositoryMockAcquireRetentionLockParams {
+	mmAcquireRetentionLock.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockAcquireRetentionLockParams, len(mmAcquireRetentionLock.callArgs))
+	copy(argCopy, mmAcquireRetentionLock.callArgs)
+
+	mmAcquireRetentionLock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcquireRetentionLockDone returns true if the count of the AcquireRetentionLock invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockAcquireRetentionLockDone() bool {
+	if m.AcquireRetentionLockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcquireRetentionLockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcquireRetentionLockMock.invocationsDone()
+}
+
+// MinimockAcquireRetentionLockInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockAcquireRetentionLockInspect() {
+	for _, e := range m.AcquireRetentionLockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.AcquireRetentionLock with params: %#v", *e.params)
+		}
+	}
+
+	afterAcquireRetentionLockCounter := mm_atomic.LoadUint64(&m.afterAcquireRetentionLockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcquireRetentionLockMock.defaultExpectation != nil && afterAcquireRetentionLockCounter < 1 {
+		if m.AcquireRetentionLockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.AcquireRetentionLock")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.AcquireRetentionLock with params: %#v", *m.AcquireRetentionLockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAcquireRetentionLock != nil && afterAcquireRetentionLockCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.AcquireRetentionLock")
+	}
+
+	if !m.AcquireRetentionLockMock.invocationsDone() && afterAcquireRetentionLockCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.AcquireRetentionLock but found %d calls",
+			mm_atomic.LoadUint64(&m.AcquireRetentionLockMock.expectedInvocations), afterAcquireRetentionLockCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockCreateAuditArchive struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockCreateAuditArchiveExpectation
+	expectations       []*AuditArchiveRepositoryMockCreateAuditArchiveExpectation
+
+	callArgs []*AuditArchiveRepositoryMockCreateAuditArchiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockCreateAuditArchiveExpectation specifies expectation struct of the AuditArchiveRepository.CreateAuditArchive
+type AuditArchiveRepositoryMockCreateAuditArchiveExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockCreateAuditArchiveParams
+	paramPtrs *AuditArchiveRepositoryMockCreateAuditArchiveParamPtrs
+	results   *AuditArchiveRepositoryMockCreateAuditArchiveResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockCreateAuditArchiveParams contains parameters of the AuditArchiveRepository.CreateAuditArchive
+type AuditArchiveRepositoryMockCreateAuditArchiveParams struct {
+	ctx    context.Context
+	params model.AuditArchive
+}
+
+// AuditArchiveRepositoryMockCreateAuditArchiveParamPtrs contains pointers to parameters of the AuditArchiveRepository.CreateAuditArchive
+type AuditArchiveRepositoryMockCreateAuditArchiveParamPtrs struct {
+	ctx    *context.Context
+	params *model.AuditArchive
+}
+
+// AuditArchiveRepositoryMockCreateAuditArchiveResults contains results of the AuditArchiveRepository.CreateAuditArchive
+type AuditArchiveRepositoryMockCreateAuditArchiveResults struct {
+	archiveID int64
+	err       error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Optional() *mAuditArchiveRepositoryMockCreateAuditArchive {
+	mmCreateAuditArchive.optional = true
+	return mmCreateAuditArchive
+}
+
+// Expect sets up expected params for AuditArchiveRepository.CreateAuditArchive
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Expect(ctx context.Context, params model.AuditArchive) *mAuditArchiveRepositoryMockCreateAuditArchive {
+	if mmCreateAuditArchive.mock.funcCreateAuditArchive != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Set")
+	}
+
+	if mmCreateAuditArchive.defaultExpectation == nil {
+		mmCreateAuditArchive.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditArchiveExpectation{}
+	}
+
+	if mmCreateAuditArchive.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuditArchive.defaultExpectation.params = &AuditArchiveRepositoryMockCreateAuditArchiveParams{ctx, params}
+	for _, e := range mmCreateAuditArchive.expectations {
+		if minimock.Equal(e.params, mmCreateAuditArchive.defaultExpectation.params) {
+			mmCreateAuditArchive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditArchive.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuditArchive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.CreateAuditArchive
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockCreateAuditArchive {
+	if mmCreateAuditArchive.mock.funcCreateAuditArchive != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Set")
+	}
+
+	if mmCreateAuditArchive.defaultExpectation == nil {
+		mmCreateAuditArchive.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditArchiveExpectation{}
+	}
+
+	if mmCreateAuditArchive.defaultExpectation.params != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Expect")
+	}
+
+	if mmCreateAuditArchive.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditArchive.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockCreateAuditArchiveParamPtrs{}
+	}
+	mmCreateAuditArchive.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAuditArchive
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditArchiveRepository.CreateAuditArchive
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) ExpectParamsParam2(params model.AuditArchive) *mAuditArchiveRepositoryMockCreateAuditArchive {
+	if mmCreateAuditArchive.mock.funcCreateAuditArchive != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Set")
+	}
+
+	if mmCreateAuditArchive.defaultExpectation == nil {
+		mmCreateAuditArchive.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditArchiveExpectation{}
+	}
+
+	if mmCreateAuditArchive.defaultExpectation.params != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Expect")
+	}
+
+	if mmCreateAuditArchive.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditArchive.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockCreateAuditArchiveParamPtrs{}
+	}
+	mmCreateAuditArchive.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateAuditArchive
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.CreateAuditArchive
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Inspect(f func(ctx context.Context, params model.AuditArchive)) *mAuditArchiveRepositoryMockCreateAuditArchive {
+	if mmCreateAuditArchive.mock.inspectFuncCreateAuditArchive != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.CreateAuditArchive")
+	}
+
+	mmCreateAuditArchive.mock.inspectFuncCreateAuditArchive = f
+
+	return mmCreateAuditArchive
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.CreateAuditArchive
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Return(archiveID int64, err error) *AuditArchiveRepositoryMock {
+	if mmCreateAuditArchive.mock.funcCreateAuditArchive != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Set")
+	}
+
+	if mmCreateAuditArchive.defaultExpectation == nil {
+		mmCreateAuditArchive.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditArchiveExpectation{mock: mmCreateAuditArchive.mock}
+	}
+	mmCreateAuditArchive.defaultExpectation.results = &AuditArchiveRepositoryMockCreateAuditArchiveResults{archiveID, err}
+	return mmCreateAuditArchive.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.CreateAuditArchive method
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Set(f func(ctx context.Context, params model.AuditArchive) (archiveID int64, err error)) *AuditArchiveRepositoryMock {
+	if mmCreateAuditArchive.defaultExpectation != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.CreateAuditArchive method")
+	}
+
+	if len(mmCreateAuditArchive.expectations) > 0 {
+		mmCreateAuditArchive.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.CreateAuditArchive method")
+	}
+
+	mmCreateAuditArchive.mock.funcCreateAuditArchive = f
+	return mmCreateAuditArchive.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.CreateAuditArchive which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) When(ctx context.Context, params model.AuditArchive) *AuditArchiveRepositoryMockCreateAuditArchiveExpectation {
+	if mmCreateAuditArchive.mock.funcCreateAuditArchive != nil {
+		mmCreateAuditArchive.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditArchive mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockCreateAuditArchiveExpectation{
+		mock:   mmCreateAuditArchive.mock,
+		params: &AuditArchiveRepositoryMockCreateAuditArchiveParams{ctx, params},
+	}
+	mmCreateAuditArchive.expectations = append(mmCreateAuditArchive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.CreateAuditArchive return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockCreateAuditArchiveExpectation) Then(archiveID int64, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockCreateAuditArchiveResults{archiveID, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.CreateAuditArchive should be invoked
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Times(n uint64) *mAuditArchiveRepositoryMockCreateAuditArchive {
+	if n == 0 {
+		mmCreateAuditArchive.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.CreateAuditArchive mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuditArchive.expectedInvocations, n)
+	return mmCreateAuditArchive
+}
+
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) invocationsDone() bool {
+	if len(mmCreateAuditArchive.expectations) == 0 && mmCreateAuditArchive.defaultExpectation == nil && mmCreateAuditArchive.mock.funcCreateAuditArchive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditArchive.mock.afterCreateAuditArchiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditArchive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuditArchive implements repository.AuditArchiveRepository
+func (mmCreateAuditArchive *AuditArchiveRepositoryMock) CreateAuditArchive(ctx context.Context, params model.AuditArchive) (archiveID int64, err error) {
+	mm_atomic.AddUint64(&mmCreateAuditArchive.beforeCreateAuditArchiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditArchive.afterCreateAuditArchiveCounter, 1)
+
+	if mmCreateAuditArchive.inspectFuncCreateAuditArchive != nil {
+		mmCreateAuditArchive.inspectFuncCreateAuditArchive(ctx, params)
+	}
+
+	mm_params := AuditArchiveRepositoryMockCreateAuditArchiveParams{ctx, params}
+
+	// Record call args
+	mmCreateAuditArchive.CreateAuditArchiveMock.mutex.Lock()
+	mmCreateAuditArchive.CreateAuditArchiveMock.callArgs = append(mmCreateAuditArchive.CreateAuditArchiveMock.callArgs, &mm_params)
+	mmCreateAuditArchive.CreateAuditArchiveMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuditArchive.CreateAuditArchiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.archiveID, e.results.err
+		}
+	}
+
+	if mmCreateAuditArchive.CreateAuditArchiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditArchive.CreateAuditArchiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditArchive.CreateAuditArchiveMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditArchive.CreateAuditArchiveMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockCreateAuditArchiveParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuditArchive.t.Errorf("AuditArchiveRepositoryMock.CreateAuditArchive got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateAuditArchive.t.Errorf("AuditArchiveRepositoryMock.CreateAuditArchive got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuditArchive.t.Errorf("AuditArchiveRepositoryMock.CreateAuditArchive got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuditArchive.CreateAuditArchiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuditArchive.t.Fatal("No results are set for the AuditArchiveRepositoryMock.CreateAuditArchive")
+		}
+		return (*mm_results).archiveID, (*mm_results).err
+	}
+	if mmCreateAuditArchive.funcCreateAuditArchive != nil {
+		return mmCreateAuditArchive.funcCreateAuditArchive(ctx, params)
+	}
+	mmCreateAuditArchive.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.CreateAuditArchive. %v %v", ctx, params)
+	return
+}
+
+// CreateAuditArchiveAfterCounter returns a count of finished AuditArchiveRepositoryMock.CreateAuditArchive invocations
+func (mmCreateAuditArchive *AuditArchiveRepositoryMock) CreateAuditArchiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditArchive.afterCreateAuditArchiveCounter)
+}
+
+// CreateAuditArchiveBeforeCounter returns a count of AuditArchiveRepositoryMock.CreateAuditArchive invocations
+func (mmCreateAuditArchive *AuditArchiveRepositoryMock) CreateAuditArchiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditArchive.beforeCreateAuditArchiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.CreateAuditArchive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuditArchive *mAuditArchiveRepositoryMockCreateAuditArchive) Calls() []*AuditArchiveRepositoryMockCreateAuditArchiveParams {
+	mmCreateAuditArchive.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockCreateAuditArchiveParams, len(mmCreateAuditArchive.callArgs))
+	copy(argCopy, mmCreateAuditArchive.callArgs)
+
+	mmCreateAuditArchive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditArchiveDone returns true if the count of the CreateAuditArchive invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockCreateAuditArchiveDone() bool {
+	if m.CreateAuditArchiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditArchiveMock.invocationsDone()
+}
+
+// MinimockCreateAuditArchiveInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockCreateAuditArchiveInspect() {
+	for _, e := range m.CreateAuditArchiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.CreateAuditArchive with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAuditArchiveCounter := mm_atomic.LoadUint64(&m.afterCreateAuditArchiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditArchiveMock.defaultExpectation != nil && afterCreateAuditArchiveCounter < 1 {
+		if m.CreateAuditArchiveMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.CreateAuditArchive")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.CreateAuditArchive with params: %#v", *m.CreateAuditArchiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuditArchive != nil && afterCreateAuditArchiveCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.CreateAuditArchive")
+	}
+
+	if !m.CreateAuditArchiveMock.invocationsDone() && afterCreateAuditArchiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.CreateAuditArchive but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditArchiveMock.expectedInvocations), afterCreateAuditArchiveCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockCreateAuditChainSeams struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation
+	expectations       []*AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation
+
+	callArgs []*AuditArchiveRepositoryMockCreateAuditChainSeamsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation specifies expectation struct of the AuditArchiveRepository.CreateAuditChainSeams
+type AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockCreateAuditChainSeamsParams
+	paramPtrs *AuditArchiveRepositoryMockCreateAuditChainSeamsParamPtrs
+	results   *AuditArchiveRepositoryMockCreateAuditChainSeamsResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockCreateAuditChainSeamsParams contains parameters of the AuditArchiveRepository.CreateAuditChainSeams
+type AuditArchiveRepositoryMockCreateAuditChainSeamsParams struct {
+	ctx    context.Context
+	params model.CreateAuditChainSeamsParams
+}
+
+// AuditArchiveRepositoryMockCreateAuditChainSeamsParamPtrs contains pointers to parameters of the AuditArchiveRepository.CreateAuditChainSeams
+type AuditArchiveRepositoryMockCreateAuditChainSeamsParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateAuditChainSeamsParams
+}
+
+// AuditArchiveRepositoryMockCreateAuditChainSeamsResults contains results of the AuditArchiveRepository.CreateAuditChainSeams
+type AuditArchiveRepositoryMockCreateAuditChainSeamsResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Optional() *mAuditArchiveRepositoryMockCreateAuditChainSeams {
+	mmCreateAuditChainSeams.optional = true
+	return mmCreateAuditChainSeams
+}
+
+// Expect sets up expected params for AuditArchiveRepository.CreateAuditChainSeams
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Expect(ctx context.Context, params model.CreateAuditChainSeamsParams) *mAuditArchiveRepositoryMockCreateAuditChainSeams {
+	if mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Set")
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation == nil {
+		mmCreateAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation{}
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuditChainSeams.defaultExpectation.params = &AuditArchiveRepositoryMockCreateAuditChainSeamsParams{ctx, params}
+	for _, e := range mmCreateAuditChainSeams.expectations {
+		if minimock.Equal(e.params, mmCreateAuditChainSeams.defaultExpectation.params) {
+			mmCreateAuditChainSeams.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditChainSeams.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuditChainSeams
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.CreateAuditChainSeams
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockCreateAuditChainSeams {
+	if mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Set")
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation == nil {
+		mmCreateAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation{}
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation.params != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Expect")
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditChainSeams.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockCreateAuditChainSeamsParamPtrs{}
+	}
+	mmCreateAuditChainSeams.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAuditChainSeams
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditArchiveRepository.CreateAuditChainSeams
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) ExpectParamsParam2(params model.CreateAuditChainSeamsParams) *mAuditArchiveRepositoryMockCreateAuditChainSeams {
+	if mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Set")
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation == nil {
+		mmCreateAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation{}
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation.params != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Expect")
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditChainSeams.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockCreateAuditChainSeamsParamPtrs{}
+	}
+	mmCreateAuditChainSeams.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateAuditChainSeams
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.CreateAuditChainSeams
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Inspect(f func(ctx context.Context, params model.CreateAuditChainSeamsParams)) *mAuditArchiveRepositoryMockCreateAuditChainSeams {
+	if mmCreateAuditChainSeams.mock.inspectFuncCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.CreateAuditChainSeams")
+	}
+
+	mmCreateAuditChainSeams.mock.inspectFuncCreateAuditChainSeams = f
+
+	return mmCreateAuditChainSeams
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.CreateAuditChainSeams
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Return(err error) *AuditArchiveRepositoryMock {
+	if mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Set")
+	}
+
+	if mmCreateAuditChainSeams.defaultExpectation == nil {
+		mmCreateAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation{mock: mmCreateAuditChainSeams.mock}
+	}
+	mmCreateAuditChainSeams.defaultExpectation.results = &AuditArchiveRepositoryMockCreateAuditChainSeamsResults{err}
+	return mmCreateAuditChainSeams.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.CreateAuditChainSeams method
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Set(f func(ctx context.Context, params model.CreateAuditChainSeamsParams) (err error)) *AuditArchiveRepositoryMock {
+	if mmCreateAuditChainSeams.defaultExpectation != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.CreateAuditChainSeams method")
+	}
+
+	if len(mmCreateAuditChainSeams.expectations) > 0 {
+		mmCreateAuditChainSeams.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.CreateAuditChainSeams method")
+	}
+
+	mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams = f
+	return mmCreateAuditChainSeams.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.CreateAuditChainSeams which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) When(ctx context.Context, params model.CreateAuditChainSeamsParams) *AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation {
+	if mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditChainSeams mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation{
+		mock:   mmCreateAuditChainSeams.mock,
+		params: &AuditArchiveRepositoryMockCreateAuditChainSeamsParams{ctx, params},
+	}
+	mmCreateAuditChainSeams.expectations = append(mmCreateAuditChainSeams.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.CreateAuditChainSeams return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockCreateAuditChainSeamsExpectation) Then(err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockCreateAuditChainSeamsResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.CreateAuditChainSeams should be invoked
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Times(n uint64) *mAuditArchiveRepositoryMockCreateAuditChainSeams {
+	if n == 0 {
+		mmCreateAuditChainSeams.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.CreateAuditChainSeams mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuditChainSeams.expectedInvocations, n)
+	return mmCreateAuditChainSeams
+}
+
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) invocationsDone() bool {
+	if len(mmCreateAuditChainSeams.expectations) == 0 && mmCreateAuditChainSeams.defaultExpectation == nil && mmCreateAuditChainSeams.mock.funcCreateAuditChainSeams == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditChainSeams.mock.afterCreateAuditChainSeamsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditChainSeams.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuditChainSeams implements repository.AuditArchiveRepository
+func (mmCreateAuditChainSeams *AuditArchiveRepositoryMock) CreateAuditChainSeams(ctx context.Context, params model.CreateAuditChainSeamsParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuditChainSeams.beforeCreateAuditChainSeamsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditChainSeams.afterCreateAuditChainSeamsCounter, 1)
+
+	if mmCreateAuditChainSeams.inspectFuncCreateAuditChainSeams != nil {
+		mmCreateAuditChainSeams.inspectFuncCreateAuditChainSeams(ctx, params)
+	}
+
+	mm_params := AuditArchiveRepositoryMockCreateAuditChainSeamsParams{ctx, params}
+
+	// Record call args
+	mmCreateAuditChainSeams.CreateAuditChainSeamsMock.mutex.Lock()
+	mmCreateAuditChainSeams.CreateAuditChainSeamsMock.callArgs = append(mmCreateAuditChainSeams.CreateAuditChainSeamsMock.callArgs, &mm_params)
+	mmCreateAuditChainSeams.CreateAuditChainSeamsMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuditChainSeams.CreateAuditChainSeamsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAuditChainSeams.CreateAuditChainSeamsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditChainSeams.CreateAuditChainSeamsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditChainSeams.CreateAuditChainSeamsMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditChainSeams.CreateAuditChainSeamsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockCreateAuditChainSeamsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuditChainSeams.t.Errorf("AuditArchiveRepositoryMock.CreateAuditChainSeams got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateAuditChainSeams.t.Errorf("AuditArchiveRepositoryMock.CreateAuditChainSeams got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuditChainSeams.t.Errorf("AuditArchiveRepositoryMock.CreateAuditChainSeams got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuditChainSeams.CreateAuditChainSeamsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuditChainSeams.t.Fatal("No results are set for the AuditArchiveRepositoryMock.CreateAuditChainSeams")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAuditChainSeams.funcCreateAuditChainSeams != nil {
+		return mmCreateAuditChainSeams.funcCreateAuditChainSeams(ctx, params)
+	}
+	mmCreateAuditChainSeams.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.CreateAuditChainSeams. %v %v", ctx, params)
+	return
+}
+
+// CreateAuditChainSeamsAfterCounter returns a count of finished AuditArchiveRepositoryMock.CreateAuditChainSeams invocations
+func (mmCreateAuditChainSeams *AuditArchiveRepositoryMock) CreateAuditChainSeamsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditChainSeams.afterCreateAuditChainSeamsCounter)
+}
+
+// CreateAuditChainSeamsBeforeCounter returns a count of AuditArchiveRepositoryMock.CreateAuditChainSeams invocations
+func (mmCreateAuditChainSeams *AuditArchiveRepositoryMock) CreateAuditChainSeamsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditChainSeams.beforeCreateAuditChainSeamsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.CreateAuditChainSeams.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuditChainSeams *mAuditArchiveRepositoryMockCreateAuditChainSeams) Calls() []*AuditArchiveRepositoryMockCreateAuditChainSeamsParams {
+	mmCreateAuditChainSeams.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockCreateAuditChainSeamsParams, len(mmCreateAuditChainSeams.callArgs))
+	copy(argCopy, mmCreateAuditChainSeams.callArgs)
+
+	mmCreateAuditChainSeams.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditChainSeamsDone returns true if the count of the CreateAuditChainSeams invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockCreateAuditChainSeamsDone() bool {
+	if m.CreateAuditChainSeamsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditChainSeamsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditChainSeamsMock.invocationsDone()
+}
+
+// MinimockCreateAuditChainSeamsInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockCreateAuditChainSeamsInspect() {
+	for _, e := range m.CreateAuditChainSeamsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.CreateAuditChainSeams with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAuditChainSeamsCounter := mm_atomic.LoadUint64(&m.afterCreateAuditChainSeamsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditChainSeamsMock.defaultExpectation != nil && afterCreateAuditChainSeamsCounter < 1 {
+		if m.CreateAuditChainSeamsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.CreateAuditChainSeams")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.CreateAuditChainSeams with params: %#v", *m.CreateAuditChainSeamsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuditChainSeams != nil && afterCreateAuditChainSeamsCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.CreateAuditChainSeams")
+	}
+
+	if !m.CreateAuditChainSeamsMock.invocationsDone() && afterCreateAuditChainSeamsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.CreateAuditChainSeams but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditChainSeamsMock.expectedInvocations), afterCreateAuditChainSeamsCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockCreateAuditPartition struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockCreateAuditPartitionExpectation
+	expectations       []*AuditArchiveRepositoryMockCreateAuditPartitionExpectation
+
+	callArgs []*AuditArchiveRepositoryMockCreateAuditPartitionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockCreateAuditPartitionExpectation specifies expectation struct of the AuditArchiveRepository.CreateAuditPartition
+type AuditArchiveRepositoryMockCreateAuditPartitionExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockCreateAuditPartitionParams
+	paramPtrs *AuditArchiveRepositoryMockCreateAuditPartitionParamPtrs
+	results   *AuditArchiveRepositoryMockCreateAuditPartitionResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockCreateAuditPartitionParams contains parameters of the AuditArchiveRepository.CreateAuditPartition
+type AuditArchiveRepositoryMockCreateAuditPartitionParams struct {
+	ctx   context.Context
+	month time.Time
+}
+
+// AuditArchiveRepositoryMockCreateAuditPartitionParamPtrs contains pointers to parameters of the AuditArchiveRepository.CreateAuditPartition
+type AuditArchiveRepositoryMockCreateAuditPartitionParamPtrs struct {
+	ctx   *context.Context
+	month *time.Time
+}
+
+// AuditArchiveRepositoryMockCreateAuditPartitionResults contains results of the AuditArchiveRepository.CreateAuditPartition
+type AuditArchiveRepositoryMockCreateAuditPartitionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Optional() *mAuditArchiveRepositoryMockCreateAuditPartition {
+	mmCreateAuditPartition.optional = true
+	return mmCreateAuditPartition
+}
+
+// Expect sets up expected params for AuditArchiveRepository.CreateAuditPartition
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Expect(ctx context.Context, month time.Time) *mAuditArchiveRepositoryMockCreateAuditPartition {
+	if mmCreateAuditPartition.mock.funcCreateAuditPartition != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Set")
+	}
+
+	if mmCreateAuditPartition.defaultExpectation == nil {
+		mmCreateAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditPartitionExpectation{}
+	}
+
+	if mmCreateAuditPartition.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuditPartition.defaultExpectation.params = &AuditArchiveRepositoryMockCreateAuditPartitionParams{ctx, month}
+	for _, e := range mmCreateAuditPartition.expectations {
+		if minimock.Equal(e.params, mmCreateAuditPartition.defaultExpectation.params) {
+			mmCreateAuditPartition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditPartition.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuditPartition
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.CreateAuditPartition
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockCreateAuditPartition {
+	if mmCreateAuditPartition.mock.funcCreateAuditPartition != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Set")
+	}
+
+	if mmCreateAuditPartition.defaultExpectation == nil {
+		mmCreateAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditPartitionExpectation{}
+	}
+
+	if mmCreateAuditPartition.defaultExpectation.params != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Expect")
+	}
+
+	if mmCreateAuditPartition.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditPartition.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockCreateAuditPartitionParamPtrs{}
+	}
+	mmCreateAuditPartition.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAuditPartition
+}
+
+// ExpectMonthParam2 sets up expected param month for AuditArchiveRepository.CreateAuditPartition
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) ExpectMonthParam2(month time.Time) *mAuditArchiveRepositoryMockCreateAuditPartition {
+	if mmCreateAuditPartition.mock.funcCreateAuditPartition != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Set")
+	}
+
+	if mmCreateAuditPartition.defaultExpectation == nil {
+		mmCreateAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditPartitionExpectation{}
+	}
+
+	if mmCreateAuditPartition.defaultExpectation.params != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Expect")
+	}
+
+	if mmCreateAuditPartition.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditPartition.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockCreateAuditPartitionParamPtrs{}
+	}
+	mmCreateAuditPartition.defaultExpectation.paramPtrs.month = &month
+
+	return mmCreateAuditPartition
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.CreateAuditPartition
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Inspect(f func(ctx context.Context, month time.Time)) *mAuditArchiveRepositoryMockCreateAuditPartition {
+	if mmCreateAuditPartition.mock.inspectFuncCreateAuditPartition != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.CreateAuditPartition")
+	}
+
+	mmCreateAuditPartition.mock.inspectFuncCreateAuditPartition = f
+
+	return mmCreateAuditPartition
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.CreateAuditPartition
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Return(err error) *AuditArchiveRepositoryMock {
+	if mmCreateAuditPartition.mock.funcCreateAuditPartition != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Set")
+	}
+
+	if mmCreateAuditPartition.defaultExpectation == nil {
+		mmCreateAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockCreateAuditPartitionExpectation{mock: mmCreateAuditPartition.mock}
+	}
+	mmCreateAuditPartition.defaultExpectation.results = &AuditArchiveRepositoryMockCreateAuditPartitionResults{err}
+	return mmCreateAuditPartition.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.CreateAuditPartition method
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Set(f func(ctx context.Context, month time.Time) (err error)) *AuditArchiveRepositoryMock {
+	if mmCreateAuditPartition.defaultExpectation != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.CreateAuditPartition method")
+	}
+
+	if len(mmCreateAuditPartition.expectations) > 0 {
+		mmCreateAuditPartition.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.CreateAuditPartition method")
+	}
+
+	mmCreateAuditPartition.mock.funcCreateAuditPartition = f
+	return mmCreateAuditPartition.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.CreateAuditPartition which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) When(ctx context.Context, month time.Time) *AuditArchiveRepositoryMockCreateAuditPartitionExpectation {
+	if mmCreateAuditPartition.mock.funcCreateAuditPartition != nil {
+		mmCreateAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.CreateAuditPartition mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockCreateAuditPartitionExpectation{
+		mock:   mmCreateAuditPartition.mock,
+		params: &AuditArchiveRepositoryMockCreateAuditPartitionParams{ctx, month},
+	}
+	mmCreateAuditPartition.expectations = append(mmCreateAuditPartition.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.CreateAuditPartition return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockCreateAuditPartitionExpectation) Then(err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockCreateAuditPartitionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.CreateAuditPartition should be invoked
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Times(n uint64) *mAuditArchiveRepositoryMockCreateAuditPartition {
+	if n == 0 {
+		mmCreateAuditPartition.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.CreateAuditPartition mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuditPartition.expectedInvocations, n)
+	return mmCreateAuditPartition
+}
+
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) invocationsDone() bool {
+	if len(mmCreateAuditPartition.expectations) == 0 && mmCreateAuditPartition.defaultExpectation == nil && mmCreateAuditPartition.mock.funcCreateAuditPartition == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditPartition.mock.afterCreateAuditPartitionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditPartition.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuditPartition implements repository.AuditArchiveRepository
+func (mmCreateAuditPartition *AuditArchiveRepositoryMock) CreateAuditPartition(ctx context.Context, month time.Time) (err error) {
+	mm_atomic.AddUint64(&mmCreateAuditPartition.beforeCreateAuditPartitionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditPartition.afterCreateAuditPartitionCounter, 1)
+
+	if mmCreateAuditPartition.inspectFuncCreateAuditPartition != nil {
+		mmCreateAuditPartition.inspectFuncCreateAuditPartition(ctx, month)
+	}
+
+	mm_params := AuditArchiveRepositoryMockCreateAuditPartitionParams{ctx, month}
+
+	// Record call args
+	mmCreateAuditPartition.CreateAuditPartitionMock.mutex.Lock()
+	mmCreateAuditPartition.CreateAuditPartitionMock.callArgs = append(mmCreateAuditPartition.CreateAuditPartitionMock.callArgs, &mm_params)
+	mmCreateAuditPartition.CreateAuditPartitionMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuditPartition.CreateAuditPartitionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAuditPartition.CreateAuditPartitionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditPartition.CreateAuditPartitionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditPartition.CreateAuditPartitionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditPartition.CreateAuditPartitionMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockCreateAuditPartitionParams{ctx, month}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuditPartition.t.Errorf("AuditArchiveRepositoryMock.CreateAuditPartition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.month != nil && !minimock.Equal(*mm_want_ptrs.month, mm_got.month) {
+				mmCreateAuditPartition.t.Errorf("AuditArchiveRepositoryMock.CreateAuditPartition got unexpected parameter month, want: %#v, got: %#v%s\n", *mm_want_ptrs.month, mm_got.month, minimock.Diff(*mm_want_ptrs.month, mm_got.month))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuditPartition.t.Errorf("AuditArchiveRepositoryMock.CreateAuditPartition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuditPartition.CreateAuditPartitionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuditPartition.t.Fatal("No results are set for the AuditArchiveRepositoryMock.CreateAuditPartition")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAuditPartition.funcCreateAuditPartition != nil {
+		return mmCreateAuditPartition.funcCreateAuditPartition(ctx, month)
+	}
+	mmCreateAuditPartition.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.CreateAuditPartition. %v %v", ctx, month)
+	return
+}
+
+// CreateAuditPartitionAfterCounter returns a count of finished AuditArchiveRepositoryMock.CreateAuditPartition invocations
+func (mmCreateAuditPartition *AuditArchiveRepositoryMock) CreateAuditPartitionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditPartition.afterCreateAuditPartitionCounter)
+}
+
+// CreateAuditPartitionBeforeCounter returns a count of AuditArchiveRepositoryMock.CreateAuditPartition invocations
+func (mmCreateAuditPartition *AuditArchiveRepositoryMock) CreateAuditPartitionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditPartition.beforeCreateAuditPartitionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.CreateAuditPartition.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuditPartition *mAuditArchiveRepositoryMockCreateAuditPartition) Calls() []*AuditArchiveRepositoryMockCreateAuditPartitionParams {
+	mmCreateAuditPartition.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockCreateAuditPartitionParams, len(mmCreateAuditPartition.callArgs))
+	copy(argCopy, mmCreateAuditPartition.callArgs)
+
+	mmCreateAuditPartition.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditPartitionDone returns true if the count of the CreateAuditPartition invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockCreateAuditPartitionDone() bool {
+	if m.CreateAuditPartitionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditPartitionMock.invocationsDone()
+}
+
+// MinimockCreateAuditPartitionInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockCreateAuditPartitionInspect() {
+	for _, e := range m.CreateAuditPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.CreateAuditPartition with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAuditPartitionCounter := mm_atomic.LoadUint64(&m.afterCreateAuditPartitionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditPartitionMock.defaultExpectation != nil && afterCreateAuditPartitionCounter < 1 {
+		if m.CreateAuditPartitionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.CreateAuditPartition")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.CreateAuditPartition with params: %#v", *m.CreateAuditPartitionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuditPartition != nil && afterCreateAuditPartitionCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.CreateAuditPartition")
+	}
+
+	if !m.CreateAuditPartitionMock.invocationsDone() && afterCreateAuditPartitionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.CreateAuditPartition but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditPartitionMock.expectedInvocations), afterCreateAuditPartitionCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockDropAuditPartition struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockDropAuditPartitionExpectation
+	expectations       []*AuditArchiveRepositoryMockDropAuditPartitionExpectation
+
+	callArgs []*AuditArchiveRepositoryMockDropAuditPartitionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockDropAuditPartitionExpectation specifies expectation struct of the AuditArchiveRepository.DropAuditPartition
+type AuditArchiveRepositoryMockDropAuditPartitionExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockDropAuditPartitionParams
+	paramPtrs *AuditArchiveRepositoryMockDropAuditPartitionParamPtrs
+	results   *AuditArchiveRepositoryMockDropAuditPartitionResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockDropAuditPartitionParams contains parameters of the AuditArchiveRepository.DropAuditPartition
+type AuditArchiveRepositoryMockDropAuditPartitionParams struct {
+	ctx    context.Context
+	params model.DropAuditPartitionParams
+}
+
+// AuditArchiveRepositoryMockDropAuditPartitionParamPtrs contains pointers to parameters of the AuditArchiveRepository.DropAuditPartition
+type AuditArchiveRepositoryMockDropAuditPartitionParamPtrs struct {
+	ctx    *context.Context
+	params *model.DropAuditPartitionParams
+}
+
+// AuditArchiveRepositoryMockDropAuditPartitionResults contains results of the AuditArchiveRepository.DropAuditPartition
+type AuditArchiveRepositoryMockDropAuditPartitionResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Optional() *mAuditArchiveRepositoryMockDropAuditPartition {
+	mmDropAuditPartition.optional = true
+	return mmDropAuditPartition
+}
+
+// Expect sets up expected params for AuditArchiveRepository.DropAuditPartition
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Expect(ctx context.Context, params model.DropAuditPartitionParams) *mAuditArchiveRepositoryMockDropAuditPartition {
+	if mmDropAuditPartition.mock.funcDropAuditPartition != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Set")
+	}
+
+	if mmDropAuditPartition.defaultExpectation == nil {
+		mmDropAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockDropAuditPartitionExpectation{}
+	}
+
+	if mmDropAuditPartition.defaultExpectation.paramPtrs != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by ExpectParams functions")
+	}
+
+	mmDropAuditPartition.defaultExpectation.params = &AuditArchiveRepositoryMockDropAuditPartitionParams{ctx, params}
+	for _, e := range mmDropAuditPartition.expectations {
+		if minimock.Equal(e.params, mmDropAuditPartition.defaultExpectation.params) {
+			mmDropAuditPartition.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDropAuditPartition.defaultExpectation.params)
+		}
+	}
+
+	return mmDropAuditPartition
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.DropAuditPartition
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockDropAuditPartition {
+	if mmDropAuditPartition.mock.funcDropAuditPartition != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Set")
+	}
+
+	if mmDropAuditPartition.defaultExpectation == nil {
+		mmDropAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockDropAuditPartitionExpectation{}
+	}
+
+	if mmDropAuditPartition.defaultExpectation.params != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Expect")
+	}
+
+	if mmDropAuditPartition.defaultExpectation.paramPtrs == nil {
+		mmDropAuditPartition.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockDropAuditPartitionParamPtrs{}
+	}
+	mmDropAuditPartition.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDropAuditPartition
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditArchiveRepository.DropAuditPartition
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) ExpectParamsParam2(params model.DropAuditPartitionParams) *mAuditArchiveRepositoryMockDropAuditPartition {
+	if mmDropAuditPartition.mock.funcDropAuditPartition != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Set")
+	}
+
+	if mmDropAuditPartition.defaultExpectation == nil {
+		mmDropAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockDropAuditPartitionExpectation{}
+	}
+
+	if mmDropAuditPartition.defaultExpectation.params != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Expect")
+	}
+
+	if mmDropAuditPartition.defaultExpectation.paramPtrs == nil {
+		mmDropAuditPartition.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockDropAuditPartitionParamPtrs{}
+	}
+	mmDropAuditPartition.defaultExpectation.paramPtrs.params = &params
+
+	return mmDropAuditPartition
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.DropAuditPartition
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Inspect(f func(ctx context.Context, params model.DropAuditPartitionParams)) *mAuditArchiveRepositoryMockDropAuditPartition {
+	if mmDropAuditPartition.mock.inspectFuncDropAuditPartition != nil {
+		mmDropAuditPartition.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.DropAuditPartition")
+	}
+
+	mmDropAuditPartition.mock.inspectFuncDropAuditPartition = f
+
+	return mmDropAuditPartition
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.DropAuditPartition
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Return(err error) *AuditArchiveRepositoryMock {
+	if mmDropAuditPartition.mock.funcDropAuditPartition != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Set")
+	}
+
+	if mmDropAuditPartition.defaultExpectation == nil {
+		mmDropAuditPartition.defaultExpectation = &AuditArchiveRepositoryMockDropAuditPartitionExpectation{mock: mmDropAuditPartition.mock}
+	}
+	mmDropAuditPartition.defaultExpectation.results = &AuditArchiveRepositoryMockDropAuditPartitionResults{err}
+	return mmDropAuditPartition.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.DropAuditPartition method
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Set(f func(ctx context.Context, params model.DropAuditPartitionParams) (err error)) *AuditArchiveRepositoryMock {
+	if mmDropAuditPartition.defaultExpectation != nil {
+		mmDropAuditPartition.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.DropAuditPartition method")
+	}
+
+	if len(mmDropAuditPartition.expectations) > 0 {
+		mmDropAuditPartition.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.DropAuditPartition method")
+	}
+
+	mmDropAuditPartition.mock.funcDropAuditPartition = f
+	return mmDropAuditPartition.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.DropAuditPartition which will trigger the result defined by the following
+// Then helper
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) When(ctx context.Context, params model.DropAuditPartitionParams) *AuditArchiveRepositoryMockDropAuditPartitionExpectation {
+	if mmDropAuditPartition.mock.funcDropAuditPartition != nil {
+		mmDropAuditPartition.mock.t.Fatalf("AuditArchiveRepositoryMock.DropAuditPartition mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockDropAuditPartitionExpectation{
+		mock:   mmDropAuditPartition.mock,
+		params: &AuditArchiveRepositoryMockDropAuditPartitionParams{ctx, params},
+	}
+	mmDropAuditPartition.expectations = append(mmDropAuditPartition.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.DropAuditPartition return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockDropAuditPartitionExpectation) Then(err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockDropAuditPartitionResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.DropAuditPartition should be invoked
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Times(n uint64) *mAuditArchiveRepositoryMockDropAuditPartition {
+	if n == 0 {
+		mmDropAuditPartition.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.DropAuditPartition mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDropAuditPartition.expectedInvocations, n)
+	return mmDropAuditPartition
+}
+
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) invocationsDone() bool {
+	if len(mmDropAuditPartition.expectations) == 0 && mmDropAuditPartition.defaultExpectation == nil && mmDropAuditPartition.mock.funcDropAuditPartition == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDropAuditPartition.mock.afterDropAuditPartitionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDropAuditPartition.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DropAuditPartition implements repository.AuditArchiveRepository
+func (mmDropAuditPartition *AuditArchiveRepositoryMock) DropAuditPartition(ctx context.Context, params model.DropAuditPartitionParams) (err error) {
+	mm_atomic.AddUint64(&mmDropAuditPartition.beforeDropAuditPartitionCounter, 1)
+	defer mm_atomic.AddUint64(&mmDropAuditPartition.afterDropAuditPartitionCounter, 1)
+
+	if mmDropAuditPartition.inspectFuncDropAuditPartition != nil {
+		mmDropAuditPartition.inspectFuncDropAuditPartition(ctx, params)
+	}
+
+	mm_params := AuditArchiveRepositoryMockDropAuditPartitionParams{ctx, params}
+
+	// Record call args
+	mmDropAuditPartition.DropAuditPartitionMock.mutex.Lock()
+	mmDropAuditPartition.DropAuditPartitionMock.callArgs = append(mmDropAuditPartition.DropAuditPartitionMock.callArgs, &mm_params)
+	mmDropAuditPartition.DropAuditPartitionMock.mutex.Unlock()
+
+	for _, e := range mmDropAuditPartition.DropAuditPartitionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDropAuditPartition.DropAuditPartitionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDropAuditPartition.DropAuditPartitionMock.defaultExpectation.Counter, 1)
+		mm_want := mmDropAuditPartition.DropAuditPartitionMock.defaultExpectation.params
+		mm_want_ptrs := mmDropAuditPartition.DropAuditPartitionMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockDropAuditPartitionParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDropAuditPartition.t.Errorf("AuditArchiveRepositoryMock.DropAuditPartition got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDropAuditPartition.t.Errorf("AuditArchiveRepositoryMock.DropAuditPartition got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDropAuditPartition.t.Errorf("AuditArchiveRepositoryMock.DropAuditPartition got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDropAuditPartition.DropAuditPartitionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDropAuditPartition.t.Fatal("No results are set for the AuditArchiveRepositoryMock.DropAuditPartition")
+		}
+		return (*mm_results).err
+	}
+	if mmDropAuditPartition.funcDropAuditPartition != nil {
+		return mmDropAuditPartition.funcDropAuditPartition(ctx, params)
+	}
+	mmDropAuditPartition.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.DropAuditPartition. %v %v", ctx, params)
+	return
+}
+
+// DropAuditPartitionAfterCounter returns a count of finished AuditArchiveRepositoryMock.DropAuditPartition invocations
+func (mmDropAuditPartition *AuditArchiveRepositoryMock) DropAuditPartitionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDropAuditPartition.afterDropAuditPartitionCounter)
+}
+
+// DropAuditPartitionBeforeCounter returns a count of AuditArchiveRepositoryMock.DropAuditPartition invocations
+func (mmDropAuditPartition *AuditArchiveRepositoryMock) DropAuditPartitionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDropAuditPartition.beforeDropAuditPartitionCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.DropAuditPartition.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDropAuditPartition *mAuditArchiveRepositoryMockDropAuditPartition) Calls() []*AuditArchiveRepositoryMockDropAuditPartitionParams {
+	mmDropAuditPartition.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockDropAuditPartitionParams, len(mmDropAuditPartition.callArgs))
+	copy(argCopy, mmDropAuditPartition.callArgs)
+
+	mmDropAuditPartition.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDropAuditPartitionDone returns true if the count of the DropAuditPartition invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockDropAuditPartitionDone() bool {
+	if m.DropAuditPartitionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DropAuditPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DropAuditPartitionMock.invocationsDone()
+}
+
+// MinimockDropAuditPartitionInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockDropAuditPartitionInspect() {
+	for _, e := range m.DropAuditPartitionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.DropAuditPartition with params: %#v", *e.params)
+		}
+	}
+
+	afterDropAuditPartitionCounter := mm_atomic.LoadUint64(&m.afterDropAuditPartitionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DropAuditPartitionMock.defaultExpectation != nil && afterDropAuditPartitionCounter < 1 {
+		if m.DropAuditPartitionMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.DropAuditPartition")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.DropAuditPartition with params: %#v", *m.DropAuditPartitionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDropAuditPartition != nil && afterDropAuditPartitionCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.DropAuditPartition")
+	}
+
+	if !m.DropAuditPartitionMock.invocationsDone() && afterDropAuditPartitionCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.DropAuditPartition but found %d calls",
+			mm_atomic.LoadUint64(&m.DropAuditPartitionMock.expectedInvocations), afterDropAuditPartitionCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockGetCurrentAuditMonth struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation
+	expectations       []*AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation
+
+	callArgs []*AuditArchiveRepositoryMockGetCurrentAuditMonthParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation specifies expectation struct of the AuditArchiveRepository.GetCurrentAuditMonth
+type AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockGetCurrentAuditMonthParams
+	paramPtrs *AuditArchiveRepositoryMockGetCurrentAuditMonthParamPtrs
+	results   *AuditArchiveRepositoryMockGetCurrentAuditMonthResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockGetCurrentAuditMonthParams contains parameters of the AuditArchiveRepository.GetCurrentAuditMonth
+type AuditArchiveRepositoryMockGetCurrentAuditMonthParams struct {
+	ctx context.Context
+}
+
+// AuditArchiveRepositoryMockGetCurrentAuditMonthParamPtrs contains pointers to parameters of the AuditArchiveRepository.GetCurrentAuditMonth
+type AuditArchiveRepositoryMockGetCurrentAuditMonthParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditArchiveRepositoryMockGetCurrentAuditMonthResults contains results of the AuditArchiveRepository.GetCurrentAuditMonth
+type AuditArchiveRepositoryMockGetCurrentAuditMonthResults struct {
+	month time.Time
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Optional() *mAuditArchiveRepositoryMockGetCurrentAuditMonth {
+	mmGetCurrentAuditMonth.optional = true
+	return mmGetCurrentAuditMonth
+}
+
+// Expect sets up expected params for AuditArchiveRepository.GetCurrentAuditMonth
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Expect(ctx context.Context) *mAuditArchiveRepositoryMockGetCurrentAuditMonth {
+	if mmGetCurrentAuditMonth.mock.funcGetCurrentAuditMonth != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("AuditArchiveRepositoryMock.GetCurrentAuditMonth mock is already set by Set")
+	}
+
+	if mmGetCurrentAuditMonth.defaultExpectation == nil {
+		mmGetCurrentAuditMonth.defaultExpectation = &AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation{}
+	}
+
+	if mmGetCurrentAuditMonth.defaultExpectation.paramPtrs != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("AuditArchiveRepositoryMock.GetCurrentAuditMonth mock is already set by ExpectParams functions")
+	}
+
+	mmGetCurrentAuditMonth.defaultExpectation.params = &AuditArchiveRepositoryMockGetCurrentAuditMonthParams{ctx}
+	for _, e := range mmGetCurrentAuditMonth.expectations {
+		if minimock.Equal(e.params, mmGetCurrentAuditMonth.defaultExpectation.params) {
+			mmGetCurrentAuditMonth.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCurrentAuditMonth.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCurrentAuditMonth
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.GetCurrentAuditMonth
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockGetCurrentAuditMonth {
+	if mmGetCurrentAuditMonth.mock.funcGetCurrentAuditMonth != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("AuditArchiveRepositoryMock.GetCurrentAuditMonth mock is already set by Set")
+	}
+
+	if mmGetCurrentAuditMonth.defaultExpectation == nil {
+		mmGetCurrentAuditMonth.defaultExpectation = &AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation{}
+	}
+
+	if mmGetCurrentAuditMonth.defaultExpectation.params != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("AuditArchiveRepositoryMock.GetCurrentAuditMonth mock is already set by Expect")
+	}
+
+	if mmGetCurrentAuditMonth.defaultExpectation.paramPtrs == nil {
+		mmGetCurrentAuditMonth.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockGetCurrentAuditMonthParamPtrs{}
+	}
+	mmGetCurrentAuditMonth.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetCurrentAuditMonth
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.GetCurrentAuditMonth
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Inspect(f func(ctx context.Context)) *mAuditArchiveRepositoryMockGetCurrentAuditMonth {
+	if mmGetCurrentAuditMonth.mock.inspectFuncGetCurrentAuditMonth != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.GetCurrentAuditMonth")
+	}
+
+	mmGetCurrentAuditMonth.mock.inspectFuncGetCurrentAuditMonth = f
+
+	return mmGetCurrentAuditMonth
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.GetCurrentAuditMonth
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Return(month time.Time, err error) *AuditArchiveRepositoryMock {
+	if mmGetCurrentAuditMonth.mock.funcGetCurrentAuditMonth != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("AuditArchiveRepositoryMock.GetCurrentAuditMonth mock is already set by Set")
+	}
+
+	if mmGetCurrentAuditMonth.defaultExpectation == nil {
+		mmGetCurrentAuditMonth.defaultExpectation = &AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation{mock: mmGetCurrentAuditMonth.mock}
+	}
+	mmGetCurrentAuditMonth.defaultExpectation.results = &AuditArchiveRepositoryMockGetCurrentAuditMonthResults{month, err}
+	return mmGetCurrentAuditMonth.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.GetCurrentAuditMonth method
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Set(f func(ctx context.Context) (month time.Time, err error)) *AuditArchiveRepositoryMock {
+	if mmGetCurrentAuditMonth.defaultExpectation != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.GetCurrentAuditMonth method")
+	}
+
+	if len(mmGetCurrentAuditMonth.expectations) > 0 {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.GetCurrentAuditMonth method")
+	}
+
+	mmGetCurrentAuditMonth.mock.funcGetCurrentAuditMonth = f
+	return mmGetCurrentAuditMonth.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.GetCurrentAuditMonth which will trigger the result defined by the following
+// Then helper
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) When(ctx context.Context) *AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation {
+	if mmGetCurrentAuditMonth.mock.funcGetCurrentAuditMonth != nil {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("AuditArchiveRepositoryMock.GetCurrentAuditMonth mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation{
+		mock:   mmGetCurrentAuditMonth.mock,
+		params: &AuditArchiveRepositoryMockGetCurrentAuditMonthParams{ctx},
+	}
+	mmGetCurrentAuditMonth.expectations = append(mmGetCurrentAuditMonth.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.GetCurrentAuditMonth return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockGetCurrentAuditMonthExpectation) Then(month time.Time, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockGetCurrentAuditMonthResults{month, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.GetCurrentAuditMonth should be invoked
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Times(n uint64) *mAuditArchiveRepositoryMockGetCurrentAuditMonth {
+	if n == 0 {
+		mmGetCurrentAuditMonth.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.GetCurrentAuditMonth mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCurrentAuditMonth.expectedInvocations, n)
+	return mmGetCurrentAuditMonth
+}
+
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) invocationsDone() bool {
+	if len(mmGetCurrentAuditMonth.expectations) == 0 && mmGetCurrentAuditMonth.defaultExpectation == nil && mmGetCurrentAuditMonth.mock.funcGetCurrentAuditMonth == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCurrentAuditMonth.mock.afterGetCurrentAuditMonthCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCurrentAuditMonth.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCurrentAuditMonth implements repository.AuditArchiveRepository
+func (mmGetCurrentAuditMonth *AuditArchiveRepositoryMock) GetCurrentAuditMonth(ctx context.Context) (month time.Time, err error) {
+	mm_atomic.AddUint64(&mmGetCurrentAuditMonth.beforeGetCurrentAuditMonthCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCurrentAuditMonth.afterGetCurrentAuditMonthCounter, 1)
+
+	if mmGetCurrentAuditMonth.inspectFuncGetCurrentAuditMonth != nil {
+		mmGetCurrentAuditMonth.inspectFuncGetCurrentAuditMonth(ctx)
+	}
+
+	mm_params := AuditArchiveRepositoryMockGetCurrentAuditMonthParams{ctx}
+
+	// Record call args
+	mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.mutex.Lock()
+	mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.callArgs = append(mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.callArgs, &mm_params)
+	mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.mutex.Unlock()
+
+	for _, e := range mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.month, e.results.err
+		}
+	}
+
+	if mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockGetCurrentAuditMonthParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCurrentAuditMonth.t.Errorf("AuditArchiveRepositoryMock.GetCurrentAuditMonth got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCurrentAuditMonth.t.Errorf("AuditArchiveRepositoryMock.GetCurrentAuditMonth got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCurrentAuditMonth.GetCurrentAuditMonthMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCurrentAuditMonth.t.Fatal("No results are set for the AuditArchiveRepositoryMock.GetCurrentAuditMonth")
+		}
+		return (*mm_results).month, (*mm_results).err
+	}
+	if mmGetCurrentAuditMonth.funcGetCurrentAuditMonth != nil {
+		return mmGetCurrentAuditMonth.funcGetCurrentAuditMonth(ctx)
+	}
+	mmGetCurrentAuditMonth.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.GetCurrentAuditMonth. %v", ctx)
+	return
+}
+
+// GetCurrentAuditMonthAfterCounter returns a count of finished AuditArchiveRepositoryMock.GetCurrentAuditMonth invocations
+func (mmGetCurrentAuditMonth *AuditArchiveRepositoryMock) GetCurrentAuditMonthAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCurrentAuditMonth.afterGetCurrentAuditMonthCounter)
+}
+
+// GetCurrentAuditMonthBeforeCounter returns a count of AuditArchiveRepositoryMock.GetCurrentAuditMonth invocations
+func (mmGetCurrentAuditMonth *AuditArchiveRepositoryMock) GetCurrentAuditMonthBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCurrentAuditMonth.beforeGetCurrentAuditMonthCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.GetCurrentAuditMonth.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCurrentAuditMonth *mAuditArchiveRepositoryMockGetCurrentAuditMonth) Calls() []*AuditArchiveRepositoryMockGetCurrentAuditMonthParams {
+	mmGetCurrentAuditMonth.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockGetCurrentAuditMonthParams, len(mmGetCurrentAuditMonth.callArgs))
+	copy(argCopy, mmGetCurrentAuditMonth.callArgs)
+
+	mmGetCurrentAuditMonth.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCurrentAuditMonthDone returns true if the count of the GetCurrentAuditMonth invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockGetCurrentAuditMonthDone() bool {
+	if m.GetCurrentAuditMonthMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCurrentAuditMonthMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCurrentAuditMonthMock.invocationsDone()
+}
+
+// MinimockGetCurrentAuditMonthInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockGetCurrentAuditMonthInspect() {
+	for _, e := range m.GetCurrentAuditMonthMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.GetCurrentAuditMonth with params: %#v", *e.params)
+		}
+	}
+
+	afterGetCurrentAuditMonthCounter := mm_atomic.LoadUint64(&m.afterGetCurrentAuditMonthCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCurrentAuditMonthMock.defaultExpectation != nil && afterGetCurrentAuditMonthCounter < 1 {
+		if m.GetCurrentAuditMonthMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.GetCurrentAuditMonth")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.GetCurrentAuditMonth with params: %#v", *m.GetCurrentAuditMonthMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCurrentAuditMonth != nil && afterGetCurrentAuditMonthCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.GetCurrentAuditMonth")
+	}
+
+	if !m.GetCurrentAuditMonthMock.invocationsDone() && afterGetCurrentAuditMonthCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.GetCurrentAuditMonth but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCurrentAuditMonthMock.expectedInvocations), afterGetCurrentAuditMonthCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockGetMaxArchivedEventID struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation
+	expectations       []*AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation
+
+	callArgs []*AuditArchiveRepositoryMockGetMaxArchivedEventIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation specifies expectation struct of the AuditArchiveRepository.GetMaxArchivedEventID
+type AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockGetMaxArchivedEventIDParams
+	paramPtrs *AuditArchiveRepositoryMockGetMaxArchivedEventIDParamPtrs
+	results   *AuditArchiveRepositoryMockGetMaxArchivedEventIDResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockGetMaxArchivedEventIDParams contains parameters of the AuditArchiveRepository.GetMaxArchivedEventID
+type AuditArchiveRepositoryMockGetMaxArchivedEventIDParams struct {
+	ctx context.Context
+}
+
+// AuditArchiveRepositoryMockGetMaxArchivedEventIDParamPtrs contains pointers to parameters of the AuditArchiveRepository.GetMaxArchivedEventID
+type AuditArchiveRepositoryMockGetMaxArchivedEventIDParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditArchiveRepositoryMockGetMaxArchivedEventIDResults contains results of the AuditArchiveRepository.GetMaxArchivedEventID
+type AuditArchiveRepositoryMockGetMaxArchivedEventIDResults struct {
+	eventID int64
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Optional() *mAuditArchiveRepositoryMockGetMaxArchivedEventID {
+	mmGetMaxArchivedEventID.optional = true
+	return mmGetMaxArchivedEventID
+}
+
+// Expect sets up expected params for AuditArchiveRepository.GetMaxArchivedEventID
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Expect(ctx context.Context) *mAuditArchiveRepositoryMockGetMaxArchivedEventID {
+	if mmGetMaxArchivedEventID.mock.funcGetMaxArchivedEventID != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("AuditArchiveRepositoryMock.GetMaxArchivedEventID mock is already set by Set")
+	}
+
+	if mmGetMaxArchivedEventID.defaultExpectation == nil {
+		mmGetMaxArchivedEventID.defaultExpectation = &AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation{}
+	}
+
+	if mmGetMaxArchivedEventID.defaultExpectation.paramPtrs != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("AuditArchiveRepositoryMock.GetMaxArchivedEventID mock is already set by ExpectParams functions")
+	}
+
+	mmGetMaxArchivedEventID.defaultExpectation.params = &AuditArchiveRepositoryMockGetMaxArchivedEventIDParams{ctx}
+	for _, e := range mmGetMaxArchivedEventID.expectations {
+		if minimock.Equal(e.params, mmGetMaxArchivedEventID.defaultExpectation.params) {
+			mmGetMaxArchivedEventID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMaxArchivedEventID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMaxArchivedEventID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.GetMaxArchivedEventID
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockGetMaxArchivedEventID {
+	if mmGetMaxArchivedEventID.mock.funcGetMaxArchivedEventID != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("AuditArchiveRepositoryMock.GetMaxArchivedEventID mock is already set by Set")
+	}
+
+	if mmGetMaxArchivedEventID.defaultExpectation == nil {
+		mmGetMaxArchivedEventID.defaultExpectation = &AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation{}
+	}
+
+	if mmGetMaxArchivedEventID.defaultExpectation.params != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("AuditArchiveRepositoryMock.GetMaxArchivedEventID mock is already set by Expect")
+	}
+
+	if mmGetMaxArchivedEventID.defaultExpectation.paramPtrs == nil {
+		mmGetMaxArchivedEventID.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockGetMaxArchivedEventIDParamPtrs{}
+	}
+	mmGetMaxArchivedEventID.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetMaxArchivedEventID
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.GetMaxArchivedEventID
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Inspect(f func(ctx context.Context)) *mAuditArchiveRepositoryMockGetMaxArchivedEventID {
+	if mmGetMaxArchivedEventID.mock.inspectFuncGetMaxArchivedEventID != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.GetMaxArchivedEventID")
+	}
+
+	mmGetMaxArchivedEventID.mock.inspectFuncGetMaxArchivedEventID = f
+
+	return mmGetMaxArchivedEventID
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.GetMaxArchivedEventID
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Return(eventID int64, err error) *AuditArchiveRepositoryMock {
+	if mmGetMaxArchivedEventID.mock.funcGetMaxArchivedEventID != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("AuditArchiveRepositoryMock.GetMaxArchivedEventID mock is already set by Set")
+	}
+
+	if mmGetMaxArchivedEventID.defaultExpectation == nil {
+		mmGetMaxArchivedEventID.defaultExpectation = &AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation{mock: mmGetMaxArchivedEventID.mock}
+	}
+	mmGetMaxArchivedEventID.defaultExpectation.results = &AuditArchiveRepositoryMockGetMaxArchivedEventIDResults{eventID, err}
+	return mmGetMaxArchivedEventID.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.GetMaxArchivedEventID method
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Set(f func(ctx context.Context) (eventID int64, err error)) *AuditArchiveRepositoryMock {
+	if mmGetMaxArchivedEventID.defaultExpectation != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.GetMaxArchivedEventID method")
+	}
+
+	if len(mmGetMaxArchivedEventID.expectations) > 0 {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.GetMaxArchivedEventID method")
+	}
+
+	mmGetMaxArchivedEventID.mock.funcGetMaxArchivedEventID = f
+	return mmGetMaxArchivedEventID.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.GetMaxArchivedEventID which will trigger the result defined by the following
+// Then helper
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) When(ctx context.Context) *AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation {
+	if mmGetMaxArchivedEventID.mock.funcGetMaxArchivedEventID != nil {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("AuditArchiveRepositoryMock.GetMaxArchivedEventID mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation{
+		mock:   mmGetMaxArchivedEventID.mock,
+		params: &AuditArchiveRepositoryMockGetMaxArchivedEventIDParams{ctx},
+	}
+	mmGetMaxArchivedEventID.expectations = append(mmGetMaxArchivedEventID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.GetMaxArchivedEventID return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockGetMaxArchivedEventIDExpectation) Then(eventID int64, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockGetMaxArchivedEventIDResults{eventID, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.GetMaxArchivedEventID should be invoked
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Times(n uint64) *mAuditArchiveRepositoryMockGetMaxArchivedEventID {
+	if n == 0 {
+		mmGetMaxArchivedEventID.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.GetMaxArchivedEventID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMaxArchivedEventID.expectedInvocations, n)
+	return mmGetMaxArchivedEventID
+}
+
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) invocationsDone() bool {
+	if len(mmGetMaxArchivedEventID.expectations) == 0 && mmGetMaxArchivedEventID.defaultExpectation == nil && mmGetMaxArchivedEventID.mock.funcGetMaxArchivedEventID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMaxArchivedEventID.mock.afterGetMaxArchivedEventIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMaxArchivedEventID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMaxArchivedEventID implements repository.AuditArchiveRepository
+func (mmGetMaxArchivedEventID *AuditArchiveRepositoryMock) GetMaxArchivedEventID(ctx context.Context) (eventID int64, err error) {
+	mm_atomic.AddUint64(&mmGetMaxArchivedEventID.beforeGetMaxArchivedEventIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMaxArchivedEventID.afterGetMaxArchivedEventIDCounter, 1)
+
+	if mmGetMaxArchivedEventID.inspectFuncGetMaxArchivedEventID != nil {
+		mmGetMaxArchivedEventID.inspectFuncGetMaxArchivedEventID(ctx)
+	}
+
+	mm_params := AuditArchiveRepositoryMockGetMaxArchivedEventIDParams{ctx}
+
+	// Record call args
+	mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.mutex.Lock()
+	mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.callArgs = append(mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.callArgs, &mm_params)
+	mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.mutex.Unlock()
+
+	for _, e := range mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.eventID, e.results.err
+		}
+	}
+
+	if mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockGetMaxArchivedEventIDParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMaxArchivedEventID.t.Errorf("AuditArchiveRepositoryMock.GetMaxArchivedEventID got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMaxArchivedEventID.t.Errorf("AuditArchiveRepositoryMock.GetMaxArchivedEventID got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMaxArchivedEventID.GetMaxArchivedEventIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMaxArchivedEventID.t.Fatal("No results are set for the AuditArchiveRepositoryMock.GetMaxArchivedEventID")
+		}
+		return (*mm_results).eventID, (*mm_results).err
+	}
+	if mmGetMaxArchivedEventID.funcGetMaxArchivedEventID != nil {
+		return mmGetMaxArchivedEventID.funcGetMaxArchivedEventID(ctx)
+	}
+	mmGetMaxArchivedEventID.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.GetMaxArchivedEventID. %v", ctx)
+	return
+}
+
+// GetMaxArchivedEventIDAfterCounter returns a count of finished AuditArchiveRepositoryMock.GetMaxArchivedEventID invocations
+func (mmGetMaxArchivedEventID *AuditArchiveRepositoryMock) GetMaxArchivedEventIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMaxArchivedEventID.afterGetMaxArchivedEventIDCounter)
+}
+
+// GetMaxArchivedEventIDBeforeCounter returns a count of AuditArchiveRepositoryMock.GetMaxArchivedEventID invocations
+func (mmGetMaxArchivedEventID *AuditArchiveRepositoryMock) GetMaxArchivedEventIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMaxArchivedEventID.beforeGetMaxArchivedEventIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.GetMaxArchivedEventID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMaxArchivedEventID *mAuditArchiveRepositoryMockGetMaxArchivedEventID) Calls() []*AuditArchiveRepositoryMockGetMaxArchivedEventIDParams {
+	mmGetMaxArchivedEventID.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockGetMaxArchivedEventIDParams, len(mmGetMaxArchivedEventID.callArgs))
+	copy(argCopy, mmGetMaxArchivedEventID.callArgs)
+
+	mmGetMaxArchivedEventID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMaxArchivedEventIDDone returns true if the count of the GetMaxArchivedEventID invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockGetMaxArchivedEventIDDone() bool {
+	if m.GetMaxArchivedEventIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMaxArchivedEventIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMaxArchivedEventIDMock.invocationsDone()
+}
+
+// MinimockGetMaxArchivedEventIDInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockGetMaxArchivedEventIDInspect() {
+	for _, e := range m.GetMaxArchivedEventIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.GetMaxArchivedEventID with params: %#v", *e.params)
+		}
+	}
+
+	afterGetMaxArchivedEventIDCounter := mm_atomic.LoadUint64(&m.afterGetMaxArchivedEventIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMaxArchivedEventIDMock.defaultExpectation != nil && afterGetMaxArchivedEventIDCounter < 1 {
+		if m.GetMaxArchivedEventIDMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.GetMaxArchivedEventID")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.GetMaxArchivedEventID with params: %#v", *m.GetMaxArchivedEventIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMaxArchivedEventID != nil && afterGetMaxArchivedEventIDCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.GetMaxArchivedEventID")
+	}
+
+	if !m.GetMaxArchivedEventIDMock.invocationsDone() && afterGetMaxArchivedEventIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.GetMaxArchivedEventID but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMaxArchivedEventIDMock.expectedInvocations), afterGetMaxArchivedEventIDCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockListAuditChainSeams struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockListAuditChainSeamsExpectation
+	expectations       []*AuditArchiveRepositoryMockListAuditChainSeamsExpectation
+
+	callArgs []*AuditArchiveRepositoryMockListAuditChainSeamsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockListAuditChainSeamsExpectation specifies expectation struct of the AuditArchiveRepository.ListAuditChainSeams
+type AuditArchiveRepositoryMockListAuditChainSeamsExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockListAuditChainSeamsParams
+	paramPtrs *AuditArchiveRepositoryMockListAuditChainSeamsParamPtrs
+	results   *AuditArchiveRepositoryMockListAuditChainSeamsResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockListAuditChainSeamsParams contains parameters of the AuditArchiveRepository.ListAuditChainSeams
+type AuditArchiveRepositoryMockListAuditChainSeamsParams struct {
+	ctx context.Context
+}
+
+// AuditArchiveRepositoryMockListAuditChainSeamsParamPtrs contains pointers to parameters of the AuditArchiveRepository.ListAuditChainSeams
+type AuditArchiveRepositoryMockListAuditChainSeamsParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditArchiveRepositoryMockListAuditChainSeamsResults contains results of the AuditArchiveRepository.ListAuditChainSeams
+type AuditArchiveRepositoryMockListAuditChainSeamsResults struct {
+	resp []model.AuditChainSeam
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Optional() *mAuditArchiveRepositoryMockListAuditChainSeams {
+	mmListAuditChainSeams.optional = true
+	return mmListAuditChainSeams
+}
+
+// Expect sets up expected params for AuditArchiveRepository.ListAuditChainSeams
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Expect(ctx context.Context) *mAuditArchiveRepositoryMockListAuditChainSeams {
+	if mmListAuditChainSeams.mock.funcListAuditChainSeams != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditChainSeams mock is already set by Set")
+	}
+
+	if mmListAuditChainSeams.defaultExpectation == nil {
+		mmListAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockListAuditChainSeamsExpectation{}
+	}
+
+	if mmListAuditChainSeams.defaultExpectation.paramPtrs != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditChainSeams mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditChainSeams.defaultExpectation.params = &AuditArchiveRepositoryMockListAuditChainSeamsParams{ctx}
+	for _, e := range mmListAuditChainSeams.expectations {
+		if minimock.Equal(e.params, mmListAuditChainSeams.defaultExpectation.params) {
+			mmListAuditChainSeams.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditChainSeams.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditChainSeams
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.ListAuditChainSeams
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockListAuditChainSeams {
+	if mmListAuditChainSeams.mock.funcListAuditChainSeams != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditChainSeams mock is already set by Set")
+	}
+
+	if mmListAuditChainSeams.defaultExpectation == nil {
+		mmListAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockListAuditChainSeamsExpectation{}
+	}
+
+	if mmListAuditChainSeams.defaultExpectation.params != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditChainSeams mock is already set by Expect")
+	}
+
+	if mmListAuditChainSeams.defaultExpectation.paramPtrs == nil {
+		mmListAuditChainSeams.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockListAuditChainSeamsParamPtrs{}
+	}
+	mmListAuditChainSeams.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditChainSeams
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.ListAuditChainSeams
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Inspect(f func(ctx context.Context)) *mAuditArchiveRepositoryMockListAuditChainSeams {
+	if mmListAuditChainSeams.mock.inspectFuncListAuditChainSeams != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.ListAuditChainSeams")
+	}
+
+	mmListAuditChainSeams.mock.inspectFuncListAuditChainSeams = f
+
+	return mmListAuditChainSeams
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.ListAuditChainSeams
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Return(resp []model.AuditChainSeam, err error) *AuditArchiveRepositoryMock {
+	if mmListAuditChainSeams.mock.funcListAuditChainSeams != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditChainSeams mock is already set by Set")
+	}
+
+	if mmListAuditChainSeams.defaultExpectation == nil {
+		mmListAuditChainSeams.defaultExpectation = &AuditArchiveRepositoryMockListAuditChainSeamsExpectation{mock: mmListAuditChainSeams.mock}
+	}
+	mmListAuditChainSeams.defaultExpectation.results = &AuditArchiveRepositoryMockListAuditChainSeamsResults{resp, err}
+	return mmListAuditChainSeams.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.ListAuditChainSeams method
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Set(f func(ctx context.Context) (resp []model.AuditChainSeam, err error)) *AuditArchiveRepositoryMock {
+	if mmListAuditChainSeams.defaultExpectation != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.ListAuditChainSeams method")
+	}
+
+	if len(mmListAuditChainSeams.expectations) > 0 {
+		mmListAuditChainSeams.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.ListAuditChainSeams method")
+	}
+
+	mmListAuditChainSeams.mock.funcListAuditChainSeams = f
+	return mmListAuditChainSeams.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.ListAuditChainSeams which will trigger the result defined by the following
+// Then helper
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) When(ctx context.Context) *AuditArchiveRepositoryMockListAuditChainSeamsExpectation {
+	if mmListAuditChainSeams.mock.funcListAuditChainSeams != nil {
+		mmListAuditChainSeams.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditChainSeams mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockListAuditChainSeamsExpectation{
+		mock:   mmListAuditChainSeams.mock,
+		params: &AuditArchiveRepositoryMockListAuditChainSeamsParams{ctx},
+	}
+	mmListAuditChainSeams.expectations = append(mmListAuditChainSeams.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.ListAuditChainSeams return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockListAuditChainSeamsExpectation) Then(resp []model.AuditChainSeam, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockListAuditChainSeamsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.ListAuditChainSeams should be invoked
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Times(n uint64) *mAuditArchiveRepositoryMockListAuditChainSeams {
+	if n == 0 {
+		mmListAuditChainSeams.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.ListAuditChainSeams mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditChainSeams.expectedInvocations, n)
+	return mmListAuditChainSeams
+}
+
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) invocationsDone() bool {
+	if len(mmListAuditChainSeams.expectations) == 0 && mmListAuditChainSeams.defaultExpectation == nil && mmListAuditChainSeams.mock.funcListAuditChainSeams == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditChainSeams.mock.afterListAuditChainSeamsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditChainSeams.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditChainSeams implements repository.AuditArchiveRepository
+func (mmListAuditChainSeams *AuditArchiveRepositoryMock) ListAuditChainSeams(ctx context.Context) (resp []model.AuditChainSeam, err error) {
+	mm_atomic.AddUint64(&mmListAuditChainSeams.beforeListAuditChainSeamsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditChainSeams.afterListAuditChainSeamsCounter, 1)
+
+	if mmListAuditChainSeams.inspectFuncListAuditChainSeams != nil {
+		mmListAuditChainSeams.inspectFuncListAuditChainSeams(ctx)
+	}
+
+	mm_params := AuditArchiveRepositoryMockListAuditChainSeamsParams{ctx}
+
+	// Record call args
+	mmListAuditChainSeams.ListAuditChainSeamsMock.mutex.Lock()
+	mmListAuditChainSeams.ListAuditChainSeamsMock.callArgs = append(mmListAuditChainSeams.ListAuditChainSeamsMock.callArgs, &mm_params)
+	mmListAuditChainSeams.ListAuditChainSeamsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditChainSeams.ListAuditChainSeamsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditChainSeams.ListAuditChainSeamsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditChainSeams.ListAuditChainSeamsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditChainSeams.ListAuditChainSeamsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditChainSeams.ListAuditChainSeamsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockListAuditChainSeamsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditChainSeams.t.Errorf("AuditArchiveRepositoryMock.ListAuditChainSeams got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditChainSeams.t.Errorf("AuditArchiveRepositoryMock.ListAuditChainSeams got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditChainSeams.ListAuditChainSeamsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditChainSeams.t.Fatal("No results are set for the AuditArchiveRepositoryMock.ListAuditChainSeams")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditChainSeams.funcListAuditChainSeams != nil {
+		return mmListAuditChainSeams.funcListAuditChainSeams(ctx)
+	}
+	mmListAuditChainSeams.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.ListAuditChainSeams. %v", ctx)
+	return
+}
+
+// ListAuditChainSeamsAfterCounter returns a count of finished AuditArchiveRepositoryMock.ListAuditChainSeams invocations
+func (mmListAuditChainSeams *AuditArchiveRepositoryMock) ListAuditChainSeamsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditChainSeams.afterListAuditChainSeamsCounter)
+}
+
+// ListAuditChainSeamsBeforeCounter returns a count of AuditArchiveRepositoryMock.ListAuditChainSeams invocations
+func (mmListAuditChainSeams *AuditArchiveRepositoryMock) ListAuditChainSeamsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditChainSeams.beforeListAuditChainSeamsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.ListAuditChainSeams.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditChainSeams *mAuditArchiveRepositoryMockListAuditChainSeams) Calls() []*AuditArchiveRepositoryMockListAuditChainSeamsParams {
+	mmListAuditChainSeams.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockListAuditChainSeamsParams, len(mmListAuditChainSeams.callArgs))
+	copy(argCopy, mmListAuditChainSeams.callArgs)
+
+	mmListAuditChainSeams.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditChainSeamsDone returns true if the count of the ListAuditChainSeams invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockListAuditChainSeamsDone() bool {
+	if m.ListAuditChainSeamsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditChainSeamsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditChainSeamsMock.invocationsDone()
+}
+
+// MinimockListAuditChainSeamsInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockListAuditChainSeamsInspect() {
+	for _, e := range m.ListAuditChainSeamsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.ListAuditChainSeams with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditChainSeamsCounter := mm_atomic.LoadUint64(&m.afterListAuditChainSeamsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditChainSeamsMock.defaultExpectation != nil && afterListAuditChainSeamsCounter < 1 {
+		if m.ListAuditChainSeamsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.ListAuditChainSeams")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.ListAuditChainSeams with params: %#v", *m.ListAuditChainSeamsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditChainSeams != nil && afterListAuditChainSeamsCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.ListAuditChainSeams")
+	}
+
+	if !m.ListAuditChainSeamsMock.invocationsDone() && afterListAuditChainSeamsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.ListAuditChainSeams but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditChainSeamsMock.expectedInvocations), afterListAuditChainSeamsCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockListAuditPartitionRecords struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation
+	expectations       []*AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation
+
+	callArgs []*AuditArchiveRepositoryMockListAuditPartitionRecordsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation specifies expectation struct of the AuditArchiveRepository.ListAuditPartitionRecords
+type AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockListAuditPartitionRecordsParams
+	paramPtrs *AuditArchiveRepositoryMockListAuditPartitionRecordsParamPtrs
+	results   *AuditArchiveRepositoryMockListAuditPartitionRecordsResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionRecordsParams contains parameters of the AuditArchiveRepository.ListAuditPartitionRecords
+type AuditArchiveRepositoryMockListAuditPartitionRecordsParams struct {
+	ctx    context.Context
+	params model.ListAuditPartitionRecordsParams
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionRecordsParamPtrs contains pointers to parameters of the AuditArchiveRepository.ListAuditPartitionRecords
+type AuditArchiveRepositoryMockListAuditPartitionRecordsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditPartitionRecordsParams
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionRecordsResults contains results of the AuditArchiveRepository.ListAuditPartitionRecords
+type AuditArchiveRepositoryMockListAuditPartitionRecordsResults struct {
+	resp []model.AuditChainLink
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Optional() *mAuditArchiveRepositoryMockListAuditPartitionRecords {
+	mmListAuditPartitionRecords.optional = true
+	return mmListAuditPartitionRecords
+}
+
+// Expect sets up expected params for AuditArchiveRepository.ListAuditPartitionRecords
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Expect(ctx context.Context, params model.ListAuditPartitionRecordsParams) *mAuditArchiveRepositoryMockListAuditPartitionRecords {
+	if mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Set")
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation == nil {
+		mmListAuditPartitionRecords.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation{}
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation.paramPtrs != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditPartitionRecords.defaultExpectation.params = &AuditArchiveRepositoryMockListAuditPartitionRecordsParams{ctx, params}
+	for _, e := range mmListAuditPartitionRecords.expectations {
+		if minimock.Equal(e.params, mmListAuditPartitionRecords.defaultExpectation.params) {
+			mmListAuditPartitionRecords.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditPartitionRecords.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditPartitionRecords
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.ListAuditPartitionRecords
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockListAuditPartitionRecords {
+	if mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Set")
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation == nil {
+		mmListAuditPartitionRecords.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation{}
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation.params != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Expect")
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation.paramPtrs == nil {
+		mmListAuditPartitionRecords.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockListAuditPartitionRecordsParamPtrs{}
+	}
+	mmListAuditPartitionRecords.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditPartitionRecords
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditArchiveRepository.ListAuditPartitionRecords
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) ExpectParamsParam2(params model.ListAuditPartitionRecordsParams) *mAuditArchiveRepositoryMockListAuditPartitionRecords {
+	if mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Set")
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation == nil {
+		mmListAuditPartitionRecords.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation{}
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation.params != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Expect")
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation.paramPtrs == nil {
+		mmListAuditPartitionRecords.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockListAuditPartitionRecordsParamPtrs{}
+	}
+	mmListAuditPartitionRecords.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAuditPartitionRecords
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.ListAuditPartitionRecords
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Inspect(f func(ctx context.Context, params model.ListAuditPartitionRecordsParams)) *mAuditArchiveRepositoryMockListAuditPartitionRecords {
+	if mmListAuditPartitionRecords.mock.inspectFuncListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.ListAuditPartitionRecords")
+	}
+
+	mmListAuditPartitionRecords.mock.inspectFuncListAuditPartitionRecords = f
+
+	return mmListAuditPartitionRecords
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.ListAuditPartitionRecords
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Return(resp []model.AuditChainLink, err error) *AuditArchiveRepositoryMock {
+	if mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Set")
+	}
+
+	if mmListAuditPartitionRecords.defaultExpectation == nil {
+		mmListAuditPartitionRecords.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation{mock: mmListAuditPartitionRecords.mock}
+	}
+	mmListAuditPartitionRecords.defaultExpectation.results = &AuditArchiveRepositoryMockListAuditPartitionRecordsResults{resp, err}
+	return mmListAuditPartitionRecords.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.ListAuditPartitionRecords method
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Set(f func(ctx context.Context, params model.ListAuditPartitionRecordsParams) (resp []model.AuditChainLink, err error)) *AuditArchiveRepositoryMock {
+	if mmListAuditPartitionRecords.defaultExpectation != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.ListAuditPartitionRecords method")
+	}
+
+	if len(mmListAuditPartitionRecords.expectations) > 0 {
+		mmListAuditPartitionRecords.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.ListAuditPartitionRecords method")
+	}
+
+	mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords = f
+	return mmListAuditPartitionRecords.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.ListAuditPartitionRecords which will trigger the result defined by the following
+// Then helper
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) When(ctx context.Context, params model.ListAuditPartitionRecordsParams) *AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation {
+	if mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitionRecords mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation{
+		mock:   mmListAuditPartitionRecords.mock,
+		params: &AuditArchiveRepositoryMockListAuditPartitionRecordsParams{ctx, params},
+	}
+	mmListAuditPartitionRecords.expectations = append(mmListAuditPartitionRecords.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.ListAuditPartitionRecords return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockListAuditPartitionRecordsExpectation) Then(resp []model.AuditChainLink, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockListAuditPartitionRecordsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.ListAuditPartitionRecords should be invoked
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Times(n uint64) *mAuditArchiveRepositoryMockListAuditPartitionRecords {
+	if n == 0 {
+		mmListAuditPartitionRecords.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.ListAuditPartitionRecords mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditPartitionRecords.expectedInvocations, n)
+	return mmListAuditPartitionRecords
+}
+
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) invocationsDone() bool {
+	if len(mmListAuditPartitionRecords.expectations) == 0 && mmListAuditPartitionRecords.defaultExpectation == nil && mmListAuditPartitionRecords.mock.funcListAuditPartitionRecords == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditPartitionRecords.mock.afterListAuditPartitionRecordsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditPartitionRecords.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditPartitionRecords implements repository.AuditArchiveRepository
+func (mmListAuditPartitionRecords *AuditArchiveRepositoryMock) ListAuditPartitionRecords(ctx context.Context, params model.ListAuditPartitionRecordsParams) (resp []model.AuditChainLink, err error) {
+	mm_atomic.AddUint64(&mmListAuditPartitionRecords.beforeListAuditPartitionRecordsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditPartitionRecords.afterListAuditPartitionRecordsCounter, 1)
+
+	if mmListAuditPartitionRecords.inspectFuncListAuditPartitionRecords != nil {
+		mmListAuditPartitionRecords.inspectFuncListAuditPartitionRecords(ctx, params)
+	}
+
+	mm_params := AuditArchiveRepositoryMockListAuditPartitionRecordsParams{ctx, params}
+
+	// Record call args
+	mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.mutex.Lock()
+	mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.callArgs = append(mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.callArgs, &mm_params)
+	mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockListAuditPartitionRecordsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditPartitionRecords.t.Errorf("AuditArchiveRepositoryMock.ListAuditPartitionRecords got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAuditPartitionRecords.t.Errorf("AuditArchiveRepositoryMock.ListAuditPartitionRecords got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditPartitionRecords.t.Errorf("AuditArchiveRepositoryMock.ListAuditPartitionRecords got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditPartitionRecords.ListAuditPartitionRecordsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditPartitionRecords.t.Fatal("No results are set for the AuditArchiveRepositoryMock.ListAuditPartitionRecords")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditPartitionRecords.funcListAuditPartitionRecords != nil {
+		return mmListAuditPartitionRecords.funcListAuditPartitionRecords(ctx, params)
+	}
+	mmListAuditPartitionRecords.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.ListAuditPartitionRecords. %v %v", ctx, params)
+	return
+}
+
+// ListAuditPartitionRecordsAfterCounter returns a count of finished AuditArchiveRepositoryMock.ListAuditPartitionRecords invocations
+func (mmListAuditPartitionRecords *AuditArchiveRepositoryMock) ListAuditPartitionRecordsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditPartitionRecords.afterListAuditPartitionRecordsCounter)
+}
+
+// ListAuditPartitionRecordsBeforeCounter returns a count of AuditArchiveRepositoryMock.ListAuditPartitionRecords invocations
+func (mmListAuditPartitionRecords *AuditArchiveRepositoryMock) ListAuditPartitionRecordsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditPartitionRecords.beforeListAuditPartitionRecordsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.ListAuditPartitionRecords.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditPartitionRecords *mAuditArchiveRepositoryMockListAuditPartitionRecords) Calls() []*AuditArchiveRepositoryMockListAuditPartitionRecordsParams {
+	mmListAuditPartitionRecords.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockListAuditPartitionRecordsParams, len(mmListAuditPartitionRecords.callArgs))
+	copy(argCopy, mmListAuditPartitionRecords.callArgs)
+
+	mmListAuditPartitionRecords.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditPartitionRecordsDone returns true if the count of the ListAuditPartitionRecords invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockListAuditPartitionRecordsDone() bool {
+	if m.ListAuditPartitionRecordsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditPartitionRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditPartitionRecordsMock.invocationsDone()
+}
+
+// MinimockListAuditPartitionRecordsInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockListAuditPartitionRecordsInspect() {
+	for _, e := range m.ListAuditPartitionRecordsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.ListAuditPartitionRecords with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditPartitionRecordsCounter := mm_atomic.LoadUint64(&m.afterListAuditPartitionRecordsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditPartitionRecordsMock.defaultExpectation != nil && afterListAuditPartitionRecordsCounter < 1 {
+		if m.ListAuditPartitionRecordsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.ListAuditPartitionRecords")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.ListAuditPartitionRecords with params: %#v", *m.ListAuditPartitionRecordsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditPartitionRecords != nil && afterListAuditPartitionRecordsCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.ListAuditPartitionRecords")
+	}
+
+	if !m.ListAuditPartitionRecordsMock.invocationsDone() && afterListAuditPartitionRecordsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.ListAuditPartitionRecords but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditPartitionRecordsMock.expectedInvocations), afterListAuditPartitionRecordsCounter)
+	}
+}
+
+type mAuditArchiveRepositoryMockListAuditPartitions struct {
+	optional           bool
+	mock               *AuditArchiveRepositoryMock
+	defaultExpectation *AuditArchiveRepositoryMockListAuditPartitionsExpectation
+	expectations       []*AuditArchiveRepositoryMockListAuditPartitionsExpectation
+
+	callArgs []*AuditArchiveRepositoryMockListAuditPartitionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionsExpectation specifies expectation struct of the AuditArchiveRepository.ListAuditPartitions
+type AuditArchiveRepositoryMockListAuditPartitionsExpectation struct {
+	mock      *AuditArchiveRepositoryMock
+	params    *AuditArchiveRepositoryMockListAuditPartitionsParams
+	paramPtrs *AuditArchiveRepositoryMockListAuditPartitionsParamPtrs
+	results   *AuditArchiveRepositoryMockListAuditPartitionsResults
+	Counter   uint64
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionsParams contains parameters of the AuditArchiveRepository.ListAuditPartitions
+type AuditArchiveRepositoryMockListAuditPartitionsParams struct {
+	ctx context.Context
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionsParamPtrs contains pointers to parameters of the AuditArchiveRepository.ListAuditPartitions
+type AuditArchiveRepositoryMockListAuditPartitionsParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditArchiveRepositoryMockListAuditPartitionsResults contains results of the AuditArchiveRepository.ListAuditPartitions
+type AuditArchiveRepositoryMockListAuditPartitionsResults struct {
+	resp []model.AuditPartition
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Optional() *mAuditArchiveRepositoryMockListAuditPartitions {
+	mmListAuditPartitions.optional = true
+	return mmListAuditPartitions
+}
+
+// Expect sets up expected params for AuditArchiveRepository.ListAuditPartitions
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Expect(ctx context.Context) *mAuditArchiveRepositoryMockListAuditPartitions {
+	if mmListAuditPartitions.mock.funcListAuditPartitions != nil {
+		mmListAuditPartitions.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitions mock is already set by Set")
+	}
+
+	if mmListAuditPartitions.defaultExpectation == nil {
+		mmListAuditPartitions.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionsExpectation{}
+	}
+
+	if mmListAuditPartitions.defaultExpectation.paramPtrs != nil {
+		mmListAuditPartitions.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitions mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditPartitions.defaultExpectation.params = &AuditArchiveRepositoryMockListAuditPartitionsParams{ctx}
+	for _, e := range mmListAuditPartitions.expectations {
+		if minimock.Equal(e.params, mmListAuditPartitions.defaultExpectation.params) {
+			mmListAuditPartitions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditPartitions.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditPartitions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditArchiveRepository.ListAuditPartitions
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) ExpectCtxParam1(ctx context.Context) *mAuditArchiveRepositoryMockListAuditPartitions {
+	if mmListAuditPartitions.mock.funcListAuditPartitions != nil {
+		mmListAuditPartitions.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitions mock is already set by Set")
+	}
+
+	if mmListAuditPartitions.defaultExpectation == nil {
+		mmListAuditPartitions.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionsExpectation{}
+	}
+
+	if mmListAuditPartitions.defaultExpectation.params != nil {
+		mmListAuditPartitions.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitions mock is already set by Expect")
+	}
+
+	if mmListAuditPartitions.defaultExpectation.paramPtrs == nil {
+		mmListAuditPartitions.defaultExpectation.paramPtrs = &AuditArchiveRepositoryMockListAuditPartitionsParamPtrs{}
+	}
+	mmListAuditPartitions.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditPartitions
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditArchiveRepository.ListAuditPartitions
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Inspect(f func(ctx context.Context)) *mAuditArchiveRepositoryMockListAuditPartitions {
+	if mmListAuditPartitions.mock.inspectFuncListAuditPartitions != nil {
+		mmListAuditPartitions.mock.t.Fatalf("Inspect function is already set for AuditArchiveRepositoryMock.ListAuditPartitions")
+	}
+
+	mmListAuditPartitions.mock.inspectFuncListAuditPartitions = f
+
+	return mmListAuditPartitions
+}
+
+// Return sets up results that will be returned by AuditArchiveRepository.ListAuditPartitions
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Return(resp []model.AuditPartition, err error) *AuditArchiveRepositoryMock {
+	if mmListAuditPartitions.mock.funcListAuditPartitions != nil {
+		mmListAuditPartitions.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitions mock is already set by Set")
+	}
+
+	if mmListAuditPartitions.defaultExpectation == nil {
+		mmListAuditPartitions.defaultExpectation = &AuditArchiveRepositoryMockListAuditPartitionsExpectation{mock: mmListAuditPartitions.mock}
+	}
+	mmListAuditPartitions.defaultExpectation.results = &AuditArchiveRepositoryMockListAuditPartitionsResults{resp, err}
+	return mmListAuditPartitions.mock
+}
+
+// Set uses given function f to mock the AuditArchiveRepository.ListAuditPartitions method
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Set(f func(ctx context.Context) (resp []model.AuditPartition, err error)) *AuditArchiveRepositoryMock {
+	if mmListAuditPartitions.defaultExpectation != nil {
+		mmListAuditPartitions.mock.t.Fatalf("Default expectation is already set for the AuditArchiveRepository.ListAuditPartitions method")
+	}
+
+	if len(mmListAuditPartitions.expectations) > 0 {
+		mmListAuditPartitions.mock.t.Fatalf("Some expectations are already set for the AuditArchiveRepository.ListAuditPartitions method")
+	}
+
+	mmListAuditPartitions.mock.funcListAuditPartitions = f
+	return mmListAuditPartitions.mock
+}
+
+// When sets expectation for the AuditArchiveRepository.ListAuditPartitions which will trigger the result defined by the following
+// Then helper
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) When(ctx context.Context) *AuditArchiveRepositoryMockListAuditPartitionsExpectation {
+	if mmListAuditPartitions.mock.funcListAuditPartitions != nil {
+		mmListAuditPartitions.mock.t.Fatalf("AuditArchiveRepositoryMock.ListAuditPartitions mock is already set by Set")
+	}
+
+	expectation := &AuditArchiveRepositoryMockListAuditPartitionsExpectation{
+		mock:   mmListAuditPartitions.mock,
+		params: &AuditArchiveRepositoryMockListAuditPartitionsParams{ctx},
+	}
+	mmListAuditPartitions.expectations = append(mmListAuditPartitions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditArchiveRepository.ListAuditPartitions return parameters for the expectation previously defined by the When method
+func (e *AuditArchiveRepositoryMockListAuditPartitionsExpectation) Then(resp []model.AuditPartition, err error) *AuditArchiveRepositoryMock {
+	e.results = &AuditArchiveRepositoryMockListAuditPartitionsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuditArchiveRepository.ListAuditPartitions should be invoked
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Times(n uint64) *mAuditArchiveRepositoryMockListAuditPartitions {
+	if n == 0 {
+		mmListAuditPartitions.mock.t.Fatalf("Times of AuditArchiveRepositoryMock.ListAuditPartitions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditPartitions.expectedInvocations, n)
+	return mmListAuditPartitions
+}
+
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) invocationsDone() bool {
+	if len(mmListAuditPartitions.expectations) == 0 && mmListAuditPartitions.defaultExpectation == nil && mmListAuditPartitions.mock.funcListAuditPartitions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditPartitions.mock.afterListAuditPartitionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditPartitions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditPartitions implements repository.AuditArchiveRepository
+func (mmListAuditPartitions *AuditArchiveRepositoryMock) ListAuditPartitions(ctx context.Context) (resp []model.AuditPartition, err error) {
+	mm_atomic.AddUint64(&mmListAuditPartitions.beforeListAuditPartitionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditPartitions.afterListAuditPartitionsCounter, 1)
+
+	if mmListAuditPartitions.inspectFuncListAuditPartitions != nil {
+		mmListAuditPartitions.inspectFuncListAuditPartitions(ctx)
+	}
+
+	mm_params := AuditArchiveRepositoryMockListAuditPartitionsParams{ctx}
+
+	// Record call args
+	mmListAuditPartitions.ListAuditPartitionsMock.mutex.Lock()
+	mmListAuditPartitions.ListAuditPartitionsMock.callArgs = append(mmListAuditPartitions.ListAuditPartitionsMock.callArgs, &mm_params)
+	mmListAuditPartitions.ListAuditPartitionsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditPartitions.ListAuditPartitionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditPartitions.ListAuditPartitionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditPartitions.ListAuditPartitionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditPartitions.ListAuditPartitionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditPartitions.ListAuditPartitionsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditArchiveRepositoryMockListAuditPartitionsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditPartitions.t.Errorf("AuditArchiveRepositoryMock.ListAuditPartitions got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditPartitions.t.Errorf("AuditArchiveRepositoryMock.ListAuditPartitions got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditPartitions.ListAuditPartitionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditPartitions.t.Fatal("No results are set for the AuditArchiveRepositoryMock.ListAuditPartitions")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditPartitions.funcListAuditPartitions != nil {
+		return mmListAuditPartitions.funcListAuditPartitions(ctx)
+	}
+	mmListAuditPartitions.t.Fatalf("Unexpected call to AuditArchiveRepositoryMock.ListAuditPartitions. %v", ctx)
+	return
+}
+
+// ListAuditPartitionsAfterCounter returns a count of finished AuditArchiveRepositoryMock.ListAuditPartitions invocations
+func (mmListAuditPartitions *AuditArchiveRepositoryMock) ListAuditPartitionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditPartitions.afterListAuditPartitionsCounter)
+}
+
+// ListAuditPartitionsBeforeCounter returns a count of AuditArchiveRepositoryMock.ListAuditPartitions invocations
+func (mmListAuditPartitions *AuditArchiveRepositoryMock) ListAuditPartitionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditPartitions.beforeListAuditPartitionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditArchiveRepositoryMock.ListAuditPartitions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditPartitions *mAuditArchiveRepositoryMockListAuditPartitions) Calls() []*AuditArchiveRepositoryMockListAuditPartitionsParams {
+	mmListAuditPartitions.mutex.RLock()
+
+	argCopy := make([]*AuditArchiveRepositoryMockListAuditPartitionsParams, len(mmListAuditPartitions.callArgs))
+	copy(argCopy, mmListAuditPartitions.callArgs)
+
+	mmListAuditPartitions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditPartitionsDone returns true if the count of the ListAuditPartitions invocations corresponds
+// the number of defined expectations
+func (m *AuditArchiveRepositoryMock) MinimockListAuditPartitionsDone() bool {
+	if m.ListAuditPartitionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditPartitionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditPartitionsMock.invocationsDone()
+}
+
+// MinimockListAuditPartitionsInspect logs each unmet expectation
+func (m *AuditArchiveRepositoryMock) MinimockListAuditPartitionsInspect() {
+	for _, e := range m.ListAuditPartitionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.ListAuditPartitions with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditPartitionsCounter := mm_atomic.LoadUint64(&m.afterListAuditPartitionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditPartitionsMock.defaultExpectation != nil && afterListAuditPartitionsCounter < 1 {
+		if m.ListAuditPartitionsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditArchiveRepositoryMock.ListAuditPartitions")
+		} else {
+			m.t.Errorf("Expected call to AuditArchiveRepositoryMock.ListAuditPartitions with params: %#v", *m.ListAuditPartitionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditPartitions != nil && afterListAuditPartitionsCounter < 1 {
+		m.t.Error("Expected call to AuditArchiveRepositoryMock.ListAuditPartitions")
+	}
+
+	if !m.ListAuditPartitionsMock.invocationsDone() && afterListAuditPartitionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditArchiveRepositoryMock.ListAuditPartitions but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditPartitionsMock.expectedInvocations), afterListAuditPartitionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditArchiveRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcquireRetentionLockInspect()
+
+			m.MinimockCreateAuditArchiveInspect()
+
+			m.MinimockCreateAuditChainSeamsInspect()
+
+			m.MinimockCreateAuditPartitionInspect()
+
+			m.MinimockDropAuditPartitionInspect()
+
+			m.MinimockGetCurrentAuditMonthInspect()
+
+			m.MinimockGetMaxArchivedEventIDInspect()
+
+			m.MinimockListAuditChainSeamsInspect()
+
+			m.MinimockListAuditPartitionRecordsInspect()
+
+			m.MinimockListAuditPartitionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditArchiveRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditArchiveRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcquireRetentionLockDone() &&
+		m.MinimockCreateAuditArchiveDone() &&
+		m.MinimockCreateAuditChainSeamsDone() &&
+		m.MinimockCreateAuditPartitionDone() &&
+		m.MinimockDropAuditPartitionDone() &&
+		m.MinimockGetCurrentAuditMonthDone() &&
+		m.MinimockGetMaxArchivedEventIDDone() &&
+		m.MinimockListAuditChainSeamsDone() &&
+		m.MinimockListAuditPartitionRecordsDone() &&
+		m.MinimockListAuditPartitionsDone()
+}
@@ -24,6 +24,42 @@ type LogRepositoryMock struct {
 	afterCreateAPILogCounter  uint64
 	beforeCreateAPILogCounter uint64
 	CreateAPILogMock          mLogRepositoryMockCreateAPILog
+
+	funcCreateAPILogs          func(ctx context.Context, records []model.APILogRecord) (err error)
+	inspectFuncCreateAPILogs   func(ctx context.Context, records []model.APILogRecord)
+	afterCreateAPILogsCounter  uint64
+	beforeCreateAPILogsCounter uint64
+	CreateAPILogsMock          mLogRepositoryMockCreateAPILogs
+
+	funcCreateAuditCheckpoint          func(ctx context.Context, params model.AuditCheckpoint) (created bool, err error)
+	inspectFuncCreateAuditCheckpoint   func(ctx context.Context, params model.AuditCheckpoint)
+	afterCreateAuditCheckpointCounter  uint64
+	beforeCreateAuditCheckpointCounter uint64
+	CreateAuditCheckpointMock          mLogRepositoryMockCreateAuditCheckpoint
+
+	funcGetAuditChainHead          func(ctx context.Context) (resp model.AuditChainHead, err error)
+	inspectFuncGetAuditChainHead   func(ctx context.Context)
+	afterGetAuditChainHeadCounter  uint64
+	beforeGetAuditChainHeadCounter uint64
+	GetAuditChainHeadMock          mLogRepositoryMockGetAuditChainHead
+
+	funcListAuditChain          func(ctx context.Context, params model.ListAuditChainParams) (resp []model.AuditChainLink, err error)
+	inspectFuncListAuditChain   func(ctx context.Context, params model.ListAuditChainParams)
+	afterListAuditChainCounter  uint64
+	beforeListAuditChainCounter uint64
+	ListAuditChainMock          mLogRepositoryMockListAuditChain
+
+	funcListAuditCheckpoints          func(ctx context.Context, params model.ListAuditCheckpointsParams) (resp []model.AuditCheckpoint, err error)
+	inspectFuncListAuditCheckpoints   func(ctx context.Context, params model.ListAuditCheckpointsParams)
+	afterListAuditCheckpointsCounter  uint64
+	beforeListAuditCheckpointsCounter uint64
+	ListAuditCheckpointsMock          mLogRepositoryMockListAuditCheckpoints
+
+	funcListAuditEvents          func(ctx context.Context, params model.ListAuditEventsPageParams) (resp model.ListAuditEventsPageResponse, err error)
+	inspectFuncListAuditEvents   func(ctx context.Context, params model.ListAuditEventsPageParams)
+	afterListAuditEventsCounter  uint64
+	beforeListAuditEventsCounter uint64
+	ListAuditEventsMock          mLogRepositoryMockListAuditEvents
 }
 
 // NewLogRepositoryMock returns a mock for repository.LogRepository
@@ -37,6 +73,24 @@ func NewLogRepositoryMock(t minimock.Tester) *LogRepositoryMock {
 	m.CreateAPILogMock = mLogRepositoryMockCreateAPILog{mock: m}
 	m.CreateAPILogMock.callArgs = []*LogRepositoryMockCreateAPILogParams{}
 
+	m.CreateAPILogsMock = mLogRepositoryMockCreateAPILogs{mock: m}
+	m.CreateAPILogsMock.callArgs = []*LogRepositoryMockCreateAPILogsParams{}
+
+	m.CreateAuditCheckpointMock = mLogRepositoryMockCreateAuditCheckpoint{mock: m}
+	m.CreateAuditCheckpointMock.callArgs = []*LogRepositoryMockCreateAuditCheckpointParams{}
+
+	m.GetAuditChainHeadMock = mLogRepositoryMockGetAuditChainHead{mock: m}
+	m.GetAuditChainHeadMock.callArgs = []*LogRepositoryMockGetAuditChainHeadParams{}
+
+	m.ListAuditChainMock = mLogRepositoryMockListAuditChain{mock: m}
+	m.ListAuditChainMock.callArgs = []*LogRepositoryMockListAuditChainParams{}
+
+	m.ListAuditCheckpointsMock = mLogRepositoryMockListAuditCheckpoints{mock: m}
+	m.ListAuditCheckpointsMock.callArgs = []*LogRepositoryMockListAuditCheckpointsParams{}
+
+	m.ListAuditEventsMock = mLogRepositoryMockListAuditEvents{mock: m}
+	m.ListAuditEventsMock.callArgs = []*LogRepositoryMockListAuditEventsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -362,11 +416,1920 @@ func (m *LogRepositoryMock) MinimockCreateAPILogInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *LogRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockCreateAPILogInspect()
+type mLogRepositoryMockCreateAPILogs struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockCreateAPILogsExpectation
+	expectations       []*LogRepositoryMockCreateAPILogsExpectation
+
+	callArgs []*LogRepositoryMockCreateAPILogsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockCreateAPILogsExpectation specifies expectation struct of the LogRepository.CreateAPILogs
+type LogRepositoryMockCreateAPILogsExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockCreateAPILogsParams
+	paramPtrs *LogRepositoryMockCreateAPILogsParamPtrs
+	results   *LogRepositoryMockCreateAPILogsResults
+	Counter   uint64
+}
+
+// LogRepositoryMockCreateAPILogsParams contains parameters of the LogRepository.CreateAPILogs
+type LogRepositoryMockCreateAPILogsParams struct {
+	ctx     context.Context
+	records []model.APILogRecord
+}
+
+// LogRepositoryMockCreateAPILogsParamPtrs contains pointers to parameters of the LogRepository.CreateAPILogs
+type LogRepositoryMockCreateAPILogsParamPtrs struct {
+	ctx     *context.Context
+	records *[]model.APILogRecord
+}
+
+// LogRepositoryMockCreateAPILogsResults contains results of the LogRepository.CreateAPILogs
+type LogRepositoryMockCreateAPILogsResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Optional() *mLogRepositoryMockCreateAPILogs {
+	mmCreateAPILogs.optional = true
+	return mmCreateAPILogs
+}
+
+// Expect sets up expected params for LogRepository.CreateAPILogs
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Expect(ctx context.Context, records []model.APILogRecord) *mLogRepositoryMockCreateAPILogs {
+	if mmCreateAPILogs.mock.funcCreateAPILogs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Set")
+	}
+
+	if mmCreateAPILogs.defaultExpectation == nil {
+		mmCreateAPILogs.defaultExpectation = &LogRepositoryMockCreateAPILogsExpectation{}
+	}
+
+	if mmCreateAPILogs.defaultExpectation.paramPtrs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPILogs.defaultExpectation.params = &LogRepositoryMockCreateAPILogsParams{ctx, records}
+	for _, e := range mmCreateAPILogs.expectations {
+		if minimock.Equal(e.params, mmCreateAPILogs.defaultExpectation.params) {
+			mmCreateAPILogs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPILogs.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPILogs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.CreateAPILogs
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockCreateAPILogs {
+	if mmCreateAPILogs.mock.funcCreateAPILogs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Set")
+	}
+
+	if mmCreateAPILogs.defaultExpectation == nil {
+		mmCreateAPILogs.defaultExpectation = &LogRepositoryMockCreateAPILogsExpectation{}
+	}
+
+	if mmCreateAPILogs.defaultExpectation.params != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Expect")
+	}
+
+	if mmCreateAPILogs.defaultExpectation.paramPtrs == nil {
+		mmCreateAPILogs.defaultExpectation.paramPtrs = &LogRepositoryMockCreateAPILogsParamPtrs{}
+	}
+	mmCreateAPILogs.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAPILogs
+}
+
+// ExpectRecordsParam2 sets up expected param records for LogRepository.CreateAPILogs
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) ExpectRecordsParam2(records []model.APILogRecord) *mLogRepositoryMockCreateAPILogs {
+	if mmCreateAPILogs.mock.funcCreateAPILogs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Set")
+	}
+
+	if mmCreateAPILogs.defaultExpectation == nil {
+		mmCreateAPILogs.defaultExpectation = &LogRepositoryMockCreateAPILogsExpectation{}
+	}
+
+	if mmCreateAPILogs.defaultExpectation.params != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Expect")
+	}
+
+	if mmCreateAPILogs.defaultExpectation.paramPtrs == nil {
+		mmCreateAPILogs.defaultExpectation.paramPtrs = &LogRepositoryMockCreateAPILogsParamPtrs{}
+	}
+	mmCreateAPILogs.defaultExpectation.paramPtrs.records = &records
+
+	return mmCreateAPILogs
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.CreateAPILogs
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Inspect(f func(ctx context.Context, records []model.APILogRecord)) *mLogRepositoryMockCreateAPILogs {
+	if mmCreateAPILogs.mock.inspectFuncCreateAPILogs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.CreateAPILogs")
+	}
+
+	mmCreateAPILogs.mock.inspectFuncCreateAPILogs = f
+
+	return mmCreateAPILogs
+}
+
+// Return sets up results that will be returned by LogRepository.CreateAPILogs
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Return(err error) *LogRepositoryMock {
+	if mmCreateAPILogs.mock.funcCreateAPILogs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Set")
+	}
+
+	if mmCreateAPILogs.defaultExpectation == nil {
+		mmCreateAPILogs.defaultExpectation = &LogRepositoryMockCreateAPILogsExpectation{mock: mmCreateAPILogs.mock}
+	}
+	mmCreateAPILogs.defaultExpectation.results = &LogRepositoryMockCreateAPILogsResults{err}
+	return mmCreateAPILogs.mock
+}
+
+// Set uses given function f to mock the LogRepository.CreateAPILogs method
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Set(f func(ctx context.Context, records []model.APILogRecord) (err error)) *LogRepositoryMock {
+	if mmCreateAPILogs.defaultExpectation != nil {
+		mmCreateAPILogs.mock.t.Fatalf("Default expectation is already set for the LogRepository.CreateAPILogs method")
+	}
+
+	if len(mmCreateAPILogs.expectations) > 0 {
+		mmCreateAPILogs.mock.t.Fatalf("Some expectations are already set for the LogRepository.CreateAPILogs method")
+	}
+
+	mmCreateAPILogs.mock.funcCreateAPILogs = f
+	return mmCreateAPILogs.mock
+}
+
+// When sets expectation for the LogRepository.CreateAPILogs which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) When(ctx context.Context, records []model.APILogRecord) *LogRepositoryMockCreateAPILogsExpectation {
+	if mmCreateAPILogs.mock.funcCreateAPILogs != nil {
+		mmCreateAPILogs.mock.t.Fatalf("LogRepositoryMock.CreateAPILogs mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockCreateAPILogsExpectation{
+		mock:   mmCreateAPILogs.mock,
+		params: &LogRepositoryMockCreateAPILogsParams{ctx, records},
+	}
+	mmCreateAPILogs.expectations = append(mmCreateAPILogs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.CreateAPILogs return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockCreateAPILogsExpectation) Then(err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockCreateAPILogsResults{err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.CreateAPILogs should be invoked
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Times(n uint64) *mLogRepositoryMockCreateAPILogs {
+	if n == 0 {
+		mmCreateAPILogs.mock.t.Fatalf("Times of LogRepositoryMock.CreateAPILogs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPILogs.expectedInvocations, n)
+	return mmCreateAPILogs
+}
+
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) invocationsDone() bool {
+	if len(mmCreateAPILogs.expectations) == 0 && mmCreateAPILogs.defaultExpectation == nil && mmCreateAPILogs.mock.funcCreateAPILogs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPILogs.mock.afterCreateAPILogsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPILogs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPILogs implements repository.LogRepository
+func (mmCreateAPILogs *LogRepositoryMock) CreateAPILogs(ctx context.Context, records []model.APILogRecord) (err error) {
+	mm_atomic.AddUint64(&mmCreateAPILogs.beforeCreateAPILogsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPILogs.afterCreateAPILogsCounter, 1)
+
+	if mmCreateAPILogs.inspectFuncCreateAPILogs != nil {
+		mmCreateAPILogs.inspectFuncCreateAPILogs(ctx, records)
+	}
+
+	mm_params := LogRepositoryMockCreateAPILogsParams{ctx, records}
+
+	// Record call args
+	mmCreateAPILogs.CreateAPILogsMock.mutex.Lock()
+	mmCreateAPILogs.CreateAPILogsMock.callArgs = append(mmCreateAPILogs.CreateAPILogsMock.callArgs, &mm_params)
+	mmCreateAPILogs.CreateAPILogsMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPILogs.CreateAPILogsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateAPILogs.CreateAPILogsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPILogs.CreateAPILogsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPILogs.CreateAPILogsMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPILogs.CreateAPILogsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockCreateAPILogsParams{ctx, records}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPILogs.t.Errorf("LogRepositoryMock.CreateAPILogs got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.records != nil && !minimock.Equal(*mm_want_ptrs.records, mm_got.records) {
+				mmCreateAPILogs.t.Errorf("LogRepositoryMock.CreateAPILogs got unexpected parameter records, want: %#v, got: %#v%s\n", *mm_want_ptrs.records, mm_got.records, minimock.Diff(*mm_want_ptrs.records, mm_got.records))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPILogs.t.Errorf("LogRepositoryMock.CreateAPILogs got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPILogs.CreateAPILogsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPILogs.t.Fatal("No results are set for the LogRepositoryMock.CreateAPILogs")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateAPILogs.funcCreateAPILogs != nil {
+		return mmCreateAPILogs.funcCreateAPILogs(ctx, records)
+	}
+	mmCreateAPILogs.t.Fatalf("Unexpected call to LogRepositoryMock.CreateAPILogs. %v %v", ctx, records)
+	return
+}
+
+// CreateAPILogsAfterCounter returns a count of finished LogRepositoryMock.CreateAPILogs invocations
+func (mmCreateAPILogs *LogRepositoryMock) CreateAPILogsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPILogs.afterCreateAPILogsCounter)
+}
+
+// CreateAPILogsBeforeCounter returns a count of LogRepositoryMock.CreateAPILogs invocations
+func (mmCreateAPILogs *LogRepositoryMock) CreateAPILogsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPILogs.beforeCreateAPILogsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.CreateAPILogs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPILogs *mLogRepositoryMockCreateAPILogs) Calls() []*LogRepositoryMockCreateAPILogsParams {
+	mmCreateAPILogs.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockCreateAPILogsParams, len(mmCreateAPILogs.callArgs))
+	copy(argCopy, mmCreateAPILogs.callArgs)
+
+	mmCreateAPILogs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPILogsDone returns true if the count of the CreateAPILogs invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockCreateAPILogsDone() bool {
+	if m.CreateAPILogsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPILogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPILogsMock.invocationsDone()
+}
+
+// MinimockCreateAPILogsInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockCreateAPILogsInspect() {
+	for _, e := range m.CreateAPILogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.CreateAPILogs with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAPILogsCounter := mm_atomic.LoadUint64(&m.afterCreateAPILogsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPILogsMock.defaultExpectation != nil && afterCreateAPILogsCounter < 1 {
+		if m.CreateAPILogsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.CreateAPILogs")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.CreateAPILogs with params: %#v", *m.CreateAPILogsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPILogs != nil && afterCreateAPILogsCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.CreateAPILogs")
+	}
+
+	if !m.CreateAPILogsMock.invocationsDone() && afterCreateAPILogsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.CreateAPILogs but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPILogsMock.expectedInvocations), afterCreateAPILogsCounter)
+	}
+}
+
+type mLogRepositoryMockCreateAuditCheckpoint struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockCreateAuditCheckpointExpectation
+	expectations       []*LogRepositoryMockCreateAuditCheckpointExpectation
+
+	callArgs []*LogRepositoryMockCreateAuditCheckpointParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockCreateAuditCheckpointExpectation specifies expectation struct of the LogRepository.CreateAuditCheckpoint
+type LogRepositoryMockCreateAuditCheckpointExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockCreateAuditCheckpointParams
+	paramPtrs *LogRepositoryMockCreateAuditCheckpointParamPtrs
+	results   *LogRepositoryMockCreateAuditCheckpointResults
+	Counter   uint64
+}
+
+// LogRepositoryMockCreateAuditCheckpointParams contains parameters of the LogRepository.CreateAuditCheckpoint
+type LogRepositoryMockCreateAuditCheckpointParams struct {
+	ctx    context.Context
+	params model.AuditCheckpoint
+}
+
+// LogRepositoryMockCreateAuditCheckpointParamPtrs contains pointers to parameters of the LogRepository.CreateAuditCheckpoint
+type LogRepositoryMockCreateAuditCheckpointParamPtrs struct {
+	ctx    *context.Context
+	params *model.AuditCheckpoint
+}
+
+// LogRepositoryMockCreateAuditCheckpointResults contains results of the LogRepository.CreateAuditCheckpoint
+type LogRepositoryMockCreateAuditCheckpointResults struct {
+	created bool
+	err     error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Optional() *mLogRepositoryMockCreateAuditCheckpoint {
+	mmCreateAuditCheckpoint.optional = true
+	return mmCreateAuditCheckpoint
+}
+
+// Expect sets up expected params for LogRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Expect(ctx context.Context, params model.AuditCheckpoint) *mLogRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &LogRepositoryMockCreateAuditCheckpointExpectation{}
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.paramPtrs != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAuditCheckpoint.defaultExpectation.params = &LogRepositoryMockCreateAuditCheckpointParams{ctx, params}
+	for _, e := range mmCreateAuditCheckpoint.expectations {
+		if minimock.Equal(e.params, mmCreateAuditCheckpoint.defaultExpectation.params) {
+			mmCreateAuditCheckpoint.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAuditCheckpoint.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAuditCheckpoint
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &LogRepositoryMockCreateAuditCheckpointExpectation{}
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.params != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Expect")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditCheckpoint.defaultExpectation.paramPtrs = &LogRepositoryMockCreateAuditCheckpointParamPtrs{}
+	}
+	mmCreateAuditCheckpoint.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateAuditCheckpoint
+}
+
+// ExpectParamsParam2 sets up expected param params for LogRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) ExpectParamsParam2(params model.AuditCheckpoint) *mLogRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &LogRepositoryMockCreateAuditCheckpointExpectation{}
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.params != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Expect")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation.paramPtrs == nil {
+		mmCreateAuditCheckpoint.defaultExpectation.paramPtrs = &LogRepositoryMockCreateAuditCheckpointParamPtrs{}
+	}
+	mmCreateAuditCheckpoint.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateAuditCheckpoint
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Inspect(f func(ctx context.Context, params model.AuditCheckpoint)) *mLogRepositoryMockCreateAuditCheckpoint {
+	if mmCreateAuditCheckpoint.mock.inspectFuncCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.CreateAuditCheckpoint")
+	}
+
+	mmCreateAuditCheckpoint.mock.inspectFuncCreateAuditCheckpoint = f
+
+	return mmCreateAuditCheckpoint
+}
+
+// Return sets up results that will be returned by LogRepository.CreateAuditCheckpoint
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Return(created bool, err error) *LogRepositoryMock {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	if mmCreateAuditCheckpoint.defaultExpectation == nil {
+		mmCreateAuditCheckpoint.defaultExpectation = &LogRepositoryMockCreateAuditCheckpointExpectation{mock: mmCreateAuditCheckpoint.mock}
+	}
+	mmCreateAuditCheckpoint.defaultExpectation.results = &LogRepositoryMockCreateAuditCheckpointResults{created, err}
+	return mmCreateAuditCheckpoint.mock
+}
+
+// Set uses given function f to mock the LogRepository.CreateAuditCheckpoint method
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Set(f func(ctx context.Context, params model.AuditCheckpoint) (created bool, err error)) *LogRepositoryMock {
+	if mmCreateAuditCheckpoint.defaultExpectation != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Default expectation is already set for the LogRepository.CreateAuditCheckpoint method")
+	}
+
+	if len(mmCreateAuditCheckpoint.expectations) > 0 {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Some expectations are already set for the LogRepository.CreateAuditCheckpoint method")
+	}
+
+	mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint = f
+	return mmCreateAuditCheckpoint.mock
+}
+
+// When sets expectation for the LogRepository.CreateAuditCheckpoint which will trigger the result defined by the following
+// Then helper
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) When(ctx context.Context, params model.AuditCheckpoint) *LogRepositoryMockCreateAuditCheckpointExpectation {
+	if mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("LogRepositoryMock.CreateAuditCheckpoint mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockCreateAuditCheckpointExpectation{
+		mock:   mmCreateAuditCheckpoint.mock,
+		params: &LogRepositoryMockCreateAuditCheckpointParams{ctx, params},
+	}
+	mmCreateAuditCheckpoint.expectations = append(mmCreateAuditCheckpoint.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.CreateAuditCheckpoint return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockCreateAuditCheckpointExpectation) Then(created bool, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockCreateAuditCheckpointResults{created, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.CreateAuditCheckpoint should be invoked
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Times(n uint64) *mLogRepositoryMockCreateAuditCheckpoint {
+	if n == 0 {
+		mmCreateAuditCheckpoint.mock.t.Fatalf("Times of LogRepositoryMock.CreateAuditCheckpoint mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAuditCheckpoint.expectedInvocations, n)
+	return mmCreateAuditCheckpoint
+}
+
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) invocationsDone() bool {
+	if len(mmCreateAuditCheckpoint.expectations) == 0 && mmCreateAuditCheckpoint.defaultExpectation == nil && mmCreateAuditCheckpoint.mock.funcCreateAuditCheckpoint == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.mock.afterCreateAuditCheckpointCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAuditCheckpoint implements repository.LogRepository
+func (mmCreateAuditCheckpoint *LogRepositoryMock) CreateAuditCheckpoint(ctx context.Context, params model.AuditCheckpoint) (created bool, err error) {
+	mm_atomic.AddUint64(&mmCreateAuditCheckpoint.beforeCreateAuditCheckpointCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAuditCheckpoint.afterCreateAuditCheckpointCounter, 1)
+
+	if mmCreateAuditCheckpoint.inspectFuncCreateAuditCheckpoint != nil {
+		mmCreateAuditCheckpoint.inspectFuncCreateAuditCheckpoint(ctx, params)
+	}
+
+	mm_params := LogRepositoryMockCreateAuditCheckpointParams{ctx, params}
+
+	// Record call args
+	mmCreateAuditCheckpoint.CreateAuditCheckpointMock.mutex.Lock()
+	mmCreateAuditCheckpoint.CreateAuditCheckpointMock.callArgs = append(mmCreateAuditCheckpoint.CreateAuditCheckpointMock.callArgs, &mm_params)
+	mmCreateAuditCheckpoint.CreateAuditCheckpointMock.mutex.Unlock()
+
+	for _, e := range mmCreateAuditCheckpoint.CreateAuditCheckpointMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.created, e.results.err
+		}
+	}
+
+	if mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockCreateAuditCheckpointParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAuditCheckpoint.t.Errorf("LogRepositoryMock.CreateAuditCheckpoint got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateAuditCheckpoint.t.Errorf("LogRepositoryMock.CreateAuditCheckpoint got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAuditCheckpoint.t.Errorf("LogRepositoryMock.CreateAuditCheckpoint got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAuditCheckpoint.CreateAuditCheckpointMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAuditCheckpoint.t.Fatal("No results are set for the LogRepositoryMock.CreateAuditCheckpoint")
+		}
+		return (*mm_results).created, (*mm_results).err
+	}
+	if mmCreateAuditCheckpoint.funcCreateAuditCheckpoint != nil {
+		return mmCreateAuditCheckpoint.funcCreateAuditCheckpoint(ctx, params)
+	}
+	mmCreateAuditCheckpoint.t.Fatalf("Unexpected call to LogRepositoryMock.CreateAuditCheckpoint. %v %v", ctx, params)
+	return
+}
+
+// CreateAuditCheckpointAfterCounter returns a count of finished LogRepositoryMock.CreateAuditCheckpoint invocations
+func (mmCreateAuditCheckpoint *LogRepositoryMock) CreateAuditCheckpointAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.afterCreateAuditCheckpointCounter)
+}
+
+// CreateAuditCheckpointBeforeCounter returns a count of LogRepositoryMock.CreateAuditCheckpoint invocations
+func (mmCreateAuditCheckpoint *LogRepositoryMock) CreateAuditCheckpointBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAuditCheckpoint.beforeCreateAuditCheckpointCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.CreateAuditCheckpoint.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAuditCheckpoint *mLogRepositoryMockCreateAuditCheckpoint) Calls() []*LogRepositoryMockCreateAuditCheckpointParams {
+	mmCreateAuditCheckpoint.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockCreateAuditCheckpointParams, len(mmCreateAuditCheckpoint.callArgs))
+	copy(argCopy, mmCreateAuditCheckpoint.callArgs)
+
+	mmCreateAuditCheckpoint.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAuditCheckpointDone returns true if the count of the CreateAuditCheckpoint invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockCreateAuditCheckpointDone() bool {
+	if m.CreateAuditCheckpointMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAuditCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAuditCheckpointMock.invocationsDone()
+}
+
+// MinimockCreateAuditCheckpointInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockCreateAuditCheckpointInspect() {
+	for _, e := range m.CreateAuditCheckpointMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.CreateAuditCheckpoint with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateAuditCheckpointCounter := mm_atomic.LoadUint64(&m.afterCreateAuditCheckpointCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAuditCheckpointMock.defaultExpectation != nil && afterCreateAuditCheckpointCounter < 1 {
+		if m.CreateAuditCheckpointMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.CreateAuditCheckpoint")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.CreateAuditCheckpoint with params: %#v", *m.CreateAuditCheckpointMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAuditCheckpoint != nil && afterCreateAuditCheckpointCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.CreateAuditCheckpoint")
+	}
+
+	if !m.CreateAuditCheckpointMock.invocationsDone() && afterCreateAuditCheckpointCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.CreateAuditCheckpoint but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAuditCheckpointMock.expectedInvocations), afterCreateAuditCheckpointCounter)
+	}
+}
+
+type mLogRepositoryMockGetAuditChainHead struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockGetAuditChainHeadExpectation
+	expectations       []*LogRepositoryMockGetAuditChainHeadExpectation
+
+	callArgs []*LogRepositoryMockGetAuditChainHeadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockGetAuditChainHeadExpectation specifies expectation struct of the LogRepository.GetAuditChainHead
+type LogRepositoryMockGetAuditChainHeadExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockGetAuditChainHeadParams
+	paramPtrs *LogRepositoryMockGetAuditChainHeadParamPtrs
+	results   *LogRepositoryMockGetAuditChainHeadResults
+	Counter   uint64
+}
+
+// LogRepositoryMockGetAuditChainHeadParams contains parameters of the LogRepository.GetAuditChainHead
+type LogRepositoryMockGetAuditChainHeadParams struct {
+	ctx context.Context
+}
+
+// LogRepositoryMockGetAuditChainHeadParamPtrs contains pointers to parameters of the LogRepository.GetAuditChainHead
+type LogRepositoryMockGetAuditChainHeadParamPtrs struct {
+	ctx *context.Context
+}
+
+// LogRepositoryMockGetAuditChainHeadResults contains results of the LogRepository.GetAuditChainHead
+type LogRepositoryMockGetAuditChainHeadResults struct {
+	resp model.AuditChainHead
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Optional() *mLogRepositoryMockGetAuditChainHead {
+	mmGetAuditChainHead.optional = true
+	return mmGetAuditChainHead
+}
+
+// Expect sets up expected params for LogRepository.GetAuditChainHead
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Expect(ctx context.Context) *mLogRepositoryMockGetAuditChainHead {
+	if mmGetAuditChainHead.mock.funcGetAuditChainHead != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("LogRepositoryMock.GetAuditChainHead mock is already set by Set")
+	}
+
+	if mmGetAuditChainHead.defaultExpectation == nil {
+		mmGetAuditChainHead.defaultExpectation = &LogRepositoryMockGetAuditChainHeadExpectation{}
+	}
+
+	if mmGetAuditChainHead.defaultExpectation.paramPtrs != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("LogRepositoryMock.GetAuditChainHead mock is already set by ExpectParams functions")
+	}
+
+	mmGetAuditChainHead.defaultExpectation.params = &LogRepositoryMockGetAuditChainHeadParams{ctx}
+	for _, e := range mmGetAuditChainHead.expectations {
+		if minimock.Equal(e.params, mmGetAuditChainHead.defaultExpectation.params) {
+			mmGetAuditChainHead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAuditChainHead.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAuditChainHead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.GetAuditChainHead
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockGetAuditChainHead {
+	if mmGetAuditChainHead.mock.funcGetAuditChainHead != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("LogRepositoryMock.GetAuditChainHead mock is already set by Set")
+	}
+
+	if mmGetAuditChainHead.defaultExpectation == nil {
+		mmGetAuditChainHead.defaultExpectation = &LogRepositoryMockGetAuditChainHeadExpectation{}
+	}
+
+	if mmGetAuditChainHead.defaultExpectation.params != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("LogRepositoryMock.GetAuditChainHead mock is already set by Expect")
+	}
+
+	if mmGetAuditChainHead.defaultExpectation.paramPtrs == nil {
+		mmGetAuditChainHead.defaultExpectation.paramPtrs = &LogRepositoryMockGetAuditChainHeadParamPtrs{}
+	}
+	mmGetAuditChainHead.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetAuditChainHead
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.GetAuditChainHead
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Inspect(f func(ctx context.Context)) *mLogRepositoryMockGetAuditChainHead {
+	if mmGetAuditChainHead.mock.inspectFuncGetAuditChainHead != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.GetAuditChainHead")
+	}
+
+	mmGetAuditChainHead.mock.inspectFuncGetAuditChainHead = f
+
+	return mmGetAuditChainHead
+}
+
+// Return sets up results that will be returned by LogRepository.GetAuditChainHead
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Return(resp model.AuditChainHead, err error) *LogRepositoryMock {
+	if mmGetAuditChainHead.mock.funcGetAuditChainHead != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("LogRepositoryMock.GetAuditChainHead mock is already set by Set")
+	}
+
+	if mmGetAuditChainHead.defaultExpectation == nil {
+		mmGetAuditChainHead.defaultExpectation = &LogRepositoryMockGetAuditChainHeadExpectation{mock: mmGetAuditChainHead.mock}
+	}
+	mmGetAuditChainHead.defaultExpectation.results = &LogRepositoryMockGetAuditChainHeadResults{resp, err}
+	return mmGetAuditChainHead.mock
+}
+
+// Set uses given function f to mock the LogRepository.GetAuditChainHead method
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Set(f func(ctx context.Context) (resp model.AuditChainHead, err error)) *LogRepositoryMock {
+	if mmGetAuditChainHead.defaultExpectation != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("Default expectation is already set for the LogRepository.GetAuditChainHead method")
+	}
+
+	if len(mmGetAuditChainHead.expectations) > 0 {
+		mmGetAuditChainHead.mock.t.Fatalf("Some expectations are already set for the LogRepository.GetAuditChainHead method")
+	}
+
+	mmGetAuditChainHead.mock.funcGetAuditChainHead = f
+	return mmGetAuditChainHead.mock
+}
+
+// When sets expectation for the LogRepository.GetAuditChainHead which will trigger the result defined by the following
+// Then helper
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) When(ctx context.Context) *LogRepositoryMockGetAuditChainHeadExpectation {
+	if mmGetAuditChainHead.mock.funcGetAuditChainHead != nil {
+		mmGetAuditChainHead.mock.t.Fatalf("LogRepositoryMock.GetAuditChainHead mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockGetAuditChainHeadExpectation{
+		mock:   mmGetAuditChainHead.mock,
+		params: &LogRepositoryMockGetAuditChainHeadParams{ctx},
+	}
+	mmGetAuditChainHead.expectations = append(mmGetAuditChainHead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.GetAuditChainHead return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockGetAuditChainHeadExpectation) Then(resp model.AuditChainHead, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockGetAuditChainHeadResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.GetAuditChainHead should be invoked
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Times(n uint64) *mLogRepositoryMockGetAuditChainHead {
+	if n == 0 {
+		mmGetAuditChainHead.mock.t.Fatalf("Times of LogRepositoryMock.GetAuditChainHead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAuditChainHead.expectedInvocations, n)
+	return mmGetAuditChainHead
+}
+
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) invocationsDone() bool {
+	if len(mmGetAuditChainHead.expectations) == 0 && mmGetAuditChainHead.defaultExpectation == nil && mmGetAuditChainHead.mock.funcGetAuditChainHead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAuditChainHead.mock.afterGetAuditChainHeadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAuditChainHead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAuditChainHead implements repository.LogRepository
+func (mmGetAuditChainHead *LogRepositoryMock) GetAuditChainHead(ctx context.Context) (resp model.AuditChainHead, err error) {
+	mm_atomic.AddUint64(&mmGetAuditChainHead.beforeGetAuditChainHeadCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAuditChainHead.afterGetAuditChainHeadCounter, 1)
+
+	if mmGetAuditChainHead.inspectFuncGetAuditChainHead != nil {
+		mmGetAuditChainHead.inspectFuncGetAuditChainHead(ctx)
+	}
+
+	mm_params := LogRepositoryMockGetAuditChainHeadParams{ctx}
+
+	// Record call args
+	mmGetAuditChainHead.GetAuditChainHeadMock.mutex.Lock()
+	mmGetAuditChainHead.GetAuditChainHeadMock.callArgs = append(mmGetAuditChainHead.GetAuditChainHeadMock.callArgs, &mm_params)
+	mmGetAuditChainHead.GetAuditChainHeadMock.mutex.Unlock()
+
+	for _, e := range mmGetAuditChainHead.GetAuditChainHeadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmGetAuditChainHead.GetAuditChainHeadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAuditChainHead.GetAuditChainHeadMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAuditChainHead.GetAuditChainHeadMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAuditChainHead.GetAuditChainHeadMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockGetAuditChainHeadParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAuditChainHead.t.Errorf("LogRepositoryMock.GetAuditChainHead got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAuditChainHead.t.Errorf("LogRepositoryMock.GetAuditChainHead got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAuditChainHead.GetAuditChainHeadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAuditChainHead.t.Fatal("No results are set for the LogRepositoryMock.GetAuditChainHead")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmGetAuditChainHead.funcGetAuditChainHead != nil {
+		return mmGetAuditChainHead.funcGetAuditChainHead(ctx)
+	}
+	mmGetAuditChainHead.t.Fatalf("Unexpected call to LogRepositoryMock.GetAuditChainHead. %v", ctx)
+	return
+}
+
+// GetAuditChainHeadAfterCounter returns a count of finished LogRepositoryMock.GetAuditChainHead invocations
+func (mmGetAuditChainHead *LogRepositoryMock) GetAuditChainHeadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuditChainHead.afterGetAuditChainHeadCounter)
+}
+
+// GetAuditChainHeadBeforeCounter returns a count of LogRepositoryMock.GetAuditChainHead invocations
+func (mmGetAuditChainHead *LogRepositoryMock) GetAuditChainHeadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAuditChainHead.beforeGetAuditChainHeadCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.GetAuditChainHead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAuditChainHead *mLogRepositoryMockGetAuditChainHead) Calls() []*LogRepositoryMockGetAuditChainHeadParams {
+	mmGetAuditChainHead.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockGetAuditChainHeadParams, len(mmGetAuditChainHead.callArgs))
+	copy(argCopy, mmGetAuditChainHead.callArgs)
+
+	mmGetAuditChainHead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAuditChainHeadDone returns true if the count of the GetAuditChainHead invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockGetAuditChainHeadDone() bool {
+	if m.GetAuditChainHeadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAuditChainHeadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAuditChainHeadMock.invocationsDone()
+}
+
+// MinimockGetAuditChainHeadInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockGetAuditChainHeadInspect() {
+	for _, e := range m.GetAuditChainHeadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.GetAuditChainHead with params: %#v", *e.params)
+		}
+	}
+
+	afterGetAuditChainHeadCounter := mm_atomic.LoadUint64(&m.afterGetAuditChainHeadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAuditChainHeadMock.defaultExpectation != nil && afterGetAuditChainHeadCounter < 1 {
+		if m.GetAuditChainHeadMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.GetAuditChainHead")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.GetAuditChainHead with params: %#v", *m.GetAuditChainHeadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAuditChainHead != nil && afterGetAuditChainHeadCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.GetAuditChainHead")
+	}
+
+	if !m.GetAuditChainHeadMock.invocationsDone() && afterGetAuditChainHeadCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.GetAuditChainHead but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAuditChainHeadMock.expectedInvocations), afterGetAuditChainHeadCounter)
+	}
+}
+
+type mLogRepositoryMockListAuditChain struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListAuditChainExpectation
+	expectations       []*LogRepositoryMockListAuditChainExpectation
+
+	callArgs []*LogRepositoryMockListAuditChainParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockListAuditChainExpectation specifies expectation struct of the LogRepository.ListAuditChain
+type LogRepositoryMockListAuditChainExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockListAuditChainParams
+	paramPtrs *LogRepositoryMockListAuditChainParamPtrs
+	results   *LogRepositoryMockListAuditChainResults
+	Counter   uint64
+}
+
+// LogRepositoryMockListAuditChainParams contains parameters of the LogRepository.ListAuditChain
+type LogRepositoryMockListAuditChainParams struct {
+	ctx    context.Context
+	params model.ListAuditChainParams
+}
+
+// LogRepositoryMockListAuditChainParamPtrs contains pointers to parameters of the LogRepository.ListAuditChain
+type LogRepositoryMockListAuditChainParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditChainParams
+}
+
+// LogRepositoryMockListAuditChainResults contains results of the LogRepository.ListAuditChain
+type LogRepositoryMockListAuditChainResults struct {
+	resp []model.AuditChainLink
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Optional() *mLogRepositoryMockListAuditChain {
+	mmListAuditChain.optional = true
+	return mmListAuditChain
+}
+
+// Expect sets up expected params for LogRepository.ListAuditChain
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Expect(ctx context.Context, params model.ListAuditChainParams) *mLogRepositoryMockListAuditChain {
+	if mmListAuditChain.mock.funcListAuditChain != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Set")
+	}
+
+	if mmListAuditChain.defaultExpectation == nil {
+		mmListAuditChain.defaultExpectation = &LogRepositoryMockListAuditChainExpectation{}
+	}
+
+	if mmListAuditChain.defaultExpectation.paramPtrs != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditChain.defaultExpectation.params = &LogRepositoryMockListAuditChainParams{ctx, params}
+	for _, e := range mmListAuditChain.expectations {
+		if minimock.Equal(e.params, mmListAuditChain.defaultExpectation.params) {
+			mmListAuditChain.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditChain.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditChain
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListAuditChain
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListAuditChain {
+	if mmListAuditChain.mock.funcListAuditChain != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Set")
+	}
+
+	if mmListAuditChain.defaultExpectation == nil {
+		mmListAuditChain.defaultExpectation = &LogRepositoryMockListAuditChainExpectation{}
+	}
+
+	if mmListAuditChain.defaultExpectation.params != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Expect")
+	}
+
+	if mmListAuditChain.defaultExpectation.paramPtrs == nil {
+		mmListAuditChain.defaultExpectation.paramPtrs = &LogRepositoryMockListAuditChainParamPtrs{}
+	}
+	mmListAuditChain.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditChain
+}
+
+// ExpectParamsParam2 sets up expected param params for LogRepository.ListAuditChain
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) ExpectParamsParam2(params model.ListAuditChainParams) *mLogRepositoryMockListAuditChain {
+	if mmListAuditChain.mock.funcListAuditChain != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Set")
+	}
+
+	if mmListAuditChain.defaultExpectation == nil {
+		mmListAuditChain.defaultExpectation = &LogRepositoryMockListAuditChainExpectation{}
+	}
+
+	if mmListAuditChain.defaultExpectation.params != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Expect")
+	}
+
+	if mmListAuditChain.defaultExpectation.paramPtrs == nil {
+		mmListAuditChain.defaultExpectation.paramPtrs = &LogRepositoryMockListAuditChainParamPtrs{}
+	}
+	mmListAuditChain.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAuditChain
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListAuditChain
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Inspect(f func(ctx context.Context, params model.ListAuditChainParams)) *mLogRepositoryMockListAuditChain {
+	if mmListAuditChain.mock.inspectFuncListAuditChain != nil {
+		mmListAuditChain.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListAuditChain")
+	}
+
+	mmListAuditChain.mock.inspectFuncListAuditChain = f
+
+	return mmListAuditChain
+}
+
+// Return sets up results that will be returned by LogRepository.ListAuditChain
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Return(resp []model.AuditChainLink, err error) *LogRepositoryMock {
+	if mmListAuditChain.mock.funcListAuditChain != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Set")
+	}
+
+	if mmListAuditChain.defaultExpectation == nil {
+		mmListAuditChain.defaultExpectation = &LogRepositoryMockListAuditChainExpectation{mock: mmListAuditChain.mock}
+	}
+	mmListAuditChain.defaultExpectation.results = &LogRepositoryMockListAuditChainResults{resp, err}
+	return mmListAuditChain.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListAuditChain method
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Set(f func(ctx context.Context, params model.ListAuditChainParams) (resp []model.AuditChainLink, err error)) *LogRepositoryMock {
+	if mmListAuditChain.defaultExpectation != nil {
+		mmListAuditChain.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListAuditChain method")
+	}
+
+	if len(mmListAuditChain.expectations) > 0 {
+		mmListAuditChain.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListAuditChain method")
+	}
+
+	mmListAuditChain.mock.funcListAuditChain = f
+	return mmListAuditChain.mock
+}
+
+// When sets expectation for the LogRepository.ListAuditChain which will trigger the result defined by the following
+// Then helper
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) When(ctx context.Context, params model.ListAuditChainParams) *LogRepositoryMockListAuditChainExpectation {
+	if mmListAuditChain.mock.funcListAuditChain != nil {
+		mmListAuditChain.mock.t.Fatalf("LogRepositoryMock.ListAuditChain mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListAuditChainExpectation{
+		mock:   mmListAuditChain.mock,
+		params: &LogRepositoryMockListAuditChainParams{ctx, params},
+	}
+	mmListAuditChain.expectations = append(mmListAuditChain.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListAuditChain return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListAuditChainExpectation) Then(resp []model.AuditChainLink, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListAuditChainResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListAuditChain should be invoked
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Times(n uint64) *mLogRepositoryMockListAuditChain {
+	if n == 0 {
+		mmListAuditChain.mock.t.Fatalf("Times of LogRepositoryMock.ListAuditChain mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditChain.expectedInvocations, n)
+	return mmListAuditChain
+}
+
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) invocationsDone() bool {
+	if len(mmListAuditChain.expectations) == 0 && mmListAuditChain.defaultExpectation == nil && mmListAuditChain.mock.funcListAuditChain == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditChain.mock.afterListAuditChainCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditChain.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditChain implements repository.LogRepository
+func (mmListAuditChain *LogRepositoryMock) ListAuditChain(ctx context.Context, params model.ListAuditChainParams) (resp []model.AuditChainLink, err error) {
+	mm_atomic.AddUint64(&mmListAuditChain.beforeListAuditChainCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditChain.afterListAuditChainCounter, 1)
+
+	if mmListAuditChain.inspectFuncListAuditChain != nil {
+		mmListAuditChain.inspectFuncListAuditChain(ctx, params)
+	}
+
+	mm_params := LogRepositoryMockListAuditChainParams{ctx, params}
+
+	// Record call args
+	mmListAuditChain.ListAuditChainMock.mutex.Lock()
+	mmListAuditChain.ListAuditChainMock.callArgs = append(mmListAuditChain.ListAuditChainMock.callArgs, &mm_params)
+	mmListAuditChain.ListAuditChainMock.mutex.Unlock()
+
+	for _, e := range mmListAuditChain.ListAuditChainMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditChain.ListAuditChainMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditChain.ListAuditChainMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditChain.ListAuditChainMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditChain.ListAuditChainMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListAuditChainParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditChain.t.Errorf("LogRepositoryMock.ListAuditChain got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAuditChain.t.Errorf("LogRepositoryMock.ListAuditChain got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditChain.t.Errorf("LogRepositoryMock.ListAuditChain got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditChain.ListAuditChainMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditChain.t.Fatal("No results are set for the LogRepositoryMock.ListAuditChain")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditChain.funcListAuditChain != nil {
+		return mmListAuditChain.funcListAuditChain(ctx, params)
+	}
+	mmListAuditChain.t.Fatalf("Unexpected call to LogRepositoryMock.ListAuditChain. %v %v", ctx, params)
+	return
+}
+
+// ListAuditChainAfterCounter returns a count of finished LogRepositoryMock.ListAuditChain invocations
+func (mmListAuditChain *LogRepositoryMock) ListAuditChainAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditChain.afterListAuditChainCounter)
+}
+
+// ListAuditChainBeforeCounter returns a count of LogRepositoryMock.ListAuditChain invocations
+func (mmListAuditChain *LogRepositoryMock) ListAuditChainBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditChain.beforeListAuditChainCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListAuditChain.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditChain *mLogRepositoryMockListAuditChain) Calls() []*LogRepositoryMockListAuditChainParams {
+	mmListAuditChain.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListAuditChainParams, len(mmListAuditChain.callArgs))
+	copy(argCopy, mmListAuditChain.callArgs)
+
+	mmListAuditChain.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditChainDone returns true if the count of the ListAuditChain invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListAuditChainDone() bool {
+	if m.ListAuditChainMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditChainMock.invocationsDone()
+}
+
+// MinimockListAuditChainInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListAuditChainInspect() {
+	for _, e := range m.ListAuditChainMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAuditChain with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditChainCounter := mm_atomic.LoadUint64(&m.afterListAuditChainCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditChainMock.defaultExpectation != nil && afterListAuditChainCounter < 1 {
+		if m.ListAuditChainMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.ListAuditChain")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAuditChain with params: %#v", *m.ListAuditChainMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditChain != nil && afterListAuditChainCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.ListAuditChain")
+	}
+
+	if !m.ListAuditChainMock.invocationsDone() && afterListAuditChainCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListAuditChain but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditChainMock.expectedInvocations), afterListAuditChainCounter)
+	}
+}
+
+type mLogRepositoryMockListAuditCheckpoints struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListAuditCheckpointsExpectation
+	expectations       []*LogRepositoryMockListAuditCheckpointsExpectation
+
+	callArgs []*LogRepositoryMockListAuditCheckpointsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockListAuditCheckpointsExpectation specifies expectation struct of the LogRepository.ListAuditCheckpoints
+type LogRepositoryMockListAuditCheckpointsExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockListAuditCheckpointsParams
+	paramPtrs *LogRepositoryMockListAuditCheckpointsParamPtrs
+	results   *LogRepositoryMockListAuditCheckpointsResults
+	Counter   uint64
+}
+
+// LogRepositoryMockListAuditCheckpointsParams contains parameters of the LogRepository.ListAuditCheckpoints
+type LogRepositoryMockListAuditCheckpointsParams struct {
+	ctx    context.Context
+	params model.ListAuditCheckpointsParams
+}
+
+// LogRepositoryMockListAuditCheckpointsParamPtrs contains pointers to parameters of the LogRepository.ListAuditCheckpoints
+type LogRepositoryMockListAuditCheckpointsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditCheckpointsParams
+}
+
+// LogRepositoryMockListAuditCheckpointsResults contains results of the LogRepository.ListAuditCheckpoints
+type LogRepositoryMockListAuditCheckpointsResults struct {
+	resp []model.AuditCheckpoint
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Optional() *mLogRepositoryMockListAuditCheckpoints {
+	mmListAuditCheckpoints.optional = true
+	return mmListAuditCheckpoints
+}
+
+// Expect sets up expected params for LogRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Expect(ctx context.Context, params model.ListAuditCheckpointsParams) *mLogRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &LogRepositoryMockListAuditCheckpointsExpectation{}
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.paramPtrs != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditCheckpoints.defaultExpectation.params = &LogRepositoryMockListAuditCheckpointsParams{ctx, params}
+	for _, e := range mmListAuditCheckpoints.expectations {
+		if minimock.Equal(e.params, mmListAuditCheckpoints.defaultExpectation.params) {
+			mmListAuditCheckpoints.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditCheckpoints.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditCheckpoints
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &LogRepositoryMockListAuditCheckpointsExpectation{}
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.params != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Expect")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.paramPtrs == nil {
+		mmListAuditCheckpoints.defaultExpectation.paramPtrs = &LogRepositoryMockListAuditCheckpointsParamPtrs{}
+	}
+	mmListAuditCheckpoints.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditCheckpoints
+}
+
+// ExpectParamsParam2 sets up expected param params for LogRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) ExpectParamsParam2(params model.ListAuditCheckpointsParams) *mLogRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &LogRepositoryMockListAuditCheckpointsExpectation{}
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.params != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Expect")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation.paramPtrs == nil {
+		mmListAuditCheckpoints.defaultExpectation.paramPtrs = &LogRepositoryMockListAuditCheckpointsParamPtrs{}
+	}
+	mmListAuditCheckpoints.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAuditCheckpoints
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Inspect(f func(ctx context.Context, params model.ListAuditCheckpointsParams)) *mLogRepositoryMockListAuditCheckpoints {
+	if mmListAuditCheckpoints.mock.inspectFuncListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListAuditCheckpoints")
+	}
+
+	mmListAuditCheckpoints.mock.inspectFuncListAuditCheckpoints = f
+
+	return mmListAuditCheckpoints
+}
+
+// Return sets up results that will be returned by LogRepository.ListAuditCheckpoints
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Return(resp []model.AuditCheckpoint, err error) *LogRepositoryMock {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	if mmListAuditCheckpoints.defaultExpectation == nil {
+		mmListAuditCheckpoints.defaultExpectation = &LogRepositoryMockListAuditCheckpointsExpectation{mock: mmListAuditCheckpoints.mock}
+	}
+	mmListAuditCheckpoints.defaultExpectation.results = &LogRepositoryMockListAuditCheckpointsResults{resp, err}
+	return mmListAuditCheckpoints.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListAuditCheckpoints method
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Set(f func(ctx context.Context, params model.ListAuditCheckpointsParams) (resp []model.AuditCheckpoint, err error)) *LogRepositoryMock {
+	if mmListAuditCheckpoints.defaultExpectation != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListAuditCheckpoints method")
+	}
+
+	if len(mmListAuditCheckpoints.expectations) > 0 {
+		mmListAuditCheckpoints.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListAuditCheckpoints method")
+	}
+
+	mmListAuditCheckpoints.mock.funcListAuditCheckpoints = f
+	return mmListAuditCheckpoints.mock
+}
+
+// When sets expectation for the LogRepository.ListAuditCheckpoints which will trigger the result defined by the following
+// Then helper
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) When(ctx context.Context, params model.ListAuditCheckpointsParams) *LogRepositoryMockListAuditCheckpointsExpectation {
+	if mmListAuditCheckpoints.mock.funcListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.mock.t.Fatalf("LogRepositoryMock.ListAuditCheckpoints mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListAuditCheckpointsExpectation{
+		mock:   mmListAuditCheckpoints.mock,
+		params: &LogRepositoryMockListAuditCheckpointsParams{ctx, params},
+	}
+	mmListAuditCheckpoints.expectations = append(mmListAuditCheckpoints.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListAuditCheckpoints return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListAuditCheckpointsExpectation) Then(resp []model.AuditCheckpoint, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListAuditCheckpointsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListAuditCheckpoints should be invoked
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Times(n uint64) *mLogRepositoryMockListAuditCheckpoints {
+	if n == 0 {
+		mmListAuditCheckpoints.mock.t.Fatalf("Times of LogRepositoryMock.ListAuditCheckpoints mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditCheckpoints.expectedInvocations, n)
+	return mmListAuditCheckpoints
+}
+
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) invocationsDone() bool {
+	if len(mmListAuditCheckpoints.expectations) == 0 && mmListAuditCheckpoints.defaultExpectation == nil && mmListAuditCheckpoints.mock.funcListAuditCheckpoints == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditCheckpoints.mock.afterListAuditCheckpointsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditCheckpoints.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditCheckpoints implements repository.LogRepository
+func (mmListAuditCheckpoints *LogRepositoryMock) ListAuditCheckpoints(ctx context.Context, params model.ListAuditCheckpointsParams) (resp []model.AuditCheckpoint, err error) {
+	mm_atomic.AddUint64(&mmListAuditCheckpoints.beforeListAuditCheckpointsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditCheckpoints.afterListAuditCheckpointsCounter, 1)
+
+	if mmListAuditCheckpoints.inspectFuncListAuditCheckpoints != nil {
+		mmListAuditCheckpoints.inspectFuncListAuditCheckpoints(ctx, params)
+	}
+
+	mm_params := LogRepositoryMockListAuditCheckpointsParams{ctx, params}
+
+	// Record call args
+	mmListAuditCheckpoints.ListAuditCheckpointsMock.mutex.Lock()
+	mmListAuditCheckpoints.ListAuditCheckpointsMock.callArgs = append(mmListAuditCheckpoints.ListAuditCheckpointsMock.callArgs, &mm_params)
+	mmListAuditCheckpoints.ListAuditCheckpointsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditCheckpoints.ListAuditCheckpointsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListAuditCheckpointsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditCheckpoints.t.Errorf("LogRepositoryMock.ListAuditCheckpoints got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAuditCheckpoints.t.Errorf("LogRepositoryMock.ListAuditCheckpoints got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditCheckpoints.t.Errorf("LogRepositoryMock.ListAuditCheckpoints got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditCheckpoints.ListAuditCheckpointsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditCheckpoints.t.Fatal("No results are set for the LogRepositoryMock.ListAuditCheckpoints")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditCheckpoints.funcListAuditCheckpoints != nil {
+		return mmListAuditCheckpoints.funcListAuditCheckpoints(ctx, params)
+	}
+	mmListAuditCheckpoints.t.Fatalf("Unexpected call to LogRepositoryMock.ListAuditCheckpoints. %v %v", ctx, params)
+	return
+}
+
+// ListAuditCheckpointsAfterCounter returns a count of finished LogRepositoryMock.ListAuditCheckpoints invocations
+func (mmListAuditCheckpoints *LogRepositoryMock) ListAuditCheckpointsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditCheckpoints.afterListAuditCheckpointsCounter)
+}
+
+// ListAuditCheckpointsBeforeCounter returns a count of LogRepositoryMock.ListAuditCheckpoints invocations
+func (mmListAuditCheckpoints *LogRepositoryMock) ListAuditCheckpointsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditCheckpoints.beforeListAuditCheckpointsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListAuditCheckpoints.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditCheckpoints *mLogRepositoryMockListAuditCheckpoints) Calls() []*LogRepositoryMockListAuditCheckpointsParams {
+	mmListAuditCheckpoints.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListAuditCheckpointsParams, len(mmListAuditCheckpoints.callArgs))
+	copy(argCopy, mmListAuditCheckpoints.callArgs)
+
+	mmListAuditCheckpoints.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditCheckpointsDone returns true if the count of the ListAuditCheckpoints invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListAuditCheckpointsDone() bool {
+	if m.ListAuditCheckpointsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditCheckpointsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditCheckpointsMock.invocationsDone()
+}
+
+// MinimockListAuditCheckpointsInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListAuditCheckpointsInspect() {
+	for _, e := range m.ListAuditCheckpointsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAuditCheckpoints with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditCheckpointsCounter := mm_atomic.LoadUint64(&m.afterListAuditCheckpointsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditCheckpointsMock.defaultExpectation != nil && afterListAuditCheckpointsCounter < 1 {
+		if m.ListAuditCheckpointsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.ListAuditCheckpoints")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAuditCheckpoints with params: %#v", *m.ListAuditCheckpointsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditCheckpoints != nil && afterListAuditCheckpointsCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.ListAuditCheckpoints")
+	}
+
+	if !m.ListAuditCheckpointsMock.invocationsDone() && afterListAuditCheckpointsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListAuditCheckpoints but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditCheckpointsMock.expectedInvocations), afterListAuditCheckpointsCounter)
+	}
+}
+
+type mLogRepositoryMockListAuditEvents struct {
+	optional           bool
+	mock               *LogRepositoryMock
+	defaultExpectation *LogRepositoryMockListAuditEventsExpectation
+	expectations       []*LogRepositoryMockListAuditEventsExpectation
+
+	callArgs []*LogRepositoryMockListAuditEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LogRepositoryMockListAuditEventsExpectation specifies expectation struct of the LogRepository.ListAuditEvents
+type LogRepositoryMockListAuditEventsExpectation struct {
+	mock      *LogRepositoryMock
+	params    *LogRepositoryMockListAuditEventsParams
+	paramPtrs *LogRepositoryMockListAuditEventsParamPtrs
+	results   *LogRepositoryMockListAuditEventsResults
+	Counter   uint64
+}
+
+// LogRepositoryMockListAuditEventsParams contains parameters of the LogRepository.ListAuditEvents
+type LogRepositoryMockListAuditEventsParams struct {
+	ctx    context.Context
+	params model.ListAuditEventsPageParams
+}
+
+// LogRepositoryMockListAuditEventsParamPtrs contains pointers to parameters of the LogRepository.ListAuditEvents
+type LogRepositoryMockListAuditEventsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditEventsPageParams
+}
+
+// LogRepositoryMockListAuditEventsResults contains results of the LogRepository.ListAuditEvents
+type LogRepositoryMockListAuditEventsResults struct {
+	resp model.ListAuditEventsPageResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Optional() *mLogRepositoryMockListAuditEvents {
+	mmListAuditEvents.optional = true
+	return mmListAuditEvents
+}
+
+// Expect sets up expected params for LogRepository.ListAuditEvents
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Expect(ctx context.Context, params model.ListAuditEventsPageParams) *mLogRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &LogRepositoryMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditEvents.defaultExpectation.params = &LogRepositoryMockListAuditEventsParams{ctx, params}
+	for _, e := range mmListAuditEvents.expectations {
+		if minimock.Equal(e.params, mmListAuditEvents.defaultExpectation.params) {
+			mmListAuditEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LogRepository.ListAuditEvents
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) ExpectCtxParam1(ctx context.Context) *mLogRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &LogRepositoryMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.params != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Expect")
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs == nil {
+		mmListAuditEvents.defaultExpectation.paramPtrs = &LogRepositoryMockListAuditEventsParamPtrs{}
+	}
+	mmListAuditEvents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditEvents
+}
+
+// ExpectParamsParam2 sets up expected param params for LogRepository.ListAuditEvents
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) ExpectParamsParam2(params model.ListAuditEventsPageParams) *mLogRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &LogRepositoryMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.params != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Expect")
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs == nil {
+		mmListAuditEvents.defaultExpectation.paramPtrs = &LogRepositoryMockListAuditEventsParamPtrs{}
+	}
+	mmListAuditEvents.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAuditEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the LogRepository.ListAuditEvents
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Inspect(f func(ctx context.Context, params model.ListAuditEventsPageParams)) *mLogRepositoryMockListAuditEvents {
+	if mmListAuditEvents.mock.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("Inspect function is already set for LogRepositoryMock.ListAuditEvents")
+	}
+
+	mmListAuditEvents.mock.inspectFuncListAuditEvents = f
+
+	return mmListAuditEvents
+}
+
+// Return sets up results that will be returned by LogRepository.ListAuditEvents
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Return(resp model.ListAuditEventsPageResponse, err error) *LogRepositoryMock {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &LogRepositoryMockListAuditEventsExpectation{mock: mmListAuditEvents.mock}
+	}
+	mmListAuditEvents.defaultExpectation.results = &LogRepositoryMockListAuditEventsResults{resp, err}
+	return mmListAuditEvents.mock
+}
+
+// Set uses given function f to mock the LogRepository.ListAuditEvents method
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Set(f func(ctx context.Context, params model.ListAuditEventsPageParams) (resp model.ListAuditEventsPageResponse, err error)) *LogRepositoryMock {
+	if mmListAuditEvents.defaultExpectation != nil {
+		mmListAuditEvents.mock.t.Fatalf("Default expectation is already set for the LogRepository.ListAuditEvents method")
+	}
+
+	if len(mmListAuditEvents.expectations) > 0 {
+		mmListAuditEvents.mock.t.Fatalf("Some expectations are already set for the LogRepository.ListAuditEvents method")
+	}
+
+	mmListAuditEvents.mock.funcListAuditEvents = f
+	return mmListAuditEvents.mock
+}
+
+// When sets expectation for the LogRepository.ListAuditEvents which will trigger the result defined by the following
+// Then helper
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) When(ctx context.Context, params model.ListAuditEventsPageParams) *LogRepositoryMockListAuditEventsExpectation {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("LogRepositoryMock.ListAuditEvents mock is already set by Set")
+	}
+
+	expectation := &LogRepositoryMockListAuditEventsExpectation{
+		mock:   mmListAuditEvents.mock,
+		params: &LogRepositoryMockListAuditEventsParams{ctx, params},
+	}
+	mmListAuditEvents.expectations = append(mmListAuditEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LogRepository.ListAuditEvents return parameters for the expectation previously defined by the When method
+func (e *LogRepositoryMockListAuditEventsExpectation) Then(resp model.ListAuditEventsPageResponse, err error) *LogRepositoryMock {
+	e.results = &LogRepositoryMockListAuditEventsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LogRepository.ListAuditEvents should be invoked
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Times(n uint64) *mLogRepositoryMockListAuditEvents {
+	if n == 0 {
+		mmListAuditEvents.mock.t.Fatalf("Times of LogRepositoryMock.ListAuditEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditEvents.expectedInvocations, n)
+	return mmListAuditEvents
+}
+
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) invocationsDone() bool {
+	if len(mmListAuditEvents.expectations) == 0 && mmListAuditEvents.defaultExpectation == nil && mmListAuditEvents.mock.funcListAuditEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditEvents.mock.afterListAuditEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditEvents implements repository.LogRepository
+func (mmListAuditEvents *LogRepositoryMock) ListAuditEvents(ctx context.Context, params model.ListAuditEventsPageParams) (resp model.ListAuditEventsPageResponse, err error) {
+	mm_atomic.AddUint64(&mmListAuditEvents.beforeListAuditEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditEvents.afterListAuditEventsCounter, 1)
+
+	if mmListAuditEvents.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.inspectFuncListAuditEvents(ctx, params)
+	}
+
+	mm_params := LogRepositoryMockListAuditEventsParams{ctx, params}
+
+	// Record call args
+	mmListAuditEvents.ListAuditEventsMock.mutex.Lock()
+	mmListAuditEvents.ListAuditEventsMock.callArgs = append(mmListAuditEvents.ListAuditEventsMock.callArgs, &mm_params)
+	mmListAuditEvents.ListAuditEventsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditEvents.ListAuditEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditEvents.ListAuditEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditEvents.ListAuditEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := LogRepositoryMockListAuditEventsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditEvents.t.Errorf("LogRepositoryMock.ListAuditEvents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAuditEvents.t.Errorf("LogRepositoryMock.ListAuditEvents got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditEvents.t.Errorf("LogRepositoryMock.ListAuditEvents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditEvents.t.Fatal("No results are set for the LogRepositoryMock.ListAuditEvents")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditEvents.funcListAuditEvents != nil {
+		return mmListAuditEvents.funcListAuditEvents(ctx, params)
+	}
+	mmListAuditEvents.t.Fatalf("Unexpected call to LogRepositoryMock.ListAuditEvents. %v %v", ctx, params)
+	return
+}
+
+// ListAuditEventsAfterCounter returns a count of finished LogRepositoryMock.ListAuditEvents invocations
+func (mmListAuditEvents *LogRepositoryMock) ListAuditEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.afterListAuditEventsCounter)
+}
+
+// ListAuditEventsBeforeCounter returns a count of LogRepositoryMock.ListAuditEvents invocations
+func (mmListAuditEvents *LogRepositoryMock) ListAuditEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.beforeListAuditEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to LogRepositoryMock.ListAuditEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditEvents *mLogRepositoryMockListAuditEvents) Calls() []*LogRepositoryMockListAuditEventsParams {
+	mmListAuditEvents.mutex.RLock()
+
+	argCopy := make([]*LogRepositoryMockListAuditEventsParams, len(mmListAuditEvents.callArgs))
+	copy(argCopy, mmListAuditEvents.callArgs)
+
+	mmListAuditEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditEventsDone returns true if the count of the ListAuditEvents invocations corresponds
+// the number of defined expectations
+func (m *LogRepositoryMock) MinimockListAuditEventsDone() bool {
+	if m.ListAuditEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditEventsMock.invocationsDone()
+}
+
+// MinimockListAuditEventsInspect logs each unmet expectation
+func (m *LogRepositoryMock) MinimockListAuditEventsInspect() {
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAuditEvents with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditEventsCounter := mm_atomic.LoadUint64(&m.afterListAuditEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditEventsMock.defaultExpectation != nil && afterListAuditEventsCounter < 1 {
+		if m.ListAuditEventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LogRepositoryMock.ListAuditEvents")
+		} else {
+			m.t.Errorf("Expected call to LogRepositoryMock.ListAuditEvents with params: %#v", *m.ListAuditEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditEvents != nil && afterListAuditEventsCounter < 1 {
+		m.t.Error("Expected call to LogRepositoryMock.ListAuditEvents")
+	}
+
+	if !m.ListAuditEventsMock.invocationsDone() && afterListAuditEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to LogRepositoryMock.ListAuditEvents but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditEventsMock.expectedInvocations), afterListAuditEventsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LogRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAPILogInspect()
+
+			m.MinimockCreateAPILogsInspect()
+
+			m.MinimockCreateAuditCheckpointInspect()
+
+			m.MinimockGetAuditChainHeadInspect()
+
+			m.MinimockListAuditChainInspect()
+
+			m.MinimockListAuditCheckpointsInspect()
+
+			m.MinimockListAuditEventsInspect()
 		}
 	})
 }
@@ -390,5 +2353,11 @@ func (m *LogRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LogRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCreateAPILogDone()
+		m.MinimockCreateAPILogDone() &&
+		m.MinimockCreateAPILogsDone() &&
+		m.MinimockCreateAuditCheckpointDone() &&
+		m.MinimockGetAuditChainHeadDone() &&
+		m.MinimockListAuditChainDone() &&
+		m.MinimockListAuditCheckpointsDone() &&
+		m.MinimockListAuditEventsDone()
 }
@@ -0,0 +1,1392 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/repository.LoginFailureRepository -o login_failure_repository_minimock.go -n LoginFailureRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// LoginFailureRepositoryMock implements repository.LoginFailureRepository
+type LoginFailureRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteLoginFailures          func(ctx context.Context, params model.DeleteLoginFailuresParams) (err error)
+	inspectFuncDeleteLoginFailures   func(ctx context.Context, params model.DeleteLoginFailuresParams)
+	afterDeleteLoginFailuresCounter  uint64
+	beforeDeleteLoginFailuresCounter uint64
+	DeleteLoginFailuresMock          mLoginFailureRepositoryMockDeleteLoginFailures
+
+	funcLockLogin          func(ctx context.Context, params model.LockLoginParams) (err error)
+	inspectFuncLockLogin   func(ctx context.Context, params model.LockLoginParams)
+	afterLockLoginCounter  uint64
+	beforeLockLoginCounter uint64
+	LockLoginMock          mLoginFailureRepositoryMockLockLogin
+
+	funcLockLoginFailures          func(ctx context.Context, params model.LockLoginFailuresParams) (resp []model.LoginFailure, err error)
+	inspectFuncLockLoginFailures   func(ctx context.Context, params model.LockLoginFailuresParams)
+	afterLockLoginFailuresCounter  uint64
+	beforeLockLoginFailuresCounter uint64
+	LockLoginFailuresMock          mLoginFailureRepositoryMockLockLoginFailures
+
+	funcRecordLoginFailure          func(ctx context.Context, params model.RecordLoginFailureParams) (resp model.LoginFailure, err error)
+	inspectFuncRecordLoginFailure   func(ctx context.Context, params model.RecordLoginFailureParams)
+	afterRecordLoginFailureCounter  uint64
+	beforeRecordLoginFailureCounter uint64
+	RecordLoginFailureMock          mLoginFailureRepositoryMockRecordLoginFailure
+}
+
+// NewLoginFailureRepositoryMock returns a mock for repository.LoginFailureRepository
+func NewLoginFailureRepositoryMock(t minimock.Tester) *LoginFailureRepositoryMock {
+	m := &LoginFailureRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteLoginFailuresMock = mLoginFailureRepositoryMockDeleteLoginFailures{mock: m}
+	m.DeleteLoginFailuresMock.callArgs = []*LoginFailureRepositoryMockDeleteLoginFailuresParams{}
+
+	m.LockLoginMock = mLoginFailureRepositoryMockLockLogin{mock: m}
+	m.LockLoginMock.callArgs = []*LoginFailureRepositoryMockLockLoginParams{}
+
+	m.LockLoginFailuresMock = mLoginFailureRepositoryMockLockLoginFailures{mock: m}
+	m.LockLoginFailuresMock.callArgs = []*LoginFailureRepositoryMockLockLoginFailuresParams{}
+
+	m.RecordLoginFailureMock = mLoginFailureRepositoryMockRecordLoginFailure{mock: m}
+	m.RecordLoginFailureMock.callArgs = []*LoginFailureRepositoryMockRecordLoginFailureParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mLoginFailureRepositoryMockDeleteLoginFailures struct {
+	optional           bool
+	mock               *LoginFailureRepositoryMock
+	defaultExpectation *LoginFailureRepositoryMockDeleteLoginFailuresExpectation
+	expectations       []*LoginFailureRepositoryMockDeleteLoginFailuresExpectation
+
+	callArgs []*LoginFailureRepositoryMockDeleteLoginFailuresParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginFailureRepositoryMockDeleteLoginFailuresExpectation specifies expectation struct of the LoginFailureRepository.DeleteLoginFailures
+type LoginFailureRepositoryMockDeleteLoginFailuresExpectation struct {
+	mock      *LoginFailureRepositoryMock
+	params    *LoginFailureRepositoryMockDeleteLoginFailuresParams
+	paramPtrs *LoginFailureRepositoryMockDeleteLoginFailuresParamPtrs
+	results   *LoginFailureRepositoryMockDeleteLoginFailuresResults
+	Counter   uint64
+}
+
+// LoginFailureRepositoryMockDeleteLoginFailuresParams contains parameters of the LoginFailureRepository.DeleteLoginFailures
+type LoginFailureRepositoryMockDeleteLoginFailuresParams struct {
+	ctx    context.Context
+	params model.DeleteLoginFailuresParams
+}
+
+// LoginFailureRepositoryMockDeleteLoginFailuresParamPtrs contains pointers to parameters of the LoginFailureRepository.DeleteLoginFailures
+type LoginFailureRepositoryMockDeleteLoginFailuresParamPtrs struct {
+	ctx    *context.Context
+	params *model.DeleteLoginFailuresParams
+}
+
+// LoginFailureRepositoryMockDeleteLoginFailuresResults contains results of the LoginFailureRepository.DeleteLoginFailures
+type LoginFailureRepositoryMockDeleteLoginFailuresResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Optional() *mLoginFailureRepositoryMockDeleteLoginFailures {
+	mmDeleteLoginFailures.optional = true
+	return mmDeleteLoginFailures
+}
+
+// Expect sets up expected params for LoginFailureRepository.DeleteLoginFailures
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Expect(ctx context.Context, params model.DeleteLoginFailuresParams) *mLoginFailureRepositoryMockDeleteLoginFailures {
+	if mmDeleteLoginFailures.mock.funcDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Set")
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation == nil {
+		mmDeleteLoginFailures.defaultExpectation = &LoginFailureRepositoryMockDeleteLoginFailuresExpectation{}
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation.paramPtrs != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteLoginFailures.defaultExpectation.params = &LoginFailureRepositoryMockDeleteLoginFailuresParams{ctx, params}
+	for _, e := range mmDeleteLoginFailures.expectations {
+		if minimock.Equal(e.params, mmDeleteLoginFailures.defaultExpectation.params) {
+			mmDeleteLoginFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteLoginFailures.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteLoginFailures
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginFailureRepository.DeleteLoginFailures
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) ExpectCtxParam1(ctx context.Context) *mLoginFailureRepositoryMockDeleteLoginFailures {
+	if mmDeleteLoginFailures.mock.funcDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Set")
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation == nil {
+		mmDeleteLoginFailures.defaultExpectation = &LoginFailureRepositoryMockDeleteLoginFailuresExpectation{}
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation.params != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Expect")
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmDeleteLoginFailures.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockDeleteLoginFailuresParamPtrs{}
+	}
+	mmDeleteLoginFailures.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmDeleteLoginFailures
+}
+
+// ExpectParamsParam2 sets up expected param params for LoginFailureRepository.DeleteLoginFailures
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) ExpectParamsParam2(params model.DeleteLoginFailuresParams) *mLoginFailureRepositoryMockDeleteLoginFailures {
+	if mmDeleteLoginFailures.mock.funcDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Set")
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation == nil {
+		mmDeleteLoginFailures.defaultExpectation = &LoginFailureRepositoryMockDeleteLoginFailuresExpectation{}
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation.params != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Expect")
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmDeleteLoginFailures.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockDeleteLoginFailuresParamPtrs{}
+	}
+	mmDeleteLoginFailures.defaultExpectation.paramPtrs.params = &params
+
+	return mmDeleteLoginFailures
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginFailureRepository.DeleteLoginFailures
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Inspect(f func(ctx context.Context, params model.DeleteLoginFailuresParams)) *mLoginFailureRepositoryMockDeleteLoginFailures {
+	if mmDeleteLoginFailures.mock.inspectFuncDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("Inspect function is already set for LoginFailureRepositoryMock.DeleteLoginFailures")
+	}
+
+	mmDeleteLoginFailures.mock.inspectFuncDeleteLoginFailures = f
+
+	return mmDeleteLoginFailures
+}
+
+// Return sets up results that will be returned by LoginFailureRepository.DeleteLoginFailures
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Return(err error) *LoginFailureRepositoryMock {
+	if mmDeleteLoginFailures.mock.funcDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Set")
+	}
+
+	if mmDeleteLoginFailures.defaultExpectation == nil {
+		mmDeleteLoginFailures.defaultExpectation = &LoginFailureRepositoryMockDeleteLoginFailuresExpectation{mock: mmDeleteLoginFailures.mock}
+	}
+	mmDeleteLoginFailures.defaultExpectation.results = &LoginFailureRepositoryMockDeleteLoginFailuresResults{err}
+	return mmDeleteLoginFailures.mock
+}
+
+// Set uses given function f to mock the LoginFailureRepository.DeleteLoginFailures method
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Set(f func(ctx context.Context, params model.DeleteLoginFailuresParams) (err error)) *LoginFailureRepositoryMock {
+	if mmDeleteLoginFailures.defaultExpectation != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("Default expectation is already set for the LoginFailureRepository.DeleteLoginFailures method")
+	}
+
+	if len(mmDeleteLoginFailures.expectations) > 0 {
+		mmDeleteLoginFailures.mock.t.Fatalf("Some expectations are already set for the LoginFailureRepository.DeleteLoginFailures method")
+	}
+
+	mmDeleteLoginFailures.mock.funcDeleteLoginFailures = f
+	return mmDeleteLoginFailures.mock
+}
+
+// When sets expectation for the LoginFailureRepository.DeleteLoginFailures which will trigger the result defined by the following
+// Then helper
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) When(ctx context.Context, params model.DeleteLoginFailuresParams) *LoginFailureRepositoryMockDeleteLoginFailuresExpectation {
+	if mmDeleteLoginFailures.mock.funcDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.DeleteLoginFailures mock is already set by Set")
+	}
+
+	expectation := &LoginFailureRepositoryMockDeleteLoginFailuresExpectation{
+		mock:   mmDeleteLoginFailures.mock,
+		params: &LoginFailureRepositoryMockDeleteLoginFailuresParams{ctx, params},
+	}
+	mmDeleteLoginFailures.expectations = append(mmDeleteLoginFailures.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginFailureRepository.DeleteLoginFailures return parameters for the expectation previously defined by the When method
+func (e *LoginFailureRepositoryMockDeleteLoginFailuresExpectation) Then(err error) *LoginFailureRepositoryMock {
+	e.results = &LoginFailureRepositoryMockDeleteLoginFailuresResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginFailureRepository.DeleteLoginFailures should be invoked
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Times(n uint64) *mLoginFailureRepositoryMockDeleteLoginFailures {
+	if n == 0 {
+		mmDeleteLoginFailures.mock.t.Fatalf("Times of LoginFailureRepositoryMock.DeleteLoginFailures mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteLoginFailures.expectedInvocations, n)
+	return mmDeleteLoginFailures
+}
+
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) invocationsDone() bool {
+	if len(mmDeleteLoginFailures.expectations) == 0 && mmDeleteLoginFailures.defaultExpectation == nil && mmDeleteLoginFailures.mock.funcDeleteLoginFailures == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteLoginFailures.mock.afterDeleteLoginFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteLoginFailures.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteLoginFailures implements repository.LoginFailureRepository
+func (mmDeleteLoginFailures *LoginFailureRepositoryMock) DeleteLoginFailures(ctx context.Context, params model.DeleteLoginFailuresParams) (err error) {
+	mm_atomic.AddUint64(&mmDeleteLoginFailures.beforeDeleteLoginFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteLoginFailures.afterDeleteLoginFailuresCounter, 1)
+
+	if mmDeleteLoginFailures.inspectFuncDeleteLoginFailures != nil {
+		mmDeleteLoginFailures.inspectFuncDeleteLoginFailures(ctx, params)
+	}
+
+	mm_params := LoginFailureRepositoryMockDeleteLoginFailuresParams{ctx, params}
+
+	// Record call args
+	mmDeleteLoginFailures.DeleteLoginFailuresMock.mutex.Lock()
+	mmDeleteLoginFailures.DeleteLoginFailuresMock.callArgs = append(mmDeleteLoginFailures.DeleteLoginFailuresMock.callArgs, &mm_params)
+	mmDeleteLoginFailures.DeleteLoginFailuresMock.mutex.Unlock()
+
+	for _, e := range mmDeleteLoginFailures.DeleteLoginFailuresMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteLoginFailures.DeleteLoginFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteLoginFailures.DeleteLoginFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteLoginFailures.DeleteLoginFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteLoginFailures.DeleteLoginFailuresMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginFailureRepositoryMockDeleteLoginFailuresParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteLoginFailures.t.Errorf("LoginFailureRepositoryMock.DeleteLoginFailures got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmDeleteLoginFailures.t.Errorf("LoginFailureRepositoryMock.DeleteLoginFailures got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteLoginFailures.t.Errorf("LoginFailureRepositoryMock.DeleteLoginFailures got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteLoginFailures.DeleteLoginFailuresMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteLoginFailures.t.Fatal("No results are set for the LoginFailureRepositoryMock.DeleteLoginFailures")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteLoginFailures.funcDeleteLoginFailures != nil {
+		return mmDeleteLoginFailures.funcDeleteLoginFailures(ctx, params)
+	}
+	mmDeleteLoginFailures.t.Fatalf("Unexpected call to LoginFailureRepositoryMock.DeleteLoginFailures. %v %v", ctx, params)
+	return
+}
+
+// DeleteLoginFailuresAfterCounter returns a count of finished LoginFailureRepositoryMock.DeleteLoginFailures invocations
+func (mmDeleteLoginFailures *LoginFailureRepositoryMock) DeleteLoginFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteLoginFailures.afterDeleteLoginFailuresCounter)
+}
+
+// DeleteLoginFailuresBeforeCounter returns a count of LoginFailureRepositoryMock.DeleteLoginFailures invocations
+func (mmDeleteLoginFailures *LoginFailureRepositoryMock) DeleteLoginFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteLoginFailures.beforeDeleteLoginFailuresCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginFailureRepositoryMock.DeleteLoginFailures.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteLoginFailures *mLoginFailureRepositoryMockDeleteLoginFailures) Calls() []*LoginFailureRepositoryMockDeleteLoginFailuresParams {
+	mmDeleteLoginFailures.mutex.RLock()
+
+	argCopy := make([]*LoginFailureRepositoryMockDeleteLoginFailuresParams, len(mmDeleteLoginFailures.callArgs))
+	copy(argCopy, mmDeleteLoginFailures.callArgs)
+
+	mmDeleteLoginFailures.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteLoginFailuresDone returns true if the count of the DeleteLoginFailures invocations corresponds
+// the number of defined expectations
+func (m *LoginFailureRepositoryMock) MinimockDeleteLoginFailuresDone() bool {
+	if m.DeleteLoginFailuresMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteLoginFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteLoginFailuresMock.invocationsDone()
+}
+
+// MinimockDeleteLoginFailuresInspect logs each unmet expectation
+func (m *LoginFailureRepositoryMock) MinimockDeleteLoginFailuresInspect() {
+	for _, e := range m.DeleteLoginFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.DeleteLoginFailures with params: %#v", *e.params)
+		}
+	}
+
+	afterDeleteLoginFailuresCounter := mm_atomic.LoadUint64(&m.afterDeleteLoginFailuresCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteLoginFailuresMock.defaultExpectation != nil && afterDeleteLoginFailuresCounter < 1 {
+		if m.DeleteLoginFailuresMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginFailureRepositoryMock.DeleteLoginFailures")
+		} else {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.DeleteLoginFailures with params: %#v", *m.DeleteLoginFailuresMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteLoginFailures != nil && afterDeleteLoginFailuresCounter < 1 {
+		m.t.Error("Expected call to LoginFailureRepositoryMock.DeleteLoginFailures")
+	}
+
+	if !m.DeleteLoginFailuresMock.invocationsDone() && afterDeleteLoginFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginFailureRepositoryMock.DeleteLoginFailures but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteLoginFailuresMock.expectedInvocations), afterDeleteLoginFailuresCounter)
+	}
+}
+
+type mLoginFailureRepositoryMockLockLogin struct {
+	optional           bool
+	mock               *LoginFailureRepositoryMock
+	defaultExpectation *LoginFailureRepositoryMockLockLoginExpectation
+	expectations       []*LoginFailureRepositoryMockLockLoginExpectation
+
+	callArgs []*LoginFailureRepositoryMockLockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginFailureRepositoryMockLockLoginExpectation specifies expectation struct of the LoginFailureRepository.LockLogin
+type LoginFailureRepositoryMockLockLoginExpectation struct {
+	mock      *LoginFailureRepositoryMock
+	params    *LoginFailureRepositoryMockLockLoginParams
+	paramPtrs *LoginFailureRepositoryMockLockLoginParamPtrs
+	results   *LoginFailureRepositoryMockLockLoginResults
+	Counter   uint64
+}
+
+// LoginFailureRepositoryMockLockLoginParams contains parameters of the LoginFailureRepository.LockLogin
+type LoginFailureRepositoryMockLockLoginParams struct {
+	ctx    context.Context
+	params model.LockLoginParams
+}
+
+// LoginFailureRepositoryMockLockLoginParamPtrs contains pointers to parameters of the LoginFailureRepository.LockLogin
+type LoginFailureRepositoryMockLockLoginParamPtrs struct {
+	ctx    *context.Context
+	params *model.LockLoginParams
+}
+
+// LoginFailureRepositoryMockLockLoginResults contains results of the LoginFailureRepository.LockLogin
+type LoginFailureRepositoryMockLockLoginResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Optional() *mLoginFailureRepositoryMockLockLogin {
+	mmLockLogin.optional = true
+	return mmLockLogin
+}
+
+// Expect sets up expected params for LoginFailureRepository.LockLogin
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Expect(ctx context.Context, params model.LockLoginParams) *mLoginFailureRepositoryMockLockLogin {
+	if mmLockLogin.mock.funcLockLogin != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Set")
+	}
+
+	if mmLockLogin.defaultExpectation == nil {
+		mmLockLogin.defaultExpectation = &LoginFailureRepositoryMockLockLoginExpectation{}
+	}
+
+	if mmLockLogin.defaultExpectation.paramPtrs != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by ExpectParams functions")
+	}
+
+	mmLockLogin.defaultExpectation.params = &LoginFailureRepositoryMockLockLoginParams{ctx, params}
+	for _, e := range mmLockLogin.expectations {
+		if minimock.Equal(e.params, mmLockLogin.defaultExpectation.params) {
+			mmLockLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLockLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginFailureRepository.LockLogin
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) ExpectCtxParam1(ctx context.Context) *mLoginFailureRepositoryMockLockLogin {
+	if mmLockLogin.mock.funcLockLogin != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Set")
+	}
+
+	if mmLockLogin.defaultExpectation == nil {
+		mmLockLogin.defaultExpectation = &LoginFailureRepositoryMockLockLoginExpectation{}
+	}
+
+	if mmLockLogin.defaultExpectation.params != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Expect")
+	}
+
+	if mmLockLogin.defaultExpectation.paramPtrs == nil {
+		mmLockLogin.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockLockLoginParamPtrs{}
+	}
+	mmLockLogin.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLockLogin
+}
+
+// ExpectParamsParam2 sets up expected param params for LoginFailureRepository.LockLogin
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) ExpectParamsParam2(params model.LockLoginParams) *mLoginFailureRepositoryMockLockLogin {
+	if mmLockLogin.mock.funcLockLogin != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Set")
+	}
+
+	if mmLockLogin.defaultExpectation == nil {
+		mmLockLogin.defaultExpectation = &LoginFailureRepositoryMockLockLoginExpectation{}
+	}
+
+	if mmLockLogin.defaultExpectation.params != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Expect")
+	}
+
+	if mmLockLogin.defaultExpectation.paramPtrs == nil {
+		mmLockLogin.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockLockLoginParamPtrs{}
+	}
+	mmLockLogin.defaultExpectation.paramPtrs.params = &params
+
+	return mmLockLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginFailureRepository.LockLogin
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Inspect(f func(ctx context.Context, params model.LockLoginParams)) *mLoginFailureRepositoryMockLockLogin {
+	if mmLockLogin.mock.inspectFuncLockLogin != nil {
+		mmLockLogin.mock.t.Fatalf("Inspect function is already set for LoginFailureRepositoryMock.LockLogin")
+	}
+
+	mmLockLogin.mock.inspectFuncLockLogin = f
+
+	return mmLockLogin
+}
+
+// Return sets up results that will be returned by LoginFailureRepository.LockLogin
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Return(err error) *LoginFailureRepositoryMock {
+	if mmLockLogin.mock.funcLockLogin != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Set")
+	}
+
+	if mmLockLogin.defaultExpectation == nil {
+		mmLockLogin.defaultExpectation = &LoginFailureRepositoryMockLockLoginExpectation{mock: mmLockLogin.mock}
+	}
+	mmLockLogin.defaultExpectation.results = &LoginFailureRepositoryMockLockLoginResults{err}
+	return mmLockLogin.mock
+}
+
+// Set uses given function f to mock the LoginFailureRepository.LockLogin method
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Set(f func(ctx context.Context, params model.LockLoginParams) (err error)) *LoginFailureRepositoryMock {
+	if mmLockLogin.defaultExpectation != nil {
+		mmLockLogin.mock.t.Fatalf("Default expectation is already set for the LoginFailureRepository.LockLogin method")
+	}
+
+	if len(mmLockLogin.expectations) > 0 {
+		mmLockLogin.mock.t.Fatalf("Some expectations are already set for the LoginFailureRepository.LockLogin method")
+	}
+
+	mmLockLogin.mock.funcLockLogin = f
+	return mmLockLogin.mock
+}
+
+// When sets expectation for the LoginFailureRepository.LockLogin which will trigger the result defined by the following
+// Then helper
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) When(ctx context.Context, params model.LockLoginParams) *LoginFailureRepositoryMockLockLoginExpectation {
+	if mmLockLogin.mock.funcLockLogin != nil {
+		mmLockLogin.mock.t.Fatalf("LoginFailureRepositoryMock.LockLogin mock is already set by Set")
+	}
+
+	expectation := &LoginFailureRepositoryMockLockLoginExpectation{
+		mock:   mmLockLogin.mock,
+		params: &LoginFailureRepositoryMockLockLoginParams{ctx, params},
+	}
+	mmLockLogin.expectations = append(mmLockLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginFailureRepository.LockLogin return parameters for the expectation previously defined by the When method
+func (e *LoginFailureRepositoryMockLockLoginExpectation) Then(err error) *LoginFailureRepositoryMock {
+	e.results = &LoginFailureRepositoryMockLockLoginResults{err}
+	return e.mock
+}
+
+// Times sets number of times LoginFailureRepository.LockLogin should be invoked
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Times(n uint64) *mLoginFailureRepositoryMockLockLogin {
+	if n == 0 {
+		mmLockLogin.mock.t.Fatalf("Times of LoginFailureRepositoryMock.LockLogin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockLogin.expectedInvocations, n)
+	return mmLockLogin
+}
+
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) invocationsDone() bool {
+	if len(mmLockLogin.expectations) == 0 && mmLockLogin.defaultExpectation == nil && mmLockLogin.mock.funcLockLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockLogin.mock.afterLockLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockLogin implements repository.LoginFailureRepository
+func (mmLockLogin *LoginFailureRepositoryMock) LockLogin(ctx context.Context, params model.LockLoginParams) (err error) {
+	mm_atomic.AddUint64(&mmLockLogin.beforeLockLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockLogin.afterLockLoginCounter, 1)
+
+	if mmLockLogin.inspectFuncLockLogin != nil {
+		mmLockLogin.inspectFuncLockLogin(ctx, params)
+	}
+
+	mm_params := LoginFailureRepositoryMockLockLoginParams{ctx, params}
+
+	// Record call args
+	mmLockLogin.LockLoginMock.mutex.Lock()
+	mmLockLogin.LockLoginMock.callArgs = append(mmLockLogin.LockLoginMock.callArgs, &mm_params)
+	mmLockLogin.LockLoginMock.mutex.Unlock()
+
+	for _, e := range mmLockLogin.LockLoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLockLogin.LockLoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockLogin.LockLoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockLogin.LockLoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLockLogin.LockLoginMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginFailureRepositoryMockLockLoginParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockLogin.t.Errorf("LoginFailureRepositoryMock.LockLogin got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmLockLogin.t.Errorf("LoginFailureRepositoryMock.LockLogin got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockLogin.t.Errorf("LoginFailureRepositoryMock.LockLogin got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockLogin.LockLoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockLogin.t.Fatal("No results are set for the LoginFailureRepositoryMock.LockLogin")
+		}
+		return (*mm_results).err
+	}
+	if mmLockLogin.funcLockLogin != nil {
+		return mmLockLogin.funcLockLogin(ctx, params)
+	}
+	mmLockLogin.t.Fatalf("Unexpected call to LoginFailureRepositoryMock.LockLogin. %v %v", ctx, params)
+	return
+}
+
+// LockLoginAfterCounter returns a count of finished LoginFailureRepositoryMock.LockLogin invocations
+func (mmLockLogin *LoginFailureRepositoryMock) LockLoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockLogin.afterLockLoginCounter)
+}
+
+// LockLoginBeforeCounter returns a count of LoginFailureRepositoryMock.LockLogin invocations
+func (mmLockLogin *LoginFailureRepositoryMock) LockLoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockLogin.beforeLockLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginFailureRepositoryMock.LockLogin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockLogin *mLoginFailureRepositoryMockLockLogin) Calls() []*LoginFailureRepositoryMockLockLoginParams {
+	mmLockLogin.mutex.RLock()
+
+	argCopy := make([]*LoginFailureRepositoryMockLockLoginParams, len(mmLockLogin.callArgs))
+	copy(argCopy, mmLockLogin.callArgs)
+
+	mmLockLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockLoginDone returns true if the count of the LockLogin invocations corresponds
+// the number of defined expectations
+func (m *LoginFailureRepositoryMock) MinimockLockLoginDone() bool {
+	if m.LockLoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockLoginMock.invocationsDone()
+}
+
+// MinimockLockLoginInspect logs each unmet expectation
+func (m *LoginFailureRepositoryMock) MinimockLockLoginInspect() {
+	for _, e := range m.LockLoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.LockLogin with params: %#v", *e.params)
+		}
+	}
+
+	afterLockLoginCounter := mm_atomic.LoadUint64(&m.afterLockLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockLoginMock.defaultExpectation != nil && afterLockLoginCounter < 1 {
+		if m.LockLoginMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginFailureRepositoryMock.LockLogin")
+		} else {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.LockLogin with params: %#v", *m.LockLoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockLogin != nil && afterLockLoginCounter < 1 {
+		m.t.Error("Expected call to LoginFailureRepositoryMock.LockLogin")
+	}
+
+	if !m.LockLoginMock.invocationsDone() && afterLockLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginFailureRepositoryMock.LockLogin but found %d calls",
+			mm_atomic.LoadUint64(&m.LockLoginMock.expectedInvocations), afterLockLoginCounter)
+	}
+}
+
+type mLoginFailureRepositoryMockLockLoginFailures struct {
+	optional           bool
+	mock               *LoginFailureRepositoryMock
+	defaultExpectation *LoginFailureRepositoryMockLockLoginFailuresExpectation
+	expectations       []*LoginFailureRepositoryMockLockLoginFailuresExpectation
+
+	callArgs []*LoginFailureRepositoryMockLockLoginFailuresParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginFailureRepositoryMockLockLoginFailuresExpectation specifies expectation struct of the LoginFailureRepository.LockLoginFailures
+type LoginFailureRepositoryMockLockLoginFailuresExpectation struct {
+	mock      *LoginFailureRepositoryMock
+	params    *LoginFailureRepositoryMockLockLoginFailuresParams
+	paramPtrs *LoginFailureRepositoryMockLockLoginFailuresParamPtrs
+	results   *LoginFailureRepositoryMockLockLoginFailuresResults
+	Counter   uint64
+}
+
+// LoginFailureRepositoryMockLockLoginFailuresParams contains parameters of the LoginFailureRepository.LockLoginFailures
+type LoginFailureRepositoryMockLockLoginFailuresParams struct {
+	ctx    context.Context
+	params model.LockLoginFailuresParams
+}
+
+// LoginFailureRepositoryMockLockLoginFailuresParamPtrs contains pointers to parameters of the LoginFailureRepository.LockLoginFailures
+type LoginFailureRepositoryMockLockLoginFailuresParamPtrs struct {
+	ctx    *context.Context
+	params *model.LockLoginFailuresParams
+}
+
+// LoginFailureRepositoryMockLockLoginFailuresResults contains results of the LoginFailureRepository.LockLoginFailures
+type LoginFailureRepositoryMockLockLoginFailuresResults struct {
+	resp []model.LoginFailure
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Optional() *mLoginFailureRepositoryMockLockLoginFailures {
+	mmLockLoginFailures.optional = true
+	return mmLockLoginFailures
+}
+
+// Expect sets up expected params for LoginFailureRepository.LockLoginFailures
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Expect(ctx context.Context, params model.LockLoginFailuresParams) *mLoginFailureRepositoryMockLockLoginFailures {
+	if mmLockLoginFailures.mock.funcLockLoginFailures != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Set")
+	}
+
+	if mmLockLoginFailures.defaultExpectation == nil {
+		mmLockLoginFailures.defaultExpectation = &LoginFailureRepositoryMockLockLoginFailuresExpectation{}
+	}
+
+	if mmLockLoginFailures.defaultExpectation.paramPtrs != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by ExpectParams functions")
+	}
+
+	mmLockLoginFailures.defaultExpectation.params = &LoginFailureRepositoryMockLockLoginFailuresParams{ctx, params}
+	for _, e := range mmLockLoginFailures.expectations {
+		if minimock.Equal(e.params, mmLockLoginFailures.defaultExpectation.params) {
+			mmLockLoginFailures.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockLoginFailures.defaultExpectation.params)
+		}
+	}
+
+	return mmLockLoginFailures
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginFailureRepository.LockLoginFailures
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) ExpectCtxParam1(ctx context.Context) *mLoginFailureRepositoryMockLockLoginFailures {
+	if mmLockLoginFailures.mock.funcLockLoginFailures != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Set")
+	}
+
+	if mmLockLoginFailures.defaultExpectation == nil {
+		mmLockLoginFailures.defaultExpectation = &LoginFailureRepositoryMockLockLoginFailuresExpectation{}
+	}
+
+	if mmLockLoginFailures.defaultExpectation.params != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Expect")
+	}
+
+	if mmLockLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmLockLoginFailures.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockLockLoginFailuresParamPtrs{}
+	}
+	mmLockLoginFailures.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLockLoginFailures
+}
+
+// ExpectParamsParam2 sets up expected param params for LoginFailureRepository.LockLoginFailures
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) ExpectParamsParam2(params model.LockLoginFailuresParams) *mLoginFailureRepositoryMockLockLoginFailures {
+	if mmLockLoginFailures.mock.funcLockLoginFailures != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Set")
+	}
+
+	if mmLockLoginFailures.defaultExpectation == nil {
+		mmLockLoginFailures.defaultExpectation = &LoginFailureRepositoryMockLockLoginFailuresExpectation{}
+	}
+
+	if mmLockLoginFailures.defaultExpectation.params != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Expect")
+	}
+
+	if mmLockLoginFailures.defaultExpectation.paramPtrs == nil {
+		mmLockLoginFailures.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockLockLoginFailuresParamPtrs{}
+	}
+	mmLockLoginFailures.defaultExpectation.paramPtrs.params = &params
+
+	return mmLockLoginFailures
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginFailureRepository.LockLoginFailures
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Inspect(f func(ctx context.Context, params model.LockLoginFailuresParams)) *mLoginFailureRepositoryMockLockLoginFailures {
+	if mmLockLoginFailures.mock.inspectFuncLockLoginFailures != nil {
+		mmLockLoginFailures.mock.t.Fatalf("Inspect function is already set for LoginFailureRepositoryMock.LockLoginFailures")
+	}
+
+	mmLockLoginFailures.mock.inspectFuncLockLoginFailures = f
+
+	return mmLockLoginFailures
+}
+
+// Return sets up results that will be returned by LoginFailureRepository.LockLoginFailures
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Return(resp []model.LoginFailure, err error) *LoginFailureRepositoryMock {
+	if mmLockLoginFailures.mock.funcLockLoginFailures != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Set")
+	}
+
+	if mmLockLoginFailures.defaultExpectation == nil {
+		mmLockLoginFailures.defaultExpectation = &LoginFailureRepositoryMockLockLoginFailuresExpectation{mock: mmLockLoginFailures.mock}
+	}
+	mmLockLoginFailures.defaultExpectation.results = &LoginFailureRepositoryMockLockLoginFailuresResults{resp, err}
+	return mmLockLoginFailures.mock
+}
+
+// Set uses given function f to mock the LoginFailureRepository.LockLoginFailures method
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Set(f func(ctx context.Context, params model.LockLoginFailuresParams) (resp []model.LoginFailure, err error)) *LoginFailureRepositoryMock {
+	if mmLockLoginFailures.defaultExpectation != nil {
+		mmLockLoginFailures.mock.t.Fatalf("Default expectation is already set for the LoginFailureRepository.LockLoginFailures method")
+	}
+
+	if len(mmLockLoginFailures.expectations) > 0 {
+		mmLockLoginFailures.mock.t.Fatalf("Some expectations are already set for the LoginFailureRepository.LockLoginFailures method")
+	}
+
+	mmLockLoginFailures.mock.funcLockLoginFailures = f
+	return mmLockLoginFailures.mock
+}
+
+// When sets expectation for the LoginFailureRepository.LockLoginFailures which will trigger the result defined by the following
+// Then helper
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) When(ctx context.Context, params model.LockLoginFailuresParams) *LoginFailureRepositoryMockLockLoginFailuresExpectation {
+	if mmLockLoginFailures.mock.funcLockLoginFailures != nil {
+		mmLockLoginFailures.mock.t.Fatalf("LoginFailureRepositoryMock.LockLoginFailures mock is already set by Set")
+	}
+
+	expectation := &LoginFailureRepositoryMockLockLoginFailuresExpectation{
+		mock:   mmLockLoginFailures.mock,
+		params: &LoginFailureRepositoryMockLockLoginFailuresParams{ctx, params},
+	}
+	mmLockLoginFailures.expectations = append(mmLockLoginFailures.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginFailureRepository.LockLoginFailures return parameters for the expectation previously defined by the When method
+func (e *LoginFailureRepositoryMockLockLoginFailuresExpectation) Then(resp []model.LoginFailure, err error) *LoginFailureRepositoryMock {
+	e.results = &LoginFailureRepositoryMockLockLoginFailuresResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LoginFailureRepository.LockLoginFailures should be invoked
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Times(n uint64) *mLoginFailureRepositoryMockLockLoginFailures {
+	if n == 0 {
+		mmLockLoginFailures.mock.t.Fatalf("Times of LoginFailureRepositoryMock.LockLoginFailures mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockLoginFailures.expectedInvocations, n)
+	return mmLockLoginFailures
+}
+
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) invocationsDone() bool {
+	if len(mmLockLoginFailures.expectations) == 0 && mmLockLoginFailures.defaultExpectation == nil && mmLockLoginFailures.mock.funcLockLoginFailures == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockLoginFailures.mock.afterLockLoginFailuresCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockLoginFailures.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockLoginFailures implements repository.LoginFailureRepository
+func (mmLockLoginFailures *LoginFailureRepositoryMock) LockLoginFailures(ctx context.Context, params model.LockLoginFailuresParams) (resp []model.LoginFailure, err error) {
+	mm_atomic.AddUint64(&mmLockLoginFailures.beforeLockLoginFailuresCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockLoginFailures.afterLockLoginFailuresCounter, 1)
+
+	if mmLockLoginFailures.inspectFuncLockLoginFailures != nil {
+		mmLockLoginFailures.inspectFuncLockLoginFailures(ctx, params)
+	}
+
+	mm_params := LoginFailureRepositoryMockLockLoginFailuresParams{ctx, params}
+
+	// Record call args
+	mmLockLoginFailures.LockLoginFailuresMock.mutex.Lock()
+	mmLockLoginFailures.LockLoginFailuresMock.callArgs = append(mmLockLoginFailures.LockLoginFailuresMock.callArgs, &mm_params)
+	mmLockLoginFailures.LockLoginFailuresMock.mutex.Unlock()
+
+	for _, e := range mmLockLoginFailures.LockLoginFailuresMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmLockLoginFailures.LockLoginFailuresMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockLoginFailures.LockLoginFailuresMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockLoginFailures.LockLoginFailuresMock.defaultExpectation.params
+		mm_want_ptrs := mmLockLoginFailures.LockLoginFailuresMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginFailureRepositoryMockLockLoginFailuresParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockLoginFailures.t.Errorf("LoginFailureRepositoryMock.LockLoginFailures got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmLockLoginFailures.t.Errorf("LoginFailureRepositoryMock.LockLoginFailures got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockLoginFailures.t.Errorf("LoginFailureRepositoryMock.LockLoginFailures got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockLoginFailures.LockLoginFailuresMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockLoginFailures.t.Fatal("No results are set for the LoginFailureRepositoryMock.LockLoginFailures")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmLockLoginFailures.funcLockLoginFailures != nil {
+		return mmLockLoginFailures.funcLockLoginFailures(ctx, params)
+	}
+	mmLockLoginFailures.t.Fatalf("Unexpected call to LoginFailureRepositoryMock.LockLoginFailures. %v %v", ctx, params)
+	return
+}
+
+// LockLoginFailuresAfterCounter returns a count of finished LoginFailureRepositoryMock.LockLoginFailures invocations
+func (mmLockLoginFailures *LoginFailureRepositoryMock) LockLoginFailuresAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockLoginFailures.afterLockLoginFailuresCounter)
+}
+
+// LockLoginFailuresBeforeCounter returns a count of LoginFailureRepositoryMock.LockLoginFailures invocations
+func (mmLockLoginFailures *LoginFailureRepositoryMock) LockLoginFailuresBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockLoginFailures.beforeLockLoginFailuresCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginFailureRepositoryMock.LockLoginFailures.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockLoginFailures *mLoginFailureRepositoryMockLockLoginFailures) Calls() []*LoginFailureRepositoryMockLockLoginFailuresParams {
+	mmLockLoginFailures.mutex.RLock()
+
+	argCopy := make([]*LoginFailureRepositoryMockLockLoginFailuresParams, len(mmLockLoginFailures.callArgs))
+	copy(argCopy, mmLockLoginFailures.callArgs)
+
+	mmLockLoginFailures.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockLoginFailuresDone returns true if the count of the LockLoginFailures invocations corresponds
+// the number of defined expectations
+func (m *LoginFailureRepositoryMock) MinimockLockLoginFailuresDone() bool {
+	if m.LockLoginFailuresMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockLoginFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockLoginFailuresMock.invocationsDone()
+}
+
+// MinimockLockLoginFailuresInspect logs each unmet expectation
+func (m *LoginFailureRepositoryMock) MinimockLockLoginFailuresInspect() {
+	for _, e := range m.LockLoginFailuresMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.LockLoginFailures with params: %#v", *e.params)
+		}
+	}
+
+	afterLockLoginFailuresCounter := mm_atomic.LoadUint64(&m.afterLockLoginFailuresCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockLoginFailuresMock.defaultExpectation != nil && afterLockLoginFailuresCounter < 1 {
+		if m.LockLoginFailuresMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginFailureRepositoryMock.LockLoginFailures")
+		} else {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.LockLoginFailures with params: %#v", *m.LockLoginFailuresMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockLoginFailures != nil && afterLockLoginFailuresCounter < 1 {
+		m.t.Error("Expected call to LoginFailureRepositoryMock.LockLoginFailures")
+	}
+
+	if !m.LockLoginFailuresMock.invocationsDone() && afterLockLoginFailuresCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginFailureRepositoryMock.LockLoginFailures but found %d calls",
+			mm_atomic.LoadUint64(&m.LockLoginFailuresMock.expectedInvocations), afterLockLoginFailuresCounter)
+	}
+}
+
+type mLoginFailureRepositoryMockRecordLoginFailure struct {
+	optional           bool
+	mock               *LoginFailureRepositoryMock
+	defaultExpectation *LoginFailureRepositoryMockRecordLoginFailureExpectation
+	expectations       []*LoginFailureRepositoryMockRecordLoginFailureExpectation
+
+	callArgs []*LoginFailureRepositoryMockRecordLoginFailureParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// LoginFailureRepositoryMockRecordLoginFailureExpectation specifies expectation struct of the LoginFailureRepository.RecordLoginFailure
+type LoginFailureRepositoryMockRecordLoginFailureExpectation struct {
+	mock      *LoginFailureRepositoryMock
+	params    *LoginFailureRepositoryMockRecordLoginFailureParams
+	paramPtrs *LoginFailureRepositoryMockRecordLoginFailureParamPtrs
+	results   *LoginFailureRepositoryMockRecordLoginFailureResults
+	Counter   uint64
+}
+
+// LoginFailureRepositoryMockRecordLoginFailureParams contains parameters of the LoginFailureRepository.RecordLoginFailure
+type LoginFailureRepositoryMockRecordLoginFailureParams struct {
+	ctx    context.Context
+	params model.RecordLoginFailureParams
+}
+
+// LoginFailureRepositoryMockRecordLoginFailureParamPtrs contains pointers to parameters of the LoginFailureRepository.RecordLoginFailure
+type LoginFailureRepositoryMockRecordLoginFailureParamPtrs struct {
+	ctx    *context.Context
+	params *model.RecordLoginFailureParams
+}
+
+// LoginFailureRepositoryMockRecordLoginFailureResults contains results of the LoginFailureRepository.RecordLoginFailure
+type LoginFailureRepositoryMockRecordLoginFailureResults struct {
+	resp model.LoginFailure
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Optional() *mLoginFailureRepositoryMockRecordLoginFailure {
+	mmRecordLoginFailure.optional = true
+	return mmRecordLoginFailure
+}
+
+// Expect sets up expected params for LoginFailureRepository.RecordLoginFailure
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Expect(ctx context.Context, params model.RecordLoginFailureParams) *mLoginFailureRepositoryMockRecordLoginFailure {
+	if mmRecordLoginFailure.mock.funcRecordLoginFailure != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Set")
+	}
+
+	if mmRecordLoginFailure.defaultExpectation == nil {
+		mmRecordLoginFailure.defaultExpectation = &LoginFailureRepositoryMockRecordLoginFailureExpectation{}
+	}
+
+	if mmRecordLoginFailure.defaultExpectation.paramPtrs != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by ExpectParams functions")
+	}
+
+	mmRecordLoginFailure.defaultExpectation.params = &LoginFailureRepositoryMockRecordLoginFailureParams{ctx, params}
+	for _, e := range mmRecordLoginFailure.expectations {
+		if minimock.Equal(e.params, mmRecordLoginFailure.defaultExpectation.params) {
+			mmRecordLoginFailure.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordLoginFailure.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordLoginFailure
+}
+
+// ExpectCtxParam1 sets up expected param ctx for LoginFailureRepository.RecordLoginFailure
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) ExpectCtxParam1(ctx context.Context) *mLoginFailureRepositoryMockRecordLoginFailure {
+	if mmRecordLoginFailure.mock.funcRecordLoginFailure != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Set")
+	}
+
+	if mmRecordLoginFailure.defaultExpectation == nil {
+		mmRecordLoginFailure.defaultExpectation = &LoginFailureRepositoryMockRecordLoginFailureExpectation{}
+	}
+
+	if mmRecordLoginFailure.defaultExpectation.params != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Expect")
+	}
+
+	if mmRecordLoginFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordLoginFailure.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockRecordLoginFailureParamPtrs{}
+	}
+	mmRecordLoginFailure.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRecordLoginFailure
+}
+
+// ExpectParamsParam2 sets up expected param params for LoginFailureRepository.RecordLoginFailure
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) ExpectParamsParam2(params model.RecordLoginFailureParams) *mLoginFailureRepositoryMockRecordLoginFailure {
+	if mmRecordLoginFailure.mock.funcRecordLoginFailure != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Set")
+	}
+
+	if mmRecordLoginFailure.defaultExpectation == nil {
+		mmRecordLoginFailure.defaultExpectation = &LoginFailureRepositoryMockRecordLoginFailureExpectation{}
+	}
+
+	if mmRecordLoginFailure.defaultExpectation.params != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Expect")
+	}
+
+	if mmRecordLoginFailure.defaultExpectation.paramPtrs == nil {
+		mmRecordLoginFailure.defaultExpectation.paramPtrs = &LoginFailureRepositoryMockRecordLoginFailureParamPtrs{}
+	}
+	mmRecordLoginFailure.defaultExpectation.paramPtrs.params = &params
+
+	return mmRecordLoginFailure
+}
+
+// Inspect accepts an inspector function that has same arguments as the LoginFailureRepository.RecordLoginFailure
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Inspect(f func(ctx context.Context, params model.RecordLoginFailureParams)) *mLoginFailureRepositoryMockRecordLoginFailure {
+	if mmRecordLoginFailure.mock.inspectFuncRecordLoginFailure != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("Inspect function is already set for LoginFailureRepositoryMock.RecordLoginFailure")
+	}
+
+	mmRecordLoginFailure.mock.inspectFuncRecordLoginFailure = f
+
+	return mmRecordLoginFailure
+}
+
+// Return sets up results that will be returned by LoginFailureRepository.RecordLoginFailure
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Return(resp model.LoginFailure, err error) *LoginFailureRepositoryMock {
+	if mmRecordLoginFailure.mock.funcRecordLoginFailure != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Set")
+	}
+
+	if mmRecordLoginFailure.defaultExpectation == nil {
+		mmRecordLoginFailure.defaultExpectation = &LoginFailureRepositoryMockRecordLoginFailureExpectation{mock: mmRecordLoginFailure.mock}
+	}
+	mmRecordLoginFailure.defaultExpectation.results = &LoginFailureRepositoryMockRecordLoginFailureResults{resp, err}
+	return mmRecordLoginFailure.mock
+}
+
+// Set uses given function f to mock the LoginFailureRepository.RecordLoginFailure method
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Set(f func(ctx context.Context, params model.RecordLoginFailureParams) (resp model.LoginFailure, err error)) *LoginFailureRepositoryMock {
+	if mmRecordLoginFailure.defaultExpectation != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("Default expectation is already set for the LoginFailureRepository.RecordLoginFailure method")
+	}
+
+	if len(mmRecordLoginFailure.expectations) > 0 {
+		mmRecordLoginFailure.mock.t.Fatalf("Some expectations are already set for the LoginFailureRepository.RecordLoginFailure method")
+	}
+
+	mmRecordLoginFailure.mock.funcRecordLoginFailure = f
+	return mmRecordLoginFailure.mock
+}
+
+// When sets expectation for the LoginFailureRepository.RecordLoginFailure which will trigger the result defined by the following
+// Then helper
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) When(ctx context.Context, params model.RecordLoginFailureParams) *LoginFailureRepositoryMockRecordLoginFailureExpectation {
+	if mmRecordLoginFailure.mock.funcRecordLoginFailure != nil {
+		mmRecordLoginFailure.mock.t.Fatalf("LoginFailureRepositoryMock.RecordLoginFailure mock is already set by Set")
+	}
+
+	expectation := &LoginFailureRepositoryMockRecordLoginFailureExpectation{
+		mock:   mmRecordLoginFailure.mock,
+		params: &LoginFailureRepositoryMockRecordLoginFailureParams{ctx, params},
+	}
+	mmRecordLoginFailure.expectations = append(mmRecordLoginFailure.expectations, expectation)
+	return expectation
+}
+
+// Then sets up LoginFailureRepository.RecordLoginFailure return parameters for the expectation previously defined by the When method
+func (e *LoginFailureRepositoryMockRecordLoginFailureExpectation) Then(resp model.LoginFailure, err error) *LoginFailureRepositoryMock {
+	e.results = &LoginFailureRepositoryMockRecordLoginFailureResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times LoginFailureRepository.RecordLoginFailure should be invoked
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Times(n uint64) *mLoginFailureRepositoryMockRecordLoginFailure {
+	if n == 0 {
+		mmRecordLoginFailure.mock.t.Fatalf("Times of LoginFailureRepositoryMock.RecordLoginFailure mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordLoginFailure.expectedInvocations, n)
+	return mmRecordLoginFailure
+}
+
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) invocationsDone() bool {
+	if len(mmRecordLoginFailure.expectations) == 0 && mmRecordLoginFailure.defaultExpectation == nil && mmRecordLoginFailure.mock.funcRecordLoginFailure == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordLoginFailure.mock.afterRecordLoginFailureCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordLoginFailure.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordLoginFailure implements repository.LoginFailureRepository
+func (mmRecordLoginFailure *LoginFailureRepositoryMock) RecordLoginFailure(ctx context.Context, params model.RecordLoginFailureParams) (resp model.LoginFailure, err error) {
+	mm_atomic.AddUint64(&mmRecordLoginFailure.beforeRecordLoginFailureCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordLoginFailure.afterRecordLoginFailureCounter, 1)
+
+	if mmRecordLoginFailure.inspectFuncRecordLoginFailure != nil {
+		mmRecordLoginFailure.inspectFuncRecordLoginFailure(ctx, params)
+	}
+
+	mm_params := LoginFailureRepositoryMockRecordLoginFailureParams{ctx, params}
+
+	// Record call args
+	mmRecordLoginFailure.RecordLoginFailureMock.mutex.Lock()
+	mmRecordLoginFailure.RecordLoginFailureMock.callArgs = append(mmRecordLoginFailure.RecordLoginFailureMock.callArgs, &mm_params)
+	mmRecordLoginFailure.RecordLoginFailureMock.mutex.Unlock()
+
+	for _, e := range mmRecordLoginFailure.RecordLoginFailureMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmRecordLoginFailure.RecordLoginFailureMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordLoginFailure.RecordLoginFailureMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordLoginFailure.RecordLoginFailureMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordLoginFailure.RecordLoginFailureMock.defaultExpectation.paramPtrs
+
+		mm_got := LoginFailureRepositoryMockRecordLoginFailureParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordLoginFailure.t.Errorf("LoginFailureRepositoryMock.RecordLoginFailure got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRecordLoginFailure.t.Errorf("LoginFailureRepositoryMock.RecordLoginFailure got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordLoginFailure.t.Errorf("LoginFailureRepositoryMock.RecordLoginFailure got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordLoginFailure.RecordLoginFailureMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordLoginFailure.t.Fatal("No results are set for the LoginFailureRepositoryMock.RecordLoginFailure")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmRecordLoginFailure.funcRecordLoginFailure != nil {
+		return mmRecordLoginFailure.funcRecordLoginFailure(ctx, params)
+	}
+	mmRecordLoginFailure.t.Fatalf("Unexpected call to LoginFailureRepositoryMock.RecordLoginFailure. %v %v", ctx, params)
+	return
+}
+
+// RecordLoginFailureAfterCounter returns a count of finished LoginFailureRepositoryMock.RecordLoginFailure invocations
+func (mmRecordLoginFailure *LoginFailureRepositoryMock) RecordLoginFailureAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordLoginFailure.afterRecordLoginFailureCounter)
+}
+
+// RecordLoginFailureBeforeCounter returns a count of LoginFailureRepositoryMock.RecordLoginFailure invocations
+func (mmRecordLoginFailure *LoginFailureRepositoryMock) RecordLoginFailureBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordLoginFailure.beforeRecordLoginFailureCounter)
+}
+
+// Calls returns a list of arguments used in each call to LoginFailureRepositoryMock.RecordLoginFailure.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordLoginFailure *mLoginFailureRepositoryMockRecordLoginFailure) Calls() []*LoginFailureRepositoryMockRecordLoginFailureParams {
+	mmRecordLoginFailure.mutex.RLock()
+
+	argCopy := make([]*LoginFailureRepositoryMockRecordLoginFailureParams, len(mmRecordLoginFailure.callArgs))
+	copy(argCopy, mmRecordLoginFailure.callArgs)
+
+	mmRecordLoginFailure.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordLoginFailureDone returns true if the count of the RecordLoginFailure invocations corresponds
+// the number of defined expectations
+func (m *LoginFailureRepositoryMock) MinimockRecordLoginFailureDone() bool {
+	if m.RecordLoginFailureMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordLoginFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordLoginFailureMock.invocationsDone()
+}
+
+// MinimockRecordLoginFailureInspect logs each unmet expectation
+func (m *LoginFailureRepositoryMock) MinimockRecordLoginFailureInspect() {
+	for _, e := range m.RecordLoginFailureMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.RecordLoginFailure with params: %#v", *e.params)
+		}
+	}
+
+	afterRecordLoginFailureCounter := mm_atomic.LoadUint64(&m.afterRecordLoginFailureCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordLoginFailureMock.defaultExpectation != nil && afterRecordLoginFailureCounter < 1 {
+		if m.RecordLoginFailureMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to LoginFailureRepositoryMock.RecordLoginFailure")
+		} else {
+			m.t.Errorf("Expected call to LoginFailureRepositoryMock.RecordLoginFailure with params: %#v", *m.RecordLoginFailureMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordLoginFailure != nil && afterRecordLoginFailureCounter < 1 {
+		m.t.Error("Expected call to LoginFailureRepositoryMock.RecordLoginFailure")
+	}
+
+	if !m.RecordLoginFailureMock.invocationsDone() && afterRecordLoginFailureCounter > 0 {
+		m.t.Errorf("Expected %d calls to LoginFailureRepositoryMock.RecordLoginFailure but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordLoginFailureMock.expectedInvocations), afterRecordLoginFailureCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *LoginFailureRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteLoginFailuresInspect()
+
+			m.MinimockLockLoginInspect()
+
+			m.MinimockLockLoginFailuresInspect()
+
+			m.MinimockRecordLoginFailureInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *LoginFailureRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *LoginFailureRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteLoginFailuresDone() &&
+		m.MinimockLockLoginDone() &&
+		m.MinimockLockLoginFailuresDone() &&
+		m.MinimockRecordLoginFailureDone()
+}
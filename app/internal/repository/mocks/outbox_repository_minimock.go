@@ -0,0 +1,1364 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/repository.OutboxRepository -o outbox_repository_minimock.go -n OutboxRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OutboxRepositoryMock implements repository.OutboxRepository
+type OutboxRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAcquireRelayLock          func(ctx context.Context) (acquired bool, err error)
+	inspectFuncAcquireRelayLock   func(ctx context.Context)
+	afterAcquireRelayLockCounter  uint64
+	beforeAcquireRelayLockCounter uint64
+	AcquireRelayLockMock          mOutboxRepositoryMockAcquireRelayLock
+
+	funcCreateOutboxEvent          func(ctx context.Context, params model.CreateOutboxEventParams) (err error)
+	inspectFuncCreateOutboxEvent   func(ctx context.Context, params model.CreateOutboxEventParams)
+	afterCreateOutboxEventCounter  uint64
+	beforeCreateOutboxEventCounter uint64
+	CreateOutboxEventMock          mOutboxRepositoryMockCreateOutboxEvent
+
+	funcListPendingOutboxEvents          func(ctx context.Context, params model.ListPendingOutboxEventsParams) (resp []model.OutboxEvent, err error)
+	inspectFuncListPendingOutboxEvents   func(ctx context.Context, params model.ListPendingOutboxEventsParams)
+	afterListPendingOutboxEventsCounter  uint64
+	beforeListPendingOutboxEventsCounter uint64
+	ListPendingOutboxEventsMock          mOutboxRepositoryMockListPendingOutboxEvents
+
+	funcMarkOutboxEventsSent          func(ctx context.Context, params model.MarkOutboxEventsSentParams) (err error)
+	inspectFuncMarkOutboxEventsSent   func(ctx context.Context, params model.MarkOutboxEventsSentParams)
+	afterMarkOutboxEventsSentCounter  uint64
+	beforeMarkOutboxEventsSentCounter uint64
+	MarkOutboxEventsSentMock          mOutboxRepositoryMockMarkOutboxEventsSent
+}
+
+// NewOutboxRepositoryMock returns a mock for repository.OutboxRepository
+func NewOutboxRepositoryMock(t minimock.Tester) *OutboxRepositoryMock {
+	m := &OutboxRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcquireRelayLockMock = mOutboxRepositoryMockAcquireRelayLock{mock: m}
+	m.AcquireRelayLockMock.callArgs = []*OutboxRepositoryMockAcquireRelayLockParams{}
+
+	m.CreateOutboxEventMock = mOutboxRepositoryMockCreateOutboxEvent{mock: m}
+	m.CreateOutboxEventMock.callArgs = []*OutboxRepositoryMockCreateOutboxEventParams{}
+
+	m.ListPendingOutboxEventsMock = mOutboxRepositoryMockListPendingOutboxEvents{mock: m}
+	m.ListPendingOutboxEventsMock.callArgs = []*OutboxRepositoryMockListPendingOutboxEventsParams{}
+
+	m.MarkOutboxEventsSentMock = mOutboxRepositoryMockMarkOutboxEventsSent{mock: m}
+	m.MarkOutboxEventsSentMock.callArgs = []*OutboxRepositoryMockMarkOutboxEventsSentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOutboxRepositoryMockAcquireRelayLock struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockAcquireRelayLockExpectation
+	expectations       []*OutboxRepositoryMockAcquireRelayLockExpectation
+
+	callArgs []*OutboxRepositoryMockAcquireRelayLockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockAcquireRelayLockExpectation specifies expectation struct of the OutboxRepository.AcquireRelayLock
+type OutboxRepositoryMockAcquireRelayLockExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockAcquireRelayLockParams
+	paramPtrs *OutboxRepositoryMockAcquireRelayLockParamPtrs
+	results   *OutboxRepositoryMockAcquireRelayLockResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockAcquireRelayLockParams contains parameters of the OutboxRepository.AcquireRelayLock
+type OutboxRepositoryMockAcquireRelayLockParams struct {
+	ctx context.Context
+}
+
+// OutboxRepositoryMockAcquireRelayLockParamPtrs contains pointers to parameters of the OutboxRepository.AcquireRelayLock
+type OutboxRepositoryMockAcquireRelayLockParamPtrs struct {
+	ctx *context.Context
+}
+
+// OutboxRepositoryMockAcquireRelayLockResults contains results of the OutboxRepository.AcquireRelayLock
+type OutboxRepositoryMockAcquireRelayLockResults struct {
+	acquired bool
+	err      error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Optional() *mOutboxRepositoryMockAcquireRelayLock {
+	mmAcquireRelayLock.optional = true
+	return mmAcquireRelayLock
+}
+
+// Expect sets up expected params for OutboxRepository.AcquireRelayLock
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Expect(ctx context.Context) *mOutboxRepositoryMockAcquireRelayLock {
+	if mmAcquireRelayLock.mock.funcAcquireRelayLock != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("OutboxRepositoryMock.AcquireRelayLock mock is already set by Set")
+	}
+
+	if mmAcquireRelayLock.defaultExpectation == nil {
+		mmAcquireRelayLock.defaultExpectation = &OutboxRepositoryMockAcquireRelayLockExpectation{}
+	}
+
+	if mmAcquireRelayLock.defaultExpectation.paramPtrs != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("OutboxRepositoryMock.AcquireRelayLock mock is already set by ExpectParams functions")
+	}
+
+	mmAcquireRelayLock.defaultExpectation.params = &OutboxRepositoryMockAcquireRelayLockParams{ctx}
+	for _, e := range mmAcquireRelayLock.expectations {
+		if minimock.Equal(e.params, mmAcquireRelayLock.defaultExpectation.params) {
+			mmAcquireRelayLock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAcquireRelayLock.defaultExpectation.params)
+		}
+	}
+
+	return mmAcquireRelayLock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.AcquireRelayLock
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockAcquireRelayLock {
+	if mmAcquireRelayLock.mock.funcAcquireRelayLock != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("OutboxRepositoryMock.AcquireRelayLock mock is already set by Set")
+	}
+
+	if mmAcquireRelayLock.defaultExpectation == nil {
+		mmAcquireRelayLock.defaultExpectation = &OutboxRepositoryMockAcquireRelayLockExpectation{}
+	}
+
+	if mmAcquireRelayLock.defaultExpectation.params != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("OutboxRepositoryMock.AcquireRelayLock mock is already set by Expect")
+	}
+
+	if mmAcquireRelayLock.defaultExpectation.paramPtrs == nil {
+		mmAcquireRelayLock.defaultExpectation.paramPtrs = &OutboxRepositoryMockAcquireRelayLockParamPtrs{}
+	}
+	mmAcquireRelayLock.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmAcquireRelayLock
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.AcquireRelayLock
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Inspect(f func(ctx context.Context)) *mOutboxRepositoryMockAcquireRelayLock {
+	if mmAcquireRelayLock.mock.inspectFuncAcquireRelayLock != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.AcquireRelayLock")
+	}
+
+	mmAcquireRelayLock.mock.inspectFuncAcquireRelayLock = f
+
+	return mmAcquireRelayLock
+}
+
+// Return sets up results that will be returned by OutboxRepository.AcquireRelayLock
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Return(acquired bool, err error) *OutboxRepositoryMock {
+	if mmAcquireRelayLock.mock.funcAcquireRelayLock != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("OutboxRepositoryMock.AcquireRelayLock mock is already set by Set")
+	}
+
+	if mmAcquireRelayLock.defaultExpectation == nil {
+		mmAcquireRelayLock.defaultExpectation = &OutboxRepositoryMockAcquireRelayLockExpectation{mock: mmAcquireRelayLock.mock}
+	}
+	mmAcquireRelayLock.defaultExpectation.results = &OutboxRepositoryMockAcquireRelayLockResults{acquired, err}
+	return mmAcquireRelayLock.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.AcquireRelayLock method
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Set(f func(ctx context.Context) (acquired bool, err error)) *OutboxRepositoryMock {
+	if mmAcquireRelayLock.defaultExpectation != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.AcquireRelayLock method")
+	}
+
+	if len(mmAcquireRelayLock.expectations) > 0 {
+		mmAcquireRelayLock.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.AcquireRelayLock method")
+	}
+
+	mmAcquireRelayLock.mock.funcAcquireRelayLock = f
+	return mmAcquireRelayLock.mock
+}
+
+// When sets expectation for the OutboxRepository.AcquireRelayLock which will trigger the result defined by the following
+// Then helper
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) When(ctx context.Context) *OutboxRepositoryMockAcquireRelayLockExpectation {
+	if mmAcquireRelayLock.mock.funcAcquireRelayLock != nil {
+		mmAcquireRelayLock.mock.t.Fatalf("OutboxRepositoryMock.AcquireRelayLock mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockAcquireRelayLockExpectation{
+		mock:   mmAcquireRelayLock.mock,
+		params: &OutboxRepositoryMockAcquireRelayLockParams{ctx},
+	}
+	mmAcquireRelayLock.expectations = append(mmAcquireRelayLock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.AcquireRelayLock return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockAcquireRelayLockExpectation) Then(acquired bool, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockAcquireRelayLockResults{acquired, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.AcquireRelayLock should be invoked
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Times(n uint64) *mOutboxRepositoryMockAcquireRelayLock {
+	if n == 0 {
+		mmAcquireRelayLock.mock.t.Fatalf("Times of OutboxRepositoryMock.AcquireRelayLock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAcquireRelayLock.expectedInvocations, n)
+	return mmAcquireRelayLock
+}
+
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) invocationsDone() bool {
+	if len(mmAcquireRelayLock.expectations) == 0 && mmAcquireRelayLock.defaultExpectation == nil && mmAcquireRelayLock.mock.funcAcquireRelayLock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAcquireRelayLock.mock.afterAcquireRelayLockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAcquireRelayLock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AcquireRelayLock implements repository.OutboxRepository
+func (mmAcquireRelayLock *OutboxRepositoryMock) AcquireRelayLock(ctx context.Context) (acquired bool, err error) {
+	mm_atomic.AddUint64(&mmAcquireRelayLock.beforeAcquireRelayLockCounter, 1)
+	defer mm_atomic.AddUint64(&mmAcquireRelayLock.afterAcquireRelayLockCounter, 1)
+
+	if mmAcquireRelayLock.inspectFuncAcquireRelayLock != nil {
+		mmAcquireRelayLock.inspectFuncAcquireRelayLock(ctx)
+	}
+
+	mm_params := OutboxRepositoryMockAcquireRelayLockParams{ctx}
+
+	// Record call args
+	mmAcquireRelayLock.AcquireRelayLockMock.mutex.Lock()
+	mmAcquireRelayLock.AcquireRelayLockMock.callArgs = append(mmAcquireRelayLock.AcquireRelayLockMock.callArgs, &mm_params)
+	mmAcquireRelayLock.AcquireRelayLockMock.mutex.Unlock()
+
+	for _, e := range mmAcquireRelayLock.AcquireRelayLockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.acquired, e.results.err
+		}
+	}
+
+	if mmAcquireRelayLock.AcquireRelayLockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAcquireRelayLock.AcquireRelayLockMock.defaultExpectation.Counter, 1)
+		mm_want := mmAcquireRelayLock.AcquireRelayLockMock.defaultExpectation.params
+		mm_want_ptrs := mmAcquireRelayLock.AcquireRelayLockMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockAcquireRelayLockParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAcquireRelayLock.t.Errorf("OutboxRepositoryMock.AcquireRelayLock got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAcquireRelayLock.t.Errorf("OutboxRepositoryMock.AcquireRelayLock got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAcquireRelayLock.AcquireRelayLockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAcquireRelayLock.t.Fatal("No results are set for the OutboxRepositoryMock.AcquireRelayLock")
+		}
+		return (*mm_results).acquired, (*mm_results).err
+	}
+	if mmAcquireRelayLock.funcAcquireRelayLock != nil {
+		return mmAcquireRelayLock.funcAcquireRelayLock(ctx)
+	}
+	mmAcquireRelayLock.t.Fatalf("Unexpected call to OutboxRepositoryMock.AcquireRelayLock. %v", ctx)
+	return
+}
+
+// AcquireRelayLockAfterCounter returns a count of finished OutboxRepositoryMock.AcquireRelayLock invocations
+func (mmAcquireRelayLock *OutboxRepositoryMock) AcquireRelayLockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAcquireRelayLock.afterAcquireRelayLockCounter)
+}
+
+// AcquireRelayLockBeforeCounter returns a count of OutboxRepositoryMock.AcquireRelayLock invocations
+func (mmAcquireRelayLock *OutboxRepositoryMock) AcquireRelayLockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAcquireRelayLock.beforeAcquireRelayLockCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.AcquireRelayLock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAcquireRelayLock *mOutboxRepositoryMockAcquireRelayLock) Calls() []*OutboxRepositoryMockAcquireRelayLockParams {
+	mmAcquireRelayLock.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockAcquireRelayLockParams, len(mmAcquireRelayLock.callArgs))
+	copy(argCopy, mmAcquireRelayLock.callArgs)
+
+	mmAcquireRelayLock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAcquireRelayLockDone returns true if the count of the AcquireRelayLock invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockAcquireRelayLockDone() bool {
+	if m.AcquireRelayLockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AcquireRelayLockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AcquireRelayLockMock.invocationsDone()
+}
+
+// MinimockAcquireRelayLockInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockAcquireRelayLockInspect() {
+	for _, e := range m.AcquireRelayLockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AcquireRelayLock with params: %#v", *e.params)
+		}
+	}
+
+	afterAcquireRelayLockCounter := mm_atomic.LoadUint64(&m.afterAcquireRelayLockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AcquireRelayLockMock.defaultExpectation != nil && afterAcquireRelayLockCounter < 1 {
+		if m.AcquireRelayLockMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.AcquireRelayLock")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.AcquireRelayLock with params: %#v", *m.AcquireRelayLockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAcquireRelayLock != nil && afterAcquireRelayLockCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.AcquireRelayLock")
+	}
+
+	if !m.AcquireRelayLockMock.invocationsDone() && afterAcquireRelayLockCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.AcquireRelayLock but found %d calls",
+			mm_atomic.LoadUint64(&m.AcquireRelayLockMock.expectedInvocations), afterAcquireRelayLockCounter)
+	}
+}
+
+type mOutboxRepositoryMockCreateOutboxEvent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockCreateOutboxEventExpectation
+	expectations       []*OutboxRepositoryMockCreateOutboxEventExpectation
+
+	callArgs []*OutboxRepositoryMockCreateOutboxEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockCreateOutboxEventExpectation specifies expectation struct of the OutboxRepository.CreateOutboxEvent
+type OutboxRepositoryMockCreateOutboxEventExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockCreateOutboxEventParams
+	paramPtrs *OutboxRepositoryMockCreateOutboxEventParamPtrs
+	results   *OutboxRepositoryMockCreateOutboxEventResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockCreateOutboxEventParams contains parameters of the OutboxRepository.CreateOutboxEvent
+type OutboxRepositoryMockCreateOutboxEventParams struct {
+	ctx    context.Context
+	params model.CreateOutboxEventParams
+}
+
+// OutboxRepositoryMockCreateOutboxEventParamPtrs contains pointers to parameters of the OutboxRepository.CreateOutboxEvent
+type OutboxRepositoryMockCreateOutboxEventParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateOutboxEventParams
+}
+
+// OutboxRepositoryMockCreateOutboxEventResults contains results of the OutboxRepository.CreateOutboxEvent
+type OutboxRepositoryMockCreateOutboxEventResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Optional() *mOutboxRepositoryMockCreateOutboxEvent {
+	mmCreateOutboxEvent.optional = true
+	return mmCreateOutboxEvent
+}
+
+// Expect sets up expected params for OutboxRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Expect(ctx context.Context, params model.CreateOutboxEventParams) *mOutboxRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &OutboxRepositoryMockCreateOutboxEventExpectation{}
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOutboxEvent.defaultExpectation.params = &OutboxRepositoryMockCreateOutboxEventParams{ctx, params}
+	for _, e := range mmCreateOutboxEvent.expectations {
+		if minimock.Equal(e.params, mmCreateOutboxEvent.defaultExpectation.params) {
+			mmCreateOutboxEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOutboxEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOutboxEvent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &OutboxRepositoryMockCreateOutboxEventExpectation{}
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.params != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Expect")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateOutboxEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockCreateOutboxEventParamPtrs{}
+	}
+	mmCreateOutboxEvent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateOutboxEvent
+}
+
+// ExpectParamsParam2 sets up expected param params for OutboxRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) ExpectParamsParam2(params model.CreateOutboxEventParams) *mOutboxRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &OutboxRepositoryMockCreateOutboxEventExpectation{}
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.params != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Expect")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation.paramPtrs == nil {
+		mmCreateOutboxEvent.defaultExpectation.paramPtrs = &OutboxRepositoryMockCreateOutboxEventParamPtrs{}
+	}
+	mmCreateOutboxEvent.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateOutboxEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Inspect(f func(ctx context.Context, params model.CreateOutboxEventParams)) *mOutboxRepositoryMockCreateOutboxEvent {
+	if mmCreateOutboxEvent.mock.inspectFuncCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.CreateOutboxEvent")
+	}
+
+	mmCreateOutboxEvent.mock.inspectFuncCreateOutboxEvent = f
+
+	return mmCreateOutboxEvent
+}
+
+// Return sets up results that will be returned by OutboxRepository.CreateOutboxEvent
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Return(err error) *OutboxRepositoryMock {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	if mmCreateOutboxEvent.defaultExpectation == nil {
+		mmCreateOutboxEvent.defaultExpectation = &OutboxRepositoryMockCreateOutboxEventExpectation{mock: mmCreateOutboxEvent.mock}
+	}
+	mmCreateOutboxEvent.defaultExpectation.results = &OutboxRepositoryMockCreateOutboxEventResults{err}
+	return mmCreateOutboxEvent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.CreateOutboxEvent method
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Set(f func(ctx context.Context, params model.CreateOutboxEventParams) (err error)) *OutboxRepositoryMock {
+	if mmCreateOutboxEvent.defaultExpectation != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.CreateOutboxEvent method")
+	}
+
+	if len(mmCreateOutboxEvent.expectations) > 0 {
+		mmCreateOutboxEvent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.CreateOutboxEvent method")
+	}
+
+	mmCreateOutboxEvent.mock.funcCreateOutboxEvent = f
+	return mmCreateOutboxEvent.mock
+}
+
+// When sets expectation for the OutboxRepository.CreateOutboxEvent which will trigger the result defined by the following
+// Then helper
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) When(ctx context.Context, params model.CreateOutboxEventParams) *OutboxRepositoryMockCreateOutboxEventExpectation {
+	if mmCreateOutboxEvent.mock.funcCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.mock.t.Fatalf("OutboxRepositoryMock.CreateOutboxEvent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockCreateOutboxEventExpectation{
+		mock:   mmCreateOutboxEvent.mock,
+		params: &OutboxRepositoryMockCreateOutboxEventParams{ctx, params},
+	}
+	mmCreateOutboxEvent.expectations = append(mmCreateOutboxEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.CreateOutboxEvent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockCreateOutboxEventExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockCreateOutboxEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.CreateOutboxEvent should be invoked
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Times(n uint64) *mOutboxRepositoryMockCreateOutboxEvent {
+	if n == 0 {
+		mmCreateOutboxEvent.mock.t.Fatalf("Times of OutboxRepositoryMock.CreateOutboxEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOutboxEvent.expectedInvocations, n)
+	return mmCreateOutboxEvent
+}
+
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) invocationsDone() bool {
+	if len(mmCreateOutboxEvent.expectations) == 0 && mmCreateOutboxEvent.defaultExpectation == nil && mmCreateOutboxEvent.mock.funcCreateOutboxEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOutboxEvent.mock.afterCreateOutboxEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOutboxEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOutboxEvent implements repository.OutboxRepository
+func (mmCreateOutboxEvent *OutboxRepositoryMock) CreateOutboxEvent(ctx context.Context, params model.CreateOutboxEventParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateOutboxEvent.beforeCreateOutboxEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOutboxEvent.afterCreateOutboxEventCounter, 1)
+
+	if mmCreateOutboxEvent.inspectFuncCreateOutboxEvent != nil {
+		mmCreateOutboxEvent.inspectFuncCreateOutboxEvent(ctx, params)
+	}
+
+	mm_params := OutboxRepositoryMockCreateOutboxEventParams{ctx, params}
+
+	// Record call args
+	mmCreateOutboxEvent.CreateOutboxEventMock.mutex.Lock()
+	mmCreateOutboxEvent.CreateOutboxEventMock.callArgs = append(mmCreateOutboxEvent.CreateOutboxEventMock.callArgs, &mm_params)
+	mmCreateOutboxEvent.CreateOutboxEventMock.mutex.Unlock()
+
+	for _, e := range mmCreateOutboxEvent.CreateOutboxEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockCreateOutboxEventParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOutboxEvent.t.Errorf("OutboxRepositoryMock.CreateOutboxEvent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateOutboxEvent.t.Errorf("OutboxRepositoryMock.CreateOutboxEvent got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOutboxEvent.t.Errorf("OutboxRepositoryMock.CreateOutboxEvent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOutboxEvent.CreateOutboxEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOutboxEvent.t.Fatal("No results are set for the OutboxRepositoryMock.CreateOutboxEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateOutboxEvent.funcCreateOutboxEvent != nil {
+		return mmCreateOutboxEvent.funcCreateOutboxEvent(ctx, params)
+	}
+	mmCreateOutboxEvent.t.Fatalf("Unexpected call to OutboxRepositoryMock.CreateOutboxEvent. %v %v", ctx, params)
+	return
+}
+
+// CreateOutboxEventAfterCounter returns a count of finished OutboxRepositoryMock.CreateOutboxEvent invocations
+func (mmCreateOutboxEvent *OutboxRepositoryMock) CreateOutboxEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOutboxEvent.afterCreateOutboxEventCounter)
+}
+
+// CreateOutboxEventBeforeCounter returns a count of OutboxRepositoryMock.CreateOutboxEvent invocations
+func (mmCreateOutboxEvent *OutboxRepositoryMock) CreateOutboxEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOutboxEvent.beforeCreateOutboxEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.CreateOutboxEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOutboxEvent *mOutboxRepositoryMockCreateOutboxEvent) Calls() []*OutboxRepositoryMockCreateOutboxEventParams {
+	mmCreateOutboxEvent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockCreateOutboxEventParams, len(mmCreateOutboxEvent.callArgs))
+	copy(argCopy, mmCreateOutboxEvent.callArgs)
+
+	mmCreateOutboxEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOutboxEventDone returns true if the count of the CreateOutboxEvent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockCreateOutboxEventDone() bool {
+	if m.CreateOutboxEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOutboxEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOutboxEventMock.invocationsDone()
+}
+
+// MinimockCreateOutboxEventInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockCreateOutboxEventInspect() {
+	for _, e := range m.CreateOutboxEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CreateOutboxEvent with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateOutboxEventCounter := mm_atomic.LoadUint64(&m.afterCreateOutboxEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOutboxEventMock.defaultExpectation != nil && afterCreateOutboxEventCounter < 1 {
+		if m.CreateOutboxEventMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.CreateOutboxEvent")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.CreateOutboxEvent with params: %#v", *m.CreateOutboxEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOutboxEvent != nil && afterCreateOutboxEventCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.CreateOutboxEvent")
+	}
+
+	if !m.CreateOutboxEventMock.invocationsDone() && afterCreateOutboxEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.CreateOutboxEvent but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOutboxEventMock.expectedInvocations), afterCreateOutboxEventCounter)
+	}
+}
+
+type mOutboxRepositoryMockListPendingOutboxEvents struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockListPendingOutboxEventsExpectation
+	expectations       []*OutboxRepositoryMockListPendingOutboxEventsExpectation
+
+	callArgs []*OutboxRepositoryMockListPendingOutboxEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockListPendingOutboxEventsExpectation specifies expectation struct of the OutboxRepository.ListPendingOutboxEvents
+type OutboxRepositoryMockListPendingOutboxEventsExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockListPendingOutboxEventsParams
+	paramPtrs *OutboxRepositoryMockListPendingOutboxEventsParamPtrs
+	results   *OutboxRepositoryMockListPendingOutboxEventsResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockListPendingOutboxEventsParams contains parameters of the OutboxRepository.ListPendingOutboxEvents
+type OutboxRepositoryMockListPendingOutboxEventsParams struct {
+	ctx    context.Context
+	params model.ListPendingOutboxEventsParams
+}
+
+// OutboxRepositoryMockListPendingOutboxEventsParamPtrs contains pointers to parameters of the OutboxRepository.ListPendingOutboxEvents
+type OutboxRepositoryMockListPendingOutboxEventsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListPendingOutboxEventsParams
+}
+
+// OutboxRepositoryMockListPendingOutboxEventsResults contains results of the OutboxRepository.ListPendingOutboxEvents
+type OutboxRepositoryMockListPendingOutboxEventsResults struct {
+	resp []model.OutboxEvent
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Optional() *mOutboxRepositoryMockListPendingOutboxEvents {
+	mmListPendingOutboxEvents.optional = true
+	return mmListPendingOutboxEvents
+}
+
+// Expect sets up expected params for OutboxRepository.ListPendingOutboxEvents
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Expect(ctx context.Context, params model.ListPendingOutboxEventsParams) *mOutboxRepositoryMockListPendingOutboxEvents {
+	if mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Set")
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation == nil {
+		mmListPendingOutboxEvents.defaultExpectation = &OutboxRepositoryMockListPendingOutboxEventsExpectation{}
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation.paramPtrs != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListPendingOutboxEvents.defaultExpectation.params = &OutboxRepositoryMockListPendingOutboxEventsParams{ctx, params}
+	for _, e := range mmListPendingOutboxEvents.expectations {
+		if minimock.Equal(e.params, mmListPendingOutboxEvents.defaultExpectation.params) {
+			mmListPendingOutboxEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPendingOutboxEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListPendingOutboxEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.ListPendingOutboxEvents
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockListPendingOutboxEvents {
+	if mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Set")
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation == nil {
+		mmListPendingOutboxEvents.defaultExpectation = &OutboxRepositoryMockListPendingOutboxEventsExpectation{}
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation.params != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Expect")
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmListPendingOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockListPendingOutboxEventsParamPtrs{}
+	}
+	mmListPendingOutboxEvents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListPendingOutboxEvents
+}
+
+// ExpectParamsParam2 sets up expected param params for OutboxRepository.ListPendingOutboxEvents
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) ExpectParamsParam2(params model.ListPendingOutboxEventsParams) *mOutboxRepositoryMockListPendingOutboxEvents {
+	if mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Set")
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation == nil {
+		mmListPendingOutboxEvents.defaultExpectation = &OutboxRepositoryMockListPendingOutboxEventsExpectation{}
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation.params != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Expect")
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation.paramPtrs == nil {
+		mmListPendingOutboxEvents.defaultExpectation.paramPtrs = &OutboxRepositoryMockListPendingOutboxEventsParamPtrs{}
+	}
+	mmListPendingOutboxEvents.defaultExpectation.paramPtrs.params = &params
+
+	return mmListPendingOutboxEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.ListPendingOutboxEvents
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Inspect(f func(ctx context.Context, params model.ListPendingOutboxEventsParams)) *mOutboxRepositoryMockListPendingOutboxEvents {
+	if mmListPendingOutboxEvents.mock.inspectFuncListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.ListPendingOutboxEvents")
+	}
+
+	mmListPendingOutboxEvents.mock.inspectFuncListPendingOutboxEvents = f
+
+	return mmListPendingOutboxEvents
+}
+
+// Return sets up results that will be returned by OutboxRepository.ListPendingOutboxEvents
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Return(resp []model.OutboxEvent, err error) *OutboxRepositoryMock {
+	if mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Set")
+	}
+
+	if mmListPendingOutboxEvents.defaultExpectation == nil {
+		mmListPendingOutboxEvents.defaultExpectation = &OutboxRepositoryMockListPendingOutboxEventsExpectation{mock: mmListPendingOutboxEvents.mock}
+	}
+	mmListPendingOutboxEvents.defaultExpectation.results = &OutboxRepositoryMockListPendingOutboxEventsResults{resp, err}
+	return mmListPendingOutboxEvents.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.ListPendingOutboxEvents method
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Set(f func(ctx context.Context, params model.ListPendingOutboxEventsParams) (resp []model.OutboxEvent, err error)) *OutboxRepositoryMock {
+	if mmListPendingOutboxEvents.defaultExpectation != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.ListPendingOutboxEvents method")
+	}
+
+	if len(mmListPendingOutboxEvents.expectations) > 0 {
+		mmListPendingOutboxEvents.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.ListPendingOutboxEvents method")
+	}
+
+	mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents = f
+	return mmListPendingOutboxEvents.mock
+}
+
+// When sets expectation for the OutboxRepository.ListPendingOutboxEvents which will trigger the result defined by the following
+// Then helper
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) When(ctx context.Context, params model.ListPendingOutboxEventsParams) *OutboxRepositoryMockListPendingOutboxEventsExpectation {
+	if mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.mock.t.Fatalf("OutboxRepositoryMock.ListPendingOutboxEvents mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockListPendingOutboxEventsExpectation{
+		mock:   mmListPendingOutboxEvents.mock,
+		params: &OutboxRepositoryMockListPendingOutboxEventsParams{ctx, params},
+	}
+	mmListPendingOutboxEvents.expectations = append(mmListPendingOutboxEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.ListPendingOutboxEvents return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockListPendingOutboxEventsExpectation) Then(resp []model.OutboxEvent, err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockListPendingOutboxEventsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.ListPendingOutboxEvents should be invoked
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Times(n uint64) *mOutboxRepositoryMockListPendingOutboxEvents {
+	if n == 0 {
+		mmListPendingOutboxEvents.mock.t.Fatalf("Times of OutboxRepositoryMock.ListPendingOutboxEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPendingOutboxEvents.expectedInvocations, n)
+	return mmListPendingOutboxEvents
+}
+
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) invocationsDone() bool {
+	if len(mmListPendingOutboxEvents.expectations) == 0 && mmListPendingOutboxEvents.defaultExpectation == nil && mmListPendingOutboxEvents.mock.funcListPendingOutboxEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPendingOutboxEvents.mock.afterListPendingOutboxEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPendingOutboxEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPendingOutboxEvents implements repository.OutboxRepository
+func (mmListPendingOutboxEvents *OutboxRepositoryMock) ListPendingOutboxEvents(ctx context.Context, params model.ListPendingOutboxEventsParams) (resp []model.OutboxEvent, err error) {
+	mm_atomic.AddUint64(&mmListPendingOutboxEvents.beforeListPendingOutboxEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPendingOutboxEvents.afterListPendingOutboxEventsCounter, 1)
+
+	if mmListPendingOutboxEvents.inspectFuncListPendingOutboxEvents != nil {
+		mmListPendingOutboxEvents.inspectFuncListPendingOutboxEvents(ctx, params)
+	}
+
+	mm_params := OutboxRepositoryMockListPendingOutboxEventsParams{ctx, params}
+
+	// Record call args
+	mmListPendingOutboxEvents.ListPendingOutboxEventsMock.mutex.Lock()
+	mmListPendingOutboxEvents.ListPendingOutboxEventsMock.callArgs = append(mmListPendingOutboxEvents.ListPendingOutboxEventsMock.callArgs, &mm_params)
+	mmListPendingOutboxEvents.ListPendingOutboxEventsMock.mutex.Unlock()
+
+	for _, e := range mmListPendingOutboxEvents.ListPendingOutboxEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListPendingOutboxEvents.ListPendingOutboxEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPendingOutboxEvents.ListPendingOutboxEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPendingOutboxEvents.ListPendingOutboxEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListPendingOutboxEvents.ListPendingOutboxEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockListPendingOutboxEventsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPendingOutboxEvents.t.Errorf("OutboxRepositoryMock.ListPendingOutboxEvents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListPendingOutboxEvents.t.Errorf("OutboxRepositoryMock.ListPendingOutboxEvents got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPendingOutboxEvents.t.Errorf("OutboxRepositoryMock.ListPendingOutboxEvents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPendingOutboxEvents.ListPendingOutboxEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPendingOutboxEvents.t.Fatal("No results are set for the OutboxRepositoryMock.ListPendingOutboxEvents")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListPendingOutboxEvents.funcListPendingOutboxEvents != nil {
+		return mmListPendingOutboxEvents.funcListPendingOutboxEvents(ctx, params)
+	}
+	mmListPendingOutboxEvents.t.Fatalf("Unexpected call to OutboxRepositoryMock.ListPendingOutboxEvents. %v %v", ctx, params)
+	return
+}
+
+// ListPendingOutboxEventsAfterCounter returns a count of finished OutboxRepositoryMock.ListPendingOutboxEvents invocations
+func (mmListPendingOutboxEvents *OutboxRepositoryMock) ListPendingOutboxEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingOutboxEvents.afterListPendingOutboxEventsCounter)
+}
+
+// ListPendingOutboxEventsBeforeCounter returns a count of OutboxRepositoryMock.ListPendingOutboxEvents invocations
+func (mmListPendingOutboxEvents *OutboxRepositoryMock) ListPendingOutboxEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingOutboxEvents.beforeListPendingOutboxEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.ListPendingOutboxEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPendingOutboxEvents *mOutboxRepositoryMockListPendingOutboxEvents) Calls() []*OutboxRepositoryMockListPendingOutboxEventsParams {
+	mmListPendingOutboxEvents.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockListPendingOutboxEventsParams, len(mmListPendingOutboxEvents.callArgs))
+	copy(argCopy, mmListPendingOutboxEvents.callArgs)
+
+	mmListPendingOutboxEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingOutboxEventsDone returns true if the count of the ListPendingOutboxEvents invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockListPendingOutboxEventsDone() bool {
+	if m.ListPendingOutboxEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingOutboxEventsMock.invocationsDone()
+}
+
+// MinimockListPendingOutboxEventsInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockListPendingOutboxEventsInspect() {
+	for _, e := range m.ListPendingOutboxEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPendingOutboxEvents with params: %#v", *e.params)
+		}
+	}
+
+	afterListPendingOutboxEventsCounter := mm_atomic.LoadUint64(&m.afterListPendingOutboxEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingOutboxEventsMock.defaultExpectation != nil && afterListPendingOutboxEventsCounter < 1 {
+		if m.ListPendingOutboxEventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.ListPendingOutboxEvents")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.ListPendingOutboxEvents with params: %#v", *m.ListPendingOutboxEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPendingOutboxEvents != nil && afterListPendingOutboxEventsCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.ListPendingOutboxEvents")
+	}
+
+	if !m.ListPendingOutboxEventsMock.invocationsDone() && afterListPendingOutboxEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.ListPendingOutboxEvents but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingOutboxEventsMock.expectedInvocations), afterListPendingOutboxEventsCounter)
+	}
+}
+
+type mOutboxRepositoryMockMarkOutboxEventsSent struct {
+	optional           bool
+	mock               *OutboxRepositoryMock
+	defaultExpectation *OutboxRepositoryMockMarkOutboxEventsSentExpectation
+	expectations       []*OutboxRepositoryMockMarkOutboxEventsSentExpectation
+
+	callArgs []*OutboxRepositoryMockMarkOutboxEventsSentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// OutboxRepositoryMockMarkOutboxEventsSentExpectation specifies expectation struct of the OutboxRepository.MarkOutboxEventsSent
+type OutboxRepositoryMockMarkOutboxEventsSentExpectation struct {
+	mock      *OutboxRepositoryMock
+	params    *OutboxRepositoryMockMarkOutboxEventsSentParams
+	paramPtrs *OutboxRepositoryMockMarkOutboxEventsSentParamPtrs
+	results   *OutboxRepositoryMockMarkOutboxEventsSentResults
+	Counter   uint64
+}
+
+// OutboxRepositoryMockMarkOutboxEventsSentParams contains parameters of the OutboxRepository.MarkOutboxEventsSent
+type OutboxRepositoryMockMarkOutboxEventsSentParams struct {
+	ctx    context.Context
+	params model.MarkOutboxEventsSentParams
+}
+
+// OutboxRepositoryMockMarkOutboxEventsSentParamPtrs contains pointers to parameters of the OutboxRepository.MarkOutboxEventsSent
+type OutboxRepositoryMockMarkOutboxEventsSentParamPtrs struct {
+	ctx    *context.Context
+	params *model.MarkOutboxEventsSentParams
+}
+
+// OutboxRepositoryMockMarkOutboxEventsSentResults contains results of the OutboxRepository.MarkOutboxEventsSent
+type OutboxRepositoryMockMarkOutboxEventsSentResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Optional() *mOutboxRepositoryMockMarkOutboxEventsSent {
+	mmMarkOutboxEventsSent.optional = true
+	return mmMarkOutboxEventsSent
+}
+
+// Expect sets up expected params for OutboxRepository.MarkOutboxEventsSent
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Expect(ctx context.Context, params model.MarkOutboxEventsSentParams) *mOutboxRepositoryMockMarkOutboxEventsSent {
+	if mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation == nil {
+		mmMarkOutboxEventsSent.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventsSentExpectation{}
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation.paramPtrs != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by ExpectParams functions")
+	}
+
+	mmMarkOutboxEventsSent.defaultExpectation.params = &OutboxRepositoryMockMarkOutboxEventsSentParams{ctx, params}
+	for _, e := range mmMarkOutboxEventsSent.expectations {
+		if minimock.Equal(e.params, mmMarkOutboxEventsSent.defaultExpectation.params) {
+			mmMarkOutboxEventsSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkOutboxEventsSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkOutboxEventsSent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OutboxRepository.MarkOutboxEventsSent
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) ExpectCtxParam1(ctx context.Context) *mOutboxRepositoryMockMarkOutboxEventsSent {
+	if mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation == nil {
+		mmMarkOutboxEventsSent.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventsSentExpectation{}
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation.params != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Expect")
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxEventsSent.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkOutboxEventsSentParamPtrs{}
+	}
+	mmMarkOutboxEventsSent.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkOutboxEventsSent
+}
+
+// ExpectParamsParam2 sets up expected param params for OutboxRepository.MarkOutboxEventsSent
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) ExpectParamsParam2(params model.MarkOutboxEventsSentParams) *mOutboxRepositoryMockMarkOutboxEventsSent {
+	if mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation == nil {
+		mmMarkOutboxEventsSent.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventsSentExpectation{}
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation.params != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Expect")
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation.paramPtrs == nil {
+		mmMarkOutboxEventsSent.defaultExpectation.paramPtrs = &OutboxRepositoryMockMarkOutboxEventsSentParamPtrs{}
+	}
+	mmMarkOutboxEventsSent.defaultExpectation.paramPtrs.params = &params
+
+	return mmMarkOutboxEventsSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OutboxRepository.MarkOutboxEventsSent
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Inspect(f func(ctx context.Context, params model.MarkOutboxEventsSentParams)) *mOutboxRepositoryMockMarkOutboxEventsSent {
+	if mmMarkOutboxEventsSent.mock.inspectFuncMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("Inspect function is already set for OutboxRepositoryMock.MarkOutboxEventsSent")
+	}
+
+	mmMarkOutboxEventsSent.mock.inspectFuncMarkOutboxEventsSent = f
+
+	return mmMarkOutboxEventsSent
+}
+
+// Return sets up results that will be returned by OutboxRepository.MarkOutboxEventsSent
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Return(err error) *OutboxRepositoryMock {
+	if mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Set")
+	}
+
+	if mmMarkOutboxEventsSent.defaultExpectation == nil {
+		mmMarkOutboxEventsSent.defaultExpectation = &OutboxRepositoryMockMarkOutboxEventsSentExpectation{mock: mmMarkOutboxEventsSent.mock}
+	}
+	mmMarkOutboxEventsSent.defaultExpectation.results = &OutboxRepositoryMockMarkOutboxEventsSentResults{err}
+	return mmMarkOutboxEventsSent.mock
+}
+
+// Set uses given function f to mock the OutboxRepository.MarkOutboxEventsSent method
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Set(f func(ctx context.Context, params model.MarkOutboxEventsSentParams) (err error)) *OutboxRepositoryMock {
+	if mmMarkOutboxEventsSent.defaultExpectation != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("Default expectation is already set for the OutboxRepository.MarkOutboxEventsSent method")
+	}
+
+	if len(mmMarkOutboxEventsSent.expectations) > 0 {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("Some expectations are already set for the OutboxRepository.MarkOutboxEventsSent method")
+	}
+
+	mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent = f
+	return mmMarkOutboxEventsSent.mock
+}
+
+// When sets expectation for the OutboxRepository.MarkOutboxEventsSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) When(ctx context.Context, params model.MarkOutboxEventsSentParams) *OutboxRepositoryMockMarkOutboxEventsSentExpectation {
+	if mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("OutboxRepositoryMock.MarkOutboxEventsSent mock is already set by Set")
+	}
+
+	expectation := &OutboxRepositoryMockMarkOutboxEventsSentExpectation{
+		mock:   mmMarkOutboxEventsSent.mock,
+		params: &OutboxRepositoryMockMarkOutboxEventsSentParams{ctx, params},
+	}
+	mmMarkOutboxEventsSent.expectations = append(mmMarkOutboxEventsSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OutboxRepository.MarkOutboxEventsSent return parameters for the expectation previously defined by the When method
+func (e *OutboxRepositoryMockMarkOutboxEventsSentExpectation) Then(err error) *OutboxRepositoryMock {
+	e.results = &OutboxRepositoryMockMarkOutboxEventsSentResults{err}
+	return e.mock
+}
+
+// Times sets number of times OutboxRepository.MarkOutboxEventsSent should be invoked
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Times(n uint64) *mOutboxRepositoryMockMarkOutboxEventsSent {
+	if n == 0 {
+		mmMarkOutboxEventsSent.mock.t.Fatalf("Times of OutboxRepositoryMock.MarkOutboxEventsSent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkOutboxEventsSent.expectedInvocations, n)
+	return mmMarkOutboxEventsSent
+}
+
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) invocationsDone() bool {
+	if len(mmMarkOutboxEventsSent.expectations) == 0 && mmMarkOutboxEventsSent.defaultExpectation == nil && mmMarkOutboxEventsSent.mock.funcMarkOutboxEventsSent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkOutboxEventsSent.mock.afterMarkOutboxEventsSentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkOutboxEventsSent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkOutboxEventsSent implements repository.OutboxRepository
+func (mmMarkOutboxEventsSent *OutboxRepositoryMock) MarkOutboxEventsSent(ctx context.Context, params model.MarkOutboxEventsSentParams) (err error) {
+	mm_atomic.AddUint64(&mmMarkOutboxEventsSent.beforeMarkOutboxEventsSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkOutboxEventsSent.afterMarkOutboxEventsSentCounter, 1)
+
+	if mmMarkOutboxEventsSent.inspectFuncMarkOutboxEventsSent != nil {
+		mmMarkOutboxEventsSent.inspectFuncMarkOutboxEventsSent(ctx, params)
+	}
+
+	mm_params := OutboxRepositoryMockMarkOutboxEventsSentParams{ctx, params}
+
+	// Record call args
+	mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.mutex.Lock()
+	mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.callArgs = append(mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.callArgs, &mm_params)
+	mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.defaultExpectation.paramPtrs
+
+		mm_got := OutboxRepositoryMockMarkOutboxEventsSentParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkOutboxEventsSent.t.Errorf("OutboxRepositoryMock.MarkOutboxEventsSent got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmMarkOutboxEventsSent.t.Errorf("OutboxRepositoryMock.MarkOutboxEventsSent got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkOutboxEventsSent.t.Errorf("OutboxRepositoryMock.MarkOutboxEventsSent got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkOutboxEventsSent.MarkOutboxEventsSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkOutboxEventsSent.t.Fatal("No results are set for the OutboxRepositoryMock.MarkOutboxEventsSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkOutboxEventsSent.funcMarkOutboxEventsSent != nil {
+		return mmMarkOutboxEventsSent.funcMarkOutboxEventsSent(ctx, params)
+	}
+	mmMarkOutboxEventsSent.t.Fatalf("Unexpected call to OutboxRepositoryMock.MarkOutboxEventsSent. %v %v", ctx, params)
+	return
+}
+
+// MarkOutboxEventsSentAfterCounter returns a count of finished OutboxRepositoryMock.MarkOutboxEventsSent invocations
+func (mmMarkOutboxEventsSent *OutboxRepositoryMock) MarkOutboxEventsSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxEventsSent.afterMarkOutboxEventsSentCounter)
+}
+
+// MarkOutboxEventsSentBeforeCounter returns a count of OutboxRepositoryMock.MarkOutboxEventsSent invocations
+func (mmMarkOutboxEventsSent *OutboxRepositoryMock) MarkOutboxEventsSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkOutboxEventsSent.beforeMarkOutboxEventsSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OutboxRepositoryMock.MarkOutboxEventsSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkOutboxEventsSent *mOutboxRepositoryMockMarkOutboxEventsSent) Calls() []*OutboxRepositoryMockMarkOutboxEventsSentParams {
+	mmMarkOutboxEventsSent.mutex.RLock()
+
+	argCopy := make([]*OutboxRepositoryMockMarkOutboxEventsSentParams, len(mmMarkOutboxEventsSent.callArgs))
+	copy(argCopy, mmMarkOutboxEventsSent.callArgs)
+
+	mmMarkOutboxEventsSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkOutboxEventsSentDone returns true if the count of the MarkOutboxEventsSent invocations corresponds
+// the number of defined expectations
+func (m *OutboxRepositoryMock) MinimockMarkOutboxEventsSentDone() bool {
+	if m.MarkOutboxEventsSentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkOutboxEventsSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkOutboxEventsSentMock.invocationsDone()
+}
+
+// MinimockMarkOutboxEventsSentInspect logs each unmet expectation
+func (m *OutboxRepositoryMock) MinimockMarkOutboxEventsSentInspect() {
+	for _, e := range m.MarkOutboxEventsSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkOutboxEventsSent with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkOutboxEventsSentCounter := mm_atomic.LoadUint64(&m.afterMarkOutboxEventsSentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkOutboxEventsSentMock.defaultExpectation != nil && afterMarkOutboxEventsSentCounter < 1 {
+		if m.MarkOutboxEventsSentMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to OutboxRepositoryMock.MarkOutboxEventsSent")
+		} else {
+			m.t.Errorf("Expected call to OutboxRepositoryMock.MarkOutboxEventsSent with params: %#v", *m.MarkOutboxEventsSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkOutboxEventsSent != nil && afterMarkOutboxEventsSentCounter < 1 {
+		m.t.Error("Expected call to OutboxRepositoryMock.MarkOutboxEventsSent")
+	}
+
+	if !m.MarkOutboxEventsSentMock.invocationsDone() && afterMarkOutboxEventsSentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OutboxRepositoryMock.MarkOutboxEventsSent but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkOutboxEventsSentMock.expectedInvocations), afterMarkOutboxEventsSentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OutboxRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAcquireRelayLockInspect()
+
+			m.MinimockCreateOutboxEventInspect()
+
+			m.MinimockListPendingOutboxEventsInspect()
+
+			m.MinimockMarkOutboxEventsSentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OutboxRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OutboxRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAcquireRelayLockDone() &&
+		m.MinimockCreateOutboxEventDone() &&
+		m.MinimockListPendingOutboxEventsDone() &&
+		m.MinimockMarkOutboxEventsSentDone()
+}
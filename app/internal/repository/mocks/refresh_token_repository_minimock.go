@@ -0,0 +1,1391 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/repository.RefreshTokenRepository -o refresh_token_repository_minimock.go -n RefreshTokenRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// RefreshTokenRepositoryMock implements repository.RefreshTokenRepository
+type RefreshTokenRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateRefreshToken          func(ctx context.Context, params model.CreateRefreshTokenParams) (err error)
+	inspectFuncCreateRefreshToken   func(ctx context.Context, params model.CreateRefreshTokenParams)
+	afterCreateRefreshTokenCounter  uint64
+	beforeCreateRefreshTokenCounter uint64
+	CreateRefreshTokenMock          mRefreshTokenRepositoryMockCreateRefreshToken
+
+	funcGetRefreshToken          func(ctx context.Context, params model.GetRefreshTokenParams) (resp model.RefreshToken, err error)
+	inspectFuncGetRefreshToken   func(ctx context.Context, params model.GetRefreshTokenParams)
+	afterGetRefreshTokenCounter  uint64
+	beforeGetRefreshTokenCounter uint64
+	GetRefreshTokenMock          mRefreshTokenRepositoryMockGetRefreshToken
+
+	funcMarkRefreshTokenUsed          func(ctx context.Context, params model.MarkRefreshTokenUsedParams) (err error)
+	inspectFuncMarkRefreshTokenUsed   func(ctx context.Context, params model.MarkRefreshTokenUsedParams)
+	afterMarkRefreshTokenUsedCounter  uint64
+	beforeMarkRefreshTokenUsedCounter uint64
+	MarkRefreshTokenUsedMock          mRefreshTokenRepositoryMockMarkRefreshTokenUsed
+
+	funcRevokeRefreshTokenFamily          func(ctx context.Context, params model.RevokeRefreshTokenFamilyParams) (err error)
+	inspectFuncRevokeRefreshTokenFamily   func(ctx context.Context, params model.RevokeRefreshTokenFamilyParams)
+	afterRevokeRefreshTokenFamilyCounter  uint64
+	beforeRevokeRefreshTokenFamilyCounter uint64
+	RevokeRefreshTokenFamilyMock          mRefreshTokenRepositoryMockRevokeRefreshTokenFamily
+}
+
+// NewRefreshTokenRepositoryMock returns a mock for repository.RefreshTokenRepository
+func NewRefreshTokenRepositoryMock(t minimock.Tester) *RefreshTokenRepositoryMock {
+	m := &RefreshTokenRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateRefreshTokenMock = mRefreshTokenRepositoryMockCreateRefreshToken{mock: m}
+	m.CreateRefreshTokenMock.callArgs = []*RefreshTokenRepositoryMockCreateRefreshTokenParams{}
+
+	m.GetRefreshTokenMock = mRefreshTokenRepositoryMockGetRefreshToken{mock: m}
+	m.GetRefreshTokenMock.callArgs = []*RefreshTokenRepositoryMockGetRefreshTokenParams{}
+
+	m.MarkRefreshTokenUsedMock = mRefreshTokenRepositoryMockMarkRefreshTokenUsed{mock: m}
+	m.MarkRefreshTokenUsedMock.callArgs = []*RefreshTokenRepositoryMockMarkRefreshTokenUsedParams{}
+
+	m.RevokeRefreshTokenFamilyMock = mRefreshTokenRepositoryMockRevokeRefreshTokenFamily{mock: m}
+	m.RevokeRefreshTokenFamilyMock.callArgs = []*RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mRefreshTokenRepositoryMockCreateRefreshToken struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockCreateRefreshTokenExpectation
+	expectations       []*RefreshTokenRepositoryMockCreateRefreshTokenExpectation
+
+	callArgs []*RefreshTokenRepositoryMockCreateRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockCreateRefreshTokenExpectation specifies expectation struct of the RefreshTokenRepository.CreateRefreshToken
+type RefreshTokenRepositoryMockCreateRefreshTokenExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockCreateRefreshTokenParams
+	paramPtrs *RefreshTokenRepositoryMockCreateRefreshTokenParamPtrs
+	results   *RefreshTokenRepositoryMockCreateRefreshTokenResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockCreateRefreshTokenParams contains parameters of the RefreshTokenRepository.CreateRefreshToken
+type RefreshTokenRepositoryMockCreateRefreshTokenParams struct {
+	ctx    context.Context
+	params model.CreateRefreshTokenParams
+}
+
+// RefreshTokenRepositoryMockCreateRefreshTokenParamPtrs contains pointers to parameters of the RefreshTokenRepository.CreateRefreshToken
+type RefreshTokenRepositoryMockCreateRefreshTokenParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateRefreshTokenParams
+}
+
+// RefreshTokenRepositoryMockCreateRefreshTokenResults contains results of the RefreshTokenRepository.CreateRefreshToken
+type RefreshTokenRepositoryMockCreateRefreshTokenResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Optional() *mRefreshTokenRepositoryMockCreateRefreshToken {
+	mmCreateRefreshToken.optional = true
+	return mmCreateRefreshToken
+}
+
+// Expect sets up expected params for RefreshTokenRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Expect(ctx context.Context, params model.CreateRefreshTokenParams) *mRefreshTokenRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRefreshToken.defaultExpectation.params = &RefreshTokenRepositoryMockCreateRefreshTokenParams{ctx, params}
+	for _, e := range mmCreateRefreshToken.expectations {
+		if minimock.Equal(e.params, mmCreateRefreshToken.defaultExpectation.params) {
+			mmCreateRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.params != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Expect")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmCreateRefreshToken.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockCreateRefreshTokenParamPtrs{}
+	}
+	mmCreateRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmCreateRefreshToken
+}
+
+// ExpectParamsParam2 sets up expected param params for RefreshTokenRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) ExpectParamsParam2(params model.CreateRefreshTokenParams) *mRefreshTokenRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockCreateRefreshTokenExpectation{}
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.params != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Expect")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmCreateRefreshToken.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockCreateRefreshTokenParamPtrs{}
+	}
+	mmCreateRefreshToken.defaultExpectation.paramPtrs.params = &params
+
+	return mmCreateRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Inspect(f func(ctx context.Context, params model.CreateRefreshTokenParams)) *mRefreshTokenRepositoryMockCreateRefreshToken {
+	if mmCreateRefreshToken.mock.inspectFuncCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.CreateRefreshToken")
+	}
+
+	mmCreateRefreshToken.mock.inspectFuncCreateRefreshToken = f
+
+	return mmCreateRefreshToken
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.CreateRefreshToken
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Return(err error) *RefreshTokenRepositoryMock {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	if mmCreateRefreshToken.defaultExpectation == nil {
+		mmCreateRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockCreateRefreshTokenExpectation{mock: mmCreateRefreshToken.mock}
+	}
+	mmCreateRefreshToken.defaultExpectation.results = &RefreshTokenRepositoryMockCreateRefreshTokenResults{err}
+	return mmCreateRefreshToken.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.CreateRefreshToken method
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Set(f func(ctx context.Context, params model.CreateRefreshTokenParams) (err error)) *RefreshTokenRepositoryMock {
+	if mmCreateRefreshToken.defaultExpectation != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.CreateRefreshToken method")
+	}
+
+	if len(mmCreateRefreshToken.expectations) > 0 {
+		mmCreateRefreshToken.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.CreateRefreshToken method")
+	}
+
+	mmCreateRefreshToken.mock.funcCreateRefreshToken = f
+	return mmCreateRefreshToken.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.CreateRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) When(ctx context.Context, params model.CreateRefreshTokenParams) *RefreshTokenRepositoryMockCreateRefreshTokenExpectation {
+	if mmCreateRefreshToken.mock.funcCreateRefreshToken != nil {
+		mmCreateRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.CreateRefreshToken mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockCreateRefreshTokenExpectation{
+		mock:   mmCreateRefreshToken.mock,
+		params: &RefreshTokenRepositoryMockCreateRefreshTokenParams{ctx, params},
+	}
+	mmCreateRefreshToken.expectations = append(mmCreateRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.CreateRefreshToken return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockCreateRefreshTokenExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockCreateRefreshTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.CreateRefreshToken should be invoked
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Times(n uint64) *mRefreshTokenRepositoryMockCreateRefreshToken {
+	if n == 0 {
+		mmCreateRefreshToken.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.CreateRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRefreshToken.expectedInvocations, n)
+	return mmCreateRefreshToken
+}
+
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) invocationsDone() bool {
+	if len(mmCreateRefreshToken.expectations) == 0 && mmCreateRefreshToken.defaultExpectation == nil && mmCreateRefreshToken.mock.funcCreateRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRefreshToken.mock.afterCreateRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRefreshToken implements repository.RefreshTokenRepository
+func (mmCreateRefreshToken *RefreshTokenRepositoryMock) CreateRefreshToken(ctx context.Context, params model.CreateRefreshTokenParams) (err error) {
+	mm_atomic.AddUint64(&mmCreateRefreshToken.beforeCreateRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRefreshToken.afterCreateRefreshTokenCounter, 1)
+
+	if mmCreateRefreshToken.inspectFuncCreateRefreshToken != nil {
+		mmCreateRefreshToken.inspectFuncCreateRefreshToken(ctx, params)
+	}
+
+	mm_params := RefreshTokenRepositoryMockCreateRefreshTokenParams{ctx, params}
+
+	// Record call args
+	mmCreateRefreshToken.CreateRefreshTokenMock.mutex.Lock()
+	mmCreateRefreshToken.CreateRefreshTokenMock.callArgs = append(mmCreateRefreshToken.CreateRefreshTokenMock.callArgs, &mm_params)
+	mmCreateRefreshToken.CreateRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmCreateRefreshToken.CreateRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockCreateRefreshTokenParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRefreshToken.t.Errorf("RefreshTokenRepositoryMock.CreateRefreshToken got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmCreateRefreshToken.t.Errorf("RefreshTokenRepositoryMock.CreateRefreshToken got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRefreshToken.t.Errorf("RefreshTokenRepositoryMock.CreateRefreshToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRefreshToken.CreateRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRefreshToken.t.Fatal("No results are set for the RefreshTokenRepositoryMock.CreateRefreshToken")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateRefreshToken.funcCreateRefreshToken != nil {
+		return mmCreateRefreshToken.funcCreateRefreshToken(ctx, params)
+	}
+	mmCreateRefreshToken.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.CreateRefreshToken. %v %v", ctx, params)
+	return
+}
+
+// CreateRefreshTokenAfterCounter returns a count of finished RefreshTokenRepositoryMock.CreateRefreshToken invocations
+func (mmCreateRefreshToken *RefreshTokenRepositoryMock) CreateRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefreshToken.afterCreateRefreshTokenCounter)
+}
+
+// CreateRefreshTokenBeforeCounter returns a count of RefreshTokenRepositoryMock.CreateRefreshToken invocations
+func (mmCreateRefreshToken *RefreshTokenRepositoryMock) CreateRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRefreshToken.beforeCreateRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.CreateRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRefreshToken *mRefreshTokenRepositoryMockCreateRefreshToken) Calls() []*RefreshTokenRepositoryMockCreateRefreshTokenParams {
+	mmCreateRefreshToken.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockCreateRefreshTokenParams, len(mmCreateRefreshToken.callArgs))
+	copy(argCopy, mmCreateRefreshToken.callArgs)
+
+	mmCreateRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRefreshTokenDone returns true if the count of the CreateRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockCreateRefreshTokenDone() bool {
+	if m.CreateRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRefreshTokenMock.invocationsDone()
+}
+
+// MinimockCreateRefreshTokenInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockCreateRefreshTokenInspect() {
+	for _, e := range m.CreateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.CreateRefreshToken with params: %#v", *e.params)
+		}
+	}
+
+	afterCreateRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterCreateRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRefreshTokenMock.defaultExpectation != nil && afterCreateRefreshTokenCounter < 1 {
+		if m.CreateRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.CreateRefreshToken")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.CreateRefreshToken with params: %#v", *m.CreateRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRefreshToken != nil && afterCreateRefreshTokenCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.CreateRefreshToken")
+	}
+
+	if !m.CreateRefreshTokenMock.invocationsDone() && afterCreateRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.CreateRefreshToken but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRefreshTokenMock.expectedInvocations), afterCreateRefreshTokenCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockGetRefreshToken struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockGetRefreshTokenExpectation
+	expectations       []*RefreshTokenRepositoryMockGetRefreshTokenExpectation
+
+	callArgs []*RefreshTokenRepositoryMockGetRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockGetRefreshTokenExpectation specifies expectation struct of the RefreshTokenRepository.GetRefreshToken
+type RefreshTokenRepositoryMockGetRefreshTokenExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockGetRefreshTokenParams
+	paramPtrs *RefreshTokenRepositoryMockGetRefreshTokenParamPtrs
+	results   *RefreshTokenRepositoryMockGetRefreshTokenResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockGetRefreshTokenParams contains parameters of the RefreshTokenRepository.GetRefreshToken
+type RefreshTokenRepositoryMockGetRefreshTokenParams struct {
+	ctx    context.Context
+	params model.GetRefreshTokenParams
+}
+
+// RefreshTokenRepositoryMockGetRefreshTokenParamPtrs contains pointers to parameters of the RefreshTokenRepository.GetRefreshToken
+type RefreshTokenRepositoryMockGetRefreshTokenParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetRefreshTokenParams
+}
+
+// RefreshTokenRepositoryMockGetRefreshTokenResults contains results of the RefreshTokenRepository.GetRefreshToken
+type RefreshTokenRepositoryMockGetRefreshTokenResults struct {
+	resp model.RefreshToken
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Optional() *mRefreshTokenRepositoryMockGetRefreshToken {
+	mmGetRefreshToken.optional = true
+	return mmGetRefreshToken
+}
+
+// Expect sets up expected params for RefreshTokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Expect(ctx context.Context, params model.GetRefreshTokenParams) *mRefreshTokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetRefreshToken.defaultExpectation.params = &RefreshTokenRepositoryMockGetRefreshTokenParams{ctx, params}
+	for _, e := range mmGetRefreshToken.expectations {
+		if minimock.Equal(e.params, mmGetRefreshToken.defaultExpectation.params) {
+			mmGetRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetRefreshToken
+}
+
+// ExpectParamsParam2 sets up expected param params for RefreshTokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) ExpectParamsParam2(params model.GetRefreshTokenParams) *mRefreshTokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Inspect(f func(ctx context.Context, params model.GetRefreshTokenParams)) *mRefreshTokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.GetRefreshToken")
+	}
+
+	mmGetRefreshToken.mock.inspectFuncGetRefreshToken = f
+
+	return mmGetRefreshToken
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Return(resp model.RefreshToken, err error) *RefreshTokenRepositoryMock {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &RefreshTokenRepositoryMockGetRefreshTokenExpectation{mock: mmGetRefreshToken.mock}
+	}
+	mmGetRefreshToken.defaultExpectation.results = &RefreshTokenRepositoryMockGetRefreshTokenResults{resp, err}
+	return mmGetRefreshToken.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.GetRefreshToken method
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Set(f func(ctx context.Context, params model.GetRefreshTokenParams) (resp model.RefreshToken, err error)) *RefreshTokenRepositoryMock {
+	if mmGetRefreshToken.defaultExpectation != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.GetRefreshToken method")
+	}
+
+	if len(mmGetRefreshToken.expectations) > 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.GetRefreshToken method")
+	}
+
+	mmGetRefreshToken.mock.funcGetRefreshToken = f
+	return mmGetRefreshToken.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.GetRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) When(ctx context.Context, params model.GetRefreshTokenParams) *RefreshTokenRepositoryMockGetRefreshTokenExpectation {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("RefreshTokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockGetRefreshTokenExpectation{
+		mock:   mmGetRefreshToken.mock,
+		params: &RefreshTokenRepositoryMockGetRefreshTokenParams{ctx, params},
+	}
+	mmGetRefreshToken.expectations = append(mmGetRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.GetRefreshToken return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockGetRefreshTokenExpectation) Then(resp model.RefreshToken, err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockGetRefreshTokenResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.GetRefreshToken should be invoked
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Times(n uint64) *mRefreshTokenRepositoryMockGetRefreshToken {
+	if n == 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.GetRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRefreshToken.expectedInvocations, n)
+	return mmGetRefreshToken
+}
+
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) invocationsDone() bool {
+	if len(mmGetRefreshToken.expectations) == 0 && mmGetRefreshToken.defaultExpectation == nil && mmGetRefreshToken.mock.funcGetRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.mock.afterGetRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRefreshToken implements repository.RefreshTokenRepository
+func (mmGetRefreshToken *RefreshTokenRepositoryMock) GetRefreshToken(ctx context.Context, params model.GetRefreshTokenParams) (resp model.RefreshToken, err error) {
+	mm_atomic.AddUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter, 1)
+
+	if mmGetRefreshToken.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.inspectFuncGetRefreshToken(ctx, params)
+	}
+
+	mm_params := RefreshTokenRepositoryMockGetRefreshTokenParams{ctx, params}
+
+	// Record call args
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Lock()
+	mmGetRefreshToken.GetRefreshTokenMock.callArgs = append(mmGetRefreshToken.GetRefreshTokenMock.callArgs, &mm_params)
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetRefreshToken.GetRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockGetRefreshTokenParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefreshToken.t.Errorf("RefreshTokenRepositoryMock.GetRefreshToken got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetRefreshToken.t.Errorf("RefreshTokenRepositoryMock.GetRefreshToken got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRefreshToken.t.Errorf("RefreshTokenRepositoryMock.GetRefreshToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRefreshToken.t.Fatal("No results are set for the RefreshTokenRepositoryMock.GetRefreshToken")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmGetRefreshToken.funcGetRefreshToken != nil {
+		return mmGetRefreshToken.funcGetRefreshToken(ctx, params)
+	}
+	mmGetRefreshToken.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.GetRefreshToken. %v %v", ctx, params)
+	return
+}
+
+// GetRefreshTokenAfterCounter returns a count of finished RefreshTokenRepositoryMock.GetRefreshToken invocations
+func (mmGetRefreshToken *RefreshTokenRepositoryMock) GetRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter)
+}
+
+// GetRefreshTokenBeforeCounter returns a count of RefreshTokenRepositoryMock.GetRefreshToken invocations
+func (mmGetRefreshToken *RefreshTokenRepositoryMock) GetRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.GetRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRefreshToken *mRefreshTokenRepositoryMockGetRefreshToken) Calls() []*RefreshTokenRepositoryMockGetRefreshTokenParams {
+	mmGetRefreshToken.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockGetRefreshTokenParams, len(mmGetRefreshToken.callArgs))
+	copy(argCopy, mmGetRefreshToken.callArgs)
+
+	mmGetRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRefreshTokenDone returns true if the count of the GetRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockGetRefreshTokenDone() bool {
+	if m.GetRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRefreshTokenMock.invocationsDone()
+}
+
+// MinimockGetRefreshTokenInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockGetRefreshTokenInspect() {
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.GetRefreshToken with params: %#v", *e.params)
+		}
+	}
+
+	afterGetRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGetRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRefreshTokenMock.defaultExpectation != nil && afterGetRefreshTokenCounter < 1 {
+		if m.GetRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.GetRefreshToken")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.GetRefreshToken with params: %#v", *m.GetRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRefreshToken != nil && afterGetRefreshTokenCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.GetRefreshToken")
+	}
+
+	if !m.GetRefreshTokenMock.invocationsDone() && afterGetRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.GetRefreshToken but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRefreshTokenMock.expectedInvocations), afterGetRefreshTokenCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockMarkRefreshTokenUsed struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation
+	expectations       []*RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation
+
+	callArgs []*RefreshTokenRepositoryMockMarkRefreshTokenUsedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation specifies expectation struct of the RefreshTokenRepository.MarkRefreshTokenUsed
+type RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockMarkRefreshTokenUsedParams
+	paramPtrs *RefreshTokenRepositoryMockMarkRefreshTokenUsedParamPtrs
+	results   *RefreshTokenRepositoryMockMarkRefreshTokenUsedResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockMarkRefreshTokenUsedParams contains parameters of the RefreshTokenRepository.MarkRefreshTokenUsed
+type RefreshTokenRepositoryMockMarkRefreshTokenUsedParams struct {
+	ctx    context.Context
+	params model.MarkRefreshTokenUsedParams
+}
+
+// RefreshTokenRepositoryMockMarkRefreshTokenUsedParamPtrs contains pointers to parameters of the RefreshTokenRepository.MarkRefreshTokenUsed
+type RefreshTokenRepositoryMockMarkRefreshTokenUsedParamPtrs struct {
+	ctx    *context.Context
+	params *model.MarkRefreshTokenUsedParams
+}
+
+// RefreshTokenRepositoryMockMarkRefreshTokenUsedResults contains results of the RefreshTokenRepository.MarkRefreshTokenUsed
+type RefreshTokenRepositoryMockMarkRefreshTokenUsedResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Optional() *mRefreshTokenRepositoryMockMarkRefreshTokenUsed {
+	mmMarkRefreshTokenUsed.optional = true
+	return mmMarkRefreshTokenUsed
+}
+
+// Expect sets up expected params for RefreshTokenRepository.MarkRefreshTokenUsed
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Expect(ctx context.Context, params model.MarkRefreshTokenUsedParams) *mRefreshTokenRepositoryMockMarkRefreshTokenUsed {
+	if mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation == nil {
+		mmMarkRefreshTokenUsed.defaultExpectation = &RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation{}
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRefreshTokenUsed.defaultExpectation.params = &RefreshTokenRepositoryMockMarkRefreshTokenUsedParams{ctx, params}
+	for _, e := range mmMarkRefreshTokenUsed.expectations {
+		if minimock.Equal(e.params, mmMarkRefreshTokenUsed.defaultExpectation.params) {
+			mmMarkRefreshTokenUsed.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRefreshTokenUsed.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRefreshTokenUsed
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.MarkRefreshTokenUsed
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockMarkRefreshTokenUsed {
+	if mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation == nil {
+		mmMarkRefreshTokenUsed.defaultExpectation = &RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation{}
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation.params != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockMarkRefreshTokenUsedParamPtrs{}
+	}
+	mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmMarkRefreshTokenUsed
+}
+
+// ExpectParamsParam2 sets up expected param params for RefreshTokenRepository.MarkRefreshTokenUsed
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) ExpectParamsParam2(params model.MarkRefreshTokenUsedParams) *mRefreshTokenRepositoryMockMarkRefreshTokenUsed {
+	if mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation == nil {
+		mmMarkRefreshTokenUsed.defaultExpectation = &RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation{}
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation.params != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockMarkRefreshTokenUsedParamPtrs{}
+	}
+	mmMarkRefreshTokenUsed.defaultExpectation.paramPtrs.params = &params
+
+	return mmMarkRefreshTokenUsed
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.MarkRefreshTokenUsed
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Inspect(f func(ctx context.Context, params model.MarkRefreshTokenUsedParams)) *mRefreshTokenRepositoryMockMarkRefreshTokenUsed {
+	if mmMarkRefreshTokenUsed.mock.inspectFuncMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.MarkRefreshTokenUsed")
+	}
+
+	mmMarkRefreshTokenUsed.mock.inspectFuncMarkRefreshTokenUsed = f
+
+	return mmMarkRefreshTokenUsed
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.MarkRefreshTokenUsed
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Return(err error) *RefreshTokenRepositoryMock {
+	if mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenUsed.defaultExpectation == nil {
+		mmMarkRefreshTokenUsed.defaultExpectation = &RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation{mock: mmMarkRefreshTokenUsed.mock}
+	}
+	mmMarkRefreshTokenUsed.defaultExpectation.results = &RefreshTokenRepositoryMockMarkRefreshTokenUsedResults{err}
+	return mmMarkRefreshTokenUsed.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.MarkRefreshTokenUsed method
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Set(f func(ctx context.Context, params model.MarkRefreshTokenUsedParams) (err error)) *RefreshTokenRepositoryMock {
+	if mmMarkRefreshTokenUsed.defaultExpectation != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.MarkRefreshTokenUsed method")
+	}
+
+	if len(mmMarkRefreshTokenUsed.expectations) > 0 {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.MarkRefreshTokenUsed method")
+	}
+
+	mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed = f
+	return mmMarkRefreshTokenUsed.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.MarkRefreshTokenUsed which will trigger the result defined by the following
+// Then helper
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) When(ctx context.Context, params model.MarkRefreshTokenUsedParams) *RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation {
+	if mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation{
+		mock:   mmMarkRefreshTokenUsed.mock,
+		params: &RefreshTokenRepositoryMockMarkRefreshTokenUsedParams{ctx, params},
+	}
+	mmMarkRefreshTokenUsed.expectations = append(mmMarkRefreshTokenUsed.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.MarkRefreshTokenUsed return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockMarkRefreshTokenUsedExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockMarkRefreshTokenUsedResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.MarkRefreshTokenUsed should be invoked
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Times(n uint64) *mRefreshTokenRepositoryMockMarkRefreshTokenUsed {
+	if n == 0 {
+		mmMarkRefreshTokenUsed.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.MarkRefreshTokenUsed mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRefreshTokenUsed.expectedInvocations, n)
+	return mmMarkRefreshTokenUsed
+}
+
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) invocationsDone() bool {
+	if len(mmMarkRefreshTokenUsed.expectations) == 0 && mmMarkRefreshTokenUsed.defaultExpectation == nil && mmMarkRefreshTokenUsed.mock.funcMarkRefreshTokenUsed == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRefreshTokenUsed.mock.afterMarkRefreshTokenUsedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRefreshTokenUsed.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRefreshTokenUsed implements repository.RefreshTokenRepository
+func (mmMarkRefreshTokenUsed *RefreshTokenRepositoryMock) MarkRefreshTokenUsed(ctx context.Context, params model.MarkRefreshTokenUsedParams) (err error) {
+	mm_atomic.AddUint64(&mmMarkRefreshTokenUsed.beforeMarkRefreshTokenUsedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRefreshTokenUsed.afterMarkRefreshTokenUsedCounter, 1)
+
+	if mmMarkRefreshTokenUsed.inspectFuncMarkRefreshTokenUsed != nil {
+		mmMarkRefreshTokenUsed.inspectFuncMarkRefreshTokenUsed(ctx, params)
+	}
+
+	mm_params := RefreshTokenRepositoryMockMarkRefreshTokenUsedParams{ctx, params}
+
+	// Record call args
+	mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.mutex.Lock()
+	mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.callArgs = append(mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.callArgs, &mm_params)
+	mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.mutex.Unlock()
+
+	for _, e := range mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockMarkRefreshTokenUsedParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRefreshTokenUsed.t.Errorf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmMarkRefreshTokenUsed.t.Errorf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRefreshTokenUsed.t.Errorf("RefreshTokenRepositoryMock.MarkRefreshTokenUsed got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRefreshTokenUsed.MarkRefreshTokenUsedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRefreshTokenUsed.t.Fatal("No results are set for the RefreshTokenRepositoryMock.MarkRefreshTokenUsed")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRefreshTokenUsed.funcMarkRefreshTokenUsed != nil {
+		return mmMarkRefreshTokenUsed.funcMarkRefreshTokenUsed(ctx, params)
+	}
+	mmMarkRefreshTokenUsed.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.MarkRefreshTokenUsed. %v %v", ctx, params)
+	return
+}
+
+// MarkRefreshTokenUsedAfterCounter returns a count of finished RefreshTokenRepositoryMock.MarkRefreshTokenUsed invocations
+func (mmMarkRefreshTokenUsed *RefreshTokenRepositoryMock) MarkRefreshTokenUsedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefreshTokenUsed.afterMarkRefreshTokenUsedCounter)
+}
+
+// MarkRefreshTokenUsedBeforeCounter returns a count of RefreshTokenRepositoryMock.MarkRefreshTokenUsed invocations
+func (mmMarkRefreshTokenUsed *RefreshTokenRepositoryMock) MarkRefreshTokenUsedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefreshTokenUsed.beforeMarkRefreshTokenUsedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.MarkRefreshTokenUsed.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRefreshTokenUsed *mRefreshTokenRepositoryMockMarkRefreshTokenUsed) Calls() []*RefreshTokenRepositoryMockMarkRefreshTokenUsedParams {
+	mmMarkRefreshTokenUsed.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockMarkRefreshTokenUsedParams, len(mmMarkRefreshTokenUsed.callArgs))
+	copy(argCopy, mmMarkRefreshTokenUsed.callArgs)
+
+	mmMarkRefreshTokenUsed.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkRefreshTokenUsedDone returns true if the count of the MarkRefreshTokenUsed invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockMarkRefreshTokenUsedDone() bool {
+	if m.MarkRefreshTokenUsedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkRefreshTokenUsedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkRefreshTokenUsedMock.invocationsDone()
+}
+
+// MinimockMarkRefreshTokenUsedInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockMarkRefreshTokenUsedInspect() {
+	for _, e := range m.MarkRefreshTokenUsedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.MarkRefreshTokenUsed with params: %#v", *e.params)
+		}
+	}
+
+	afterMarkRefreshTokenUsedCounter := mm_atomic.LoadUint64(&m.afterMarkRefreshTokenUsedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRefreshTokenUsedMock.defaultExpectation != nil && afterMarkRefreshTokenUsedCounter < 1 {
+		if m.MarkRefreshTokenUsedMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.MarkRefreshTokenUsed")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.MarkRefreshTokenUsed with params: %#v", *m.MarkRefreshTokenUsedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRefreshTokenUsed != nil && afterMarkRefreshTokenUsedCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.MarkRefreshTokenUsed")
+	}
+
+	if !m.MarkRefreshTokenUsedMock.invocationsDone() && afterMarkRefreshTokenUsedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.MarkRefreshTokenUsed but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkRefreshTokenUsedMock.expectedInvocations), afterMarkRefreshTokenUsedCounter)
+	}
+}
+
+type mRefreshTokenRepositoryMockRevokeRefreshTokenFamily struct {
+	optional           bool
+	mock               *RefreshTokenRepositoryMock
+	defaultExpectation *RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation
+	expectations       []*RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation
+
+	callArgs []*RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation specifies expectation struct of the RefreshTokenRepository.RevokeRefreshTokenFamily
+type RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation struct {
+	mock      *RefreshTokenRepositoryMock
+	params    *RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams
+	paramPtrs *RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParamPtrs
+	results   *RefreshTokenRepositoryMockRevokeRefreshTokenFamilyResults
+	Counter   uint64
+}
+
+// RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams contains parameters of the RefreshTokenRepository.RevokeRefreshTokenFamily
+type RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams struct {
+	ctx    context.Context
+	params model.RevokeRefreshTokenFamilyParams
+}
+
+// RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParamPtrs contains pointers to parameters of the RefreshTokenRepository.RevokeRefreshTokenFamily
+type RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParamPtrs struct {
+	ctx    *context.Context
+	params *model.RevokeRefreshTokenFamilyParams
+}
+
+// RefreshTokenRepositoryMockRevokeRefreshTokenFamilyResults contains results of the RefreshTokenRepository.RevokeRefreshTokenFamily
+type RefreshTokenRepositoryMockRevokeRefreshTokenFamilyResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Optional() *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily {
+	mmRevokeRefreshTokenFamily.optional = true
+	return mmRevokeRefreshTokenFamily
+}
+
+// Expect sets up expected params for RefreshTokenRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Expect(ctx context.Context, params model.RevokeRefreshTokenFamilyParams) *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeRefreshTokenFamily.defaultExpectation.params = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams{ctx, params}
+	for _, e := range mmRevokeRefreshTokenFamily.expectations {
+		if minimock.Equal(e.params, mmRevokeRefreshTokenFamily.defaultExpectation.params) {
+			mmRevokeRefreshTokenFamily.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeRefreshTokenFamily.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RefreshTokenRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) ExpectCtxParam1(ctx context.Context) *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.params != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Expect")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParamPtrs{}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// ExpectParamsParam2 sets up expected param params for RefreshTokenRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) ExpectParamsParam2(params model.RevokeRefreshTokenFamilyParams) *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation{}
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.params != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Expect")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParamPtrs{}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.paramPtrs.params = &params
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefreshTokenRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Inspect(f func(ctx context.Context, params model.RevokeRefreshTokenFamilyParams)) *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily {
+	if mmRevokeRefreshTokenFamily.mock.inspectFuncRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Inspect function is already set for RefreshTokenRepositoryMock.RevokeRefreshTokenFamily")
+	}
+
+	mmRevokeRefreshTokenFamily.mock.inspectFuncRevokeRefreshTokenFamily = f
+
+	return mmRevokeRefreshTokenFamily
+}
+
+// Return sets up results that will be returned by RefreshTokenRepository.RevokeRefreshTokenFamily
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Return(err error) *RefreshTokenRepositoryMock {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	if mmRevokeRefreshTokenFamily.defaultExpectation == nil {
+		mmRevokeRefreshTokenFamily.defaultExpectation = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation{mock: mmRevokeRefreshTokenFamily.mock}
+	}
+	mmRevokeRefreshTokenFamily.defaultExpectation.results = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyResults{err}
+	return mmRevokeRefreshTokenFamily.mock
+}
+
+// Set uses given function f to mock the RefreshTokenRepository.RevokeRefreshTokenFamily method
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Set(f func(ctx context.Context, params model.RevokeRefreshTokenFamilyParams) (err error)) *RefreshTokenRepositoryMock {
+	if mmRevokeRefreshTokenFamily.defaultExpectation != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Default expectation is already set for the RefreshTokenRepository.RevokeRefreshTokenFamily method")
+	}
+
+	if len(mmRevokeRefreshTokenFamily.expectations) > 0 {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Some expectations are already set for the RefreshTokenRepository.RevokeRefreshTokenFamily method")
+	}
+
+	mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily = f
+	return mmRevokeRefreshTokenFamily.mock
+}
+
+// When sets expectation for the RefreshTokenRepository.RevokeRefreshTokenFamily which will trigger the result defined by the following
+// Then helper
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) When(ctx context.Context, params model.RevokeRefreshTokenFamilyParams) *RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation {
+	if mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock is already set by Set")
+	}
+
+	expectation := &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation{
+		mock:   mmRevokeRefreshTokenFamily.mock,
+		params: &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams{ctx, params},
+	}
+	mmRevokeRefreshTokenFamily.expectations = append(mmRevokeRefreshTokenFamily.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefreshTokenRepository.RevokeRefreshTokenFamily return parameters for the expectation previously defined by the When method
+func (e *RefreshTokenRepositoryMockRevokeRefreshTokenFamilyExpectation) Then(err error) *RefreshTokenRepositoryMock {
+	e.results = &RefreshTokenRepositoryMockRevokeRefreshTokenFamilyResults{err}
+	return e.mock
+}
+
+// Times sets number of times RefreshTokenRepository.RevokeRefreshTokenFamily should be invoked
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Times(n uint64) *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily {
+	if n == 0 {
+		mmRevokeRefreshTokenFamily.mock.t.Fatalf("Times of RefreshTokenRepositoryMock.RevokeRefreshTokenFamily mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeRefreshTokenFamily.expectedInvocations, n)
+	return mmRevokeRefreshTokenFamily
+}
+
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) invocationsDone() bool {
+	if len(mmRevokeRefreshTokenFamily.expectations) == 0 && mmRevokeRefreshTokenFamily.defaultExpectation == nil && mmRevokeRefreshTokenFamily.mock.funcRevokeRefreshTokenFamily == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.mock.afterRevokeRefreshTokenFamilyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeRefreshTokenFamily implements repository.RefreshTokenRepository
+func (mmRevokeRefreshTokenFamily *RefreshTokenRepositoryMock) RevokeRefreshTokenFamily(ctx context.Context, params model.RevokeRefreshTokenFamilyParams) (err error) {
+	mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.beforeRevokeRefreshTokenFamilyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.afterRevokeRefreshTokenFamilyCounter, 1)
+
+	if mmRevokeRefreshTokenFamily.inspectFuncRevokeRefreshTokenFamily != nil {
+		mmRevokeRefreshTokenFamily.inspectFuncRevokeRefreshTokenFamily(ctx, params)
+	}
+
+	mm_params := RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams{ctx, params}
+
+	// Record call args
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.mutex.Lock()
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.callArgs = append(mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.callArgs, &mm_params)
+	mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.paramPtrs
+
+		mm_got := RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeRefreshTokenFamily.t.Errorf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRevokeRefreshTokenFamily.t.Errorf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeRefreshTokenFamily.t.Errorf("RefreshTokenRepositoryMock.RevokeRefreshTokenFamily got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeRefreshTokenFamily.RevokeRefreshTokenFamilyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeRefreshTokenFamily.t.Fatal("No results are set for the RefreshTokenRepositoryMock.RevokeRefreshTokenFamily")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeRefreshTokenFamily.funcRevokeRefreshTokenFamily != nil {
+		return mmRevokeRefreshTokenFamily.funcRevokeRefreshTokenFamily(ctx, params)
+	}
+	mmRevokeRefreshTokenFamily.t.Fatalf("Unexpected call to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily. %v %v", ctx, params)
+	return
+}
+
+// RevokeRefreshTokenFamilyAfterCounter returns a count of finished RefreshTokenRepositoryMock.RevokeRefreshTokenFamily invocations
+func (mmRevokeRefreshTokenFamily *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.afterRevokeRefreshTokenFamilyCounter)
+}
+
+// RevokeRefreshTokenFamilyBeforeCounter returns a count of RefreshTokenRepositoryMock.RevokeRefreshTokenFamily invocations
+func (mmRevokeRefreshTokenFamily *RefreshTokenRepositoryMock) RevokeRefreshTokenFamilyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeRefreshTokenFamily.beforeRevokeRefreshTokenFamilyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeRefreshTokenFamily *mRefreshTokenRepositoryMockRevokeRefreshTokenFamily) Calls() []*RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams {
+	mmRevokeRefreshTokenFamily.mutex.RLock()
+
+	argCopy := make([]*RefreshTokenRepositoryMockRevokeRefreshTokenFamilyParams, len(mmRevokeRefreshTokenFamily.callArgs))
+	copy(argCopy, mmRevokeRefreshTokenFamily.callArgs)
+
+	mmRevokeRefreshTokenFamily.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeRefreshTokenFamilyDone returns true if the count of the RevokeRefreshTokenFamily invocations corresponds
+// the number of defined expectations
+func (m *RefreshTokenRepositoryMock) MinimockRevokeRefreshTokenFamilyDone() bool {
+	if m.RevokeRefreshTokenFamilyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeRefreshTokenFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeRefreshTokenFamilyMock.invocationsDone()
+}
+
+// MinimockRevokeRefreshTokenFamilyInspect logs each unmet expectation
+func (m *RefreshTokenRepositoryMock) MinimockRevokeRefreshTokenFamilyInspect() {
+	for _, e := range m.RevokeRefreshTokenFamilyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily with params: %#v", *e.params)
+		}
+	}
+
+	afterRevokeRefreshTokenFamilyCounter := mm_atomic.LoadUint64(&m.afterRevokeRefreshTokenFamilyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeRefreshTokenFamilyMock.defaultExpectation != nil && afterRevokeRefreshTokenFamilyCounter < 1 {
+		if m.RevokeRefreshTokenFamilyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily")
+		} else {
+			m.t.Errorf("Expected call to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily with params: %#v", *m.RevokeRefreshTokenFamilyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeRefreshTokenFamily != nil && afterRevokeRefreshTokenFamilyCounter < 1 {
+		m.t.Error("Expected call to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily")
+	}
+
+	if !m.RevokeRefreshTokenFamilyMock.invocationsDone() && afterRevokeRefreshTokenFamilyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefreshTokenRepositoryMock.RevokeRefreshTokenFamily but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeRefreshTokenFamilyMock.expectedInvocations), afterRevokeRefreshTokenFamilyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *RefreshTokenRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateRefreshTokenInspect()
+
+			m.MinimockGetRefreshTokenInspect()
+
+			m.MinimockMarkRefreshTokenUsedInspect()
+
+			m.MinimockRevokeRefreshTokenFamilyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *RefreshTokenRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *RefreshTokenRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateRefreshTokenDone() &&
+		m.MinimockGetRefreshTokenDone() &&
+		m.MinimockMarkRefreshTokenUsedDone() &&
+		m.MinimockRevokeRefreshTokenFamilyDone()
+}
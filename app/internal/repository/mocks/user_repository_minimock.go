@@ -37,11 +37,29 @@ type UserRepositoryMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserRepositoryMockGetUser
 
+	funcGetUserByEmail          func(ctx context.Context, params model.GetUserByEmailParams) (resp model.GetUserByEmailResponse, err error)
+	inspectFuncGetUserByEmail   func(ctx context.Context, params model.GetUserByEmailParams)
+	afterGetUserByEmailCounter  uint64
+	beforeGetUserByEmailCounter uint64
+	GetUserByEmailMock          mUserRepositoryMockGetUserByEmail
+
+	funcListUsers          func(ctx context.Context, params model.ListUsersPageParams) (resp model.ListUsersPageResponse, err error)
+	inspectFuncListUsers   func(ctx context.Context, params model.ListUsersPageParams)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserRepositoryMockListUsers
+
 	funcUpdateUser          func(ctx context.Context, params model.UpdateUserParams) (resp model.UpdateUserResponse, err error)
 	inspectFuncUpdateUser   func(ctx context.Context, params model.UpdateUserParams)
 	afterUpdateUserCounter  uint64
 	beforeUpdateUserCounter uint64
 	UpdateUserMock          mUserRepositoryMockUpdateUser
+
+	funcUpdateUserPassword          func(ctx context.Context, params model.UpdateUserPasswordParams) (err error)
+	inspectFuncUpdateUserPassword   func(ctx context.Context, params model.UpdateUserPasswordParams)
+	afterUpdateUserPasswordCounter  uint64
+	beforeUpdateUserPasswordCounter uint64
+	UpdateUserPasswordMock          mUserRepositoryMockUpdateUserPassword
 }
 
 // NewUserRepositoryMock returns a mock for repository.UserRepository
@@ -61,9 +79,18 @@ func NewUserRepositoryMock(t minimock.Tester) *UserRepositoryMock {
 	m.GetUserMock = mUserRepositoryMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserRepositoryMockGetUserParams{}
 
+	m.GetUserByEmailMock = mUserRepositoryMockGetUserByEmail{mock: m}
+	m.GetUserByEmailMock.callArgs = []*UserRepositoryMockGetUserByEmailParams{}
+
+	m.ListUsersMock = mUserRepositoryMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserRepositoryMockListUsersParams{}
+
 	m.UpdateUserMock = mUserRepositoryMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserRepositoryMockUpdateUserParams{}
 
+	m.UpdateUserPasswordMock = mUserRepositoryMockUpdateUserPassword{mock: m}
+	m.UpdateUserPasswordMock.callArgs = []*UserRepositoryMockUpdateUserPasswordParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -1031,6 +1058,648 @@ func (m *UserRepositoryMock) MinimockGetUserInspect() {
 	}
 }
 
+type mUserRepositoryMockGetUserByEmail struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockGetUserByEmailExpectation
+	expectations       []*UserRepositoryMockGetUserByEmailExpectation
+
+	callArgs []*UserRepositoryMockGetUserByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockGetUserByEmailExpectation specifies expectation struct of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockGetUserByEmailParams
+	paramPtrs *UserRepositoryMockGetUserByEmailParamPtrs
+	results   *UserRepositoryMockGetUserByEmailResults
+	Counter   uint64
+}
+
+// UserRepositoryMockGetUserByEmailParams contains parameters of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailParams struct {
+	ctx    context.Context
+	params model.GetUserByEmailParams
+}
+
+// UserRepositoryMockGetUserByEmailParamPtrs contains pointers to parameters of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailParamPtrs struct {
+	ctx    *context.Context
+	params *model.GetUserByEmailParams
+}
+
+// UserRepositoryMockGetUserByEmailResults contains results of the UserRepository.GetUserByEmail
+type UserRepositoryMockGetUserByEmailResults struct {
+	resp model.GetUserByEmailResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Optional() *mUserRepositoryMockGetUserByEmail {
+	mmGetUserByEmail.optional = true
+	return mmGetUserByEmail
+}
+
+// Expect sets up expected params for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Expect(ctx context.Context, params model.GetUserByEmailParams) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByEmail.defaultExpectation.params = &UserRepositoryMockGetUserByEmailParams{ctx, params}
+	for _, e := range mmGetUserByEmail.expectations {
+		if minimock.Equal(e.params, mmGetUserByEmail.defaultExpectation.params) {
+			mmGetUserByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmGetUserByEmail
+}
+
+// ExpectParamsParam2 sets up expected param params for UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) ExpectParamsParam2(params model.GetUserByEmailParams) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UserRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.params = &params
+
+	return mmGetUserByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Inspect(f func(ctx context.Context, params model.GetUserByEmailParams)) *mUserRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.GetUserByEmail")
+	}
+
+	mmGetUserByEmail.mock.inspectFuncGetUserByEmail = f
+
+	return mmGetUserByEmail
+}
+
+// Return sets up results that will be returned by UserRepository.GetUserByEmail
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Return(resp model.GetUserByEmailResponse, err error) *UserRepositoryMock {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UserRepositoryMockGetUserByEmailExpectation{mock: mmGetUserByEmail.mock}
+	}
+	mmGetUserByEmail.defaultExpectation.results = &UserRepositoryMockGetUserByEmailResults{resp, err}
+	return mmGetUserByEmail.mock
+}
+
+// Set uses given function f to mock the UserRepository.GetUserByEmail method
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Set(f func(ctx context.Context, params model.GetUserByEmailParams) (resp model.GetUserByEmailResponse, err error)) *UserRepositoryMock {
+	if mmGetUserByEmail.defaultExpectation != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Default expectation is already set for the UserRepository.GetUserByEmail method")
+	}
+
+	if len(mmGetUserByEmail.expectations) > 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Some expectations are already set for the UserRepository.GetUserByEmail method")
+	}
+
+	mmGetUserByEmail.mock.funcGetUserByEmail = f
+	return mmGetUserByEmail.mock
+}
+
+// When sets expectation for the UserRepository.GetUserByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) When(ctx context.Context, params model.GetUserByEmailParams) *UserRepositoryMockGetUserByEmailExpectation {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UserRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockGetUserByEmailExpectation{
+		mock:   mmGetUserByEmail.mock,
+		params: &UserRepositoryMockGetUserByEmailParams{ctx, params},
+	}
+	mmGetUserByEmail.expectations = append(mmGetUserByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.GetUserByEmail return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockGetUserByEmailExpectation) Then(resp model.GetUserByEmailResponse, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockGetUserByEmailResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.GetUserByEmail should be invoked
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Times(n uint64) *mUserRepositoryMockGetUserByEmail {
+	if n == 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Times of UserRepositoryMock.GetUserByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByEmail.expectedInvocations, n)
+	return mmGetUserByEmail
+}
+
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) invocationsDone() bool {
+	if len(mmGetUserByEmail.expectations) == 0 && mmGetUserByEmail.defaultExpectation == nil && mmGetUserByEmail.mock.funcGetUserByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.mock.afterGetUserByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByEmail implements repository.UserRepository
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmail(ctx context.Context, params model.GetUserByEmailParams) (resp model.GetUserByEmailResponse, err error) {
+	mm_atomic.AddUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByEmail.afterGetUserByEmailCounter, 1)
+
+	if mmGetUserByEmail.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.inspectFuncGetUserByEmail(ctx, params)
+	}
+
+	mm_params := UserRepositoryMockGetUserByEmailParams{ctx, params}
+
+	// Record call args
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Lock()
+	mmGetUserByEmail.GetUserByEmailMock.callArgs = append(mmGetUserByEmail.GetUserByEmailMock.callArgs, &mm_params)
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByEmail.GetUserByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmGetUserByEmail.GetUserByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockGetUserByEmailParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByEmail.t.Errorf("UserRepositoryMock.GetUserByEmail got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByEmail.t.Fatal("No results are set for the UserRepositoryMock.GetUserByEmail")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmGetUserByEmail.funcGetUserByEmail != nil {
+		return mmGetUserByEmail.funcGetUserByEmail(ctx, params)
+	}
+	mmGetUserByEmail.t.Fatalf("Unexpected call to UserRepositoryMock.GetUserByEmail. %v %v", ctx, params)
+	return
+}
+
+// GetUserByEmailAfterCounter returns a count of finished UserRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.afterGetUserByEmailCounter)
+}
+
+// GetUserByEmailBeforeCounter returns a count of UserRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UserRepositoryMock) GetUserByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.GetUserByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByEmail *mUserRepositoryMockGetUserByEmail) Calls() []*UserRepositoryMockGetUserByEmailParams {
+	mmGetUserByEmail.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockGetUserByEmailParams, len(mmGetUserByEmail.callArgs))
+	copy(argCopy, mmGetUserByEmail.callArgs)
+
+	mmGetUserByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByEmailDone returns true if the count of the GetUserByEmail invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockGetUserByEmailDone() bool {
+	if m.GetUserByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByEmailMock.invocationsDone()
+}
+
+// MinimockGetUserByEmailInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockGetUserByEmailInspect() {
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail with params: %#v", *e.params)
+		}
+	}
+
+	afterGetUserByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByEmailMock.defaultExpectation != nil && afterGetUserByEmailCounter < 1 {
+		if m.GetUserByEmailMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.GetUserByEmail")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.GetUserByEmail with params: %#v", *m.GetUserByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByEmail != nil && afterGetUserByEmailCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.GetUserByEmail")
+	}
+
+	if !m.GetUserByEmailMock.invocationsDone() && afterGetUserByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.GetUserByEmail but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByEmailMock.expectedInvocations), afterGetUserByEmailCounter)
+	}
+}
+
+type mUserRepositoryMockListUsers struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockListUsersExpectation
+	expectations       []*UserRepositoryMockListUsersExpectation
+
+	callArgs []*UserRepositoryMockListUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockListUsersExpectation specifies expectation struct of the UserRepository.ListUsers
+type UserRepositoryMockListUsersExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockListUsersParams
+	paramPtrs *UserRepositoryMockListUsersParamPtrs
+	results   *UserRepositoryMockListUsersResults
+	Counter   uint64
+}
+
+// UserRepositoryMockListUsersParams contains parameters of the UserRepository.ListUsers
+type UserRepositoryMockListUsersParams struct {
+	ctx    context.Context
+	params model.ListUsersPageParams
+}
+
+// UserRepositoryMockListUsersParamPtrs contains pointers to parameters of the UserRepository.ListUsers
+type UserRepositoryMockListUsersParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListUsersPageParams
+}
+
+// UserRepositoryMockListUsersResults contains results of the UserRepository.ListUsers
+type UserRepositoryMockListUsersResults struct {
+	resp model.ListUsersPageResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUsers *mUserRepositoryMockListUsers) Optional() *mUserRepositoryMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
+}
+
+// Expect sets up expected params for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Expect(ctx context.Context, params model.ListUsersPageParams) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by ExpectParams functions")
+	}
+
+	mmListUsers.defaultExpectation.params = &UserRepositoryMockListUsersParams{ctx, params}
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmListUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListUsers
+}
+
+// ExpectParamsParam2 sets up expected param params for UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) ExpectParamsParam2(params model.ListUsersPageParams) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserRepositoryMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.params = &params
+
+	return mmListUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Inspect(f func(ctx context.Context, params model.ListUsersPageParams)) *mUserRepositoryMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.ListUsers")
+	}
+
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserRepository.ListUsers
+func (mmListUsers *mUserRepositoryMockListUsers) Return(resp model.ListUsersPageResponse, err error) *UserRepositoryMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserRepositoryMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserRepositoryMockListUsersResults{resp, err}
+	return mmListUsers.mock
+}
+
+// Set uses given function f to mock the UserRepository.ListUsers method
+func (mmListUsers *mUserRepositoryMockListUsers) Set(f func(ctx context.Context, params model.ListUsersPageParams) (resp model.ListUsersPageResponse, err error)) *UserRepositoryMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserRepository.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserRepository.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserRepository.ListUsers which will trigger the result defined by the following
+// Then helper
+func (mmListUsers *mUserRepositoryMockListUsers) When(ctx context.Context, params model.ListUsersPageParams) *UserRepositoryMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserRepositoryMock.ListUsers mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockListUsersExpectation{
+		mock:   mmListUsers.mock,
+		params: &UserRepositoryMockListUsersParams{ctx, params},
+	}
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockListUsersExpectation) Then(resp model.ListUsersPageResponse, err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockListUsersResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.ListUsers should be invoked
+func (mmListUsers *mUserRepositoryMockListUsers) Times(n uint64) *mUserRepositoryMockListUsers {
+	if n == 0 {
+		mmListUsers.mock.t.Fatalf("Times of UserRepositoryMock.ListUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	return mmListUsers
+}
+
+func (mmListUsers *mUserRepositoryMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUsers implements repository.UserRepository
+func (mmListUsers *UserRepositoryMock) ListUsers(ctx context.Context, params model.ListUsersPageParams) (resp model.ListUsersPageResponse, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
+
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, params)
+	}
+
+	mm_params := UserRepositoryMockListUsersParams{ctx, params}
+
+	// Record call args
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
+
+	for _, e := range mmListUsers.ListUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockListUsersParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUsers.t.Errorf("UserRepositoryMock.ListUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUsers.t.Fatal("No results are set for the UserRepositoryMock.ListUsers")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, params)
+	}
+	mmListUsers.t.Fatalf("Unexpected call to UserRepositoryMock.ListUsers. %v %v", ctx, params)
+	return
+}
+
+// ListUsersAfterCounter returns a count of finished UserRepositoryMock.ListUsers invocations
+func (mmListUsers *UserRepositoryMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
+}
+
+// ListUsersBeforeCounter returns a count of UserRepositoryMock.ListUsers invocations
+func (mmListUsers *UserRepositoryMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.ListUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUsers *mUserRepositoryMockListUsers) Calls() []*UserRepositoryMockListUsersParams {
+	mmListUsers.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
+
+	mmListUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUsersMock.invocationsDone()
+}
+
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers with params: %#v", *e.params)
+		}
+	}
+
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.ListUsers")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.ListUsers with params: %#v", *m.ListUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.ListUsers")
+	}
+
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.ListUsers but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), afterListUsersCounter)
+	}
+}
+
 type mUserRepositoryMockUpdateUser struct {
 	optional           bool
 	mock               *UserRepositoryMock
@@ -1352,6 +2021,326 @@ func (m *UserRepositoryMock) MinimockUpdateUserInspect() {
 	}
 }
 
+type mUserRepositoryMockUpdateUserPassword struct {
+	optional           bool
+	mock               *UserRepositoryMock
+	defaultExpectation *UserRepositoryMockUpdateUserPasswordExpectation
+	expectations       []*UserRepositoryMockUpdateUserPasswordExpectation
+
+	callArgs []*UserRepositoryMockUpdateUserPasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserRepositoryMockUpdateUserPasswordExpectation specifies expectation struct of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordExpectation struct {
+	mock      *UserRepositoryMock
+	params    *UserRepositoryMockUpdateUserPasswordParams
+	paramPtrs *UserRepositoryMockUpdateUserPasswordParamPtrs
+	results   *UserRepositoryMockUpdateUserPasswordResults
+	Counter   uint64
+}
+
+// UserRepositoryMockUpdateUserPasswordParams contains parameters of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordParams struct {
+	ctx    context.Context
+	params model.UpdateUserPasswordParams
+}
+
+// UserRepositoryMockUpdateUserPasswordParamPtrs contains pointers to parameters of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordParamPtrs struct {
+	ctx    *context.Context
+	params *model.UpdateUserPasswordParams
+}
+
+// UserRepositoryMockUpdateUserPasswordResults contains results of the UserRepository.UpdateUserPassword
+type UserRepositoryMockUpdateUserPasswordResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Optional() *mUserRepositoryMockUpdateUserPassword {
+	mmUpdateUserPassword.optional = true
+	return mmUpdateUserPassword
+}
+
+// Expect sets up expected params for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Expect(ctx context.Context, params model.UpdateUserPasswordParams) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateUserPassword.defaultExpectation.params = &UserRepositoryMockUpdateUserPasswordParams{ctx, params}
+	for _, e := range mmUpdateUserPassword.expectations {
+		if minimock.Equal(e.params, mmUpdateUserPassword.defaultExpectation.params) {
+			mmUpdateUserPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUserPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateUserPassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) ExpectCtxParam1(ctx context.Context) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.params != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Expect")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs == nil {
+		mmUpdateUserPassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserPasswordParamPtrs{}
+	}
+	mmUpdateUserPassword.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUpdateUserPassword
+}
+
+// ExpectParamsParam2 sets up expected param params for UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) ExpectParamsParam2(params model.UpdateUserPasswordParams) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{}
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.params != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Expect")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation.paramPtrs == nil {
+		mmUpdateUserPassword.defaultExpectation.paramPtrs = &UserRepositoryMockUpdateUserPasswordParamPtrs{}
+	}
+	mmUpdateUserPassword.defaultExpectation.paramPtrs.params = &params
+
+	return mmUpdateUserPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Inspect(f func(ctx context.Context, params model.UpdateUserPasswordParams)) *mUserRepositoryMockUpdateUserPassword {
+	if mmUpdateUserPassword.mock.inspectFuncUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("Inspect function is already set for UserRepositoryMock.UpdateUserPassword")
+	}
+
+	mmUpdateUserPassword.mock.inspectFuncUpdateUserPassword = f
+
+	return mmUpdateUserPassword
+}
+
+// Return sets up results that will be returned by UserRepository.UpdateUserPassword
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Return(err error) *UserRepositoryMock {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	if mmUpdateUserPassword.defaultExpectation == nil {
+		mmUpdateUserPassword.defaultExpectation = &UserRepositoryMockUpdateUserPasswordExpectation{mock: mmUpdateUserPassword.mock}
+	}
+	mmUpdateUserPassword.defaultExpectation.results = &UserRepositoryMockUpdateUserPasswordResults{err}
+	return mmUpdateUserPassword.mock
+}
+
+// Set uses given function f to mock the UserRepository.UpdateUserPassword method
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Set(f func(ctx context.Context, params model.UpdateUserPasswordParams) (err error)) *UserRepositoryMock {
+	if mmUpdateUserPassword.defaultExpectation != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("Default expectation is already set for the UserRepository.UpdateUserPassword method")
+	}
+
+	if len(mmUpdateUserPassword.expectations) > 0 {
+		mmUpdateUserPassword.mock.t.Fatalf("Some expectations are already set for the UserRepository.UpdateUserPassword method")
+	}
+
+	mmUpdateUserPassword.mock.funcUpdateUserPassword = f
+	return mmUpdateUserPassword.mock
+}
+
+// When sets expectation for the UserRepository.UpdateUserPassword which will trigger the result defined by the following
+// Then helper
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) When(ctx context.Context, params model.UpdateUserPasswordParams) *UserRepositoryMockUpdateUserPasswordExpectation {
+	if mmUpdateUserPassword.mock.funcUpdateUserPassword != nil {
+		mmUpdateUserPassword.mock.t.Fatalf("UserRepositoryMock.UpdateUserPassword mock is already set by Set")
+	}
+
+	expectation := &UserRepositoryMockUpdateUserPasswordExpectation{
+		mock:   mmUpdateUserPassword.mock,
+		params: &UserRepositoryMockUpdateUserPasswordParams{ctx, params},
+	}
+	mmUpdateUserPassword.expectations = append(mmUpdateUserPassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserRepository.UpdateUserPassword return parameters for the expectation previously defined by the When method
+func (e *UserRepositoryMockUpdateUserPasswordExpectation) Then(err error) *UserRepositoryMock {
+	e.results = &UserRepositoryMockUpdateUserPasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserRepository.UpdateUserPassword should be invoked
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Times(n uint64) *mUserRepositoryMockUpdateUserPassword {
+	if n == 0 {
+		mmUpdateUserPassword.mock.t.Fatalf("Times of UserRepositoryMock.UpdateUserPassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateUserPassword.expectedInvocations, n)
+	return mmUpdateUserPassword
+}
+
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) invocationsDone() bool {
+	if len(mmUpdateUserPassword.expectations) == 0 && mmUpdateUserPassword.defaultExpectation == nil && mmUpdateUserPassword.mock.funcUpdateUserPassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateUserPassword.mock.afterUpdateUserPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateUserPassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateUserPassword implements repository.UserRepository
+func (mmUpdateUserPassword *UserRepositoryMock) UpdateUserPassword(ctx context.Context, params model.UpdateUserPasswordParams) (err error) {
+	mm_atomic.AddUint64(&mmUpdateUserPassword.beforeUpdateUserPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateUserPassword.afterUpdateUserPasswordCounter, 1)
+
+	if mmUpdateUserPassword.inspectFuncUpdateUserPassword != nil {
+		mmUpdateUserPassword.inspectFuncUpdateUserPassword(ctx, params)
+	}
+
+	mm_params := UserRepositoryMockUpdateUserPasswordParams{ctx, params}
+
+	// Record call args
+	mmUpdateUserPassword.UpdateUserPasswordMock.mutex.Lock()
+	mmUpdateUserPassword.UpdateUserPasswordMock.callArgs = append(mmUpdateUserPassword.UpdateUserPasswordMock.callArgs, &mm_params)
+	mmUpdateUserPassword.UpdateUserPasswordMock.mutex.Unlock()
+
+	for _, e := range mmUpdateUserPassword.UpdateUserPasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UserRepositoryMockUpdateUserPasswordParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateUserPassword.t.Errorf("UserRepositoryMock.UpdateUserPassword got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateUserPassword.UpdateUserPasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateUserPassword.t.Fatal("No results are set for the UserRepositoryMock.UpdateUserPassword")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateUserPassword.funcUpdateUserPassword != nil {
+		return mmUpdateUserPassword.funcUpdateUserPassword(ctx, params)
+	}
+	mmUpdateUserPassword.t.Fatalf("Unexpected call to UserRepositoryMock.UpdateUserPassword. %v %v", ctx, params)
+	return
+}
+
+// UpdateUserPasswordAfterCounter returns a count of finished UserRepositoryMock.UpdateUserPassword invocations
+func (mmUpdateUserPassword *UserRepositoryMock) UpdateUserPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateUserPassword.afterUpdateUserPasswordCounter)
+}
+
+// UpdateUserPasswordBeforeCounter returns a count of UserRepositoryMock.UpdateUserPassword invocations
+func (mmUpdateUserPassword *UserRepositoryMock) UpdateUserPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateUserPassword.beforeUpdateUserPasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserRepositoryMock.UpdateUserPassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateUserPassword *mUserRepositoryMockUpdateUserPassword) Calls() []*UserRepositoryMockUpdateUserPasswordParams {
+	mmUpdateUserPassword.mutex.RLock()
+
+	argCopy := make([]*UserRepositoryMockUpdateUserPasswordParams, len(mmUpdateUserPassword.callArgs))
+	copy(argCopy, mmUpdateUserPassword.callArgs)
+
+	mmUpdateUserPassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateUserPasswordDone returns true if the count of the UpdateUserPassword invocations corresponds
+// the number of defined expectations
+func (m *UserRepositoryMock) MinimockUpdateUserPasswordDone() bool {
+	if m.UpdateUserPasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateUserPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateUserPasswordMock.invocationsDone()
+}
+
+// MinimockUpdateUserPasswordInspect logs each unmet expectation
+func (m *UserRepositoryMock) MinimockUpdateUserPasswordInspect() {
+	for _, e := range m.UpdateUserPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUserPassword with params: %#v", *e.params)
+		}
+	}
+
+	afterUpdateUserPasswordCounter := mm_atomic.LoadUint64(&m.afterUpdateUserPasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateUserPasswordMock.defaultExpectation != nil && afterUpdateUserPasswordCounter < 1 {
+		if m.UpdateUserPasswordMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserRepositoryMock.UpdateUserPassword")
+		} else {
+			m.t.Errorf("Expected call to UserRepositoryMock.UpdateUserPassword with params: %#v", *m.UpdateUserPasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateUserPassword != nil && afterUpdateUserPasswordCounter < 1 {
+		m.t.Error("Expected call to UserRepositoryMock.UpdateUserPassword")
+	}
+
+	if !m.UpdateUserPasswordMock.invocationsDone() && afterUpdateUserPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserRepositoryMock.UpdateUserPassword but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateUserPasswordMock.expectedInvocations), afterUpdateUserPasswordCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UserRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
@@ -1362,7 +2351,13 @@ func (m *UserRepositoryMock) MinimockFinish() {
 
 			m.MinimockGetUserInspect()
 
+			m.MinimockGetUserByEmailInspect()
+
+			m.MinimockListUsersInspect()
+
 			m.MinimockUpdateUserInspect()
+
+			m.MinimockUpdateUserPasswordInspect()
 		}
 	})
 }
@@ -1389,5 +2384,8 @@ func (m *UserRepositoryMock) minimockDone() bool {
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetUserDone() &&
-		m.MinimockUpdateUserDone()
+		m.MinimockGetUserByEmailDone() &&
+		m.MinimockListUsersDone() &&
+		m.MinimockUpdateUserDone() &&
+		m.MinimockUpdateUserPasswordDone()
 }
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/outbox/model"
+)
+
+// ConvertCreateOutboxEventParamsFromServiceToRepo converts CreateOutboxEventParams from the service layer
+// to the repository layer.
+func ConvertCreateOutboxEventParamsFromServiceToRepo(
+	params model.CreateOutboxEventParams,
+) (modelRepo.CreateOutboxEventParams, error) {
+	payload, err := json.Marshal(params.Payload)
+	if err != nil {
+		return modelRepo.CreateOutboxEventParams{}, err
+	}
+
+	return modelRepo.CreateOutboxEventParams{
+		AggregateID: params.AggregateID,
+		EventType:   string(params.EventType),
+		Payload:     payload,
+	}, nil
+}
+
+// ConvertListPendingOutboxEventsParamsFromServiceToRepo converts ListPendingOutboxEventsParams from the service layer
+// to the repository layer.
+func ConvertListPendingOutboxEventsParamsFromServiceToRepo(
+	params model.ListPendingOutboxEventsParams,
+) modelRepo.ListPendingOutboxEventsParams {
+	return modelRepo.ListPendingOutboxEventsParams(params)
+}
+
+// ConvertMarkOutboxEventsSentParamsFromServiceToRepo converts MarkOutboxEventsSentParams from the service layer
+// to the repository layer.
+func ConvertMarkOutboxEventsSentParamsFromServiceToRepo(
+	params model.MarkOutboxEventsSentParams,
+) modelRepo.MarkOutboxEventsSentParams {
+	return modelRepo.MarkOutboxEventsSentParams(params)
+}
+
+// ConvertOutboxEventsFromRepoToService converts a list of OutboxEvent from the repository layer to the service layer.
+func ConvertOutboxEventsFromRepoToService(params []modelRepo.OutboxEvent) []model.OutboxEvent {
+	events := make([]model.OutboxEvent, 0, len(params))
+	for _, event := range params {
+		events = append(events, model.OutboxEvent{
+			EventID:     event.EventID,
+			AggregateID: event.AggregateID,
+			EventType:   model.OutboxEventType(event.EventType),
+			Payload:     event.Payload,
+			CreatedAt:   event.CreatedAt,
+		})
+	}
+
+	return events
+}
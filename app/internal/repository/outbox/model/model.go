@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// OutboxEvent represents an outbox event stored in the database.
+type OutboxEvent struct {
+	EventID     int64     `db:"id"`
+	AggregateID int64     `db:"aggregate_id"`
+	EventType   string    `db:"event_type"`
+	Payload     []byte    `db:"payload"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// CreateOutboxEventParams holds the parameters for inserting an outbox event.
+type CreateOutboxEventParams struct {
+	AggregateID int64  `db:"aggregate_id"`
+	EventType   string `db:"event_type"`
+	Payload     []byte `db:"payload"`
+}
+
+// ListPendingOutboxEventsParams holds the parameters for retrieving the events that are not sent yet.
+type ListPendingOutboxEventsParams struct {
+	Limit int64
+}
+
+// MarkOutboxEventsSentParams holds the parameters for marking outbox events as sent.
+type MarkOutboxEventsSentParams struct {
+	EventIDs []int64 `db:"id"`
+}
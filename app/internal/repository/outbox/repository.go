@@ -0,0 +1,112 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/repository/outbox/converter"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/outbox/model"
+)
+
+type outboxPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of outboxPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.OutboxRepository {
+	return &outboxPGRepo{db: db}
+}
+
+// CreateOutboxEvent inserts an event into the outbox.
+func (p *outboxPGRepo) CreateOutboxEvent(
+	ctx context.Context,
+	params model.CreateOutboxEventParams,
+) (err error) {
+	log.Infof("outboxPGRepo.CreateOutboxEvent, aggregateID: %d, eventType: %s", params.AggregateID, params.EventType)
+
+	paramsRepo, err := converter.ConvertCreateOutboxEventParamsFromServiceToRepo(params)
+	if err != nil {
+		return err
+	}
+
+	q := db.Query{
+		Name:     "outboxPGRepo.CreateOutboxEvent",
+		QueryRaw: queryCreateOutboxEvent,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.AggregateID, paramsRepo.EventType, paramsRepo.Payload)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"Cannot create outbox event(aggregateID: %d, eventType: %s)",
+			paramsRepo.AggregateID,
+			paramsRepo.EventType,
+		)
+	}
+
+	return nil
+}
+
+// AcquireRelayLock takes the relay advisory lock until the end of the transaction if no one else holds it.
+func (p *outboxPGRepo) AcquireRelayLock(ctx context.Context) (acquired bool, err error) {
+	q := db.Query{
+		Name:     "outboxPGRepo.AcquireRelayLock",
+		QueryRaw: queryAcquireRelayLock,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &acquired, q, outboxRelayLockKey)
+	if err != nil {
+		return false, errors.Wrap(err, "Cannot acquire outbox relay lock")
+	}
+
+	return acquired, nil
+}
+
+// ListPendingOutboxEvents retrieves the oldest events that are not sent yet in the order they were recorded.
+func (p *outboxPGRepo) ListPendingOutboxEvents(
+	ctx context.Context,
+	params model.ListPendingOutboxEventsParams,
+) (resp []model.OutboxEvent, err error) {
+	paramsRepo := converter.ConvertListPendingOutboxEventsParamsFromServiceToRepo(params)
+
+	var respRepo []modelRepo.OutboxEvent
+
+	q := db.Query{
+		Name:     "outboxPGRepo.ListPendingOutboxEvents",
+		QueryRaw: queryListPendingOutboxEvents,
+	}
+
+	err = p.db.DB().ScanAllContext(ctx, &respRepo, q, paramsRepo.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot list pending outbox events")
+	}
+
+	return converter.ConvertOutboxEventsFromRepoToService(respRepo), nil
+}
+
+// MarkOutboxEventsSent sets the sent timestamp of the outbox events.
+func (p *outboxPGRepo) MarkOutboxEventsSent(
+	ctx context.Context,
+	params model.MarkOutboxEventsSentParams,
+) (err error) {
+	log.Infof("outboxPGRepo.MarkOutboxEventsSent, params: %+v", params)
+
+	paramsRepo := converter.ConvertMarkOutboxEventsSentParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "outboxPGRepo.MarkOutboxEventsSent",
+		QueryRaw: queryMarkOutboxEventsSent,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.EventIDs)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot mark outbox events as sent(eventIDs: %v)", paramsRepo.EventIDs)
+	}
+
+	return nil
+}
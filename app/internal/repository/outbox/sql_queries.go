@@ -0,0 +1,37 @@
+package outbox
+
+const (
+	// outboxRelayLockKey is the advisory lock key that lets only one relay publish at a time,
+	// so events of the same user are never published out of order by concurrent relays.
+	outboxRelayLockKey = 7400190
+
+	queryCreateOutboxEvent = `
+		INSERT INTO users.outbox
+			(aggregate_id, event_type, payload)
+		VALUES
+			($1, $2, $3);
+	`
+
+	queryAcquireRelayLock = `
+		SELECT pg_try_advisory_xact_lock($1);
+	`
+
+	queryListPendingOutboxEvents = `
+		SELECT
+			id
+			, aggregate_id
+			, event_type
+			, payload
+			, created_at
+		FROM users.outbox
+		WHERE sent_at IS NULL
+		ORDER BY id
+		LIMIT $1;
+	`
+
+	queryMarkOutboxEventsSent = `
+		UPDATE users.outbox
+		SET sent_at = now()
+		WHERE id = ANY($1);
+	`
+)
@@ -0,0 +1,65 @@
+package converter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/refresh_token/model"
+)
+
+// ConvertCreateRefreshTokenParamsFromServiceToRepo converts CreateRefreshTokenParams from the service layer
+// to the repository layer.
+func ConvertCreateRefreshTokenParamsFromServiceToRepo(
+	params model.CreateRefreshTokenParams,
+) modelRepo.CreateRefreshTokenParams {
+	return modelRepo.CreateRefreshTokenParams{
+		TokenID:   params.TokenID,
+		FamilyID:  params.FamilyID,
+		UserID:    params.UserID,
+		ExpiresAt: params.ExpiresAt,
+	}
+}
+
+// ConvertGetRefreshTokenParamsFromServiceToRepo converts GetRefreshTokenParams from the service layer
+// to the repository layer.
+func ConvertGetRefreshTokenParamsFromServiceToRepo(params model.GetRefreshTokenParams) modelRepo.GetRefreshTokenParams {
+	return modelRepo.GetRefreshTokenParams(params)
+}
+
+// ConvertRefreshTokenFromRepoToService converts RefreshToken from the repository layer to the service layer.
+func ConvertRefreshTokenFromRepoToService(params modelRepo.RefreshToken) model.RefreshToken {
+	return model.RefreshToken{
+		TokenID:   params.TokenID,
+		FamilyID:  params.FamilyID,
+		UserID:    params.UserID,
+		ExpiresAt: params.ExpiresAt,
+		UsedAt:    convertNullTime(params.UsedAt),
+		RevokedAt: convertNullTime(params.RevokedAt),
+		CreatedAt: params.CreatedAt,
+	}
+}
+
+// ConvertMarkRefreshTokenUsedParamsFromServiceToRepo converts MarkRefreshTokenUsedParams from the service layer
+// to the repository layer.
+func ConvertMarkRefreshTokenUsedParamsFromServiceToRepo(
+	params model.MarkRefreshTokenUsedParams,
+) modelRepo.MarkRefreshTokenUsedParams {
+	return modelRepo.MarkRefreshTokenUsedParams(params)
+}
+
+// ConvertRevokeRefreshTokenFamilyParamsFromServiceToRepo converts RevokeRefreshTokenFamilyParams from the service layer
+// to the repository layer.
+func ConvertRevokeRefreshTokenFamilyParamsFromServiceToRepo(
+	params model.RevokeRefreshTokenFamilyParams,
+) modelRepo.RevokeRefreshTokenFamilyParams {
+	return modelRepo.RevokeRefreshTokenFamilyParams(params)
+}
+
+func convertNullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	return &t.Time
+}
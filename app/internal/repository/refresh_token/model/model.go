@@ -0,0 +1,40 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// RefreshToken represents a refresh token stored in the database.
+type RefreshToken struct {
+	TokenID   string       `db:"id"`
+	FamilyID  string       `db:"family_id"`
+	UserID    int64        `db:"user_id"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+	CreatedAt time.Time    `db:"created_at"`
+}
+
+// CreateRefreshTokenParams holds the parameters for persisting a newly issued refresh token.
+type CreateRefreshTokenParams struct {
+	TokenID   string    `db:"id"`
+	FamilyID  string    `db:"family_id"`
+	UserID    int64     `db:"user_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// GetRefreshTokenParams holds the parameters for retrieving a refresh token by ID.
+type GetRefreshTokenParams struct {
+	TokenID string `db:"id"`
+}
+
+// MarkRefreshTokenUsedParams holds the parameters for marking a refresh token as used.
+type MarkRefreshTokenUsedParams struct {
+	TokenID string `db:"id"`
+}
+
+// RevokeRefreshTokenFamilyParams holds the parameters for revoking every refresh token of a family.
+type RevokeRefreshTokenFamilyParams struct {
+	FamilyID string `db:"family_id"`
+}
@@ -0,0 +1,141 @@
+package refreshtoken
+
+import (
+	"context"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/jackc/pgx/v4"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/repository/refresh_token/converter"
+	modelRepo "github.com/Prrromanssss/auth/internal/repository/refresh_token/model"
+)
+
+type refreshTokenPGRepo struct {
+	db db.Client
+}
+
+// NewRepository creates a new instance of refreshTokenPGRepo with the provided database connection.
+func NewRepository(db db.Client) repository.RefreshTokenRepository {
+	return &refreshTokenPGRepo{db: db}
+}
+
+// CreateRefreshToken inserts a newly issued refresh token into the database.
+func (p *refreshTokenPGRepo) CreateRefreshToken(
+	ctx context.Context,
+	params model.CreateRefreshTokenParams,
+) (err error) {
+	log.Infof("refreshTokenPGRepo.CreateRefreshToken, tokenID: %s, familyID: %s", params.TokenID, params.FamilyID)
+
+	paramsRepo := converter.ConvertCreateRefreshTokenParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "refreshTokenPGRepo.CreateRefreshToken",
+		QueryRaw: queryCreateRefreshToken,
+	}
+
+	_, err = p.db.DB().ExecContext(
+		ctx,
+		q,
+		paramsRepo.TokenID,
+		paramsRepo.FamilyID,
+		paramsRepo.UserID,
+		paramsRepo.ExpiresAt,
+	)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"Cannot create refresh token(tokenID: %s)",
+			paramsRepo.TokenID,
+		)
+	}
+
+	return nil
+}
+
+// GetRefreshToken retrieves a refresh token from the database by its ID and locks the row.
+func (p *refreshTokenPGRepo) GetRefreshToken(
+	ctx context.Context,
+	params model.GetRefreshTokenParams,
+) (resp model.RefreshToken, err error) {
+	log.Infof("refreshTokenPGRepo.GetRefreshToken, params: %+v", params)
+
+	paramsRepo := converter.ConvertGetRefreshTokenParamsFromServiceToRepo(params)
+
+	var respRepo modelRepo.RefreshToken
+
+	q := db.Query{
+		Name:     "refreshTokenPGRepo.GetRefreshToken",
+		QueryRaw: queryGetRefreshToken,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &respRepo, q, paramsRepo.TokenID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resp, model.ErrRefreshTokenNotFound
+		}
+
+		return resp, errors.Wrapf(
+			err,
+			"Cannot get refresh token(tokenID: %s)",
+			paramsRepo.TokenID,
+		)
+	}
+
+	return converter.ConvertRefreshTokenFromRepoToService(respRepo), nil
+}
+
+// MarkRefreshTokenUsed sets the used timestamp of a refresh token.
+func (p *refreshTokenPGRepo) MarkRefreshTokenUsed(
+	ctx context.Context,
+	params model.MarkRefreshTokenUsedParams,
+) (err error) {
+	log.Infof("refreshTokenPGRepo.MarkRefreshTokenUsed, params: %+v", params)
+
+	paramsRepo := converter.ConvertMarkRefreshTokenUsedParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "refreshTokenPGRepo.MarkRefreshTokenUsed",
+		QueryRaw: queryMarkRefreshTokenUsed,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.TokenID)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"Cannot mark refresh token as used(tokenID: %s)",
+			paramsRepo.TokenID,
+		)
+	}
+
+	return nil
+}
+
+// RevokeRefreshTokenFamily sets the revoked timestamp of every refresh token of a family.
+func (p *refreshTokenPGRepo) RevokeRefreshTokenFamily(
+	ctx context.Context,
+	params model.RevokeRefreshTokenFamilyParams,
+) (err error) {
+	log.Infof("refreshTokenPGRepo.RevokeRefreshTokenFamily, params: %+v", params)
+
+	paramsRepo := converter.ConvertRevokeRefreshTokenFamilyParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "refreshTokenPGRepo.RevokeRefreshTokenFamily",
+		QueryRaw: queryRevokeRefreshTokenFamily,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.FamilyID)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"Cannot revoke refresh token family(familyID: %s)",
+			paramsRepo.FamilyID,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package refreshtoken
+
+const (
+	queryCreateRefreshToken = `
+		INSERT INTO users.refresh_token
+			(id, family_id, user_id, expires_at)
+		VALUES
+			($1, $2, $3, $4);
+	`
+
+	queryGetRefreshToken = `
+		SELECT
+			id
+			, family_id
+			, user_id
+			, expires_at
+			, used_at
+			, revoked_at
+			, created_at
+		FROM users.refresh_token
+		WHERE id = $1
+		FOR UPDATE;
+	`
+
+	queryMarkRefreshTokenUsed = `
+		UPDATE users.refresh_token
+		SET used_at = now()
+		WHERE id = $1;
+	`
+
+	queryRevokeRefreshTokenFamily = `
+		UPDATE users.refresh_token
+		SET revoked_at = now()
+		WHERE family_id = $1 AND revoked_at IS NULL;
+	`
+)
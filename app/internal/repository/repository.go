@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Prrromanssss/auth/internal/model"
 )
@@ -14,14 +15,133 @@ type UserRepository interface {
 	// GetUser retrieves a user by ID and returns user details and any error.
 	GetUser(ctx context.Context, params model.GetUserParams) (resp model.GetUserResponse, err error)
 
+	// GetUserByEmail retrieves a user with the password hash by email and returns user details and any error.
+	GetUserByEmail(ctx context.Context, params model.GetUserByEmailParams) (resp model.GetUserByEmailResponse, err error)
+
 	// UpdateUser updates user details by ID and returns any error.
 	UpdateUser(ctx context.Context, params model.UpdateUserParams) (resp model.UpdateUserResponse, err error)
 
+	// UpdateUserPassword replaces the password hash of a user by ID and returns any error.
+	UpdateUserPassword(ctx context.Context, params model.UpdateUserPasswordParams) (err error)
+
 	// DeleteUser removes a user by ID and returns any error.
 	DeleteUser(ctx context.Context, params model.DeleteUserParams) (err error)
+
+	// ListUsers retrieves a page of users after the cursor and returns the users and any error.
+	ListUsers(ctx context.Context, params model.ListUsersPageParams) (resp model.ListUsersPageResponse, err error)
 }
 
 type LogRepository interface {
 	// CreateAPILog creates log in database of every api action and returns any error..
 	CreateAPILog(ctx context.Context, params model.CreateAPILogParams) (err error)
+	// CreateAPILogs creates the audit records captured during earlier calls in one statement and returns any error.
+	CreateAPILogs(ctx context.Context, records []model.APILogRecord) (err error)
+	// GetAuditChainHead retrieves the last record of the audit chain and any error.
+	GetAuditChainHead(ctx context.Context) (resp model.AuditChainHead, err error)
+	// ListAuditChain retrieves the audit records after a record with their hashes, in chain order, and any error.
+	ListAuditChain(ctx context.Context, params model.ListAuditChainParams) (resp []model.AuditChainLink, err error)
+	// CreateAuditCheckpoint stores a checkpoint of the audit chain unless the chain has not moved since the last one,
+	// and returns whether it was stored and any error.
+	CreateAuditCheckpoint(ctx context.Context, params model.AuditCheckpoint) (created bool, err error)
+	// ListAuditCheckpoints retrieves the checkpoints after a checkpoint, oldest first, and any error.
+	ListAuditCheckpoints(
+		ctx context.Context,
+		params model.ListAuditCheckpointsParams,
+	) (resp []model.AuditCheckpoint, err error)
+	// ListAuditEvents retrieves a page of audit events after the cursor, newest first, and returns any error.
+	ListAuditEvents(
+		ctx context.Context,
+		params model.ListAuditEventsPageParams,
+	) (resp model.ListAuditEventsPageResponse, err error)
+}
+
+// RefreshTokenRepository defines methods for refresh token store operations.
+type RefreshTokenRepository interface {
+	// CreateRefreshToken persists a newly issued refresh token and returns any error.
+	CreateRefreshToken(ctx context.Context, params model.CreateRefreshTokenParams) (err error)
+
+	// GetRefreshToken retrieves a refresh token by ID, locking it until the end of the transaction, and returns any error.
+	GetRefreshToken(ctx context.Context, params model.GetRefreshTokenParams) (resp model.RefreshToken, err error)
+
+	// MarkRefreshTokenUsed marks a refresh token as used and returns any error.
+	MarkRefreshTokenUsed(ctx context.Context, params model.MarkRefreshTokenUsedParams) (err error)
+
+	// RevokeRefreshTokenFamily revokes every refresh token of a family and returns any error.
+	RevokeRefreshTokenFamily(ctx context.Context, params model.RevokeRefreshTokenFamilyParams) (err error)
+}
+
+// LoginFailureRepository defines methods for counting failed logins and locking out logins after too many failures.
+type LoginFailureRepository interface {
+	// LockLoginFailures locks the counters of failed logins of the given keys until the end of the transaction,
+	// retrieves them and returns any error.
+	LockLoginFailures(ctx context.Context, params model.LockLoginFailuresParams) (resp []model.LoginFailure, err error)
+
+	// RecordLoginFailure counts a failed login for a key and returns the updated counter and any error.
+	RecordLoginFailure(ctx context.Context, params model.RecordLoginFailureParams) (resp model.LoginFailure, err error)
+
+	// LockLogin makes the logins for a key wait until the given time and returns any error.
+	LockLogin(ctx context.Context, params model.LockLoginParams) (err error)
+
+	// DeleteLoginFailures resets the counters of failed logins of the given keys and returns any error.
+	DeleteLoginFailures(ctx context.Context, params model.DeleteLoginFailuresParams) (err error)
+}
+
+// AccessRepository defines methods for access rule operations.
+type AccessRepository interface {
+	// CheckAccess reports whether an access rule allows the role to call the endpoint and returns any error.
+	CheckAccess(ctx context.Context, params model.CheckAccessParams) (allowed bool, err error)
+}
+
+// OutboxRepository defines methods for transactional outbox operations.
+type OutboxRepository interface {
+	// CreateOutboxEvent records an event in the outbox and returns any error.
+	CreateOutboxEvent(ctx context.Context, params model.CreateOutboxEventParams) (err error)
+
+	// AcquireRelayLock takes the relay lock until the end of the transaction and reports whether it was taken.
+	AcquireRelayLock(ctx context.Context) (acquired bool, err error)
+
+	// ListPendingOutboxEvents retrieves the oldest events that are not sent yet and returns any error.
+	ListPendingOutboxEvents(ctx context.Context, params model.ListPendingOutboxEventsParams) (resp []model.OutboxEvent, err error)
+
+	// MarkOutboxEventsSent marks the outbox events as sent and returns any error.
+	MarkOutboxEventsSent(ctx context.Context, params model.MarkOutboxEventsSentParams) (err error)
+}
+
+// AuditArchiveRepository defines methods for managing the partitions of the audit log and their archive.
+type AuditArchiveRepository interface {
+	// AcquireRetentionLock takes the retention lock until the end of the transaction if it is free
+	// and returns whether it was taken and any error.
+	AcquireRetentionLock(ctx context.Context) (acquired bool, err error)
+
+	// GetCurrentAuditMonth retrieves the start of the current month in the time zone the partition bounds
+	// are in and any error.
+	GetCurrentAuditMonth(ctx context.Context) (month time.Time, err error)
+
+	// ListAuditPartitions retrieves the monthly partitions of the audit log and any error.
+	ListAuditPartitions(ctx context.Context) (resp []model.AuditPartition, err error)
+
+	// CreateAuditPartition creates the partition of the audit log that holds the month of the given time
+	// unless it exists and returns any error.
+	CreateAuditPartition(ctx context.Context, month time.Time) (err error)
+
+	// ListAuditPartitionRecords retrieves the records of a partition after a record, in chain order, and any error.
+	ListAuditPartitionRecords(
+		ctx context.Context,
+		params model.ListAuditPartitionRecordsParams,
+	) (resp []model.AuditChainLink, err error)
+
+	// CreateAuditArchive records a partition exported to the archive and returns its id and any error.
+	CreateAuditArchive(ctx context.Context, params model.AuditArchive) (archiveID int64, err error)
+
+	// CreateAuditChainSeams records the records chained to a record of the partition and returns any error.
+	CreateAuditChainSeams(ctx context.Context, params model.CreateAuditChainSeamsParams) (err error)
+
+	// DropAuditPartition drops or detaches a partition of the audit log and returns any error.
+	DropAuditPartition(ctx context.Context, params model.DropAuditPartitionParams) (err error)
+
+	// ListAuditChainSeams retrieves the records whose previous record was archived and any error.
+	ListAuditChainSeams(ctx context.Context) (resp []model.AuditChainSeam, err error)
+
+	// GetMaxArchivedEventID retrieves the greatest id of the archived records and any error.
+	GetMaxArchivedEventID(ctx context.Context) (eventID int64, err error)
 }
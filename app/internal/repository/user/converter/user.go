@@ -2,6 +2,7 @@ package converter
 
 import (
 	"database/sql"
+	"strings"
 
 	"github.com/Prrromanssss/auth/internal/model"
 	modelRepo "github.com/Prrromanssss/auth/internal/repository/user/model"
@@ -27,6 +28,7 @@ func ConvertCreateUserResponseFromRepoToService(params modelRepo.CreateUserRespo
 			Role:      params.Role,
 			CreatedAt: params.CreatedAt,
 			UpdatedAt: params.UpdatedAt,
+			Version:   params.Version,
 		},
 	}
 }
@@ -48,10 +50,34 @@ func ConvertGetUserResponseFromRepoToService(params modelRepo.GetUserResponse) m
 			Role:      params.Role,
 			CreatedAt: params.CreatedAt,
 			UpdatedAt: params.UpdatedAt,
+			Version:   params.Version,
 		},
 	}
 }
 
+// ConvertGetUserByEmailParamsFromServiceToRepo converts GetUserByEmailParams from the service layer to the repository layer.
+func ConvertGetUserByEmailParamsFromServiceToRepo(params model.GetUserByEmailParams) modelRepo.GetUserByEmailParams {
+	return modelRepo.GetUserByEmailParams{
+		Email: params.Email,
+	}
+}
+
+// ConvertGetUserByEmailResponseFromRepoToService converts GetUserByEmailResponse from the repository layer to the service layer.
+func ConvertGetUserByEmailResponseFromRepoToService(params modelRepo.GetUserByEmailResponse) model.GetUserByEmailResponse {
+	return model.GetUserByEmailResponse{
+		User: model.User{
+			UserID:    params.UserID,
+			Name:      params.Name,
+			Email:     params.Email,
+			Role:      params.Role,
+			CreatedAt: params.CreatedAt,
+			UpdatedAt: params.UpdatedAt,
+			Version:   params.Version,
+		},
+		HashedPassword: params.HashedPassword,
+	}
+}
+
 // ConvertUpdateUserParamsFromServiceToRepo converts UpdateUserParams from the service layer to the repository layer.
 func ConvertUpdateUserParamsFromServiceToRepo(params model.UpdateUserParams) modelRepo.UpdateUserParams {
 	var name sql.NullString
@@ -79,13 +105,79 @@ func ConvertUpdateUserResponseFromRepoToService(params modelRepo.UpdateUserRespo
 			Role:      params.Role,
 			CreatedAt: params.CreatedAt,
 			UpdatedAt: params.UpdatedAt,
+			Version:   params.Version,
 		},
 	}
 }
 
+// ConvertUpdateUserPasswordParamsFromServiceToRepo converts UpdateUserPasswordParams from the service layer
+// to the repository layer.
+func ConvertUpdateUserPasswordParamsFromServiceToRepo(
+	params model.UpdateUserPasswordParams,
+) modelRepo.UpdateUserPasswordParams {
+	return modelRepo.UpdateUserPasswordParams{
+		UserID:         params.UserID,
+		HashedPassword: params.HashedPassword,
+	}
+}
+
 // ConvertDeleteUserParamsFromServiceToRepo converts DeleteUserParams from the service layer to the repository layer.
 func ConvertDeleteUserParamsFromServiceToRepo(params model.DeleteUserParams) modelRepo.DeleteUserParams {
 	return modelRepo.DeleteUserParams{
 		UserID: params.UserID,
 	}
 }
+
+// likePatternEscaper escapes the wildcard characters of LIKE so that user input is matched literally.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ConvertListUsersPageParamsFromServiceToRepo converts ListUsersPageParams from the service layer to the repository layer.
+func ConvertListUsersPageParamsFromServiceToRepo(params model.ListUsersPageParams) modelRepo.ListUsersPageParams {
+	paramsRepo := modelRepo.ListUsersPageParams{
+		Limit:     params.Limit,
+		Ascending: params.SortOrder == model.SortOrderAsc,
+	}
+
+	if params.Filter.Role != nil {
+		paramsRepo.Role = sql.NullInt64{Int64: *params.Filter.Role, Valid: true}
+	}
+
+	if params.Filter.EmailPrefix != "" {
+		paramsRepo.EmailPattern = likePatternEscaper.Replace(params.Filter.EmailPrefix) + "%"
+	}
+
+	if params.Filter.CreatedFrom != nil {
+		paramsRepo.CreatedFrom = sql.NullTime{Time: *params.Filter.CreatedFrom, Valid: true}
+	}
+
+	if params.Filter.CreatedTo != nil {
+		paramsRepo.CreatedTo = sql.NullTime{Time: *params.Filter.CreatedTo, Valid: true}
+	}
+
+	if params.After != nil {
+		paramsRepo.AfterCreatedAt = sql.NullTime{Time: params.After.CreatedAt, Valid: true}
+		paramsRepo.AfterUserID = params.After.UserID
+	}
+
+	return paramsRepo
+}
+
+// ConvertListUsersPageResponseFromRepoToService converts a page of users from the repository layer to the service layer.
+func ConvertListUsersPageResponseFromRepoToService(params []modelRepo.User) model.ListUsersPageResponse {
+	users := make([]model.User, 0, len(params))
+	for _, user := range params {
+		users = append(users, model.User{
+			UserID:    user.UserID,
+			Name:      user.Name,
+			Email:     user.Email,
+			Role:      user.Role,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+			Version:   user.Version,
+		})
+	}
+
+	return model.ListUsersPageResponse{
+		Users: users,
+	}
+}
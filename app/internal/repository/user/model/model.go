@@ -21,6 +21,7 @@ type CreateUserResponse struct {
 	Role      int64     `db:"role_id"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	Version   int64     `db:"version"`
 }
 
 // GetUserParams holds the parameters for retrieving a user by ID.
@@ -36,6 +37,24 @@ type GetUserResponse struct {
 	Role      int64     `db:"role_id"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	Version   int64     `db:"version"`
+}
+
+// GetUserByEmailParams holds the parameters for retrieving a user by email.
+type GetUserByEmailParams struct {
+	Email string `db:"email"`
+}
+
+// GetUserByEmailResponse represents the details of a user retrieved by email, including the password hash.
+type GetUserByEmailResponse struct {
+	UserID         int64     `db:"id"`
+	Name           string    `db:"name"`
+	Email          string    `db:"email"`
+	HashedPassword string    `db:"hashed_password"`
+	Role           int64     `db:"role_id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	Version        int64     `db:"version"`
 }
 
 // UpdateUserParams holds the parameters for updating an existing user.
@@ -53,9 +72,39 @@ type UpdateUserResponse struct {
 	Role      int64     `db:"role_id"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
+	Version   int64     `db:"version"`
+}
+
+// UpdateUserPasswordParams holds the parameters for replacing a user's password hash.
+type UpdateUserPasswordParams struct {
+	UserID         int64  `db:"id"`
+	HashedPassword string `db:"hashed_password"`
 }
 
 // DeleteUserParams holds the parameters for deleting a user by ID.
 type DeleteUserParams struct {
 	UserID int64 `db:"id"`
 }
+
+// ListUsersPageParams holds the parameters for retrieving a page of users after the cursor.
+type ListUsersPageParams struct {
+	Role           sql.NullInt64 `db:"role_id"`
+	EmailPattern   string        `db:"email"`
+	CreatedFrom    sql.NullTime  `db:"created_from"`
+	CreatedTo      sql.NullTime  `db:"created_to"`
+	AfterCreatedAt sql.NullTime  `db:"after_created_at"`
+	AfterUserID    int64         `db:"after_id"`
+	Limit          int64         `db:"limit"`
+	Ascending      bool          `db:"ascending"`
+}
+
+// User represents a user row retrieved from the database.
+type User struct {
+	UserID    int64     `db:"id"`
+	Name      string    `db:"name"`
+	Email     string    `db:"email"`
+	Role      int64     `db:"role_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	Version   int64     `db:"version"`
+}
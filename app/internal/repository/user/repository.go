@@ -2,9 +2,12 @@ package user
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
 	"github.com/pkg/errors"
 
 	"github.com/Prrromanssss/auth/internal/model"
@@ -13,6 +16,12 @@ import (
 	modelRepo "github.com/Prrromanssss/auth/internal/repository/user/model"
 )
 
+const (
+	userResourceType = "user"
+
+	uniqueViolationCode = "23505"
+)
+
 type userPGRepo struct {
 	db db.Client
 }
@@ -40,6 +49,10 @@ func (p *userPGRepo) CreateUser(
 
 	err = p.db.DB().ScanOneContext(ctx, &respRepo, q, paramsRepo.Name, paramsRepo.Email, params.HashedPassword, params.Role)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return resp, model.ErrUserAlreadyExists.WithResource(userResourceType, paramsRepo.Email)
+		}
+
 		return resp, errors.Wrapf(
 			err,
 			"Cannot create user(email: %s)",
@@ -68,6 +81,10 @@ func (p *userPGRepo) GetUser(
 
 	err = p.db.DB().ScanOneContext(ctx, &respRepo, q, paramsRepo.UserID)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resp, model.ErrUserNotFound.WithResource(userResourceType, strconv.FormatInt(paramsRepo.UserID, 10))
+		}
+
 		return resp, errors.Wrapf(
 			err,
 			"Cannot get user(userID: %d)",
@@ -78,6 +95,38 @@ func (p *userPGRepo) GetUser(
 	return converter.ConvertGetUserResponseFromRepoToService(respRepo), nil
 }
 
+// GetUserByEmail retrieves a user together with the password hash from the database by their email.
+func (p *userPGRepo) GetUserByEmail(
+	ctx context.Context,
+	params model.GetUserByEmailParams,
+) (resp model.GetUserByEmailResponse, err error) {
+	log.Infof("userPGRepo.GetUserByEmail, email: %s", params.Email)
+
+	paramsRepo := converter.ConvertGetUserByEmailParamsFromServiceToRepo(params)
+
+	var respRepo modelRepo.GetUserByEmailResponse
+
+	q := db.Query{
+		Name:     "userPGRepo.GetUserByEmail",
+		QueryRaw: queryGetUserByEmail,
+	}
+
+	err = p.db.DB().ScanOneContext(ctx, &respRepo, q, paramsRepo.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resp, model.ErrUserNotFound.WithResource(userResourceType, paramsRepo.Email)
+		}
+
+		return resp, errors.Wrapf(
+			err,
+			"Cannot get user(email: %s)",
+			paramsRepo.Email,
+		)
+	}
+
+	return converter.ConvertGetUserByEmailResponseFromRepoToService(respRepo), nil
+}
+
 // UpdateUser modifies the details of an existing user in the database.
 func (p *userPGRepo) UpdateUser(
 	ctx context.Context,
@@ -96,6 +145,10 @@ func (p *userPGRepo) UpdateUser(
 
 	err = p.db.DB().ScanOneContext(ctx, &respRepo, q, paramsRepo.UserID, paramsRepo.Name, paramsRepo.Role)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return resp, model.ErrUserNotFound.WithResource(userResourceType, strconv.FormatInt(paramsRepo.UserID, 10))
+		}
+
 		return resp, errors.Wrapf(
 			err,
 			"Cannot update user(userID: %d)",
@@ -106,6 +159,32 @@ func (p *userPGRepo) UpdateUser(
 	return converter.ConvertUpdateUserResponseFromRepoToService(respRepo), nil
 }
 
+// UpdateUserPassword replaces the password hash of an existing user in the database.
+func (p *userPGRepo) UpdateUserPassword(
+	ctx context.Context,
+	params model.UpdateUserPasswordParams,
+) (err error) {
+	log.Infof("userPGRepo.UpdateUserPassword, userID: %d", params.UserID)
+
+	paramsRepo := converter.ConvertUpdateUserPasswordParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "userPGRepo.UpdateUserPassword",
+		QueryRaw: queryUpdateUserPassword,
+	}
+
+	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.UserID, paramsRepo.HashedPassword)
+	if err != nil {
+		return errors.Wrapf(
+			err,
+			"Cannot update user password(userID: %d)",
+			paramsRepo.UserID,
+		)
+	}
+
+	return nil
+}
+
 // DeleteUser removes a user from the database by their ID.
 func (p *userPGRepo) DeleteUser(
 	ctx context.Context,
@@ -120,7 +199,7 @@ func (p *userPGRepo) DeleteUser(
 		QueryRaw: queryDeleteUser,
 	}
 
-	_, err = p.db.DB().ExecContext(ctx, q, paramsRepo.UserID)
+	tag, err := p.db.DB().ExecContext(ctx, q, paramsRepo.UserID)
 	if err != nil {
 		return errors.Wrapf(
 			err,
@@ -129,5 +208,55 @@ func (p *userPGRepo) DeleteUser(
 		)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return model.ErrUserNotFound.WithResource(userResourceType, strconv.FormatInt(paramsRepo.UserID, 10))
+	}
+
 	return nil
 }
+
+// ListUsers retrieves a page of users after the cursor that match the filters, ordered by (created_at, id).
+func (p *userPGRepo) ListUsers(
+	ctx context.Context,
+	params model.ListUsersPageParams,
+) (resp model.ListUsersPageResponse, err error) {
+	log.Infof("userPGRepo.ListUsers, params: %+v", params)
+
+	paramsRepo := converter.ConvertListUsersPageParamsFromServiceToRepo(params)
+
+	q := db.Query{
+		Name:     "userPGRepo.ListUsers",
+		QueryRaw: queryListUsersDesc,
+	}
+
+	if paramsRepo.Ascending {
+		q.QueryRaw = queryListUsersAsc
+	}
+
+	var respRepo []modelRepo.User
+
+	err = p.db.DB().ScanAllContext(
+		ctx,
+		&respRepo,
+		q,
+		paramsRepo.Role,
+		paramsRepo.EmailPattern,
+		paramsRepo.CreatedFrom,
+		paramsRepo.CreatedTo,
+		paramsRepo.AfterCreatedAt,
+		paramsRepo.AfterUserID,
+		paramsRepo.Limit,
+	)
+	if err != nil {
+		return resp, errors.Wrap(err, "Cannot list users")
+	}
+
+	return converter.ConvertListUsersPageResponseFromRepoToService(respRepo), nil
+}
+
+// isUniqueViolation reports whether the error was caused by a unique constraint violation.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
@@ -3,7 +3,7 @@ package user
 const (
 	queryUpdateUser = `
 		UPDATE users.user
-		SET name = COALESCE(NULLIF($2, ''), name), role_id = $3, updated_at = now()
+		SET name = COALESCE(NULLIF($2, ''), name), role_id = $3, updated_at = now(), version = version + 1
 		WHERE id = $1
 		RETURNING
 			id
@@ -11,7 +11,14 @@ const (
 			, email
 			, role_id
 			, created_at
-			, updated_at;
+			, updated_at
+			, version;
+	`
+
+	queryUpdateUserPassword = `
+		UPDATE users.user
+		SET hashed_password = $2
+		WHERE id = $1;
 	`
 
 	queryCreateUser = `
@@ -25,7 +32,8 @@ const (
 			, email
 			, role_id
 			, created_at
-			, updated_at;
+			, updated_at
+			, version;
 	`
 
 	queryGetUser = `
@@ -36,10 +44,63 @@ const (
 			, role_id
 			, created_at
 			, updated_at
+			, version
 		FROM users.user
 		WHERE id = $1;
 	`
 
+	queryGetUserByEmail = `
+		SELECT
+			id
+			, name
+			, email
+			, hashed_password
+			, role_id
+			, created_at
+			, updated_at
+			, version
+		FROM users.user
+		WHERE email = $1;
+	`
+
+	queryListUsersAsc = `
+		SELECT
+			id
+			, name
+			, email
+			, role_id
+			, created_at
+			, updated_at
+			, version
+		FROM users.user
+		WHERE ($1::integer IS NULL OR role_id = $1)
+			AND ($2::text = '' OR email LIKE $2)
+			AND ($3::timestamp IS NULL OR created_at >= $3)
+			AND ($4::timestamp IS NULL OR created_at < $4)
+			AND ($5::timestamp IS NULL OR (created_at, id) > ($5, $6))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $7;
+	`
+
+	queryListUsersDesc = `
+		SELECT
+			id
+			, name
+			, email
+			, role_id
+			, created_at
+			, updated_at
+			, version
+		FROM users.user
+		WHERE ($1::integer IS NULL OR role_id = $1)
+			AND ($2::text = '' OR email LIKE $2)
+			AND ($3::timestamp IS NULL OR created_at >= $3)
+			AND ($4::timestamp IS NULL OR created_at < $4)
+			AND ($5::timestamp IS NULL OR (created_at, id) < ($5, $6))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $7;
+	`
+
 	queryDeleteUser = `
 		DELETE FROM users.user
 		WHERE id = $1;
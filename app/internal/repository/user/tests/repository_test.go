@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	userRepository "github.com/Prrromanssss/auth/internal/repository/user"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+var (
+	returningRe = regexp.MustCompile(`(?is)\bRETURNING\b(.*?);`)
+	selectRe    = regexp.MustCompile(`(?is)\bSELECT\b(.*?)\bFROM\b`)
+)
+
+// rowDB scans a single row into the destination the way scany does: only the fields
+// whose columns are returned by the query are set.
+type rowDB struct {
+	db.DB
+	row map[string]interface{}
+}
+
+func (d *rowDB) ScanOneContext(_ context.Context, dest interface{}, q db.Query, _ ...interface{}) error {
+	matches := returningRe.FindStringSubmatch(q.QueryRaw)
+	if matches == nil {
+		matches = selectRe.FindStringSubmatch(q.QueryRaw)
+	}
+
+	columns := make(map[string]bool)
+	for _, column := range strings.Split(matches[1], ",") {
+		columns[strings.TrimSpace(column)] = true
+	}
+
+	v := reflect.ValueOf(dest).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		column := v.Type().Field(i).Tag.Get("db")
+		if columns[column] {
+			v.Field(i).Set(reflect.ValueOf(d.row[column]))
+		}
+	}
+
+	return nil
+}
+
+type rowClient struct {
+	db *rowDB
+}
+
+func (c *rowClient) DB() db.DB {
+	return c.db
+}
+
+func (c *rowClient) Close() error {
+	return nil
+}
+
+// TestReturnedColumns checks that the queries return every column of the user,
+// in particular the version the cache orders writes by.
+func TestReturnedColumns(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		user = model.User{
+			UserID:    gofakeit.Int64(),
+			Name:      gofakeit.Name(),
+			Email:     gofakeit.Email(),
+			Role:      int64(pb.Role_USER),
+			CreatedAt: gofakeit.Date().UTC(),
+			UpdatedAt: gofakeit.Date().UTC(),
+			Version:   gofakeit.Int64(),
+		}
+
+		repo = userRepository.NewRepository(&rowClient{db: &rowDB{row: map[string]interface{}{
+			"id":              user.UserID,
+			"name":            user.Name,
+			"email":           user.Email,
+			"hashed_password": gofakeit.UUID(),
+			"role_id":         user.Role,
+			"created_at":      user.CreatedAt,
+			"updated_at":      user.UpdatedAt,
+			"version":         user.Version,
+		}}})
+	)
+
+	tests := []struct {
+		name string
+		call func() (model.User, error)
+	}{
+		{
+			name: "create user",
+			call: func() (model.User, error) {
+				resp, err := repo.CreateUser(ctx, model.CreateUserParams{
+					Name:           user.Name,
+					Email:          user.Email,
+					HashedPassword: gofakeit.UUID(),
+					Role:           user.Role,
+				})
+
+				return resp.User, err
+			},
+		},
+		{
+			name: "get user",
+			call: func() (model.User, error) {
+				resp, err := repo.GetUser(ctx, model.GetUserParams{UserID: user.UserID})
+
+				return resp.User, err
+			},
+		},
+		{
+			name: "get user by email",
+			call: func() (model.User, error) {
+				resp, err := repo.GetUserByEmail(ctx, model.GetUserByEmailParams{Email: user.Email})
+
+				return resp.User, err
+			},
+		},
+		{
+			name: "update user",
+			call: func() (model.User, error) {
+				resp, err := repo.UpdateUser(ctx, model.UpdateUserParams{
+					UserID: user.UserID,
+					Name:   &user.Name,
+					Role:   user.Role,
+				})
+
+				return resp.User, err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.call()
+			require.NoError(t, err)
+			require.Equal(t, user, got)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package access
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+	modelCache "github.com/Prrromanssss/auth/internal/cache/access/model"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/service"
+	"github.com/Prrromanssss/auth/internal/token"
+)
+
+type accessService struct {
+	accessRepository repository.AccessRepository
+	accessCache      cache.AccessCache
+	tokenManager     token.TokenManager
+}
+
+// NewService creates a new instance of accessService with the provided AccessRepository, AccessCache and TokenManager.
+func NewService(
+	accessRepository repository.AccessRepository,
+	accessCache cache.AccessCache,
+	tokenManager token.TokenManager,
+) service.AccessService {
+	return &accessService{
+		accessRepository: accessRepository,
+		accessCache:      accessCache,
+		tokenManager:     tokenManager,
+	}
+}
+
+// Check verifies the access token and evaluates the role from its claims against the access rules of the endpoint.
+// Decisions are served from the cache when possible and stored in it after a lookup in the database.
+func (s *accessService) Check(ctx context.Context, params model.CheckParams) (err error) {
+	log.Infof("accessService.Check, endpoint: %s", params.EndpointAddress)
+
+	claims, err := s.tokenManager.VerifyAccessToken(params.AccessToken)
+	if err != nil {
+		log.Warnf("Failed to verify access token, err: %v", err)
+		return model.ErrInvalidAccessToken
+	}
+
+	checkParams := model.CheckAccessParams{
+		EndpointAddress: params.EndpointAddress,
+		Role:            claims.Role,
+	}
+
+	decision, cacheErr := s.accessCache.Get(ctx, checkParams)
+	if cacheErr != nil && !errors.Is(cacheErr, modelCache.ErrAccessDecisionNotFound) {
+		log.Warnf("Failed to get access decision from cache, params: %+v, err: %+v", checkParams, cacheErr)
+	} else if cacheErr == nil {
+		log.Infof("Access decision retrieved from cache, params: %+v", checkParams)
+		return checkDecision(decision)
+	}
+
+	allowed, err := s.accessRepository.CheckAccess(ctx, checkParams)
+	if err != nil {
+		return err
+	}
+
+	decision = model.AccessDecision{
+		EndpointAddress: checkParams.EndpointAddress,
+		Role:            checkParams.Role,
+		Allowed:         allowed,
+	}
+
+	if errors.Is(cacheErr, modelCache.ErrAccessDecisionNotFound) {
+		cacheErr = s.accessCache.Set(ctx, decision)
+		if cacheErr != nil {
+			log.Warnf("Failed to set access decision in cache, params: %+v, err: %+v", decision, cacheErr)
+		}
+	}
+
+	return checkDecision(decision)
+}
+
+func checkDecision(decision model.AccessDecision) error {
+	if !decision.Allowed {
+		return model.ErrAccessDenied
+	}
+
+	return nil
+}
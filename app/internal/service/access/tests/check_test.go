@@ -0,0 +1,274 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/cache"
+	modelCache "github.com/Prrromanssss/auth/internal/cache/access/model"
+	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	accessService "github.com/Prrromanssss/auth/internal/service/access"
+	"github.com/Prrromanssss/auth/internal/token"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	type (
+		accessRepositoryMockFunc func(mc *minimock.Controller) repository.AccessRepository
+		accessCacheMockFunc      func(mc *minimock.Controller) cache.AccessCache
+		tokenManagerMockFunc     func(mc *minimock.Controller) token.TokenManager
+	)
+
+	type args struct {
+		ctx context.Context
+		req model.CheckParams
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id              = gofakeit.Int64()
+		role            = int64(pb.Role_USER)
+		accessToken     = gofakeit.UUID()
+		endpointAddress = "/user_v1.UserV1/Get"
+
+		ErrTokenManager     = errors.New("token manager error")
+		ErrAccessRepository = errors.New("access repository error")
+		ErrAccessCache      = errors.New("access cache error")
+
+		req = model.CheckParams{
+			AccessToken:     accessToken,
+			EndpointAddress: endpointAddress,
+		}
+
+		claims = &model.UserClaims{
+			UserID: id,
+			Role:   role,
+		}
+
+		checkParams = model.CheckAccessParams{
+			EndpointAddress: endpointAddress,
+			Role:            role,
+		}
+
+		allowedDecision = model.AccessDecision{
+			EndpointAddress: endpointAddress,
+			Role:            role,
+			Allowed:         true,
+		}
+
+		deniedDecision = model.AccessDecision{
+			EndpointAddress: endpointAddress,
+			Role:            role,
+			Allowed:         false,
+		}
+
+		tokenManagerMock = func(mc *minimock.Controller) token.TokenManager {
+			mock := tokenMocks.NewTokenManagerMock(mc)
+			mock.VerifyAccessTokenMock.Expect(accessToken).Return(claims, nil)
+
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		args                 args
+		err                  error
+		accessRepositoryMock accessRepositoryMockFunc
+		accessCacheMock      accessCacheMockFunc
+		tokenManagerMock     tokenManagerMockFunc
+	}{
+		{
+			name: "success case from cache",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				return repositoryMocks.NewAccessRepositoryMock(mc)
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(allowedDecision, nil)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+		{
+			name: "success case from repository",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.CheckAccessMock.Expect(ctx, checkParams).Return(true, nil)
+
+				return mock
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(model.AccessDecision{}, modelCache.ErrAccessDecisionNotFound)
+				mock.SetMock.Expect(ctx, allowedDecision).Return(nil)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+		{
+			name: "cache error falls back to repository",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.CheckAccessMock.Expect(ctx, checkParams).Return(true, nil)
+
+				return mock
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(model.AccessDecision{}, ErrAccessCache)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+		{
+			name: "access denied from cache",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: model.ErrAccessDenied,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				return repositoryMocks.NewAccessRepositoryMock(mc)
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(deniedDecision, nil)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+		{
+			name: "access denied from repository",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: model.ErrAccessDenied,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.CheckAccessMock.Expect(ctx, checkParams).Return(false, nil)
+
+				return mock
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(model.AccessDecision{}, modelCache.ErrAccessDecisionNotFound)
+				mock.SetMock.Expect(ctx, deniedDecision).Return(nil)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+		{
+			name: "invalid access token",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: model.ErrInvalidAccessToken,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				return repositoryMocks.NewAccessRepositoryMock(mc)
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				return cacheMocks.NewAccessCacheMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyAccessTokenMock.Expect(accessToken).Return(nil, ErrTokenManager)
+
+				return mock
+			},
+		},
+		{
+			name: "access repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: ErrAccessRepository,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.CheckAccessMock.Expect(ctx, checkParams).Return(false, ErrAccessRepository)
+
+				return mock
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(model.AccessDecision{}, modelCache.ErrAccessDecisionNotFound)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+		{
+			name: "cache set error is ignored",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: nil,
+			accessRepositoryMock: func(mc *minimock.Controller) repository.AccessRepository {
+				mock := repositoryMocks.NewAccessRepositoryMock(mc)
+				mock.CheckAccessMock.Expect(ctx, checkParams).Return(true, nil)
+
+				return mock
+			},
+			accessCacheMock: func(mc *minimock.Controller) cache.AccessCache {
+				mock := cacheMocks.NewAccessCacheMock(mc)
+				mock.GetMock.Expect(ctx, checkParams).Return(model.AccessDecision{}, modelCache.ErrAccessDecisionNotFound)
+				mock.SetMock.Expect(ctx, allowedDecision).Return(ErrAccessCache)
+
+				return mock
+			},
+			tokenManagerMock: tokenManagerMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := accessService.NewService(
+				tt.accessRepositoryMock(mc),
+				tt.accessCacheMock(mc),
+				tt.tokenManagerMock(mc),
+			)
+
+			err := service.Check(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
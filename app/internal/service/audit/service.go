@@ -0,0 +1,124 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/pagination"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/service"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+)
+
+type auditService struct {
+	logRepository  repository.LogRepository
+	pageTokenCodec pagination.PageTokenCodec
+}
+
+// NewService creates a new instance of auditService with the provided LogRepository and PageTokenCodec.
+func NewService(
+	logRepository repository.LogRepository,
+	pageTokenCodec pagination.PageTokenCodec,
+) service.AuditService {
+	return &auditService{
+		logRepository:  logRepository,
+		pageTokenCodec: pageTokenCodec,
+	}
+}
+
+// ListAuditEvents retrieves a page of audit events that match the filters using keyset pagination
+// on (created_at, id), newest first. The page token is bound to the filters it was issued for.
+func (s *auditService) ListAuditEvents(
+	ctx context.Context,
+	params model.ListAuditEventsParams,
+) (resp model.ListAuditEventsResponse, err error) {
+	log.Infof("auditService.ListAuditEvents, params: %+v", params)
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	scope := listAuditEventsScope(params)
+
+	var after *model.AuditEventCursor
+
+	if params.PageToken != "" {
+		after = &model.AuditEventCursor{}
+
+		err = s.pageTokenCodec.Decode(params.PageToken, scope, after)
+		if err != nil {
+			return model.ListAuditEventsResponse{}, err
+		}
+	}
+
+	// One extra row tells whether there is a next page without a separate count query.
+	page, err := s.logRepository.ListAuditEvents(ctx, model.ListAuditEventsPageParams{
+		Filter: params.Filter,
+		After:  after,
+		Limit:  pageSize + 1,
+	})
+	if err != nil {
+		return model.ListAuditEventsResponse{}, err
+	}
+
+	resp.Events = page.Events
+
+	if int64(len(page.Events)) > pageSize {
+		resp.Events = page.Events[:pageSize]
+		last := resp.Events[pageSize-1]
+
+		resp.NextPageToken, err = s.pageTokenCodec.Encode(model.AuditEventCursor{
+			CreatedAt: last.CreatedAt,
+			EventID:   last.EventID,
+		}, scope)
+		if err != nil {
+			return model.ListAuditEventsResponse{}, err
+		}
+	}
+
+	return resp, nil
+}
+
+// listAuditEventsScope describes the query a page token belongs to.
+func listAuditEventsScope(params model.ListAuditEventsParams) string {
+	var actorID, targetUserID, from, to string
+
+	if params.Filter.ActorID != nil {
+		actorID = strconv.FormatInt(*params.Filter.ActorID, 10)
+	}
+
+	if params.Filter.TargetUserID != nil {
+		targetUserID = strconv.FormatInt(*params.Filter.TargetUserID, 10)
+	}
+
+	if params.Filter.From != nil {
+		from = params.Filter.From.UTC().Format(time.RFC3339Nano)
+	}
+
+	if params.Filter.To != nil {
+		to = params.Filter.To.UTC().Format(time.RFC3339Nano)
+	}
+
+	return fmt.Sprintf(
+		"audit_events:actor=%s;target=%s;action=%q;from=%s;to=%s",
+		actorID,
+		targetUserID,
+		params.Filter.Action,
+		from,
+		to,
+	)
+}
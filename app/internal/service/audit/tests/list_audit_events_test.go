@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/pagination"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	auditService "github.com/Prrromanssss/auth/internal/service/audit"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	type (
+		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
+		pageTokenCodecMockFunc func(mc *minimock.Controller) pagination.PageTokenCodec
+	)
+
+	type args struct {
+		ctx context.Context
+		req model.ListAuditEventsParams
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		actorID   = gofakeit.Int64()
+		from      = time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		pageToken = gofakeit.UUID()
+		nextToken = gofakeit.UUID()
+
+		ErrLogRepository = errors.New("log repository error")
+
+		filter = model.ListAuditEventsFilter{
+			ActorID: &actorID,
+			Action:  "Delete",
+			From:    &from,
+		}
+
+		events = []model.AuditEvent{
+			{EventID: 3, Action: "Delete", ActorID: &actorID, CreatedAt: gofakeit.Date()},
+			{EventID: 2, Action: "Delete", ActorID: &actorID, CreatedAt: gofakeit.Date()},
+			{EventID: 1, Action: "Delete", ActorID: &actorID, CreatedAt: gofakeit.Date()},
+		}
+
+		cursor = model.AuditEventCursor{
+			CreatedAt: events[0].CreatedAt,
+			EventID:   events[0].EventID,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               model.ListAuditEventsResponse
+		err                error
+		logRepositoryMock  logRepositoryMockFunc
+		pageTokenCodecMock pageTokenCodecMockFunc
+	}{
+		{
+			name: "first page with next page",
+			args: args{
+				ctx: ctx,
+				req: model.ListAuditEventsParams{PageSize: 2, Filter: filter},
+			},
+			want: model.ListAuditEventsResponse{
+				Events:        events[:2],
+				NextPageToken: nextToken,
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAuditEventsMock.Expect(ctx, model.ListAuditEventsPageParams{
+					Filter: filter,
+					Limit:  3,
+				}).Return(model.ListAuditEventsPageResponse{Events: events}, nil)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				mock := paginationMocks.NewPageTokenCodecMock(mc)
+				mock.EncodeMock.Set(func(c interface{}, scope string) (string, error) {
+					require.Equal(t, model.AuditEventCursor{CreatedAt: events[1].CreatedAt, EventID: events[1].EventID}, c)
+					require.Contains(t, scope, "audit_events:")
+
+					return nextToken, nil
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "last page after cursor",
+			args: args{
+				ctx: ctx,
+				req: model.ListAuditEventsParams{
+					PageSize:  2,
+					PageToken: pageToken,
+					Filter:    filter,
+				},
+			},
+			want: model.ListAuditEventsResponse{
+				Events: events[1:],
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAuditEventsMock.Expect(ctx, model.ListAuditEventsPageParams{
+					Filter: filter,
+					After:  &cursor,
+					Limit:  3,
+				}).Return(model.ListAuditEventsPageResponse{Events: events[1:]}, nil)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				mock := paginationMocks.NewPageTokenCodecMock(mc)
+				mock.DecodeMock.Set(func(token string, scope string, c interface{}) error {
+					require.Equal(t, pageToken, token)
+					require.Contains(t, scope, "audit_events:")
+					*c.(*model.AuditEventCursor) = cursor
+
+					return nil
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "default page size",
+			args: args{
+				ctx: ctx,
+				req: model.ListAuditEventsParams{},
+			},
+			want: model.ListAuditEventsResponse{
+				Events: events,
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAuditEventsMock.Expect(ctx, model.ListAuditEventsPageParams{
+					Limit: 51,
+				}).Return(model.ListAuditEventsPageResponse{Events: events}, nil)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				return paginationMocks.NewPageTokenCodecMock(mc)
+			},
+		},
+		{
+			name: "invalid page token",
+			args: args{
+				ctx: ctx,
+				req: model.ListAuditEventsParams{PageToken: pageToken},
+			},
+			want: model.ListAuditEventsResponse{},
+			err:  model.ErrInvalidPageToken,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repositoryMocks.NewLogRepositoryMock(mc)
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				mock := paginationMocks.NewPageTokenCodecMock(mc)
+				mock.DecodeMock.Return(model.ErrInvalidPageToken)
+
+				return mock
+			},
+		},
+		{
+			name: "log repository error",
+			args: args{
+				ctx: ctx,
+				req: model.ListAuditEventsParams{PageSize: 2},
+			},
+			want: model.ListAuditEventsResponse{},
+			err:  ErrLogRepository,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAuditEventsMock.Expect(ctx, model.ListAuditEventsPageParams{
+					Limit: 3,
+				}).Return(model.ListAuditEventsPageResponse{}, ErrLogRepository)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				return paginationMocks.NewPageTokenCodecMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := auditService.NewService(
+				tt.logRepositoryMock(mc),
+				tt.pageTokenCodecMock(mc),
+			)
+
+			resp, err := service.ListAuditEvents(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package auditchain
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+)
+
+type service struct {
+	cfg                    *config.Config
+	logRepository          repository.LogRepository
+	auditArchiveRepository repository.AuditArchiveRepository
+	signingKey             ed25519.PrivateKey
+}
+
+// NewService creates a new instance of the audit chain service that signs checkpoints with the given key.
+func NewService(
+	cfg *config.Config,
+	logRepository repository.LogRepository,
+	auditArchiveRepository repository.AuditArchiveRepository,
+	signingKey ed25519.PrivateKey,
+) *service {
+	return &service{
+		cfg:                    cfg,
+		logRepository:          logRepository,
+		auditArchiveRepository: auditArchiveRepository,
+		signingKey:             signingKey,
+	}
+}
+
+// RunCheckpoints creates a checkpoint of the audit chain every checkpoint interval until the context is done.
+func (s *service) RunCheckpoints(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.AuditChain.CheckpointInterval)
+	defer ticker.Stop()
+
+	for {
+		_, err := s.CreateCheckpoint(ctx)
+		if err != nil {
+			log.Warnf("Failed to create audit checkpoint, err: %+v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CreateCheckpoint signs the current head of the audit chain and stores it, unless the chain is empty
+// or has not moved since the last checkpoint.
+func (s *service) CreateCheckpoint(ctx context.Context) (created bool, err error) {
+	head, err := s.logRepository.GetAuditChainHead(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if head.EventID == 0 {
+		return false, nil
+	}
+
+	// The time is stored with the precision of the database, so that the signed statement can be rebuilt from it.
+	checkpoint := model.AuditCheckpoint{
+		EventID:   head.EventID,
+		Hash:      head.Hash,
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}
+	checkpoint.Signature = audit.SignCheckpoint(s.signingKey, checkpoint)
+
+	created, err = s.logRepository.CreateAuditCheckpoint(ctx, checkpoint)
+	if err != nil {
+		return false, err
+	}
+
+	if created {
+		log.Infof("Audit checkpoint created, eventID: %d", checkpoint.EventID)
+	}
+
+	return created, nil
+}
+
+// ListCheckpoints retrieves the checkpoints of the audit chain after a checkpoint, oldest first.
+func (s *service) ListCheckpoints(
+	ctx context.Context,
+	params model.ListAuditCheckpointsParams,
+) (resp []model.AuditCheckpoint, err error) {
+	if params.Limit <= 0 {
+		params.Limit = s.cfg.AuditChain.VerifyBatchSize
+	}
+
+	return s.logRepository.ListAuditCheckpoints(ctx, params)
+}
+
+// VerifyChain walks the audit chain from the first record and reports the first record that does not verify:
+// a record whose content does not match its hash, a record that is not chained to the one before it,
+// which is how removed and inserted records show up, or a record that does not match a signed checkpoint.
+// Checkpoints also reveal records removed from the end of the chain.
+// Records whose previous record was archived by the retention job are chained to the hash recorded
+// for them at archive time, and the checkpoints of archived records are not expected to be found.
+func (s *service) VerifyChain(ctx context.Context) (resp model.VerifyAuditChainResponse, err error) {
+	checkpoints, err := s.listAllCheckpoints(ctx)
+	if err != nil {
+		return model.VerifyAuditChainResponse{}, err
+	}
+
+	seams, err := s.auditArchiveRepository.ListAuditChainSeams(ctx)
+	if err != nil {
+		return model.VerifyAuditChainResponse{}, err
+	}
+
+	maxArchivedEventID, err := s.auditArchiveRepository.GetMaxArchivedEventID(ctx)
+	if err != nil {
+		return model.VerifyAuditChainResponse{}, err
+	}
+
+	archivedPrevHashes := make(map[int64][]byte, len(seams))
+	for _, seam := range seams {
+		archivedPrevHashes[seam.EventID] = seam.PrevHash
+	}
+
+	publicKey, _ := s.signingKey.Public().(ed25519.PublicKey)
+	pending := make(map[int64][]model.AuditCheckpoint, len(checkpoints))
+
+	for _, checkpoint := range checkpoints {
+		if !audit.VerifyCheckpoint(publicKey, checkpoint) {
+			resp.Break = &model.AuditChainBreak{
+				EventID: checkpoint.EventID,
+				Reason:  fmt.Sprintf("checkpoint %d has an invalid signature", checkpoint.CheckpointID),
+			}
+
+			return resp, nil
+		}
+
+		pending[checkpoint.EventID] = append(pending[checkpoint.EventID], checkpoint)
+	}
+
+	var (
+		prevHash     []byte
+		chained      bool
+		afterEventID int64
+	)
+
+	for {
+		links, listErr := s.logRepository.ListAuditChain(ctx, model.ListAuditChainParams{
+			AfterEventID: afterEventID,
+			Limit:        s.cfg.AuditChain.VerifyBatchSize,
+		})
+		if listErr != nil {
+			return model.VerifyAuditChainResponse{}, listErr
+		}
+
+		for _, link := range links {
+			afterEventID = link.EventID
+
+			// The records written before the chain was introduced have no hashes.
+			if link.Hash == nil && !chained {
+				resp.Unchained++
+				continue
+			}
+
+			reason := verifyLink(prevHash, archivedPrevHashes, link)
+			if reason == "" {
+				for _, checkpoint := range pending[link.EventID] {
+					if !bytes.Equal(checkpoint.Hash, link.Hash) {
+						reason = fmt.Sprintf("record does not match checkpoint %d", checkpoint.CheckpointID)
+						break
+					}
+
+					resp.Checkpoints++
+				}
+			}
+
+			if reason != "" {
+				resp.Break = &model.AuditChainBreak{EventID: link.EventID, Reason: reason}
+				return resp, nil
+			}
+
+			delete(pending, link.EventID)
+
+			chained = true
+			prevHash = link.Hash
+			resp.Records++
+		}
+
+		if int64(len(links)) < s.cfg.AuditChain.VerifyBatchSize {
+			break
+		}
+	}
+
+	missing := make([]int64, 0, len(pending))
+	for eventID := range pending {
+		if eventID > maxArchivedEventID {
+			missing = append(missing, eventID)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+
+		resp.Break = &model.AuditChainBreak{
+			EventID: missing[0],
+			Reason:  fmt.Sprintf("record of checkpoint %d is missing", pending[missing[0]][0].CheckpointID),
+		}
+	}
+
+	return resp, nil
+}
+
+// verifyLink returns why the record does not verify against the hash of the record before it, if it does not.
+// The record before one on a seam was archived, so its hash is taken from the seam.
+func verifyLink(prevHash []byte, archivedPrevHashes map[int64][]byte, link model.AuditChainLink) string {
+	archivedPrevHash, onSeam := archivedPrevHashes[link.EventID]
+
+	switch {
+	case link.Hash == nil:
+		return "record is not chained"
+	case !bytes.Equal(link.PrevHash, prevHash) && !(onSeam && bytes.Equal(link.PrevHash, archivedPrevHash)):
+		return "previous hash does not match the record before it"
+	case !bytes.Equal(audit.ChainHash(link.PrevHash, link), link.Hash):
+		return "record does not match its hash"
+	default:
+		return ""
+	}
+}
+
+func (s *service) listAllCheckpoints(ctx context.Context) ([]model.AuditCheckpoint, error) {
+	var (
+		checkpoints       []model.AuditCheckpoint
+		afterCheckpointID int64
+	)
+
+	for {
+		page, err := s.ListCheckpoints(ctx, model.ListAuditCheckpointsParams{
+			AfterCheckpointID: afterCheckpointID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		checkpoints = append(checkpoints, page...)
+
+		if int64(len(page)) < s.cfg.AuditChain.VerifyBatchSize {
+			return checkpoints, nil
+		}
+
+		afterCheckpointID = page[len(page)-1].CheckpointID
+	}
+}
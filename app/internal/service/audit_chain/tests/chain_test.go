@@ -0,0 +1,404 @@
+package tests
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	auditChain "github.com/Prrromanssss/auth/internal/service/audit_chain"
+)
+
+const verifyBatchSize = 2
+
+func newConfig() *config.Config {
+	return &config.Config{
+		AuditChain: yaml.AuditChain{
+			CheckpointInterval: time.Hour,
+			VerifyBatchSize:    verifyBatchSize,
+		},
+	}
+}
+
+func newSigningKey(t *testing.T) ed25519.PrivateKey {
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	return key
+}
+
+// newChain returns the records written before the chain followed by the chained records.
+func newChain(unchained, chained int) []model.AuditChainLink {
+	links := make([]model.AuditChainLink, 0, unchained+chained)
+
+	var prevHash []byte
+
+	for i := 0; i < unchained+chained; i++ {
+		targetUserID := gofakeit.Int64()
+		requestID := gofakeit.UUID()
+
+		link := model.AuditChainLink{
+			EventID:      int64(i + 1),
+			Action:       "Get",
+			RequestData:  `{"UserID": 1}`,
+			TargetUserID: &targetUserID,
+			StatusCode:   model.AuditStatusOK,
+			RequestID:    &requestID,
+			CreatedAt:    gofakeit.Date().UTC().Truncate(time.Microsecond),
+		}
+
+		if i >= unchained {
+			link.PrevHash = prevHash
+			link.Hash = audit.ChainHash(prevHash, link)
+			prevHash = link.Hash
+		}
+
+		links = append(links, link)
+	}
+
+	return links
+}
+
+// rehash recomputes the hashes of the chain from the given record on, as someone rewriting the chain would.
+func rehash(links []model.AuditChainLink, from int) {
+	var prevHash []byte
+	if from > 0 {
+		prevHash = links[from-1].Hash
+	}
+
+	for i := from; i < len(links); i++ {
+		links[i].PrevHash = prevHash
+		links[i].Hash = audit.ChainHash(prevHash, links[i])
+		prevHash = links[i].Hash
+	}
+}
+
+func newCheckpoint(key ed25519.PrivateKey, checkpointID int64, link model.AuditChainLink) model.AuditCheckpoint {
+	checkpoint := model.AuditCheckpoint{
+		CheckpointID: checkpointID,
+		EventID:      link.EventID,
+		Hash:         link.Hash,
+		CreatedAt:    gofakeit.Date().UTC().Truncate(time.Microsecond),
+	}
+	checkpoint.Signature = audit.SignCheckpoint(key, checkpoint)
+
+	return checkpoint
+}
+
+// newLogRepositoryMock serves the records and the checkpoints page by page, like the database does.
+func newLogRepositoryMock(
+	mc *minimock.Controller,
+	links []model.AuditChainLink,
+	checkpoints []model.AuditCheckpoint,
+) *repositoryMocks.LogRepositoryMock {
+	mock := repositoryMocks.NewLogRepositoryMock(mc)
+	mock.ListAuditChainMock.Optional().Set(func(
+		_ context.Context,
+		params model.ListAuditChainParams,
+	) ([]model.AuditChainLink, error) {
+		var page []model.AuditChainLink
+		for _, link := range links {
+			if link.EventID > params.AfterEventID && int64(len(page)) < params.Limit {
+				page = append(page, link)
+			}
+		}
+
+		return page, nil
+	})
+	mock.ListAuditCheckpointsMock.Set(func(
+		_ context.Context,
+		params model.ListAuditCheckpointsParams,
+	) ([]model.AuditCheckpoint, error) {
+		var page []model.AuditCheckpoint
+		for _, checkpoint := range checkpoints {
+			if checkpoint.CheckpointID > params.AfterCheckpointID && int64(len(page)) < params.Limit {
+				page = append(page, checkpoint)
+			}
+		}
+
+		return page, nil
+	})
+
+	return mock
+}
+
+// newAuditArchiveRepositoryMock serves the seams left by the archived records.
+func newAuditArchiveRepositoryMock(
+	mc *minimock.Controller,
+	seams []model.AuditChainSeam,
+	maxArchivedEventID int64,
+) *repositoryMocks.AuditArchiveRepositoryMock {
+	mock := repositoryMocks.NewAuditArchiveRepositoryMock(mc)
+	mock.ListAuditChainSeamsMock.Optional().Return(seams, nil)
+	mock.GetMaxArchivedEventIDMock.Optional().Return(maxArchivedEventID, nil)
+
+	return mock
+}
+
+func TestVerifyChain(t *testing.T) {
+	t.Parallel()
+
+	type logRepositoryMockFunc func(mc *minimock.Controller) repository.LogRepository
+	type auditArchiveRepositoryMockFunc func(mc *minimock.Controller) repository.AuditArchiveRepository
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		key      = newSigningKey(t)
+		otherKey = newSigningKey(t)
+
+		// The records of the chain up to the third one were archived with their partition.
+		archivedChain = newChain(0, 6)
+		archiveSeams  = []model.AuditChainSeam{
+			{EventID: archivedChain[3].EventID, PrevHash: archivedChain[3].PrevHash},
+		}
+
+		ErrLogRepository = errors.New("log repository error")
+	)
+
+	tests := []struct {
+		name                       string
+		want                       model.VerifyAuditChainResponse
+		err                        error
+		logRepositoryMock          logRepositoryMockFunc
+		auditArchiveRepositoryMock auditArchiveRepositoryMockFunc
+	}{
+		{
+			name: "success case: chain and checkpoints verified",
+			want: model.VerifyAuditChainResponse{Records: 4, Unchained: 2, Checkpoints: 2},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(2, 4)
+
+				return newLogRepositoryMock(mc, links, []model.AuditCheckpoint{
+					newCheckpoint(key, 1, links[3]),
+					newCheckpoint(key, 2, links[5]),
+				})
+			},
+		},
+		{
+			name: "success case: empty log",
+			want: model.VerifyAuditChainResponse{},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				return newLogRepositoryMock(mc, nil, nil)
+			},
+		},
+		{
+			name: "modified record",
+			want: model.VerifyAuditChainResponse{
+				Records:   1,
+				Unchained: 1,
+				Break:     &model.AuditChainBreak{EventID: 3, Reason: "record does not match its hash"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(1, 3)
+				links[2].RequestData = `{"UserID": 2}`
+
+				return newLogRepositoryMock(mc, links, nil)
+			},
+		},
+		{
+			name: "record set to NULL",
+			want: model.VerifyAuditChainResponse{
+				Records: 1,
+				Break:   &model.AuditChainBreak{EventID: 2, Reason: "record does not match its hash"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 3)
+				links[1].RequestID = nil
+
+				return newLogRepositoryMock(mc, links, nil)
+			},
+		},
+		{
+			name: "removed record",
+			want: model.VerifyAuditChainResponse{
+				Records: 1,
+				Break:   &model.AuditChainBreak{EventID: 3, Reason: "previous hash does not match the record before it"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 3)
+
+				return newLogRepositoryMock(mc, append(links[:1], links[2:]...), nil)
+			},
+		},
+		{
+			name: "removed first record",
+			want: model.VerifyAuditChainResponse{
+				Break: &model.AuditChainBreak{EventID: 2, Reason: "previous hash does not match the record before it"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 3)
+
+				return newLogRepositoryMock(mc, links[1:], nil)
+			},
+		},
+		{
+			name: "record without hash in the chain",
+			want: model.VerifyAuditChainResponse{
+				Records: 2,
+				Break:   &model.AuditChainBreak{EventID: 3, Reason: "record is not chained"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 3)
+				links[2].Hash = nil
+
+				return newLogRepositoryMock(mc, links, nil)
+			},
+		},
+		{
+			name: "chain rewritten after checkpoint",
+			want: model.VerifyAuditChainResponse{
+				Records: 2,
+				Break:   &model.AuditChainBreak{EventID: 3, Reason: "record does not match checkpoint 1"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 4)
+				checkpoint := newCheckpoint(key, 1, links[2])
+
+				links[1].RequestData = `{"UserID": 2}`
+				rehash(links, 1)
+
+				return newLogRepositoryMock(mc, links, []model.AuditCheckpoint{checkpoint})
+			},
+		},
+		{
+			name: "records removed from the end",
+			want: model.VerifyAuditChainResponse{
+				Records:     2,
+				Checkpoints: 1,
+				Break:       &model.AuditChainBreak{EventID: 4, Reason: "record of checkpoint 2 is missing"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 4)
+
+				return newLogRepositoryMock(mc, links[:2], []model.AuditCheckpoint{
+					newCheckpoint(key, 1, links[1]),
+					newCheckpoint(key, 2, links[3]),
+				})
+			},
+		},
+		{
+			name: "forged checkpoint",
+			want: model.VerifyAuditChainResponse{
+				Break: &model.AuditChainBreak{EventID: 2, Reason: "checkpoint 1 has an invalid signature"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				links := newChain(0, 2)
+
+				return newLogRepositoryMock(mc, links, []model.AuditCheckpoint{
+					newCheckpoint(otherKey, 1, links[1]),
+				})
+			},
+		},
+		{
+			name: "success case: oldest partition archived",
+			want: model.VerifyAuditChainResponse{Records: 3, Checkpoints: 1},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				return newLogRepositoryMock(mc, archivedChain[3:], []model.AuditCheckpoint{
+					newCheckpoint(key, 1, archivedChain[1]),
+					newCheckpoint(key, 2, archivedChain[5]),
+				})
+			},
+			auditArchiveRepositoryMock: func(mc *minimock.Controller) repository.AuditArchiveRepository {
+				return newAuditArchiveRepositoryMock(mc, archiveSeams, archivedChain[2].EventID)
+			},
+		},
+		{
+			name: "removed record after archived partition",
+			want: model.VerifyAuditChainResponse{
+				Break: &model.AuditChainBreak{EventID: 5, Reason: "previous hash does not match the record before it"},
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				return newLogRepositoryMock(mc, archivedChain[4:], nil)
+			},
+			auditArchiveRepositoryMock: func(mc *minimock.Controller) repository.AuditArchiveRepository {
+				return newAuditArchiveRepositoryMock(mc, archiveSeams, archivedChain[2].EventID)
+			},
+		},
+		{
+			name: "log repository error",
+			want: model.VerifyAuditChainResponse{},
+			err:  ErrLogRepository,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.ListAuditCheckpointsMock.Return(nil, nil)
+				mock.ListAuditChainMock.Return(nil, ErrLogRepository)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			auditArchiveRepositoryMock := repository.AuditArchiveRepository(newAuditArchiveRepositoryMock(mc, nil, 0))
+			if tt.auditArchiveRepositoryMock != nil {
+				auditArchiveRepositoryMock = tt.auditArchiveRepositoryMock(mc)
+			}
+
+			service := auditChain.NewService(newConfig(), tt.logRepositoryMock(mc), auditArchiveRepositoryMock, key)
+
+			resp, err := service.VerifyChain(ctx)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
+
+func TestCreateCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		key  = newSigningKey(t)
+		head = model.AuditChainHead{EventID: gofakeit.Int64(), Hash: []byte(gofakeit.UUID())}
+	)
+
+	t.Run("empty chain", func(t *testing.T) {
+		t.Parallel()
+
+		logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+		logRepositoryMock.GetAuditChainHeadMock.Expect(ctx).Return(model.AuditChainHead{}, nil)
+
+		created, err := auditChain.NewService(newConfig(), logRepositoryMock, repositoryMocks.NewAuditArchiveRepositoryMock(mc), key).CreateCheckpoint(ctx)
+		require.NoError(t, err)
+		require.False(t, created)
+	})
+
+	t.Run("signed head", func(t *testing.T) {
+		t.Parallel()
+
+		logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+		logRepositoryMock.GetAuditChainHeadMock.Expect(ctx).Return(head, nil)
+		logRepositoryMock.CreateAuditCheckpointMock.Set(func(
+			_ context.Context,
+			checkpoint model.AuditCheckpoint,
+		) (bool, error) {
+			require.Equal(t, head.EventID, checkpoint.EventID)
+			require.Equal(t, head.Hash, checkpoint.Hash)
+			require.True(t, audit.VerifyCheckpoint(key.Public().(ed25519.PublicKey), checkpoint))
+
+			return true, nil
+		})
+
+		created, err := auditChain.NewService(newConfig(), logRepositoryMock, repositoryMocks.NewAuditArchiveRepositoryMock(mc), key).CreateCheckpoint(ctx)
+		require.NoError(t, err)
+		require.True(t, created)
+	})
+}
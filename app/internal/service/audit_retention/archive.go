@@ -0,0 +1,166 @@
+package auditretention
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+// archiveFileSuffix is the suffix of the archive files: gzip compressed JSON, one record per line.
+const archiveFileSuffix = ".ndjson.gz"
+
+// archiveRecord is a record of the audit log as written to the archive. The stored columns are kept as they are,
+// so that the hashes of the chain can still be checked against the archive.
+type archiveRecord struct {
+	ID           int64            `json:"id"`
+	ActionType   string           `json:"action_type"`
+	RequestData  json.RawMessage  `json:"request_data"`
+	ResponseData *json.RawMessage `json:"response_data"`
+	ActorID      *int64           `json:"actor_id"`
+	TargetUserID *int64           `json:"target_user_id"`
+	SourceIP     *string          `json:"source_ip"`
+	UserAgent    *string          `json:"user_agent"`
+	GRPCMethod   *string          `json:"grpc_method"`
+	StatusCode   string           `json:"status_code"`
+	RequestID    *string          `json:"request_id"`
+	TraceID      *string          `json:"trace_id"`
+	Timestamp    time.Time        `json:"timestamp"`
+	PrevHash     string           `json:"prev_hash,omitempty"`
+	Hash         string           `json:"hash,omitempty"`
+}
+
+// exportPartition writes the records of the partition to its file in the archive directory and returns
+// the archive to record. The file is written under a temporary name and renamed once it is complete,
+// so the archive never holds a partial file under the final name.
+func (s *service) exportPartition(ctx context.Context, partition model.AuditPartition) (model.AuditArchive, error) {
+	archive := model.AuditArchive{
+		Partition: partition,
+		FileName:  filepath.Join(s.cfg.AuditRetention.ArchiveDir, partition.Name+archiveFileSuffix),
+	}
+
+	err := os.MkdirAll(s.cfg.AuditRetention.ArchiveDir, 0o750)
+	if err != nil {
+		return model.AuditArchive{}, errors.Wrap(err, "Cannot create audit archive directory")
+	}
+
+	file, err := os.CreateTemp(s.cfg.AuditRetention.ArchiveDir, partition.Name+".*.tmp")
+	if err != nil {
+		return model.AuditArchive{}, errors.Wrap(err, "Cannot create audit archive file")
+	}
+
+	defer func() {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+	}()
+
+	err = s.writeRecords(ctx, file, partition, &archive)
+	if err != nil {
+		return model.AuditArchive{}, err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		return model.AuditArchive{}, errors.Wrapf(err, "Cannot sync audit archive file(partition: %s)", partition.Name)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return model.AuditArchive{}, errors.Wrapf(err, "Cannot close audit archive file(partition: %s)", partition.Name)
+	}
+
+	err = os.Rename(file.Name(), archive.FileName)
+	if err != nil {
+		return model.AuditArchive{}, errors.Wrapf(err, "Cannot rename audit archive file(partition: %s)", partition.Name)
+	}
+
+	return archive, nil
+}
+
+func (s *service) writeRecords(
+	ctx context.Context,
+	file *os.File,
+	partition model.AuditPartition,
+	archive *model.AuditArchive,
+) error {
+	buf := bufio.NewWriter(file)
+	zw := gzip.NewWriter(buf)
+	encoder := json.NewEncoder(zw)
+
+	var afterEventID int64
+
+	for {
+		links, err := s.auditArchiveRepository.ListAuditPartitionRecords(ctx, model.ListAuditPartitionRecordsParams{
+			Partition:    partition.Name,
+			AfterEventID: afterEventID,
+			Limit:        s.cfg.AuditRetention.ExportBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, link := range links {
+			err = encoder.Encode(newArchiveRecord(link))
+			if err != nil {
+				return errors.Wrapf(err, "Cannot write audit archive record(eventID: %d)", link.EventID)
+			}
+
+			afterEventID = link.EventID
+			archive.Records++
+		}
+
+		if int64(len(links)) < s.cfg.AuditRetention.ExportBatchSize {
+			break
+		}
+	}
+
+	if archive.Records > 0 {
+		archive.MaxEventID = &afterEventID
+	}
+
+	err := zw.Close()
+	if err != nil {
+		return errors.Wrapf(err, "Cannot compress audit archive file(partition: %s)", partition.Name)
+	}
+
+	err = buf.Flush()
+	if err != nil {
+		return errors.Wrapf(err, "Cannot write audit archive file(partition: %s)", partition.Name)
+	}
+
+	return nil
+}
+
+func newArchiveRecord(link model.AuditChainLink) archiveRecord {
+	record := archiveRecord{
+		ID:           link.EventID,
+		ActionType:   link.Action,
+		RequestData:  json.RawMessage(link.RequestData),
+		ActorID:      link.ActorID,
+		TargetUserID: link.TargetUserID,
+		SourceIP:     link.SourceIP,
+		UserAgent:    link.UserAgent,
+		GRPCMethod:   link.Method,
+		StatusCode:   link.StatusCode,
+		RequestID:    link.RequestID,
+		TraceID:      link.TraceID,
+		Timestamp:    link.CreatedAt,
+		PrevHash:     hex.EncodeToString(link.PrevHash),
+		Hash:         hex.EncodeToString(link.Hash),
+	}
+
+	if link.ResponseData != nil {
+		responseData := json.RawMessage(*link.ResponseData)
+		record.ResponseData = &responseData
+	}
+
+	return record
+}
@@ -0,0 +1,180 @@
+package auditretention
+
+import (
+	"context"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+)
+
+// errLockNotAcquired stops a run when another instance is managing the partitions.
+var errLockNotAcquired = errors.New("audit retention lock is held by another instance")
+
+type service struct {
+	cfg                    *config.Config
+	auditArchiveRepository repository.AuditArchiveRepository
+	txManager              db.TxManager
+}
+
+// NewService creates a new instance of the audit retention service.
+func NewService(
+	cfg *config.Config,
+	auditArchiveRepository repository.AuditArchiveRepository,
+	txManager db.TxManager,
+) *service {
+	return &service{
+		cfg:                    cfg,
+		auditArchiveRepository: auditArchiveRepository,
+		txManager:              txManager,
+	}
+}
+
+// RunRetention applies the retention of the audit log every interval until the context is done.
+func (s *service) RunRetention(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.AuditRetention.Interval)
+	defer ticker.Stop()
+
+	for {
+		err := s.ApplyRetention(ctx)
+		if err != nil {
+			log.Warnf("Failed to apply audit retention, err: %+v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// ApplyRetention creates the partitions of the current month and of the premake months after it, then archives
+// and removes the partitions that ended more than the retention months before the current month, oldest first.
+//
+// Each partition is exported, recorded and removed in its own transaction under the retention lock, so an instance
+// that fails halfway leaves the partition in place to be archived again on the next run. Only one instance manages
+// the partitions at a time, the others skip the run.
+//
+// The current month is taken from the database, in the time zone the log timestamps and the partition bounds
+// are in, so the partitions are neither created nor archived a month off around the turn of a month.
+func (s *service) ApplyRetention(ctx context.Context) error {
+	currentMonth, err := s.createPartitions(ctx)
+	if errors.Is(err, errLockNotAcquired) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	partitions, err := s.auditArchiveRepository.ListAuditPartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	cutoff := currentMonth.AddDate(0, -s.cfg.AuditRetention.RetentionMonths, 0)
+
+	for _, partition := range partitions {
+		if partition.To.After(cutoff) {
+			continue
+		}
+
+		err = s.archivePartition(ctx, partition)
+		if errors.Is(err, errLockNotAcquired) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createPartitions creates the partitions of the current month and of the premake months after it
+// and returns the current month.
+func (s *service) createPartitions(ctx context.Context) (currentMonth time.Time, err error) {
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		acquired, txErr := s.auditArchiveRepository.AcquireRetentionLock(ctx)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !acquired {
+			return errLockNotAcquired
+		}
+
+		currentMonth, txErr = s.auditArchiveRepository.GetCurrentAuditMonth(ctx)
+		if txErr != nil {
+			return txErr
+		}
+
+		for i := 0; i <= s.cfg.AuditRetention.PremakeMonths; i++ {
+			txErr = s.auditArchiveRepository.CreateAuditPartition(ctx, currentMonth.AddDate(0, i, 0))
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		return nil
+	})
+
+	return currentMonth, err
+}
+
+// archivePartition exports the partition to the archive directory, records the export and the records chained
+// to the partition and removes the partition from the log.
+func (s *service) archivePartition(ctx context.Context, partition model.AuditPartition) error {
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		acquired, err := s.auditArchiveRepository.AcquireRetentionLock(ctx)
+		if err != nil {
+			return err
+		}
+
+		if !acquired {
+			return errLockNotAcquired
+		}
+
+		archive, err := s.exportPartition(ctx, partition)
+		if err != nil {
+			return err
+		}
+
+		archiveID, err := s.auditArchiveRepository.CreateAuditArchive(ctx, archive)
+		if err != nil {
+			return err
+		}
+
+		err = s.auditArchiveRepository.CreateAuditChainSeams(ctx, model.CreateAuditChainSeamsParams{
+			ArchiveID: archiveID,
+			Partition: partition.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.auditArchiveRepository.DropAuditPartition(ctx, model.DropAuditPartitionParams{
+			Partition:  partition.Name,
+			DetachOnly: s.cfg.AuditRetention.DetachOnly,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Infof(
+			"Audit partition archived, partition: %s, file: %s, records: %d",
+			partition.Name,
+			archive.FileName,
+			archive.Records,
+		)
+
+		return nil
+	})
+}
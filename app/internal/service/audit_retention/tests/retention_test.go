@@ -0,0 +1,261 @@
+package tests
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	auditRetention "github.com/Prrromanssss/auth/internal/service/audit_retention"
+)
+
+const (
+	retentionMonths = 12
+	premakeMonths   = 3
+	exportBatchSize = 2
+)
+
+func newConfig(archiveDir string) *config.Config {
+	return &config.Config{
+		AuditRetention: yaml.AuditRetention{
+			Interval:        time.Hour,
+			RetentionMonths: retentionMonths,
+			PremakeMonths:   premakeMonths,
+			ArchiveDir:      archiveDir,
+			ExportBatchSize: exportBatchSize,
+		},
+	}
+}
+
+func newTxManagerMock(mc *minimock.Controller) db.TxManager {
+	mock := dbMocks.NewTxManagerMock(mc)
+	mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) error {
+		return f(ctx)
+	})
+
+	return mock
+}
+
+func newPartition(month time.Time) model.AuditPartition {
+	return model.AuditPartition{
+		Name: "api_user_log_" + month.Format("2006_01"),
+		From: month,
+		To:   month.AddDate(0, 1, 0),
+	}
+}
+
+func newLinks(n int) []model.AuditChainLink {
+	links := make([]model.AuditChainLink, 0, n)
+
+	var prevHash []byte
+
+	for i := 0; i < n; i++ {
+		responseData := `{"User": {"UserID": 1}}`
+
+		link := model.AuditChainLink{
+			EventID:      int64(i + 1),
+			Action:       "Get",
+			RequestData:  `{"UserID": 1}`,
+			ResponseData: &responseData,
+			StatusCode:   model.AuditStatusOK,
+			CreatedAt:    gofakeit.Date().UTC().Truncate(time.Microsecond),
+			PrevHash:     prevHash,
+		}
+		link.Hash = audit.ChainHash(prevHash, link)
+		prevHash = link.Hash
+
+		links = append(links, link)
+	}
+
+	return links
+}
+
+// readArchive returns the records of an archive file, one map per line.
+func readArchive(t *testing.T, fileName string) []map[string]interface{} {
+	file, err := os.Open(fileName)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	zr, err := gzip.NewReader(file)
+	require.NoError(t, err)
+
+	var records []map[string]interface{}
+
+	scanner := bufio.NewScanner(zr)
+	for scanner.Scan() {
+		var record map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		records = append(records, record)
+	}
+
+	require.NoError(t, scanner.Err())
+
+	return records
+}
+
+func TestApplyRetention(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		// The current month of the database, which differs from the month of the service clock
+		// around the turn of a month when the database is not in UTC.
+		currentMonth = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+		expired = newPartition(currentMonth.AddDate(0, -retentionMonths-1, 0))
+		kept    = newPartition(currentMonth.AddDate(0, -retentionMonths, 0))
+		current = newPartition(currentMonth)
+
+		archiveID = gofakeit.Int64()
+		links     = newLinks(3)
+
+		ErrAuditArchiveRepository = errors.New("audit archive repository error")
+	)
+
+	t.Run("partitions created and expired partition archived", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mc         = minimock.NewController(t)
+			archiveDir = filepath.Join(t.TempDir(), "archive")
+
+			mu      sync.Mutex
+			created []time.Time
+		)
+
+		mock := repositoryMocks.NewAuditArchiveRepositoryMock(mc)
+		mock.AcquireRetentionLockMock.Return(true, nil)
+		mock.GetCurrentAuditMonthMock.Expect(ctx).Return(currentMonth, nil)
+		mock.CreateAuditPartitionMock.Set(func(_ context.Context, month time.Time) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			created = append(created, month)
+
+			return nil
+		})
+		mock.ListAuditPartitionsMock.Return([]model.AuditPartition{expired, kept, current}, nil)
+		mock.ListAuditPartitionRecordsMock.Set(func(
+			_ context.Context,
+			params model.ListAuditPartitionRecordsParams,
+		) ([]model.AuditChainLink, error) {
+			require.Equal(t, expired.Name, params.Partition)
+
+			var page []model.AuditChainLink
+			for _, link := range links {
+				if link.EventID > params.AfterEventID && int64(len(page)) < params.Limit {
+					page = append(page, link)
+				}
+			}
+
+			return page, nil
+		})
+		mock.CreateAuditArchiveMock.Set(func(_ context.Context, archive model.AuditArchive) (int64, error) {
+			maxEventID := links[2].EventID
+
+			require.Equal(t, model.AuditArchive{
+				Partition:  expired,
+				FileName:   filepath.Join(archiveDir, expired.Name+".ndjson.gz"),
+				Records:    3,
+				MaxEventID: &maxEventID,
+			}, archive)
+
+			return archiveID, nil
+		})
+		mock.CreateAuditChainSeamsMock.Expect(ctx, model.CreateAuditChainSeamsParams{
+			ArchiveID: archiveID,
+			Partition: expired.Name,
+		}).Return(nil)
+		mock.DropAuditPartitionMock.Expect(ctx, model.DropAuditPartitionParams{
+			Partition: expired.Name,
+		}).Return(nil)
+
+		service := auditRetention.NewService(newConfig(archiveDir), mock, newTxManagerMock(mc))
+
+		err := service.ApplyRetention(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []time.Time{
+			currentMonth,
+			currentMonth.AddDate(0, 1, 0),
+			currentMonth.AddDate(0, 2, 0),
+			currentMonth.AddDate(0, 3, 0),
+		}, created)
+
+		records := readArchive(t, filepath.Join(archiveDir, expired.Name+".ndjson.gz"))
+		require.Len(t, records, len(links))
+
+		for i, record := range records {
+			require.Equal(t, float64(links[i].EventID), record["id"])
+			require.Equal(t, map[string]interface{}{"UserID": float64(1)}, record["request_data"])
+			require.Equal(t, hex.EncodeToString(links[i].Hash), record["hash"])
+		}
+
+		require.NotContains(t, records[0], "prev_hash")
+		require.Equal(t, hex.EncodeToString(links[1].PrevHash), records[1]["prev_hash"])
+
+		entries, err := os.ReadDir(archiveDir)
+		require.NoError(t, err)
+		require.Len(t, entries, 1, "no temporary file must be left")
+	})
+
+	t.Run("lock held by another instance", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+
+		mock := repositoryMocks.NewAuditArchiveRepositoryMock(mc)
+		mock.AcquireRetentionLockMock.Return(false, nil)
+
+		service := auditRetention.NewService(newConfig(t.TempDir()), mock, newTxManagerMock(mc))
+
+		err := service.ApplyRetention(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("export error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mc         = minimock.NewController(t)
+			archiveDir = t.TempDir()
+		)
+
+		mock := repositoryMocks.NewAuditArchiveRepositoryMock(mc)
+		mock.AcquireRetentionLockMock.Return(true, nil)
+		mock.GetCurrentAuditMonthMock.Expect(ctx).Return(currentMonth, nil)
+		mock.CreateAuditPartitionMock.Return(nil)
+		mock.ListAuditPartitionsMock.Return([]model.AuditPartition{expired, current}, nil)
+		mock.ListAuditPartitionRecordsMock.Return(nil, ErrAuditArchiveRepository)
+
+		service := auditRetention.NewService(newConfig(archiveDir), mock, newTxManagerMock(mc))
+
+		err := service.ApplyRetention(ctx)
+		require.Equal(t, ErrAuditArchiveRepository, err)
+
+		entries, err := os.ReadDir(archiveDir)
+		require.NoError(t, err)
+		require.Empty(t, entries, "the partial archive file must be removed")
+	})
+}
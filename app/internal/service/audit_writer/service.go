@@ -0,0 +1,175 @@
+package auditwriter
+
+import (
+	"context"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+)
+
+type service struct {
+	cfg           *config.Config
+	logRepository repository.LogRepository
+
+	queue   chan model.APILogRecord
+	stopped chan struct{}
+	// mu is held for reading while a record is checked against stopped and queued,
+	// so that the writer can wait for those in progress before it drains the queue.
+	mu sync.RWMutex
+}
+
+// NewService creates a new instance of the audit writer.
+func NewService(
+	cfg *config.Config,
+	logRepository repository.LogRepository,
+) *service {
+	s := &service{
+		cfg:           cfg,
+		logRepository: logRepository,
+		queue:         make(chan model.APILogRecord, cfg.AuditWriter.QueueSize),
+		stopped:       make(chan struct{}),
+	}
+
+	stats.Set(statQueueLength, expvar.Func(func() interface{} {
+		return len(s.queue)
+	}))
+
+	return s
+}
+
+// Write queues the audit record of a call together with the audit metadata of the context.
+//
+// When the queue is full the call waits up to the enqueue timeout for room and then drops the record,
+// so a slow database delays reads by at most that timeout and never fails them.
+func (s *service) Write(ctx context.Context, params model.CreateAPILogParams) {
+	record := model.APILogRecord{
+		Params:    params,
+		CreatedAt: time.Now(),
+	}
+
+	// The metadata is copied, since the writer reads it after the call has returned.
+	if md, ok := audit.FromContext(ctx); ok {
+		mdCopy := *md
+		record.Metadata = &mdCopy
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	select {
+	case <-s.stopped:
+		s.drop(record, "writer stopped")
+		return
+	default:
+	}
+
+	select {
+	case s.queue <- record:
+		stats.Add(statQueued, 1)
+		return
+	default:
+	}
+
+	if s.cfg.AuditWriter.EnqueueTimeout <= 0 {
+		s.drop(record, "queue full")
+		return
+	}
+
+	timer := time.NewTimer(s.cfg.AuditWriter.EnqueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case s.queue <- record:
+		stats.Add(statQueued, 1)
+	case <-timer.C:
+		s.drop(record, "queue full")
+	case <-ctx.Done():
+		s.drop(record, "call cancelled")
+	case <-s.stopped:
+		s.drop(record, "writer stopped")
+	}
+}
+
+// RunWriter writes the queued records in batches, whenever a batch is full and every flush interval,
+// until the context is done. The records still queued then are written before it returns.
+func (s *service) RunWriter(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.AuditWriter.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]model.APILogRecord, 0, s.cfg.AuditWriter.BatchSize)
+
+	for {
+		select {
+		case <-ctx.Done():
+			close(s.stopped)
+
+			// Every record queued after this is picked up by the drain.
+			s.mu.Lock()
+			s.mu.Unlock() //nolint:staticcheck // The lock only waits for the writes in progress
+
+			s.drain(ctx, batch)
+
+			return ctx.Err()
+		case record := <-s.queue:
+			batch = append(batch, record)
+			if len(batch) >= s.cfg.AuditWriter.BatchSize {
+				s.flush(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			s.flush(ctx, batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// drain writes the batch in progress and every record left in the queue.
+func (s *service) drain(ctx context.Context, batch []model.APILogRecord) {
+	for {
+		select {
+		case record := <-s.queue:
+			batch = append(batch, record)
+			if len(batch) >= s.cfg.AuditWriter.BatchSize {
+				s.flush(ctx, batch)
+				batch = batch[:0]
+			}
+		default:
+			s.flush(ctx, batch)
+			return
+		}
+	}
+}
+
+// flush writes one batch. The batch is written even if the context is done, since that is how
+// the records queued before a shutdown reach the database. A batch that fails is dropped.
+func (s *service) flush(ctx context.Context, batch []model.APILogRecord) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.cfg.AuditWriter.FlushTimeout)
+	defer cancel()
+
+	err := s.logRepository.CreateAPILogs(ctx, batch)
+	if err != nil {
+		stats.Add(statWriteErrors, 1)
+		stats.Add(statDropped, int64(len(batch)))
+		log.Errorf("Failed to write audit records, records: %d, err: %+v", len(batch), err)
+
+		return
+	}
+
+	stats.Add(statWritten, int64(len(batch)))
+}
+
+func (s *service) drop(record model.APILogRecord, reason string) {
+	stats.Add(statDropped, 1)
+	log.Warnf("Audit record dropped, method: %s, reason: %s", record.Params.Method, reason)
+}
@@ -0,0 +1,14 @@
+package auditwriter
+
+import "expvar"
+
+// stats counts the audit records that pass through the writer. It is published under "audit_writer" by expvar.
+var stats = expvar.NewMap("audit_writer")
+
+const (
+	statQueued      = "queued"
+	statDropped     = "dropped"
+	statWritten     = "written"
+	statWriteErrors = "write_errors"
+	statQueueLength = "queue_length"
+)
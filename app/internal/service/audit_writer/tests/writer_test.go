@@ -0,0 +1,234 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	auditWriter "github.com/Prrromanssss/auth/internal/service/audit_writer"
+)
+
+func newConfig(queueSize, batchSize int, flushInterval time.Duration) *config.Config {
+	return &config.Config{
+		AuditWriter: yaml.AuditWriter{
+			QueueSize:     queueSize,
+			BatchSize:     batchSize,
+			FlushInterval: flushInterval,
+			FlushTimeout:  time.Second,
+		},
+	}
+}
+
+func getParams(userID int64) model.CreateAPILogParams {
+	return model.CreateAPILogParams{
+		Method:       "Get",
+		TargetUserID: &userID,
+		RequestData:  model.GetUserParams{UserID: userID},
+	}
+}
+
+func stat(name string) int64 {
+	v := expvar.Get("audit_writer").(*expvar.Map).Get(name)
+	if v == nil {
+		return 0
+	}
+
+	return v.(*expvar.Int).Value()
+}
+
+func TestRunWriter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		actorID = gofakeit.Int64()
+		md      = &model.AuditMetadata{
+			ActorID:   &actorID,
+			SourceIP:  gofakeit.IPv4Address(),
+			Method:    "/user_v1.UserV1/Get",
+			RequestID: gofakeit.UUID(),
+		}
+
+		batches = make(chan []model.APILogRecord, 10)
+	)
+
+	logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+	logRepositoryMock.CreateAPILogsMock.Set(func(ctx context.Context, records []model.APILogRecord) error {
+		require.NoError(t, ctx.Err())
+
+		// The writer reuses the batch after it is written.
+		batches <- append([]model.APILogRecord(nil), records...)
+
+		return nil
+	})
+
+	writer := auditWriter.NewService(newConfig(10, 2, time.Hour), logRepositoryMock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- writer.RunWriter(ctx)
+	}()
+
+	callCtx := audit.NewContext(context.Background(), md)
+	for userID := int64(1); userID <= 3; userID++ {
+		writer.Write(callCtx, getParams(userID))
+	}
+
+	// The metadata may change after the call, the record keeps the state it had during the call.
+	md.RequestID = gofakeit.UUID()
+
+	batch := <-batches
+	require.Len(t, batch, 2)
+	require.Equal(t, getParams(1), batch[0].Params)
+	require.Equal(t, getParams(2), batch[1].Params)
+	require.NotEqual(t, md.RequestID, batch[0].Metadata.RequestID)
+	require.Equal(t, actorID, *batch[0].Metadata.ActorID)
+	require.False(t, batch[0].CreatedAt.IsZero())
+
+	// The last record waits for the flush interval, it is written on shutdown.
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	batch = <-batches
+	require.Len(t, batch, 1)
+	require.Equal(t, getParams(3), batch[0].Params)
+	require.Empty(t, batches)
+}
+
+func TestRunWriterFlushInterval(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc = minimock.NewController(t)
+
+		batches = make(chan []model.APILogRecord, 10)
+	)
+
+	logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+	logRepositoryMock.CreateAPILogsMock.Set(func(_ context.Context, records []model.APILogRecord) error {
+		batches <- append([]model.APILogRecord(nil), records...)
+		return nil
+	})
+
+	writer := auditWriter.NewService(newConfig(10, 100, 10*time.Millisecond), logRepositoryMock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		_ = writer.RunWriter(ctx)
+	}()
+
+	writer.Write(context.Background(), getParams(1))
+
+	select {
+	case batch := <-batches:
+		require.Len(t, batch, 1)
+		require.Nil(t, batch[0].Metadata)
+	case <-time.After(time.Second):
+		t.Fatal("the record was not written after the flush interval")
+	}
+}
+
+// TestWriteOverload is not parallel, since it checks the counters shared by every writer.
+func TestWriteOverload(t *testing.T) {
+	var (
+		mc = minimock.NewController(t)
+
+		ErrLogRepository = errors.New("log repository error")
+	)
+
+	logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+	logRepositoryMock.CreateAPILogsMock.Return(ErrLogRepository)
+
+	writer := auditWriter.NewService(newConfig(1, 10, time.Hour), logRepositoryMock)
+
+	dropped := stat("dropped")
+	writeErrors := stat("write_errors")
+
+	// The writer is not running, the second record finds the queue full.
+	writer.Write(context.Background(), getParams(1))
+	writer.Write(context.Background(), getParams(2))
+	require.Equal(t, dropped+1, stat("dropped"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, writer.RunWriter(ctx), context.Canceled)
+
+	// The queued record failed to be written and the writer no longer accepts records.
+	require.Equal(t, uint64(1), logRepositoryMock.CreateAPILogsAfterCounter())
+	require.Equal(t, writeErrors+1, stat("write_errors"))
+	require.Equal(t, dropped+2, stat("dropped"))
+
+	writer.Write(context.Background(), getParams(3))
+	require.Equal(t, dropped+3, stat("dropped"))
+	require.Equal(t, uint64(1), logRepositoryMock.CreateAPILogsAfterCounter())
+}
+
+// TestWriteDuringShutdown is not parallel, since it checks the counters shared by every writer.
+func TestWriteDuringShutdown(t *testing.T) {
+	const (
+		producers = 8
+		writes    = 200
+	)
+
+	var (
+		mc = minimock.NewController(t)
+
+		written atomic.Int64
+	)
+
+	logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+	logRepositoryMock.CreateAPILogsMock.Set(func(_ context.Context, records []model.APILogRecord) error {
+		written.Add(int64(len(records)))
+		return nil
+	})
+
+	writer := auditWriter.NewService(newConfig(writes, 10, time.Hour), logRepositoryMock)
+
+	dropped := stat("dropped")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- writer.RunWriter(ctx)
+	}()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < producers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for userID := int64(1); userID <= writes; userID++ {
+				writer.Write(context.Background(), getParams(userID))
+			}
+		}()
+	}
+
+	cancel()
+	wg.Wait()
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	// Every record is either written by the drain or counted as dropped.
+	require.Equal(t, int64(producers*writes), written.Load()+stat("dropped")-dropped)
+}
@@ -0,0 +1,427 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/google/uuid"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	"github.com/Prrromanssss/auth/internal/service"
+	"github.com/Prrromanssss/auth/internal/token"
+	"github.com/Prrromanssss/auth/pkg/crypto"
+)
+
+const lockoutAuditMethod = "Lockout"
+
+type authService struct {
+	cfg                    *config.Config
+	userRepository         repository.UserRepository
+	refreshTokenRepository repository.RefreshTokenRepository
+	loginFailureRepository repository.LoginFailureRepository
+	logRepository          repository.LogRepository
+	tokenManager           token.TokenManager
+	passwordHasher         crypto.PasswordHasher
+	txManager              db.TxManager
+	// dummyPasswordHash is verified against when the email is unknown, so that the login
+	// takes as long as for a known email with a wrong password.
+	dummyPasswordHash string
+}
+
+// NewService creates a new instance of authService with the provided repositories, TokenManager and PasswordHasher.
+func NewService(
+	cfg *config.Config,
+	userRepository repository.UserRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	loginFailureRepository repository.LoginFailureRepository,
+	logRepository repository.LogRepository,
+	tokenManager token.TokenManager,
+	passwordHasher crypto.PasswordHasher,
+	txManager db.TxManager,
+) service.AuthService {
+	return &authService{
+		cfg:                    cfg,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		loginFailureRepository: loginFailureRepository,
+		logRepository:          logRepository,
+		tokenManager:           tokenManager,
+		passwordHasher:         passwordHasher,
+		txManager:              txManager,
+		dummyPasswordHash:      crypto.DummyHash(cfg.Argon2.Params()),
+	}
+}
+
+// Login authenticates a user by email and password and issues a new pair of tokens
+// that starts a new refresh token family. A password hash produced by an outdated algorithm
+// is upgraded to the current one in the same transaction.
+//
+// Failed logins are counted per email and per client address. After a few failures the next login
+// for the email or from the address has to wait, after too many of them it is locked out for a while.
+// Waiting logins are rejected with ErrAccountLocked, whether a user with the email exists or not.
+// The counters are locked for the whole login, so concurrent logins for the same email or from the same
+// address are checked and counted one after another and a parallel guess cannot skip the wait.
+func (s *authService) Login(
+	ctx context.Context,
+	params model.LoginParams,
+) (resp model.LoginResponse, err error) {
+	log.Infof("authService.Login, email: %s", params.Email)
+
+	keys := s.loginFailureKeys(ctx, params.Email)
+
+	var (
+		user     model.GetUserByEmailResponse
+		loginErr error
+	)
+
+	// A failed login commits its counters and is reported after the transaction.
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		failures, txErr := s.checkLoginLockout(ctx, keys)
+		if txErr != nil {
+			return txErr
+		}
+
+		user, txErr = s.userRepository.GetUserByEmail(ctx, model.GetUserByEmailParams{Email: params.Email})
+		if errors.Is(txErr, model.ErrUserNotFound) {
+			_, _ = s.passwordHasher.Verify(params.Password, s.dummyPasswordHash)
+			loginErr = model.ErrInvalidCredentials
+
+			return s.recordLoginFailure(ctx, keys, nil)
+		}
+
+		if txErr != nil {
+			return txErr
+		}
+
+		ok, txErr := s.passwordHasher.Verify(params.Password, user.HashedPassword)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !ok {
+			loginErr = model.ErrInvalidCredentials
+
+			return s.recordLoginFailure(ctx, keys, &user.UserID)
+		}
+
+		txErr = s.resetLoginFailures(ctx, failures)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !s.passwordHasher.NeedsRehash(user.HashedPassword) {
+			return nil
+		}
+
+		hashedPassword, txErr := s.passwordHasher.Hash(params.Password)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.userRepository.UpdateUserPassword(ctx, model.UpdateUserPasswordParams{
+			UserID:         user.UserID,
+			HashedPassword: hashedPassword,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		log.Infof("Password hash upgraded, userID: %d", user.UserID)
+
+		return nil
+	})
+	if err != nil {
+		return model.LoginResponse{}, err
+	}
+
+	if loginErr != nil {
+		return model.LoginResponse{}, loginErr
+	}
+
+	resp.AccessToken, resp.RefreshToken, err = s.issueTokens(ctx, user.User, uuid.NewString())
+	if err != nil {
+		return model.LoginResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// RefreshToken exchanges a valid refresh token for a new pair of tokens and marks the presented one as used.
+// If an already used refresh token is presented again, the whole token family is revoked.
+func (s *authService) RefreshToken(
+	ctx context.Context,
+	params model.RefreshTokenParams,
+) (resp model.RefreshTokenResponse, err error) {
+	log.Info("authService.RefreshToken")
+
+	claims, err := s.tokenManager.VerifyRefreshToken(params.RefreshToken)
+	if err != nil {
+		log.Warnf("Failed to verify refresh token, err: %v", err)
+		return model.RefreshTokenResponse{}, model.ErrInvalidRefreshToken
+	}
+
+	var reused bool
+
+	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		stored, txErr := s.refreshTokenRepository.GetRefreshToken(ctx, model.GetRefreshTokenParams{
+			TokenID: claims.ID,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		if stored.RevokedAt != nil || stored.UserID != claims.UserID || time.Now().After(stored.ExpiresAt) {
+			return model.ErrInvalidRefreshToken
+		}
+
+		// The token has already been exchanged, so whoever presents it now may be an attacker.
+		// Revoke the whole family and commit, so that the legitimate client has to log in again.
+		if stored.UsedAt != nil {
+			reused = true
+
+			return s.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, model.RevokeRefreshTokenFamilyParams{
+				FamilyID: stored.FamilyID,
+			})
+		}
+
+		txErr = s.refreshTokenRepository.MarkRefreshTokenUsed(ctx, model.MarkRefreshTokenUsedParams{
+			TokenID: stored.TokenID,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		user, txErr := s.userRepository.GetUser(ctx, model.GetUserParams{UserID: stored.UserID})
+		if txErr != nil {
+			return txErr
+		}
+
+		resp.AccessToken, resp.RefreshToken, txErr = s.issueTokens(ctx, user.User, stored.FamilyID)
+		if txErr != nil {
+			return txErr
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrRefreshTokenNotFound) || errors.Is(err, model.ErrInvalidRefreshToken) {
+			return model.RefreshTokenResponse{}, model.ErrInvalidRefreshToken
+		}
+
+		return model.RefreshTokenResponse{}, err
+	}
+
+	if reused {
+		log.Warnf("Refresh token reuse detected, token family revoked, userID: %d", claims.UserID)
+		return model.RefreshTokenResponse{}, model.ErrRefreshTokenReused
+	}
+
+	return resp, nil
+}
+
+// loginFailureKeys returns the keys the failed logins of the call are counted by: the email
+// and the client address, if it is known. It returns nil if the login lockout is disabled.
+func (s *authService) loginFailureKeys(ctx context.Context, email string) []model.LoginFailureKey {
+	if !s.cfg.LoginLockout.Enabled {
+		return nil
+	}
+
+	keys := []model.LoginFailureKey{{Scope: model.LoginFailureScopeAccount, Key: email}}
+
+	if md, ok := audit.FromContext(ctx); ok && md.SourceIP != "" {
+		keys = append(keys, model.LoginFailureKey{Scope: model.LoginFailureScopeIP, Key: md.SourceIP})
+	}
+
+	return keys
+}
+
+// checkLoginLockout locks the counters of failed logins of the keys until the end of the transaction
+// and returns ErrAccountLocked with the longest wait among the keys if one of them is locked,
+// the counters otherwise.
+func (s *authService) checkLoginLockout(
+	ctx context.Context,
+	keys []model.LoginFailureKey,
+) ([]model.LoginFailure, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	failures, err := s.loginFailureRepository.LockLoginFailures(ctx, model.LockLoginFailuresParams{Keys: keys})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	var retryAfter time.Duration
+
+	for _, failure := range failures {
+		if failure.LockedUntil != nil && failure.LockedUntil.After(now) {
+			retryAfter = max(retryAfter, failure.LockedUntil.Sub(now))
+		}
+	}
+
+	if retryAfter > 0 {
+		log.Warnf("Login locked out, retryAfter: %s", retryAfter)
+		return nil, model.ErrAccountLocked.WithRetryAfter(retryAfter)
+	}
+
+	return failures, nil
+}
+
+// resetLoginFailures resets the counter of failed logins for the email after a successful login.
+// The counter of the client address keeps counting the failures for other emails.
+func (s *authService) resetLoginFailures(ctx context.Context, failures []model.LoginFailure) error {
+	for _, failure := range failures {
+		if failure.Scope != model.LoginFailureScopeAccount {
+			continue
+		}
+
+		return s.loginFailureRepository.DeleteLoginFailures(ctx, model.DeleteLoginFailuresParams{
+			Keys: []model.LoginFailureKey{{Scope: failure.Scope, Key: failure.Key}},
+		})
+	}
+
+	return nil
+}
+
+// recordLoginFailure counts a failed login for every key and makes the next logins for the keys wait
+// as their policies require. Every lockout is recorded in the audit log, with the user as the target
+// if the email belongs to one. It runs in the transaction of the login that locked the counters.
+func (s *authService) recordLoginFailure(
+	ctx context.Context,
+	keys []model.LoginFailureKey,
+	targetUserID *int64,
+) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+
+	for _, key := range keys {
+		policy := s.lockoutPolicy(key.Scope)
+
+		failure, err := s.loginFailureRepository.RecordLoginFailure(ctx, model.RecordLoginFailureParams{
+			LoginFailureKey: key,
+			FailedAt:        now,
+			ResetBefore:     now.Add(-policy.Window),
+		})
+		if err != nil {
+			return err
+		}
+
+		delay := loginDelay(policy, failure.Failures)
+		if delay == 0 {
+			continue
+		}
+
+		lockedUntil := now.Add(delay)
+
+		err = s.loginFailureRepository.LockLogin(ctx, model.LockLoginParams{
+			LoginFailureKey: key,
+			LockedUntil:     lockedUntil,
+		})
+		if err != nil {
+			return err
+		}
+
+		if failure.Failures < policy.Threshold {
+			continue
+		}
+
+		log.Warnf(
+			"Login locked out, scope: %s, failures: %d, lockedUntil: %s",
+			key.Scope,
+			failure.Failures,
+			lockedUntil,
+		)
+
+		auditParams := model.CreateAPILogParams{
+			Method: lockoutAuditMethod,
+			RequestData: model.LoginLockout{
+				Scope:       key.Scope,
+				Key:         key.Key,
+				Failures:    failure.Failures,
+				LockedUntil: lockedUntil,
+			},
+		}
+		if key.Scope == model.LoginFailureScopeAccount {
+			auditParams.TargetUserID = targetUserID
+		}
+
+		err = s.logRepository.CreateAPILog(ctx, auditParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *authService) lockoutPolicy(scope string) yaml.LoginLockoutPolicy {
+	if scope == model.LoginFailureScopeIP {
+		return s.cfg.LoginLockout.IP
+	}
+
+	return s.cfg.LoginLockout.Account
+}
+
+// loginDelay returns how long the logins for a key have to wait after the given number of failures:
+// nothing for the free attempts, then a delay that doubles with every failure up to the maximum,
+// and the lockout duration from the threshold on.
+func loginDelay(policy yaml.LoginLockoutPolicy, failures int64) time.Duration {
+	if failures >= policy.Threshold {
+		return policy.LockoutDuration
+	}
+
+	if failures <= policy.FreeAttempts {
+		return 0
+	}
+
+	delay := policy.BaseDelay
+	for i := policy.FreeAttempts + 1; i < failures && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, policy.MaxDelay)
+}
+
+// issueTokens signs a new access token and a new refresh token within the given family
+// and persists the refresh token in the token store.
+func (s *authService) issueTokens(
+	ctx context.Context,
+	user model.User,
+	familyID string,
+) (accessToken, refreshToken string, err error) {
+	accessToken, err = s.tokenManager.GenerateAccessToken(user)
+	if err != nil {
+		return "", "", err
+	}
+
+	tokenID := uuid.NewString()
+
+	refreshToken, expiresAt, err := s.tokenManager.GenerateRefreshToken(user, tokenID)
+	if err != nil {
+		return "", "", err
+	}
+
+	err = s.refreshTokenRepository.CreateRefreshToken(ctx, model.CreateRefreshTokenParams{
+		TokenID:   tokenID,
+		FamilyID:  familyID,
+		UserID:    user.UserID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
@@ -0,0 +1,443 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/audit"
+	"github.com/Prrromanssss/auth/internal/errs"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	authService "github.com/Prrromanssss/auth/internal/service/auth"
+	"github.com/Prrromanssss/auth/internal/token"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	"github.com/Prrromanssss/auth/pkg/crypto"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
+)
+
+func TestLoginLockout(t *testing.T) {
+	t.Parallel()
+
+	type (
+		userRepositoryMockFunc         func(mc *minimock.Controller) repository.UserRepository
+		loginFailureRepositoryMockFunc func(mc *minimock.Controller) repository.LoginFailureRepository
+		logRepositoryMockFunc          func(mc *minimock.Controller) repository.LogRepository
+		tokenManagerMockFunc           func(mc *minimock.Controller) token.TokenManager
+		passwordHasherMockFunc         func(mc *minimock.Controller) crypto.PasswordHasher
+	)
+
+	var (
+		mc = minimock.NewController(t)
+
+		sourceIP = gofakeit.IPv4Address()
+		ctx      = audit.NewContext(context.Background(), &model.AuditMetadata{SourceIP: sourceIP})
+
+		id             = gofakeit.Int64()
+		email          = gofakeit.Email()
+		password       = gofakeit.Password(true, true, true, true, true, 10)
+		hashedPassword = gofakeit.UUID()
+
+		ErrLoginFailureRepository = errors.New("login failure repository error")
+
+		cfg = &config.Config{
+			LoginLockout: yaml.LoginLockout{
+				Enabled: true,
+				Account: yaml.LoginLockoutPolicy{
+					Window:          15 * time.Minute,
+					FreeAttempts:    3,
+					BaseDelay:       time.Second,
+					MaxDelay:        30 * time.Second,
+					Threshold:       10,
+					LockoutDuration: 15 * time.Minute,
+				},
+				IP: yaml.LoginLockoutPolicy{
+					Window:          15 * time.Minute,
+					FreeAttempts:    20,
+					BaseDelay:       time.Second,
+					MaxDelay:        30 * time.Second,
+					Threshold:       100,
+					LockoutDuration: 15 * time.Minute,
+				},
+			},
+		}
+
+		req = model.LoginParams{
+			Email:    email,
+			Password: password,
+		}
+
+		accountKey = model.LoginFailureKey{Scope: model.LoginFailureScopeAccount, Key: email}
+		ipKey      = model.LoginFailureKey{Scope: model.LoginFailureScopeIP, Key: sourceIP}
+		keys       = []model.LoginFailureKey{accountKey, ipKey}
+
+		user = model.User{
+			UserID: id,
+			Email:  email,
+		}
+
+		repoResp = model.GetUserByEmailResponse{
+			User:           user,
+			HashedPassword: hashedPassword,
+		}
+
+		userRepositoryMock = func(mc *minimock.Controller) repository.UserRepository {
+			mock := repositoryMocks.NewUserRepositoryMock(mc)
+			mock.GetUserByEmailMock.Return(repoResp, nil)
+
+			return mock
+		}
+
+		wrongPasswordHasherMock = func(mc *minimock.Controller) crypto.PasswordHasher {
+			mock := cryptoMocks.NewPasswordHasherMock(mc)
+			mock.VerifyMock.Expect(password, hashedPassword).Return(false, nil)
+
+			return mock
+		}
+
+		// recordLoginFailures returns a LoginFailureRepository that counts the given number of failures
+		// for the account and a single one for the client address and expects the account to wait for delay.
+		recordLoginFailures = func(accountFailures int64, delay time.Duration) loginFailureRepositoryMockFunc {
+			return func(mc *minimock.Controller) repository.LoginFailureRepository {
+				var failedAt time.Time
+
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.LockLoginFailuresMock.Expect(ctx, model.LockLoginFailuresParams{Keys: keys}).Return(nil, nil)
+				mock.RecordLoginFailureMock.Set(
+					func(_ context.Context, params model.RecordLoginFailureParams) (model.LoginFailure, error) {
+						require.Equal(t, cfg.LoginLockout.Account.Window, params.FailedAt.Sub(params.ResetBefore))
+
+						failures := int64(1)
+						if params.LoginFailureKey == accountKey {
+							failedAt = params.FailedAt
+							failures = accountFailures
+						}
+
+						return model.LoginFailure{
+							Scope:    params.Scope,
+							Key:      params.Key,
+							Failures: failures,
+						}, nil
+					},
+				)
+
+				if delay == 0 {
+					return mock
+				}
+
+				mock.LockLoginMock.Set(func(_ context.Context, params model.LockLoginParams) error {
+					require.Equal(t, accountKey, params.LoginFailureKey)
+					require.Equal(t, delay, params.LockedUntil.Sub(failedAt))
+
+					return nil
+				})
+
+				return mock
+			}
+		}
+
+		emptyLogRepositoryMock = func(mc *minimock.Controller) repository.LogRepository {
+			return repositoryMocks.NewLogRepositoryMock(mc)
+		}
+
+		emptyTokenManagerMock = func(mc *minimock.Controller) token.TokenManager {
+			return tokenMocks.NewTokenManagerMock(mc)
+		}
+	)
+
+	tests := []struct {
+		name                       string
+		err                        error
+		retryAfter                 time.Duration
+		userRepositoryMock         userRepositoryMockFunc
+		loginFailureRepositoryMock loginFailureRepositoryMockFunc
+		logRepositoryMock          logRepositoryMockFunc
+		tokenManagerMock           tokenManagerMockFunc
+		passwordHasherMock         passwordHasherMockFunc
+	}{
+		{
+			name:       "locked account",
+			err:        model.ErrAccountLocked,
+			retryAfter: 10 * time.Minute,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				lockedUntil := time.Now().UTC().Add(10 * time.Minute)
+
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.LockLoginFailuresMock.Expect(ctx, model.LockLoginFailuresParams{Keys: keys}).Return([]model.LoginFailure{
+					{Scope: accountKey.Scope, Key: accountKey.Key, Failures: 10, LockedUntil: &lockedUntil},
+				}, nil)
+
+				return mock
+			},
+			logRepositoryMock: emptyLogRepositoryMock,
+			tokenManagerMock:  emptyTokenManagerMock,
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				return cryptoMocks.NewPasswordHasherMock(mc)
+			},
+		},
+		{
+			name:                       "free attempt",
+			err:                        model.ErrInvalidCredentials,
+			userRepositoryMock:         userRepositoryMock,
+			loginFailureRepositoryMock: recordLoginFailures(1, 0),
+			logRepositoryMock:          emptyLogRepositoryMock,
+			tokenManagerMock:           emptyTokenManagerMock,
+			passwordHasherMock:         wrongPasswordHasherMock,
+		},
+		{
+			name:                       "progressive delay",
+			err:                        model.ErrInvalidCredentials,
+			userRepositoryMock:         userRepositoryMock,
+			loginFailureRepositoryMock: recordLoginFailures(6, 4*time.Second),
+			logRepositoryMock:          emptyLogRepositoryMock,
+			tokenManagerMock:           emptyTokenManagerMock,
+			passwordHasherMock:         wrongPasswordHasherMock,
+		},
+		{
+			name:                       "delay capped",
+			err:                        model.ErrInvalidCredentials,
+			userRepositoryMock:         userRepositoryMock,
+			loginFailureRepositoryMock: recordLoginFailures(9, 30*time.Second),
+			logRepositoryMock:          emptyLogRepositoryMock,
+			tokenManagerMock:           emptyTokenManagerMock,
+			passwordHasherMock:         wrongPasswordHasherMock,
+		},
+		{
+			name:                       "lockout is audited",
+			err:                        model.ErrInvalidCredentials,
+			userRepositoryMock:         userRepositoryMock,
+			loginFailureRepositoryMock: recordLoginFailures(10, 15*time.Minute),
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Set(func(_ context.Context, params model.CreateAPILogParams) error {
+					require.Equal(t, "Lockout", params.Method)
+					require.Equal(t, &id, params.TargetUserID)
+
+					lockout, ok := params.RequestData.(model.LoginLockout)
+					require.True(t, ok)
+					require.Equal(t, model.LoginFailureScopeAccount, lockout.Scope)
+					require.Equal(t, email, lockout.Key)
+					require.Equal(t, int64(10), lockout.Failures)
+
+					return nil
+				})
+
+				return mock
+			},
+			tokenManagerMock:   emptyTokenManagerMock,
+			passwordHasherMock: wrongPasswordHasherMock,
+		},
+		{
+			name: "unknown email is counted and locked out the same way",
+			err:  model.ErrInvalidCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Return(model.GetUserByEmailResponse{}, model.ErrUserNotFound)
+
+				return mock
+			},
+			loginFailureRepositoryMock: recordLoginFailures(10, 15*time.Minute),
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Set(func(_ context.Context, params model.CreateAPILogParams) error {
+					require.Equal(t, "Lockout", params.Method)
+					require.Nil(t, params.TargetUserID)
+
+					return nil
+				})
+
+				return mock
+			},
+			tokenManagerMock: emptyTokenManagerMock,
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, crypto.DummyHash(cfg.Argon2.Params())).Return(false, nil)
+
+				return mock
+			},
+		},
+		{
+			name:               "success resets the account counter",
+			err:                nil,
+			userRepositoryMock: userRepositoryMock,
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.LockLoginFailuresMock.Expect(ctx, model.LockLoginFailuresParams{Keys: keys}).Return([]model.LoginFailure{
+					{Scope: accountKey.Scope, Key: accountKey.Key, Failures: 2},
+					{Scope: ipKey.Scope, Key: ipKey.Key, Failures: 5},
+				}, nil)
+				mock.DeleteLoginFailuresMock.Expect(ctx, model.DeleteLoginFailuresParams{
+					Keys: []model.LoginFailureKey{accountKey},
+				}).Return(nil)
+
+				return mock
+			},
+			logRepositoryMock: emptyLogRepositoryMock,
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.GenerateAccessTokenMock.Return(gofakeit.UUID(), nil)
+				mock.GenerateRefreshTokenMock.Return(gofakeit.UUID(), gofakeit.FutureDate(), nil)
+
+				return mock
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, hashedPassword).Return(true, nil)
+				mock.NeedsRehashMock.Expect(hashedPassword).Return(false)
+
+				return mock
+			},
+		},
+		{
+			name: "login failure repository error",
+			err:  ErrLoginFailureRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.LockLoginFailuresMock.Return(nil, ErrLoginFailureRepository)
+
+				return mock
+			},
+			logRepositoryMock: emptyLogRepositoryMock,
+			tokenManagerMock:  emptyTokenManagerMock,
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				return cryptoMocks.NewPasswordHasherMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			refreshTokenRepositoryMock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			if tt.err == nil {
+				refreshTokenRepositoryMock.CreateRefreshTokenMock.Return(nil)
+			}
+
+			service := authService.NewService(
+				cfg,
+				tt.userRepositoryMock(mc),
+				refreshTokenRepositoryMock,
+				tt.loginFailureRepositoryMock(mc),
+				tt.logRepositoryMock(mc),
+				tt.tokenManagerMock(mc),
+				tt.passwordHasherMock(mc),
+				txManagerMock,
+			)
+
+			_, err := service.Login(ctx, req)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, tt.err)
+
+			if tt.retryAfter > 0 {
+				var domainErr *errs.Error
+				require.ErrorAs(t, err, &domainErr)
+				require.InDelta(t, tt.retryAfter, domainErr.RetryAfter, float64(time.Second))
+			}
+		})
+	}
+}
+
+type txKey struct{}
+
+// TestLoginLockoutInTransaction checks that a failed login is checked and counted in one transaction,
+// so that concurrent logins cannot all pass the check before any of them is counted.
+func TestLoginLockoutInTransaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc  = minimock.NewController(t)
+		ctx = context.Background()
+
+		email          = gofakeit.Email()
+		password       = gofakeit.Password(true, true, true, true, true, 10)
+		hashedPassword = gofakeit.UUID()
+
+		cfg = &config.Config{
+			LoginLockout: yaml.LoginLockout{
+				Enabled: true,
+				Account: yaml.LoginLockoutPolicy{
+					Window:       15 * time.Minute,
+					FreeAttempts: 3,
+					BaseDelay:    time.Second,
+					MaxDelay:     30 * time.Second,
+					Threshold:    10,
+				},
+			},
+		}
+
+		inTx = func(ctx context.Context) {
+			require.Equal(t, true, ctx.Value(txKey{}), "must be called in the login transaction")
+		}
+	)
+
+	txManagerMock := dbMocks.NewTxManagerMock(mc)
+	txManagerMock.ReadCommittedMock.Times(1).Set(func(ctx context.Context, f db.Handler) (err error) {
+		return f(context.WithValue(ctx, txKey{}, true))
+	})
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.GetUserByEmailMock.Return(model.GetUserByEmailResponse{
+		User:           model.User{UserID: gofakeit.Int64(), Email: email},
+		HashedPassword: hashedPassword,
+	}, nil)
+
+	loginFailureRepositoryMock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+	loginFailureRepositoryMock.LockLoginFailuresMock.Set(
+		func(ctx context.Context, _ model.LockLoginFailuresParams) ([]model.LoginFailure, error) {
+			inTx(ctx)
+
+			return nil, nil
+		},
+	)
+	loginFailureRepositoryMock.RecordLoginFailureMock.Set(
+		func(ctx context.Context, params model.RecordLoginFailureParams) (model.LoginFailure, error) {
+			inTx(ctx)
+			require.Equal(t, uint64(1), loginFailureRepositoryMock.LockLoginFailuresAfterCounter(), "the counters must be locked first")
+
+			return model.LoginFailure{Scope: params.Scope, Key: params.Key, Failures: 1}, nil
+		},
+	)
+
+	passwordHasherMock := cryptoMocks.NewPasswordHasherMock(mc)
+	passwordHasherMock.VerifyMock.Expect(password, hashedPassword).Return(false, nil)
+
+	service := authService.NewService(
+		cfg,
+		userRepositoryMock,
+		repositoryMocks.NewRefreshTokenRepositoryMock(mc),
+		loginFailureRepositoryMock,
+		repositoryMocks.NewLogRepositoryMock(mc),
+		tokenMocks.NewTokenManagerMock(mc),
+		passwordHasherMock,
+		txManagerMock,
+	)
+
+	_, err := service.Login(ctx, model.LoginParams{Email: email, Password: password})
+	require.ErrorIs(t, err, model.ErrInvalidCredentials)
+}
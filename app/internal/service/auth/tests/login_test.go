@@ -0,0 +1,391 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	authService "github.com/Prrromanssss/auth/internal/service/auth"
+	"github.com/Prrromanssss/auth/internal/token"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	"github.com/Prrromanssss/auth/pkg/crypto"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+
+	type (
+		userRepositoryMockFunc         func(mc *minimock.Controller) repository.UserRepository
+		refreshTokenRepositoryMockFunc func(mc *minimock.Controller) repository.RefreshTokenRepository
+		tokenManagerMockFunc           func(mc *minimock.Controller) token.TokenManager
+		passwordHasherMockFunc         func(mc *minimock.Controller) crypto.PasswordHasher
+	)
+
+	type args struct {
+		ctx context.Context
+		req model.LoginParams
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id                = gofakeit.Int64()
+		name              = gofakeit.Name()
+		email             = gofakeit.Email()
+		role              = pb.Role_USER
+		password          = gofakeit.Password(true, true, true, true, true, 10)
+		hashedPassword    = gofakeit.UUID()
+		newHashedPassword = gofakeit.UUID()
+		createdAt         = gofakeit.Date()
+		updatedAt         = gofakeit.Date()
+		accessToken       = gofakeit.UUID()
+		refreshToken      = gofakeit.UUID()
+		expiresAt         = gofakeit.FutureDate()
+
+		ErrUserRepository         = errors.New("user repository error")
+		ErrRefreshTokenRepository = errors.New("refresh token repository error")
+		ErrTokenManager           = errors.New("token manager error")
+		ErrPasswordHasher         = errors.New("password hasher error")
+
+		req = model.LoginParams{
+			Email:    email,
+			Password: password,
+		}
+
+		repoParams = model.GetUserByEmailParams{
+			Email: email,
+		}
+
+		user = model.User{
+			UserID:    id,
+			Name:      name,
+			Email:     email,
+			Role:      int64(role),
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		}
+
+		repoResp = model.GetUserByEmailResponse{
+			User:           user,
+			HashedPassword: hashedPassword,
+		}
+
+		updatePasswordParams = model.UpdateUserPasswordParams{
+			UserID:         id,
+			HashedPassword: newHashedPassword,
+		}
+
+		resp = model.LoginResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+
+		refreshTokenRepositoryMock = func(mc *minimock.Controller) repository.RefreshTokenRepository {
+			mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			mock.CreateRefreshTokenMock.Set(func(_ context.Context, params model.CreateRefreshTokenParams) error {
+				require.NotEmpty(t, params.TokenID)
+				require.NotEmpty(t, params.FamilyID)
+				require.Equal(t, id, params.UserID)
+				require.Equal(t, expiresAt, params.ExpiresAt)
+
+				return nil
+			})
+
+			return mock
+		}
+
+		tokenManagerMock = func(mc *minimock.Controller) token.TokenManager {
+			mock := tokenMocks.NewTokenManagerMock(mc)
+			mock.GenerateAccessTokenMock.Expect(user).Return(accessToken, nil)
+			mock.GenerateRefreshTokenMock.ExpectUserParam1(user).Return(refreshToken, expiresAt, nil)
+
+			return mock
+		}
+
+		passwordHasherMock = func(mc *minimock.Controller) crypto.PasswordHasher {
+			mock := cryptoMocks.NewPasswordHasherMock(mc)
+			mock.VerifyMock.Expect(password, hashedPassword).Return(true, nil)
+			mock.NeedsRehashMock.Expect(hashedPassword).Return(false)
+
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name                       string
+		args                       args
+		want                       model.LoginResponse
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		refreshTokenRepositoryMock refreshTokenRepositoryMockFunc
+		tokenManagerMock           tokenManagerMockFunc
+		passwordHasherMock         passwordHasherMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: resp,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: refreshTokenRepositoryMock,
+			tokenManagerMock:           tokenManagerMock,
+			passwordHasherMock:         passwordHasherMock,
+		},
+		{
+			name: "success case with outdated password hash",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: resp,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+				mock.UpdateUserPasswordMock.Expect(ctx, updatePasswordParams).Return(nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: refreshTokenRepositoryMock,
+			tokenManagerMock:           tokenManagerMock,
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, hashedPassword).Return(true, nil)
+				mock.NeedsRehashMock.Expect(hashedPassword).Return(true)
+				mock.HashMock.Expect(password).Return(newHashedPassword, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "user not found",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  model.ErrInvalidCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(model.GetUserByEmailResponse{}, model.ErrUserNotFound)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				return tokenMocks.NewTokenManagerMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, crypto.DummyHash(crypto.Argon2Params{})).Return(false, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "wrong password",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  model.ErrInvalidCredentials,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				return tokenMocks.NewTokenManagerMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, hashedPassword).Return(false, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "password hasher error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  ErrPasswordHasher,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				return tokenMocks.NewTokenManagerMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, hashedPassword).Return(false, ErrPasswordHasher)
+
+				return mock
+			},
+		},
+		{
+			name: "user repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  ErrUserRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(model.GetUserByEmailResponse{}, ErrUserRepository)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				return tokenMocks.NewTokenManagerMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				return cryptoMocks.NewPasswordHasherMock(mc)
+			},
+		},
+		{
+			name: "update password error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  ErrUserRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+				mock.UpdateUserPasswordMock.Expect(ctx, updatePasswordParams).Return(ErrUserRepository)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				return tokenMocks.NewTokenManagerMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.VerifyMock.Expect(password, hashedPassword).Return(true, nil)
+				mock.NeedsRehashMock.Expect(hashedPassword).Return(true)
+				mock.HashMock.Expect(password).Return(newHashedPassword, nil)
+
+				return mock
+			},
+		},
+		{
+			name: "token manager error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  ErrTokenManager,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.GenerateAccessTokenMock.Expect(user).Return("", ErrTokenManager)
+
+				return mock
+			},
+			passwordHasherMock: passwordHasherMock,
+		},
+		{
+			name: "refresh token repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.LoginResponse{},
+			err:  ErrRefreshTokenRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserByEmailMock.Expect(ctx, repoParams).Return(repoResp, nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.CreateRefreshTokenMock.Return(ErrRefreshTokenRepository)
+
+				return mock
+			},
+			tokenManagerMock:   tokenManagerMock,
+			passwordHasherMock: passwordHasherMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			service := authService.NewService(
+				&config.Config{},
+				tt.userRepositoryMock(mc),
+				tt.refreshTokenRepositoryMock(mc),
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				repositoryMocks.NewLogRepositoryMock(mc),
+				tt.tokenManagerMock(mc),
+				tt.passwordHasherMock(mc),
+				txManagerMock,
+			)
+
+			resp, err := service.Login(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	authService "github.com/Prrromanssss/auth/internal/service/auth"
+	"github.com/Prrromanssss/auth/internal/token"
+	tokenMocks "github.com/Prrromanssss/auth/internal/token/mocks"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	type (
+		userRepositoryMockFunc         func(mc *minimock.Controller) repository.UserRepository
+		refreshTokenRepositoryMockFunc func(mc *minimock.Controller) repository.RefreshTokenRepository
+		tokenManagerMockFunc           func(mc *minimock.Controller) token.TokenManager
+		txManagerMockFunc              func(mc *minimock.Controller) db.TxManager
+	)
+
+	type args struct {
+		ctx context.Context
+		req model.RefreshTokenParams
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id              = gofakeit.Int64()
+		name            = gofakeit.Name()
+		email           = gofakeit.Email()
+		role            = pb.Role_USER
+		createdAt       = gofakeit.Date()
+		updatedAt       = gofakeit.Date()
+		tokenID         = gofakeit.UUID()
+		familyID        = gofakeit.UUID()
+		oldRefreshToken = gofakeit.UUID()
+		accessToken     = gofakeit.UUID()
+		refreshToken    = gofakeit.UUID()
+		expiresAt       = gofakeit.FutureDate()
+		usedAt          = time.Now()
+
+		ErrUserRepository         = errors.New("user repository error")
+		ErrRefreshTokenRepository = errors.New("refresh token repository error")
+		ErrTokenManager           = errors.New("token manager error")
+
+		req = model.RefreshTokenParams{
+			RefreshToken: oldRefreshToken,
+		}
+
+		claims = &model.UserClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ID: tokenID,
+			},
+			UserID: id,
+			Role:   int64(role),
+		}
+
+		getParams = model.GetRefreshTokenParams{
+			TokenID: tokenID,
+		}
+
+		stored = model.RefreshToken{
+			TokenID:   tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: expiresAt,
+		}
+
+		usedStored = model.RefreshToken{
+			TokenID:   tokenID,
+			FamilyID:  familyID,
+			UserID:    id,
+			ExpiresAt: expiresAt,
+			UsedAt:    &usedAt,
+		}
+
+		user = model.User{
+			UserID:    id,
+			Name:      name,
+			Email:     email,
+			Role:      int64(role),
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		}
+
+		resp = model.RefreshTokenResponse{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+		}
+
+		txManagerMock = func(mc *minimock.Controller) db.TxManager {
+			mock := dbMocks.NewTxManagerMock(mc)
+			mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name                       string
+		args                       args
+		want                       model.RefreshTokenResponse
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		refreshTokenRepositoryMock refreshTokenRepositoryMockFunc
+		tokenManagerMock           tokenManagerMockFunc
+		txManagerMock              txManagerMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: resp,
+			err:  nil,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, model.GetUserParams{UserID: id}).Return(model.GetUserResponse{User: user}, nil)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(ctx, getParams).Return(stored, nil)
+				mock.MarkRefreshTokenUsedMock.Expect(ctx, model.MarkRefreshTokenUsedParams{TokenID: tokenID}).Return(nil)
+				mock.CreateRefreshTokenMock.Set(func(_ context.Context, params model.CreateRefreshTokenParams) error {
+					require.NotEqual(t, tokenID, params.TokenID)
+					require.Equal(t, familyID, params.FamilyID)
+					require.Equal(t, id, params.UserID)
+					require.Equal(t, expiresAt, params.ExpiresAt)
+
+					return nil
+				})
+
+				return mock
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(claims, nil)
+				mock.GenerateAccessTokenMock.Expect(user).Return(accessToken, nil)
+				mock.GenerateRefreshTokenMock.ExpectUserParam1(user).Return(refreshToken, expiresAt, nil)
+
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "invalid token signature",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.RefreshTokenResponse{},
+			err:  model.ErrInvalidRefreshToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				return repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(nil, ErrTokenManager)
+
+				return mock
+			},
+			txManagerMock: func(mc *minimock.Controller) db.TxManager {
+				return dbMocks.NewTxManagerMock(mc)
+			},
+		},
+		{
+			name: "token not found in store",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.RefreshTokenResponse{},
+			err:  model.ErrInvalidRefreshToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(ctx, getParams).Return(model.RefreshToken{}, model.ErrRefreshTokenNotFound)
+
+				return mock
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(claims, nil)
+
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "revoked token",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.RefreshTokenResponse{},
+			err:  model.ErrInvalidRefreshToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(ctx, getParams).Return(model.RefreshToken{
+					TokenID:   tokenID,
+					FamilyID:  familyID,
+					UserID:    id,
+					ExpiresAt: expiresAt,
+					RevokedAt: &usedAt,
+				}, nil)
+
+				return mock
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(claims, nil)
+
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "reused token revokes family",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.RefreshTokenResponse{},
+			err:  model.ErrRefreshTokenReused,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(ctx, getParams).Return(usedStored, nil)
+				mock.RevokeRefreshTokenFamilyMock.
+					Expect(ctx, model.RevokeRefreshTokenFamilyParams{FamilyID: familyID}).
+					Return(nil)
+
+				return mock
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(claims, nil)
+
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "refresh token repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.RefreshTokenResponse{},
+			err:  ErrRefreshTokenRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(ctx, getParams).Return(stored, nil)
+				mock.MarkRefreshTokenUsedMock.
+					Expect(ctx, model.MarkRefreshTokenUsedParams{TokenID: tokenID}).
+					Return(ErrRefreshTokenRepository)
+
+				return mock
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(claims, nil)
+
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+		{
+			name: "user repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.RefreshTokenResponse{},
+			err:  ErrUserRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, model.GetUserParams{UserID: id}).Return(model.GetUserResponse{}, ErrUserRepository)
+
+				return mock
+			},
+			refreshTokenRepositoryMock: func(mc *minimock.Controller) repository.RefreshTokenRepository {
+				mock := repositoryMocks.NewRefreshTokenRepositoryMock(mc)
+				mock.GetRefreshTokenMock.Expect(ctx, getParams).Return(stored, nil)
+				mock.MarkRefreshTokenUsedMock.Expect(ctx, model.MarkRefreshTokenUsedParams{TokenID: tokenID}).Return(nil)
+
+				return mock
+			},
+			tokenManagerMock: func(mc *minimock.Controller) token.TokenManager {
+				mock := tokenMocks.NewTokenManagerMock(mc)
+				mock.VerifyRefreshTokenMock.Expect(oldRefreshToken).Return(claims, nil)
+
+				return mock
+			},
+			txManagerMock: txManagerMock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := authService.NewService(
+				&config.Config{},
+				tt.userRepositoryMock(mc),
+				tt.refreshTokenRepositoryMock(mc),
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				repositoryMocks.NewLogRepositoryMock(mc),
+				tt.tokenManagerMock(mc),
+				cryptoMocks.NewPasswordHasherMock(mc),
+				tt.txManagerMock(mc),
+			)
+
+			resp, err := service.RefreshToken(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package consumer
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/Prrromanssss/auth/internal/redact"
+)
+
+// Headers of the messages published to a dead-letter topic.
+const (
+	HeaderOriginalTopic     = "dlq-original-topic"
+	HeaderOriginalPartition = "dlq-original-partition"
+	HeaderOriginalOffset    = "dlq-original-offset"
+	HeaderErrorClass        = "dlq-error-class"
+	HeaderError             = "dlq-error"
+	HeaderAttempts          = "dlq-attempts"
+	HeaderFailedAt          = "dlq-failed-at"
+	HeaderReplayCount       = "dlq-replay-count"
+)
+
+// Error classes of the messages published to a dead-letter topic.
+const (
+	ErrorClassPermanent        = "permanent"
+	ErrorClassRetriesExhausted = "retries_exhausted"
+)
+
+// NewDeadLetterMessage builds the message that carries a failed message to the dead-letter topic.
+// The key and headers of the failed message are kept as is. Its value is passed through redact.Text,
+// so the values of the registered JSON keys, such as the password, never reach the dead-letter topic.
+func NewDeadLetterMessage(
+	topic string,
+	msg *sarama.ConsumerMessage,
+	err error,
+	attempts int,
+) *sarama.ProducerMessage {
+	errorClass := ErrorClassRetriesExhausted
+	if IsPermanent(err) {
+		errorClass = ErrorClassPermanent
+	}
+
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+7)
+	for _, header := range msg.Headers {
+		if header != nil {
+			headers = append(headers, *header)
+		}
+	}
+
+	headers = setHeader(headers, HeaderOriginalTopic, msg.Topic)
+	headers = setHeader(headers, HeaderOriginalPartition, strconv.FormatInt(int64(msg.Partition), 10))
+	headers = setHeader(headers, HeaderOriginalOffset, strconv.FormatInt(msg.Offset, 10))
+	headers = setHeader(headers, HeaderErrorClass, errorClass)
+	headers = setHeader(headers, HeaderError, redact.Text(err.Error()))
+	headers = setHeader(headers, HeaderAttempts, strconv.Itoa(attempts))
+	headers = setHeader(headers, HeaderFailedAt, time.Now().UTC().Format(time.RFC3339Nano))
+
+	deadLetterMsg := &sarama.ProducerMessage{
+		Topic:   topic,
+		Value:   sarama.ByteEncoder(redact.Text(string(msg.Value))),
+		Headers: headers,
+	}
+
+	if msg.Key != nil {
+		deadLetterMsg.Key = sarama.ByteEncoder(msg.Key)
+	}
+
+	return deadLetterMsg
+}
+
+// Header returns the value of the header with the given key and whether it was found.
+func Header(headers []*sarama.RecordHeader, key string) (string, bool) {
+	for _, header := range headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value), true
+		}
+	}
+
+	return "", false
+}
+
+func setHeader(headers []sarama.RecordHeader, key, value string) []sarama.RecordHeader {
+	for i := range headers {
+		if string(headers[i].Key) == key {
+			headers[i].Value = []byte(value)
+			return headers
+		}
+	}
+
+	return append(headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
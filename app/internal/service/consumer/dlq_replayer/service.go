@@ -0,0 +1,184 @@
+package dlqreplayer
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/Prrromanssss/platform_common/pkg/kafka"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/service/consumer"
+)
+
+const dlqHeaderPrefix = "dlq-"
+
+var errLimitReached = errors.New("replay limit reached")
+
+// ReplayParams holds the parameters of a replay run.
+type ReplayParams struct {
+	// Limit is the maximum number of messages to replay, zero means no limit.
+	Limit int
+	// IdleTimeout stops the run when no message arrives for that long.
+	IdleTimeout time.Duration
+}
+
+type service struct {
+	cfg      *config.Config
+	consumer kafka.Consumer
+	producer sarama.SyncProducer
+}
+
+// NewService creates a new instance of the dead-letter replayer.
+// The consumer must belong to a consumer group of its own, so that replayed messages are committed
+// and are not replayed again by the next run.
+func NewService(
+	cfg *config.Config,
+	consumer kafka.Consumer,
+	producer sarama.SyncProducer,
+) *service {
+	return &service{
+		cfg:      cfg,
+		consumer: consumer,
+		producer: producer,
+	}
+}
+
+type replayRun struct {
+	mu       sync.Mutex
+	limit    int
+	replayed int
+	activity chan struct{}
+	cancel   context.CancelFunc
+}
+
+// Replay re-injects messages from the dead-letter topic into the topics they originally came from
+// and returns the number of replayed messages.
+func (s *service) Replay(ctx context.Context, params ReplayParams) (replayed int, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	run := &replayRun{
+		limit:    params.Limit,
+		activity: make(chan struct{}, 1),
+		cancel:   cancel,
+	}
+
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- s.consumer.Consume(ctx, s.cfg.KafkaConsumer.DeadLetterTopicName, func(
+			ctx context.Context,
+			msg *sarama.ConsumerMessage,
+		) error {
+			return s.replayMessage(ctx, run, msg)
+		})
+	}()
+
+	idle := time.NewTimer(params.IdleTimeout)
+	defer idle.Stop()
+
+	for {
+		select {
+		case err = <-errChan:
+			if errors.Is(err, context.Canceled) {
+				err = nil
+			}
+
+			run.mu.Lock()
+			defer run.mu.Unlock()
+
+			return run.replayed, err
+		case <-run.activity:
+			if !idle.Stop() {
+				select {
+				case <-idle.C:
+				default:
+				}
+			}
+
+			idle.Reset(params.IdleTimeout)
+		case <-idle.C:
+			log.Infof("No dead-letter messages for %s, stopping replay", params.IdleTimeout)
+			cancel()
+		}
+	}
+}
+
+func (s *service) replayMessage(ctx context.Context, run *replayRun, msg *sarama.ConsumerMessage) error {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+
+	select {
+	case run.activity <- struct{}{}:
+	default:
+	}
+
+	// Messages that are not replayed stay uncommitted and are picked up by the next run.
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if run.limit > 0 && run.replayed >= run.limit {
+		return errLimitReached
+	}
+
+	_, _, err := s.producer.SendMessage(s.newReplayMessage(msg))
+	if err != nil {
+		return errors.Wrapf(err, "Cannot replay dead-letter message(offset: %d)", msg.Offset)
+	}
+
+	run.replayed++
+
+	log.Infof("Dead-letter message replayed, offset: %d, replayed: %d", msg.Offset, run.replayed)
+
+	if run.limit > 0 && run.replayed >= run.limit {
+		run.cancel()
+	}
+
+	return nil
+}
+
+// newReplayMessage restores the original message and counts how many times it was replayed.
+func (s *service) newReplayMessage(msg *sarama.ConsumerMessage) *sarama.ProducerMessage {
+	topic, ok := consumer.Header(msg.Headers, consumer.HeaderOriginalTopic)
+	if !ok || topic == "" {
+		topic = s.cfg.KafkaConsumer.UsersCreationTopicName
+	}
+
+	replayCount := 0
+	if value, ok := consumer.Header(msg.Headers, consumer.HeaderReplayCount); ok {
+		replayCount, _ = strconv.Atoi(value)
+	}
+
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+1)
+	for _, header := range msg.Headers {
+		if header == nil || strings.HasPrefix(string(header.Key), dlqHeaderPrefix) {
+			continue
+		}
+
+		headers = append(headers, *header)
+	}
+
+	headers = append(headers, sarama.RecordHeader{
+		Key:   []byte(consumer.HeaderReplayCount),
+		Value: []byte(strconv.Itoa(replayCount + 1)),
+	})
+
+	replayMsg := &sarama.ProducerMessage{
+		Topic:   topic,
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	}
+
+	if msg.Key != nil {
+		replayMsg.Key = sarama.ByteEncoder(msg.Key)
+	}
+
+	return replayMsg
+}
@@ -0,0 +1,188 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	saramaMocks "github.com/IBM/sarama/mocks"
+	kafkaConsumer "github.com/Prrromanssss/platform_common/pkg/kafka/consumer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/service/consumer"
+	dlqReplayer "github.com/Prrromanssss/auth/internal/service/consumer/dlq_replayer"
+)
+
+// fakeConsumer hands the messages to the handler and then waits for the context like a real consumer group.
+type fakeConsumer struct {
+	t        *testing.T
+	messages []*sarama.ConsumerMessage
+	errs     []error
+}
+
+func (c *fakeConsumer) Consume(ctx context.Context, topicName string, handler kafkaConsumer.Handler) error {
+	require.Equal(c.t, "User-Creation-DLQ", topicName)
+
+	for _, msg := range c.messages {
+		c.errs = append(c.errs, handler(ctx, msg))
+	}
+
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func (c *fakeConsumer) Close() error {
+	return nil
+}
+
+func header(key, value string) *sarama.RecordHeader {
+	return &sarama.RecordHeader{Key: []byte(key), Value: []byte(value)}
+}
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ErrProducer = errors.New("producer error")
+
+		cfg = &config.Config{
+			KafkaConsumer: yaml.KafkaConsumer{
+				UsersCreationTopicName: "User-Creation",
+				DeadLetterTopicName:    "User-Creation-DLQ",
+			},
+		}
+
+		deadLetter = &sarama.ConsumerMessage{
+			Topic: "User-Creation-DLQ",
+			Key:   []byte("key"),
+			Value: []byte(`{"name":"john"}`),
+			Headers: []*sarama.RecordHeader{
+				header("trace-id", "abc"),
+				header(consumer.HeaderOriginalTopic, "User-Creation-V2"),
+				header(consumer.HeaderErrorClass, consumer.ErrorClassRetriesExhausted),
+				header(consumer.HeaderError, "connection refused"),
+				header(consumer.HeaderReplayCount, "1"),
+			},
+		}
+
+		deadLetterWithoutHeaders = &sarama.ConsumerMessage{
+			Topic: "User-Creation-DLQ",
+			Value: []byte(`{"name":"jane"}`),
+		}
+
+		expectReplay = func(topic, value, replayCount string) saramaMocks.MessageChecker {
+			return func(msg *sarama.ProducerMessage) error {
+				gotValue, err := msg.Value.Encode()
+				if err != nil {
+					return err
+				}
+
+				if msg.Topic != topic || string(gotValue) != value {
+					return errors.New("unexpected replayed message")
+				}
+
+				headers := make([]*sarama.RecordHeader, 0, len(msg.Headers))
+				for i := range msg.Headers {
+					headers = append(headers, &msg.Headers[i])
+				}
+
+				if got, _ := consumer.Header(headers, consumer.HeaderReplayCount); got != replayCount {
+					return errors.New("unexpected replay count: " + got)
+				}
+
+				if _, ok := consumer.Header(headers, consumer.HeaderError); ok {
+					return errors.New("dead-letter headers are not stripped")
+				}
+
+				return nil
+			}
+		}
+	)
+
+	tests := []struct {
+		name         string
+		messages     []*sarama.ConsumerMessage
+		limit        int
+		wantReplayed int
+		wantErrs     []error
+		producerMock func(t *testing.T) *saramaMocks.SyncProducer
+	}{
+		{
+			name:         "success case",
+			messages:     []*sarama.ConsumerMessage{deadLetter, deadLetterWithoutHeaders},
+			wantReplayed: 2,
+			wantErrs:     []error{nil, nil},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectReplay("User-Creation-V2", `{"name":"john"}`, "2"),
+				)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectReplay("User-Creation", `{"name":"jane"}`, "1"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name:         "limit reached",
+			messages:     []*sarama.ConsumerMessage{deadLetter, deadLetterWithoutHeaders},
+			limit:        1,
+			wantReplayed: 1,
+			wantErrs:     []error{nil, context.Canceled},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectReplay("User-Creation-V2", `{"name":"john"}`, "2"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name:         "producer error leaves message uncommitted",
+			messages:     []*sarama.ConsumerMessage{deadLetter},
+			wantReplayed: 0,
+			wantErrs:     []error{ErrProducer},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageAndFail(ErrProducer)
+
+				return producer
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			producer := tt.producerMock(t)
+			defer func() {
+				require.NoError(t, producer.Close())
+			}()
+
+			fake := &fakeConsumer{t: t, messages: tt.messages}
+
+			replayed, err := dlqReplayer.NewService(cfg, fake, producer).Replay(
+				context.Background(),
+				dlqReplayer.ReplayParams{
+					Limit:       tt.limit,
+					IdleTimeout: 50 * time.Millisecond,
+				},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantReplayed, replayed)
+			require.Len(t, fake.errs, len(tt.wantErrs))
+
+			for i, wantErr := range tt.wantErrs {
+				require.ErrorIs(t, fake.errs[i], wantErr)
+			}
+		})
+	}
+}
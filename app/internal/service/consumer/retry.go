@@ -0,0 +1,75 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RetryPolicy describes how many times and how often a failed message is processed again.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns the delay before the given retry, doubling the initial backoff up to the maximum one.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < retry && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	return backoff
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks an error as one that processing the message again cannot fix.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether the error was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permanentErr *permanentError
+	return errors.As(err, &permanentErr)
+}
+
+// Retry calls f until it succeeds, fails with a permanent error, the policy runs out of attempts
+// or the context is done. It returns the number of attempts made and the last error.
+func Retry(ctx context.Context, policy RetryPolicy, f func(ctx context.Context) error) (attempts int, err error) {
+	for attempts = 1; ; attempts++ {
+		err = f(ctx)
+		if err == nil || IsPermanent(err) || attempts >= policy.MaxAttempts {
+			return attempts, err
+		}
+
+		timer := time.NewTimer(policy.Backoff(attempts))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return attempts, err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
+	"github.com/Prrromanssss/auth/internal/service/consumer"
+)
+
+// TestNewDeadLetterMessageRedaction checks that the password reaches neither the value
+// nor the headers of the dead-letter message.
+func TestNewDeadLetterMessageRedaction(t *testing.T) {
+	t.Parallel()
+
+	redact.RegisterJSON(model.CreateUserKafkaParams{})
+
+	var (
+		password = gofakeit.Password(true, true, true, false, false, 12)
+		value    = fmt.Sprintf(`{"name":"John","email":"john.doe@example.com","password":%q,"role":1}`, password)
+
+		msg = &sarama.ConsumerMessage{
+			Topic:     "User-Creation",
+			Partition: 2,
+			Offset:    42,
+			Key:       []byte("key"),
+			Value:     []byte(value),
+		}
+	)
+
+	deadLetterMsg := consumer.NewDeadLetterMessage(
+		"User-Creation-DLQ",
+		msg,
+		consumer.Permanent(errors.New("Cannot decode message: "+value)),
+		1,
+	)
+
+	gotValue, err := deadLetterMsg.Value.Encode()
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"John","email":"john.doe@example.com","password":"[REDACTED]","role":1}`, string(gotValue))
+
+	for _, header := range deadLetterMsg.Headers {
+		require.NotContains(t, string(header.Value), password, "header %s", header.Key)
+	}
+}
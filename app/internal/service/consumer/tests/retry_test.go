@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/internal/service/consumer"
+)
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ErrTransient = errors.New("transient error")
+		ErrPermanent = consumer.Permanent(errors.New("permanent error"))
+
+		policy = consumer.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		}
+	)
+
+	tests := []struct {
+		name         string
+		errs         []error
+		wantAttempts int
+		err          error
+	}{
+		{
+			name:         "success case",
+			errs:         []error{nil},
+			wantAttempts: 1,
+			err:          nil,
+		},
+		{
+			name:         "success after transient errors",
+			errs:         []error{ErrTransient, ErrTransient, nil},
+			wantAttempts: 3,
+			err:          nil,
+		},
+		{
+			name:         "permanent error is not retried",
+			errs:         []error{ErrPermanent},
+			wantAttempts: 1,
+			err:          ErrPermanent,
+		},
+		{
+			name:         "attempts exhausted",
+			errs:         []error{ErrTransient, ErrTransient, ErrTransient},
+			wantAttempts: 3,
+			err:          ErrTransient,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+
+			attempts, err := consumer.Retry(context.Background(), policy, func(_ context.Context) error {
+				err := tt.errs[calls]
+				calls++
+
+				return err
+			})
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.wantAttempts, attempts)
+			require.Equal(t, tt.wantAttempts, calls)
+		})
+	}
+
+	t.Run("context done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		attempts, err := consumer.Retry(ctx, consumer.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Hour,
+			MaxBackoff:     time.Hour,
+		}, func(_ context.Context) error {
+			return ErrTransient
+		})
+		require.Equal(t, ErrTransient, err)
+		require.Equal(t, 1, attempts)
+	})
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := consumer.RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+	}
+
+	require.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	require.Equal(t, 200*time.Millisecond, policy.Backoff(2))
+	require.Equal(t, 400*time.Millisecond, policy.Backoff(3))
+	require.Equal(t, 800*time.Millisecond, policy.Backoff(4))
+	require.Equal(t, time.Second, policy.Backoff(5))
+	require.Equal(t, time.Second, policy.Backoff(20))
+}
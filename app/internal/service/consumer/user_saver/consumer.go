@@ -3,27 +3,35 @@ package usersaver
 import (
 	"context"
 
+	"github.com/IBM/sarama"
 	"github.com/Prrromanssss/platform_common/pkg/kafka"
 
 	"github.com/Prrromanssss/auth/config"
-	"github.com/Prrromanssss/auth/internal/repository"
+	internalService "github.com/Prrromanssss/auth/internal/service"
+	"github.com/Prrromanssss/auth/pkg/crypto"
 )
 
 type service struct {
 	cfg            *config.Config
-	userRepository repository.UserRepository
+	userService    internalService.UserService
+	passwordHasher crypto.PasswordHasher
 	consumer       kafka.Consumer
+	producer       sarama.SyncProducer
 }
 
 func NewService(
 	cfg *config.Config,
-	userRepository repository.UserRepository,
+	userService internalService.UserService,
+	passwordHasher crypto.PasswordHasher,
 	consumer kafka.Consumer,
+	producer sarama.SyncProducer,
 ) *service {
 	return &service{
 		cfg:            cfg,
-		userRepository: userRepository,
+		userService:    userService,
+		passwordHasher: passwordHasher,
 		consumer:       consumer,
+		producer:       producer,
 	}
 }
 
@@ -3,29 +3,163 @@ package usersaver
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"math"
+	"time"
 
 	"github.com/IBM/sarama"
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/jackc/pgconn"
+	pkgErrors "github.com/pkg/errors"
 
 	"github.com/Prrromanssss/auth/internal/converter"
+	"github.com/Prrromanssss/auth/internal/errs"
 	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
+	"github.com/Prrromanssss/auth/internal/service/consumer"
+	"github.com/Prrromanssss/auth/internal/tracing"
 )
 
+const (
+	// Postgres error classes that processing the same message again cannot fix.
+	pgDataExceptionClass      = "22"
+	pgIntegrityViolationClass = "23"
+)
+
+// UserSaveHandler creates a user from the message. Transient failures are retried with backoff,
+// permanent failures and messages that ran out of retries are sent to the dead-letter topic.
+// The consumer group marks the offsets of later messages whatever the handler returns, so sending
+// to the dead-letter topic is retried until it succeeds. An error is returned only if the context
+// is done first, which happens when the consumer session ends.
+// The message is handled in a span that continues the trace context found in its headers.
 func (s *service) UserSaveHandler(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	start := time.Now()
+
+	ctx, span := tracing.StartConsumerSpan(ctx, s.cfg.KafkaConsumer.GroupID, msg)
+	defer span.End()
+
+	attempts, err := consumer.Retry(ctx, s.retryPolicy(), func(ctx context.Context) error {
+		return classify(s.saveUser(ctx, msg))
+	})
+	if err == nil {
+		observeMessage(msg.Partition, outcomeSaved, start)
+		return nil
+	}
+
+	tracing.RecordError(span, err)
+
+	if ctx.Err() != nil {
+		observeMessage(msg.Partition, outcomeFailed, start)
+		return err
+	}
+
+	log.Warnf(
+		"Failed to save user, topic: %s, partition: %d, offset: %d, attempts: %d, err: %+v",
+		msg.Topic,
+		msg.Partition,
+		msg.Offset,
+		attempts,
+		err,
+	)
+
+	dlqErr := s.sendDeadLetter(ctx, msg, err, attempts)
+	if dlqErr != nil {
+		observeMessage(msg.Partition, outcomeFailed, start)
+		return pkgErrors.Wrapf(dlqErr, "Cannot send message to dead-letter topic(offset: %d)", msg.Offset)
+	}
+
+	observeMessage(msg.Partition, outcomeDeadLettered, start)
+
+	return nil
+}
+
+// saveUser creates the user through the user service after the same validation and hashing
+// as the Create handler of the api layer.
+func (s *service) saveUser(ctx context.Context, msg *sarama.ConsumerMessage) error {
 	user := &model.CreateUserKafkaParams{}
 	err := json.Unmarshal(msg.Value, user)
 	if err != nil {
-		return err
+		return consumer.Permanent(pkgErrors.Wrap(err, "Cannot decode message"))
+	}
+
+	// A message replayed from the dead-letter topic carries the masked password, which must not become
+	// the password of the user.
+	if user.Password == redact.Mask {
+		return consumer.Permanent(errors.New("Password of the message is redacted"))
+	}
+
+	err = converter.ConvertCreateUserKafkaParamsFromConsumerServiceToHandler(*user).ValidateAll()
+	if err != nil {
+		return errs.NewInvalidArgumentFromValidation(err)
 	}
 
-	userParams := converter.ConvertCreateUserKafkaParamsFromConsumerServiceToUserService(*user)
+	hashedPassword, err := s.passwordHasher.Hash(user.Password)
+	if err != nil {
+		return err
+	}
 
-	resp, err := s.userRepository.CreateUser(ctx, userParams)
+	resp, err := s.userService.CreateUser(
+		ctx,
+		converter.ConvertCreateUserKafkaParamsFromConsumerServiceToUserService(*user, hashedPassword),
+	)
 	if err != nil {
 		return err
 	}
 
-	log.Infof("User with id %d created: %+v\n", resp.UserID, resp)
+	log.Infof("User with id %d created", resp.UserID)
 
 	return nil
 }
+
+// sendDeadLetter sends the message to the dead-letter topic, retrying with backoff until it succeeds
+// or the context is done.
+func (s *service) sendDeadLetter(ctx context.Context, msg *sarama.ConsumerMessage, cause error, attempts int) error {
+	policy := s.retryPolicy()
+	policy.MaxAttempts = math.MaxInt
+
+	_, err := consumer.Retry(ctx, policy, func(context.Context) error {
+		_, _, err := s.producer.SendMessage(
+			consumer.NewDeadLetterMessage(s.cfg.KafkaConsumer.DeadLetterTopicName, msg, cause, attempts),
+		)
+		if err != nil {
+			log.Warnf("Failed to send message to dead-letter topic, offset: %d, err: %v", msg.Offset, err)
+		}
+
+		return err
+	})
+
+	return err
+}
+
+func (s *service) retryPolicy() consumer.RetryPolicy {
+	return consumer.RetryPolicy{
+		MaxAttempts:    s.cfg.KafkaConsumer.RetryMaxAttempts,
+		InitialBackoff: s.cfg.KafkaConsumer.RetryInitialBackoff,
+		MaxBackoff:     s.cfg.KafkaConsumer.RetryMaxBackoff,
+	}
+}
+
+// classify marks the errors that are caused by the message itself as permanent.
+func classify(err error) error {
+	if err == nil || consumer.IsPermanent(err) {
+		return err
+	}
+
+	var domainErr *errs.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Kind {
+		case errs.KindInvalidArgument, errs.KindAlreadyExists:
+			return consumer.Permanent(err)
+		}
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && len(pgErr.Code) >= 2 {
+		switch pgErr.Code[:2] {
+		case pgDataExceptionClass, pgIntegrityViolationClass:
+			return consumer.Permanent(err)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,42 @@
+package usersaver
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/Prrromanssss/auth/internal/metrics"
+)
+
+const (
+	// outcomeSaved is a message whose user was created.
+	outcomeSaved = "saved"
+	// outcomeDeadLettered is a message that failed and was sent to the dead-letter topic.
+	outcomeDeadLettered = "dead_lettered"
+	// outcomeFailed is a message whose handling was cut short by the context being done.
+	outcomeFailed = "failed"
+)
+
+var (
+	messages = promauto.With(metrics.Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "user_saver",
+		Name:      "messages_total",
+		Help:      "Messages handled by the user saver consumer by partition and outcome.",
+	}, []string{"partition", "outcome"})
+
+	messageDuration = promauto.With(metrics.Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "user_saver",
+		Name:      "message_duration_seconds",
+		Help:      "Duration of the handling of a message by the user saver consumer, retries included.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"outcome"})
+)
+
+func observeMessage(partition int32, outcome string, start time.Time) {
+	messages.WithLabelValues(strconv.FormatInt(int64(partition), 10), outcome).Inc()
+	messageDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+}
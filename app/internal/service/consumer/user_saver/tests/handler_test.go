@@ -0,0 +1,378 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	saramaMocks "github.com/IBM/sarama/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/jackc/pgconn"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/redact"
+	"github.com/Prrromanssss/auth/internal/service"
+	"github.com/Prrromanssss/auth/internal/service/consumer"
+	userSaver "github.com/Prrromanssss/auth/internal/service/consumer/user_saver"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	"github.com/Prrromanssss/auth/pkg/crypto"
+	cryptoMocks "github.com/Prrromanssss/auth/pkg/crypto/mocks"
+)
+
+func TestUserSaveHandler(t *testing.T) {
+	t.Parallel()
+
+	redact.RegisterJSON(model.CreateUserKafkaParams{})
+
+	type (
+		userServiceMockFunc    func(mc *minimock.Controller) service.UserService
+		passwordHasherMockFunc func(mc *minimock.Controller) crypto.PasswordHasher
+		producerMockFunc       func(t *testing.T) *saramaMocks.SyncProducer
+	)
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		name           = gofakeit.Name()
+		email          = gofakeit.Email()
+		password       = gofakeit.Password(true, true, true, false, false, 12)
+		hashedPassword = gofakeit.UUID()
+
+		ErrTransient = errors.New("connection refused")
+		ErrHasher    = errors.New("hasher error")
+		ErrProducer  = errors.New("producer error")
+
+		cfg = &config.Config{
+			KafkaConsumer: yaml.KafkaConsumer{
+				UsersCreationTopicName: "User-Creation",
+				DeadLetterTopicName:    "User-Creation-DLQ",
+				RetryMaxAttempts:       3,
+				RetryInitialBackoff:    time.Millisecond,
+				RetryMaxBackoff:        time.Millisecond,
+			},
+		}
+
+		value         = []byte(`{"name":"` + name + `","email":"` + email + `","password":"` + password + `","role":1}`)
+		redactedValue = []byte(`{"name":"` + name + `","email":"` + email + `","password":"` + redact.Mask + `","role":1}`)
+
+		invalidValue         = []byte(`{"name":"` + name + `","email":"not-an-email","password":"short","role":1}`)
+		redactedInvalidValue = []byte(`{"name":"` + name + `","email":"not-an-email","password":"` + redact.Mask + `","role":1}`)
+
+		createParams = model.CreateUserParams{
+			Name:           name,
+			Email:          email,
+			HashedPassword: hashedPassword,
+			Role:           1,
+		}
+
+		withHash = func(mc *minimock.Controller) crypto.PasswordHasher {
+			mock := cryptoMocks.NewPasswordHasherMock(mc)
+			mock.HashMock.Expect(password).Return(hashedPassword, nil)
+
+			return mock
+		}
+
+		withoutHash = func(mc *minimock.Controller) crypto.PasswordHasher {
+			return cryptoMocks.NewPasswordHasherMock(mc)
+		}
+
+		newMessage = func(value []byte) *sarama.ConsumerMessage {
+			return &sarama.ConsumerMessage{
+				Topic:     "User-Creation",
+				Partition: 2,
+				Offset:    42,
+				Key:       []byte("key"),
+				Value:     value,
+			}
+		}
+
+		expectDeadLetter = func(value []byte, errorClass, attempts string) saramaMocks.MessageChecker {
+			return func(msg *sarama.ProducerMessage) error {
+				gotValue, err := msg.Value.Encode()
+				if err != nil {
+					return err
+				}
+
+				headers := make([]*sarama.RecordHeader, 0, len(msg.Headers))
+				for i := range msg.Headers {
+					headers = append(headers, &msg.Headers[i])
+				}
+
+				want := map[string]string{
+					consumer.HeaderOriginalTopic:     "User-Creation",
+					consumer.HeaderOriginalPartition: "2",
+					consumer.HeaderOriginalOffset:    "42",
+					consumer.HeaderErrorClass:        errorClass,
+					consumer.HeaderAttempts:          attempts,
+				}
+				for key, wantValue := range want {
+					if gotHeader, _ := consumer.Header(headers, key); gotHeader != wantValue {
+						return errors.New("unexpected header " + key + ": " + gotHeader)
+					}
+				}
+
+				if msg.Topic != "User-Creation-DLQ" || string(gotValue) != string(value) {
+					return errors.New("unexpected dead-letter message")
+				}
+
+				return nil
+			}
+		}
+	)
+
+	tests := []struct {
+		name               string
+		msg                *sarama.ConsumerMessage
+		err                error
+		userServiceMock    userServiceMockFunc
+		passwordHasherMock passwordHasherMockFunc
+		producerMock       producerMockFunc
+	}{
+		{
+			name: "success case",
+			msg:  newMessage(value),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(minimock.AnyContext, createParams).Return(model.CreateUserResponse{}, nil)
+
+				return mock
+			},
+			passwordHasherMock: withHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				return saramaMocks.NewSyncProducer(t, nil)
+			},
+		},
+		{
+			name: "success after transient error",
+			msg:  newMessage(value),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Set(func(_ context.Context, _ model.CreateUserParams) (model.CreateUserResponse, error) {
+					if mock.CreateUserBeforeCounter() == 1 {
+						return model.CreateUserResponse{}, ErrTransient
+					}
+
+					return model.CreateUserResponse{}, nil
+				})
+
+				return mock
+			},
+			passwordHasherMock: withHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				return saramaMocks.NewSyncProducer(t, nil)
+			},
+		},
+		{
+			name: "malformed json",
+			msg:  newMessage([]byte(`{"name":`)),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			passwordHasherMock: withoutHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter([]byte(`{"name":`), consumer.ErrorClassPermanent, "1"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "validation error",
+			msg:  newMessage(invalidValue),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			passwordHasherMock: withoutHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter(redactedInvalidValue, consumer.ErrorClassPermanent, "1"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "replayed message with a redacted password",
+			msg:  newMessage(redactedValue),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			passwordHasherMock: withoutHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter(redactedValue, consumer.ErrorClassPermanent, "1"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "duplicate email",
+			msg:  newMessage(value),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(minimock.AnyContext, createParams).Return(
+					model.CreateUserResponse{},
+					model.ErrUserAlreadyExists.WithResource("user", email),
+				)
+
+				return mock
+			},
+			passwordHasherMock: withHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter(redactedValue, consumer.ErrorClassPermanent, "1"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "unknown role",
+			msg:  newMessage(value),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Expect(minimock.AnyContext, createParams).Return(
+					model.CreateUserResponse{},
+					&pgconn.PgError{Code: "23503"},
+				)
+
+				return mock
+			},
+			passwordHasherMock: withHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter(redactedValue, consumer.ErrorClassPermanent, "1"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "retries exhausted",
+			msg:  newMessage(value),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				mock := serviceMocks.NewUserServiceMock(mc)
+				mock.CreateUserMock.Times(3).Expect(minimock.AnyContext, createParams).Return(model.CreateUserResponse{}, ErrTransient)
+
+				return mock
+			},
+			passwordHasherMock: withHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter(redactedValue, consumer.ErrorClassRetriesExhausted, "3"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "hasher error",
+			msg:  newMessage(value),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			passwordHasherMock: func(mc *minimock.Controller) crypto.PasswordHasher {
+				mock := cryptoMocks.NewPasswordHasherMock(mc)
+				mock.HashMock.Expect(password).Return("", ErrHasher)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter(redactedValue, consumer.ErrorClassRetriesExhausted, "3"),
+				)
+
+				return producer
+			},
+		},
+		{
+			name: "dead-letter topic is temporarily unavailable",
+			msg:  newMessage([]byte(`{"name":`)),
+			err:  nil,
+			userServiceMock: func(mc *minimock.Controller) service.UserService {
+				return serviceMocks.NewUserServiceMock(mc)
+			},
+			passwordHasherMock: withoutHash,
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageAndFail(ErrProducer)
+				producer.ExpectSendMessageAndFail(ErrProducer)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(
+					expectDeadLetter([]byte(`{"name":`), consumer.ErrorClassPermanent, "1"),
+				)
+
+				return producer
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			producer := tt.producerMock(t)
+			defer func() {
+				require.NoError(t, producer.Close())
+			}()
+
+			saver := userSaver.NewService(
+				cfg,
+				tt.userServiceMock(mc),
+				tt.passwordHasherMock(mc),
+				nil,
+				producer,
+			)
+
+			err := saver.UserSaveHandler(ctx, tt.msg)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("dead-letter topic is unavailable until the context is done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		producer := saramaMocks.NewSyncProducer(t, nil)
+		defer func() {
+			require.NoError(t, producer.Close())
+		}()
+
+		producer.ExpectSendMessageAndFail(ErrProducer)
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndFail(func(*sarama.ProducerMessage) error {
+			cancel()
+			return nil
+		}, ErrProducer)
+
+		saver := userSaver.NewService(cfg, serviceMocks.NewUserServiceMock(mc), withoutHash(mc), nil, producer)
+
+		err := saver.UserSaveHandler(ctx, newMessage([]byte(`{"name":`)))
+		require.ErrorIs(t, err, ErrProducer)
+	})
+}
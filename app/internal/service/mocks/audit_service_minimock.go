@@ -0,0 +1,395 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/service.AuditService -o audit_service_minimock.go -n AuditServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditServiceMock implements service.AuditService
+type AuditServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcListAuditEvents          func(ctx context.Context, params model.ListAuditEventsParams) (resp model.ListAuditEventsResponse, err error)
+	inspectFuncListAuditEvents   func(ctx context.Context, params model.ListAuditEventsParams)
+	afterListAuditEventsCounter  uint64
+	beforeListAuditEventsCounter uint64
+	ListAuditEventsMock          mAuditServiceMockListAuditEvents
+}
+
+// NewAuditServiceMock returns a mock for service.AuditService
+func NewAuditServiceMock(t minimock.Tester) *AuditServiceMock {
+	m := &AuditServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ListAuditEventsMock = mAuditServiceMockListAuditEvents{mock: m}
+	m.ListAuditEventsMock.callArgs = []*AuditServiceMockListAuditEventsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditServiceMockListAuditEvents struct {
+	optional           bool
+	mock               *AuditServiceMock
+	defaultExpectation *AuditServiceMockListAuditEventsExpectation
+	expectations       []*AuditServiceMockListAuditEventsExpectation
+
+	callArgs []*AuditServiceMockListAuditEventsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditServiceMockListAuditEventsExpectation specifies expectation struct of the AuditService.ListAuditEvents
+type AuditServiceMockListAuditEventsExpectation struct {
+	mock      *AuditServiceMock
+	params    *AuditServiceMockListAuditEventsParams
+	paramPtrs *AuditServiceMockListAuditEventsParamPtrs
+	results   *AuditServiceMockListAuditEventsResults
+	Counter   uint64
+}
+
+// AuditServiceMockListAuditEventsParams contains parameters of the AuditService.ListAuditEvents
+type AuditServiceMockListAuditEventsParams struct {
+	ctx    context.Context
+	params model.ListAuditEventsParams
+}
+
+// AuditServiceMockListAuditEventsParamPtrs contains pointers to parameters of the AuditService.ListAuditEvents
+type AuditServiceMockListAuditEventsParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListAuditEventsParams
+}
+
+// AuditServiceMockListAuditEventsResults contains results of the AuditService.ListAuditEvents
+type AuditServiceMockListAuditEventsResults struct {
+	resp model.ListAuditEventsResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Optional() *mAuditServiceMockListAuditEvents {
+	mmListAuditEvents.optional = true
+	return mmListAuditEvents
+}
+
+// Expect sets up expected params for AuditService.ListAuditEvents
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Expect(ctx context.Context, params model.ListAuditEventsParams) *mAuditServiceMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditServiceMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by ExpectParams functions")
+	}
+
+	mmListAuditEvents.defaultExpectation.params = &AuditServiceMockListAuditEventsParams{ctx, params}
+	for _, e := range mmListAuditEvents.expectations {
+		if minimock.Equal(e.params, mmListAuditEvents.defaultExpectation.params) {
+			mmListAuditEvents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAuditEvents.defaultExpectation.params)
+		}
+	}
+
+	return mmListAuditEvents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditService.ListAuditEvents
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) ExpectCtxParam1(ctx context.Context) *mAuditServiceMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditServiceMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.params != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Expect")
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs == nil {
+		mmListAuditEvents.defaultExpectation.paramPtrs = &AuditServiceMockListAuditEventsParamPtrs{}
+	}
+	mmListAuditEvents.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListAuditEvents
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditService.ListAuditEvents
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) ExpectParamsParam2(params model.ListAuditEventsParams) *mAuditServiceMockListAuditEvents {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditServiceMockListAuditEventsExpectation{}
+	}
+
+	if mmListAuditEvents.defaultExpectation.params != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Expect")
+	}
+
+	if mmListAuditEvents.defaultExpectation.paramPtrs == nil {
+		mmListAuditEvents.defaultExpectation.paramPtrs = &AuditServiceMockListAuditEventsParamPtrs{}
+	}
+	mmListAuditEvents.defaultExpectation.paramPtrs.params = &params
+
+	return mmListAuditEvents
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditService.ListAuditEvents
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Inspect(f func(ctx context.Context, params model.ListAuditEventsParams)) *mAuditServiceMockListAuditEvents {
+	if mmListAuditEvents.mock.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("Inspect function is already set for AuditServiceMock.ListAuditEvents")
+	}
+
+	mmListAuditEvents.mock.inspectFuncListAuditEvents = f
+
+	return mmListAuditEvents
+}
+
+// Return sets up results that will be returned by AuditService.ListAuditEvents
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Return(resp model.ListAuditEventsResponse, err error) *AuditServiceMock {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	if mmListAuditEvents.defaultExpectation == nil {
+		mmListAuditEvents.defaultExpectation = &AuditServiceMockListAuditEventsExpectation{mock: mmListAuditEvents.mock}
+	}
+	mmListAuditEvents.defaultExpectation.results = &AuditServiceMockListAuditEventsResults{resp, err}
+	return mmListAuditEvents.mock
+}
+
+// Set uses given function f to mock the AuditService.ListAuditEvents method
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Set(f func(ctx context.Context, params model.ListAuditEventsParams) (resp model.ListAuditEventsResponse, err error)) *AuditServiceMock {
+	if mmListAuditEvents.defaultExpectation != nil {
+		mmListAuditEvents.mock.t.Fatalf("Default expectation is already set for the AuditService.ListAuditEvents method")
+	}
+
+	if len(mmListAuditEvents.expectations) > 0 {
+		mmListAuditEvents.mock.t.Fatalf("Some expectations are already set for the AuditService.ListAuditEvents method")
+	}
+
+	mmListAuditEvents.mock.funcListAuditEvents = f
+	return mmListAuditEvents.mock
+}
+
+// When sets expectation for the AuditService.ListAuditEvents which will trigger the result defined by the following
+// Then helper
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) When(ctx context.Context, params model.ListAuditEventsParams) *AuditServiceMockListAuditEventsExpectation {
+	if mmListAuditEvents.mock.funcListAuditEvents != nil {
+		mmListAuditEvents.mock.t.Fatalf("AuditServiceMock.ListAuditEvents mock is already set by Set")
+	}
+
+	expectation := &AuditServiceMockListAuditEventsExpectation{
+		mock:   mmListAuditEvents.mock,
+		params: &AuditServiceMockListAuditEventsParams{ctx, params},
+	}
+	mmListAuditEvents.expectations = append(mmListAuditEvents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditService.ListAuditEvents return parameters for the expectation previously defined by the When method
+func (e *AuditServiceMockListAuditEventsExpectation) Then(resp model.ListAuditEventsResponse, err error) *AuditServiceMock {
+	e.results = &AuditServiceMockListAuditEventsResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuditService.ListAuditEvents should be invoked
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Times(n uint64) *mAuditServiceMockListAuditEvents {
+	if n == 0 {
+		mmListAuditEvents.mock.t.Fatalf("Times of AuditServiceMock.ListAuditEvents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAuditEvents.expectedInvocations, n)
+	return mmListAuditEvents
+}
+
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) invocationsDone() bool {
+	if len(mmListAuditEvents.expectations) == 0 && mmListAuditEvents.defaultExpectation == nil && mmListAuditEvents.mock.funcListAuditEvents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAuditEvents.mock.afterListAuditEventsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAuditEvents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAuditEvents implements service.AuditService
+func (mmListAuditEvents *AuditServiceMock) ListAuditEvents(ctx context.Context, params model.ListAuditEventsParams) (resp model.ListAuditEventsResponse, err error) {
+	mm_atomic.AddUint64(&mmListAuditEvents.beforeListAuditEventsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAuditEvents.afterListAuditEventsCounter, 1)
+
+	if mmListAuditEvents.inspectFuncListAuditEvents != nil {
+		mmListAuditEvents.inspectFuncListAuditEvents(ctx, params)
+	}
+
+	mm_params := AuditServiceMockListAuditEventsParams{ctx, params}
+
+	// Record call args
+	mmListAuditEvents.ListAuditEventsMock.mutex.Lock()
+	mmListAuditEvents.ListAuditEventsMock.callArgs = append(mmListAuditEvents.ListAuditEventsMock.callArgs, &mm_params)
+	mmListAuditEvents.ListAuditEventsMock.mutex.Unlock()
+
+	for _, e := range mmListAuditEvents.ListAuditEventsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListAuditEvents.ListAuditEventsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAuditEvents.ListAuditEventsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditServiceMockListAuditEventsParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAuditEvents.t.Errorf("AuditServiceMock.ListAuditEvents got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListAuditEvents.t.Errorf("AuditServiceMock.ListAuditEvents got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAuditEvents.t.Errorf("AuditServiceMock.ListAuditEvents got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAuditEvents.ListAuditEventsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAuditEvents.t.Fatal("No results are set for the AuditServiceMock.ListAuditEvents")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListAuditEvents.funcListAuditEvents != nil {
+		return mmListAuditEvents.funcListAuditEvents(ctx, params)
+	}
+	mmListAuditEvents.t.Fatalf("Unexpected call to AuditServiceMock.ListAuditEvents. %v %v", ctx, params)
+	return
+}
+
+// ListAuditEventsAfterCounter returns a count of finished AuditServiceMock.ListAuditEvents invocations
+func (mmListAuditEvents *AuditServiceMock) ListAuditEventsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.afterListAuditEventsCounter)
+}
+
+// ListAuditEventsBeforeCounter returns a count of AuditServiceMock.ListAuditEvents invocations
+func (mmListAuditEvents *AuditServiceMock) ListAuditEventsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAuditEvents.beforeListAuditEventsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditServiceMock.ListAuditEvents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAuditEvents *mAuditServiceMockListAuditEvents) Calls() []*AuditServiceMockListAuditEventsParams {
+	mmListAuditEvents.mutex.RLock()
+
+	argCopy := make([]*AuditServiceMockListAuditEventsParams, len(mmListAuditEvents.callArgs))
+	copy(argCopy, mmListAuditEvents.callArgs)
+
+	mmListAuditEvents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAuditEventsDone returns true if the count of the ListAuditEvents invocations corresponds
+// the number of defined expectations
+func (m *AuditServiceMock) MinimockListAuditEventsDone() bool {
+	if m.ListAuditEventsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAuditEventsMock.invocationsDone()
+}
+
+// MinimockListAuditEventsInspect logs each unmet expectation
+func (m *AuditServiceMock) MinimockListAuditEventsInspect() {
+	for _, e := range m.ListAuditEventsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditServiceMock.ListAuditEvents with params: %#v", *e.params)
+		}
+	}
+
+	afterListAuditEventsCounter := mm_atomic.LoadUint64(&m.afterListAuditEventsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAuditEventsMock.defaultExpectation != nil && afterListAuditEventsCounter < 1 {
+		if m.ListAuditEventsMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditServiceMock.ListAuditEvents")
+		} else {
+			m.t.Errorf("Expected call to AuditServiceMock.ListAuditEvents with params: %#v", *m.ListAuditEventsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAuditEvents != nil && afterListAuditEventsCounter < 1 {
+		m.t.Error("Expected call to AuditServiceMock.ListAuditEvents")
+	}
+
+	if !m.ListAuditEventsMock.invocationsDone() && afterListAuditEventsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditServiceMock.ListAuditEvents but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAuditEventsMock.expectedInvocations), afterListAuditEventsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockListAuditEventsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockListAuditEventsDone()
+}
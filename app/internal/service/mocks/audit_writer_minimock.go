@@ -0,0 +1,670 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/service.AuditWriter -o audit_writer_minimock.go -n AuditWriterMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuditWriterMock implements service.AuditWriter
+type AuditWriterMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRunWriter          func(ctx context.Context) (err error)
+	inspectFuncRunWriter   func(ctx context.Context)
+	afterRunWriterCounter  uint64
+	beforeRunWriterCounter uint64
+	RunWriterMock          mAuditWriterMockRunWriter
+
+	funcWrite          func(ctx context.Context, params model.CreateAPILogParams)
+	inspectFuncWrite   func(ctx context.Context, params model.CreateAPILogParams)
+	afterWriteCounter  uint64
+	beforeWriteCounter uint64
+	WriteMock          mAuditWriterMockWrite
+}
+
+// NewAuditWriterMock returns a mock for service.AuditWriter
+func NewAuditWriterMock(t minimock.Tester) *AuditWriterMock {
+	m := &AuditWriterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RunWriterMock = mAuditWriterMockRunWriter{mock: m}
+	m.RunWriterMock.callArgs = []*AuditWriterMockRunWriterParams{}
+
+	m.WriteMock = mAuditWriterMockWrite{mock: m}
+	m.WriteMock.callArgs = []*AuditWriterMockWriteParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuditWriterMockRunWriter struct {
+	optional           bool
+	mock               *AuditWriterMock
+	defaultExpectation *AuditWriterMockRunWriterExpectation
+	expectations       []*AuditWriterMockRunWriterExpectation
+
+	callArgs []*AuditWriterMockRunWriterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditWriterMockRunWriterExpectation specifies expectation struct of the AuditWriter.RunWriter
+type AuditWriterMockRunWriterExpectation struct {
+	mock      *AuditWriterMock
+	params    *AuditWriterMockRunWriterParams
+	paramPtrs *AuditWriterMockRunWriterParamPtrs
+	results   *AuditWriterMockRunWriterResults
+	Counter   uint64
+}
+
+// AuditWriterMockRunWriterParams contains parameters of the AuditWriter.RunWriter
+type AuditWriterMockRunWriterParams struct {
+	ctx context.Context
+}
+
+// AuditWriterMockRunWriterParamPtrs contains pointers to parameters of the AuditWriter.RunWriter
+type AuditWriterMockRunWriterParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuditWriterMockRunWriterResults contains results of the AuditWriter.RunWriter
+type AuditWriterMockRunWriterResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRunWriter *mAuditWriterMockRunWriter) Optional() *mAuditWriterMockRunWriter {
+	mmRunWriter.optional = true
+	return mmRunWriter
+}
+
+// Expect sets up expected params for AuditWriter.RunWriter
+func (mmRunWriter *mAuditWriterMockRunWriter) Expect(ctx context.Context) *mAuditWriterMockRunWriter {
+	if mmRunWriter.mock.funcRunWriter != nil {
+		mmRunWriter.mock.t.Fatalf("AuditWriterMock.RunWriter mock is already set by Set")
+	}
+
+	if mmRunWriter.defaultExpectation == nil {
+		mmRunWriter.defaultExpectation = &AuditWriterMockRunWriterExpectation{}
+	}
+
+	if mmRunWriter.defaultExpectation.paramPtrs != nil {
+		mmRunWriter.mock.t.Fatalf("AuditWriterMock.RunWriter mock is already set by ExpectParams functions")
+	}
+
+	mmRunWriter.defaultExpectation.params = &AuditWriterMockRunWriterParams{ctx}
+	for _, e := range mmRunWriter.expectations {
+		if minimock.Equal(e.params, mmRunWriter.defaultExpectation.params) {
+			mmRunWriter.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRunWriter.defaultExpectation.params)
+		}
+	}
+
+	return mmRunWriter
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditWriter.RunWriter
+func (mmRunWriter *mAuditWriterMockRunWriter) ExpectCtxParam1(ctx context.Context) *mAuditWriterMockRunWriter {
+	if mmRunWriter.mock.funcRunWriter != nil {
+		mmRunWriter.mock.t.Fatalf("AuditWriterMock.RunWriter mock is already set by Set")
+	}
+
+	if mmRunWriter.defaultExpectation == nil {
+		mmRunWriter.defaultExpectation = &AuditWriterMockRunWriterExpectation{}
+	}
+
+	if mmRunWriter.defaultExpectation.params != nil {
+		mmRunWriter.mock.t.Fatalf("AuditWriterMock.RunWriter mock is already set by Expect")
+	}
+
+	if mmRunWriter.defaultExpectation.paramPtrs == nil {
+		mmRunWriter.defaultExpectation.paramPtrs = &AuditWriterMockRunWriterParamPtrs{}
+	}
+	mmRunWriter.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRunWriter
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditWriter.RunWriter
+func (mmRunWriter *mAuditWriterMockRunWriter) Inspect(f func(ctx context.Context)) *mAuditWriterMockRunWriter {
+	if mmRunWriter.mock.inspectFuncRunWriter != nil {
+		mmRunWriter.mock.t.Fatalf("Inspect function is already set for AuditWriterMock.RunWriter")
+	}
+
+	mmRunWriter.mock.inspectFuncRunWriter = f
+
+	return mmRunWriter
+}
+
+// Return sets up results that will be returned by AuditWriter.RunWriter
+func (mmRunWriter *mAuditWriterMockRunWriter) Return(err error) *AuditWriterMock {
+	if mmRunWriter.mock.funcRunWriter != nil {
+		mmRunWriter.mock.t.Fatalf("AuditWriterMock.RunWriter mock is already set by Set")
+	}
+
+	if mmRunWriter.defaultExpectation == nil {
+		mmRunWriter.defaultExpectation = &AuditWriterMockRunWriterExpectation{mock: mmRunWriter.mock}
+	}
+	mmRunWriter.defaultExpectation.results = &AuditWriterMockRunWriterResults{err}
+	return mmRunWriter.mock
+}
+
+// Set uses given function f to mock the AuditWriter.RunWriter method
+func (mmRunWriter *mAuditWriterMockRunWriter) Set(f func(ctx context.Context) (err error)) *AuditWriterMock {
+	if mmRunWriter.defaultExpectation != nil {
+		mmRunWriter.mock.t.Fatalf("Default expectation is already set for the AuditWriter.RunWriter method")
+	}
+
+	if len(mmRunWriter.expectations) > 0 {
+		mmRunWriter.mock.t.Fatalf("Some expectations are already set for the AuditWriter.RunWriter method")
+	}
+
+	mmRunWriter.mock.funcRunWriter = f
+	return mmRunWriter.mock
+}
+
+// When sets expectation for the AuditWriter.RunWriter which will trigger the result defined by the following
+// Then helper
+func (mmRunWriter *mAuditWriterMockRunWriter) When(ctx context.Context) *AuditWriterMockRunWriterExpectation {
+	if mmRunWriter.mock.funcRunWriter != nil {
+		mmRunWriter.mock.t.Fatalf("AuditWriterMock.RunWriter mock is already set by Set")
+	}
+
+	expectation := &AuditWriterMockRunWriterExpectation{
+		mock:   mmRunWriter.mock,
+		params: &AuditWriterMockRunWriterParams{ctx},
+	}
+	mmRunWriter.expectations = append(mmRunWriter.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuditWriter.RunWriter return parameters for the expectation previously defined by the When method
+func (e *AuditWriterMockRunWriterExpectation) Then(err error) *AuditWriterMock {
+	e.results = &AuditWriterMockRunWriterResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuditWriter.RunWriter should be invoked
+func (mmRunWriter *mAuditWriterMockRunWriter) Times(n uint64) *mAuditWriterMockRunWriter {
+	if n == 0 {
+		mmRunWriter.mock.t.Fatalf("Times of AuditWriterMock.RunWriter mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRunWriter.expectedInvocations, n)
+	return mmRunWriter
+}
+
+func (mmRunWriter *mAuditWriterMockRunWriter) invocationsDone() bool {
+	if len(mmRunWriter.expectations) == 0 && mmRunWriter.defaultExpectation == nil && mmRunWriter.mock.funcRunWriter == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRunWriter.mock.afterRunWriterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRunWriter.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RunWriter implements service.AuditWriter
+func (mmRunWriter *AuditWriterMock) RunWriter(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmRunWriter.beforeRunWriterCounter, 1)
+	defer mm_atomic.AddUint64(&mmRunWriter.afterRunWriterCounter, 1)
+
+	if mmRunWriter.inspectFuncRunWriter != nil {
+		mmRunWriter.inspectFuncRunWriter(ctx)
+	}
+
+	mm_params := AuditWriterMockRunWriterParams{ctx}
+
+	// Record call args
+	mmRunWriter.RunWriterMock.mutex.Lock()
+	mmRunWriter.RunWriterMock.callArgs = append(mmRunWriter.RunWriterMock.callArgs, &mm_params)
+	mmRunWriter.RunWriterMock.mutex.Unlock()
+
+	for _, e := range mmRunWriter.RunWriterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRunWriter.RunWriterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRunWriter.RunWriterMock.defaultExpectation.Counter, 1)
+		mm_want := mmRunWriter.RunWriterMock.defaultExpectation.params
+		mm_want_ptrs := mmRunWriter.RunWriterMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditWriterMockRunWriterParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRunWriter.t.Errorf("AuditWriterMock.RunWriter got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRunWriter.t.Errorf("AuditWriterMock.RunWriter got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRunWriter.RunWriterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRunWriter.t.Fatal("No results are set for the AuditWriterMock.RunWriter")
+		}
+		return (*mm_results).err
+	}
+	if mmRunWriter.funcRunWriter != nil {
+		return mmRunWriter.funcRunWriter(ctx)
+	}
+	mmRunWriter.t.Fatalf("Unexpected call to AuditWriterMock.RunWriter. %v", ctx)
+	return
+}
+
+// RunWriterAfterCounter returns a count of finished AuditWriterMock.RunWriter invocations
+func (mmRunWriter *AuditWriterMock) RunWriterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunWriter.afterRunWriterCounter)
+}
+
+// RunWriterBeforeCounter returns a count of AuditWriterMock.RunWriter invocations
+func (mmRunWriter *AuditWriterMock) RunWriterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunWriter.beforeRunWriterCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditWriterMock.RunWriter.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRunWriter *mAuditWriterMockRunWriter) Calls() []*AuditWriterMockRunWriterParams {
+	mmRunWriter.mutex.RLock()
+
+	argCopy := make([]*AuditWriterMockRunWriterParams, len(mmRunWriter.callArgs))
+	copy(argCopy, mmRunWriter.callArgs)
+
+	mmRunWriter.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunWriterDone returns true if the count of the RunWriter invocations corresponds
+// the number of defined expectations
+func (m *AuditWriterMock) MinimockRunWriterDone() bool {
+	if m.RunWriterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunWriterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunWriterMock.invocationsDone()
+}
+
+// MinimockRunWriterInspect logs each unmet expectation
+func (m *AuditWriterMock) MinimockRunWriterInspect() {
+	for _, e := range m.RunWriterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditWriterMock.RunWriter with params: %#v", *e.params)
+		}
+	}
+
+	afterRunWriterCounter := mm_atomic.LoadUint64(&m.afterRunWriterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunWriterMock.defaultExpectation != nil && afterRunWriterCounter < 1 {
+		if m.RunWriterMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditWriterMock.RunWriter")
+		} else {
+			m.t.Errorf("Expected call to AuditWriterMock.RunWriter with params: %#v", *m.RunWriterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRunWriter != nil && afterRunWriterCounter < 1 {
+		m.t.Error("Expected call to AuditWriterMock.RunWriter")
+	}
+
+	if !m.RunWriterMock.invocationsDone() && afterRunWriterCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditWriterMock.RunWriter but found %d calls",
+			mm_atomic.LoadUint64(&m.RunWriterMock.expectedInvocations), afterRunWriterCounter)
+	}
+}
+
+type mAuditWriterMockWrite struct {
+	optional           bool
+	mock               *AuditWriterMock
+	defaultExpectation *AuditWriterMockWriteExpectation
+	expectations       []*AuditWriterMockWriteExpectation
+
+	callArgs []*AuditWriterMockWriteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuditWriterMockWriteExpectation specifies expectation struct of the AuditWriter.Write
+type AuditWriterMockWriteExpectation struct {
+	mock      *AuditWriterMock
+	params    *AuditWriterMockWriteParams
+	paramPtrs *AuditWriterMockWriteParamPtrs
+
+	Counter uint64
+}
+
+// AuditWriterMockWriteParams contains parameters of the AuditWriter.Write
+type AuditWriterMockWriteParams struct {
+	ctx    context.Context
+	params model.CreateAPILogParams
+}
+
+// AuditWriterMockWriteParamPtrs contains pointers to parameters of the AuditWriter.Write
+type AuditWriterMockWriteParamPtrs struct {
+	ctx    *context.Context
+	params *model.CreateAPILogParams
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWrite *mAuditWriterMockWrite) Optional() *mAuditWriterMockWrite {
+	mmWrite.optional = true
+	return mmWrite
+}
+
+// Expect sets up expected params for AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) Expect(ctx context.Context, params model.CreateAPILogParams) *mAuditWriterMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by ExpectParams functions")
+	}
+
+	mmWrite.defaultExpectation.params = &AuditWriterMockWriteParams{ctx, params}
+	for _, e := range mmWrite.expectations {
+		if minimock.Equal(e.params, mmWrite.defaultExpectation.params) {
+			mmWrite.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWrite.defaultExpectation.params)
+		}
+	}
+
+	return mmWrite
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) ExpectCtxParam1(ctx context.Context) *mAuditWriterMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.params != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Expect")
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs == nil {
+		mmWrite.defaultExpectation.paramPtrs = &AuditWriterMockWriteParamPtrs{}
+	}
+	mmWrite.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmWrite
+}
+
+// ExpectParamsParam2 sets up expected param params for AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) ExpectParamsParam2(params model.CreateAPILogParams) *mAuditWriterMockWrite {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{}
+	}
+
+	if mmWrite.defaultExpectation.params != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Expect")
+	}
+
+	if mmWrite.defaultExpectation.paramPtrs == nil {
+		mmWrite.defaultExpectation.paramPtrs = &AuditWriterMockWriteParamPtrs{}
+	}
+	mmWrite.defaultExpectation.paramPtrs.params = &params
+
+	return mmWrite
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) Inspect(f func(ctx context.Context, params model.CreateAPILogParams)) *mAuditWriterMockWrite {
+	if mmWrite.mock.inspectFuncWrite != nil {
+		mmWrite.mock.t.Fatalf("Inspect function is already set for AuditWriterMock.Write")
+	}
+
+	mmWrite.mock.inspectFuncWrite = f
+
+	return mmWrite
+}
+
+// Return sets up results that will be returned by AuditWriter.Write
+func (mmWrite *mAuditWriterMockWrite) Return() *AuditWriterMock {
+	if mmWrite.mock.funcWrite != nil {
+		mmWrite.mock.t.Fatalf("AuditWriterMock.Write mock is already set by Set")
+	}
+
+	if mmWrite.defaultExpectation == nil {
+		mmWrite.defaultExpectation = &AuditWriterMockWriteExpectation{mock: mmWrite.mock}
+	}
+
+	return mmWrite.mock
+}
+
+// Set uses given function f to mock the AuditWriter.Write method
+func (mmWrite *mAuditWriterMockWrite) Set(f func(ctx context.Context, params model.CreateAPILogParams)) *AuditWriterMock {
+	if mmWrite.defaultExpectation != nil {
+		mmWrite.mock.t.Fatalf("Default expectation is already set for the AuditWriter.Write method")
+	}
+
+	if len(mmWrite.expectations) > 0 {
+		mmWrite.mock.t.Fatalf("Some expectations are already set for the AuditWriter.Write method")
+	}
+
+	mmWrite.mock.funcWrite = f
+	return mmWrite.mock
+}
+
+// Times sets number of times AuditWriter.Write should be invoked
+func (mmWrite *mAuditWriterMockWrite) Times(n uint64) *mAuditWriterMockWrite {
+	if n == 0 {
+		mmWrite.mock.t.Fatalf("Times of AuditWriterMock.Write mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWrite.expectedInvocations, n)
+	return mmWrite
+}
+
+func (mmWrite *mAuditWriterMockWrite) invocationsDone() bool {
+	if len(mmWrite.expectations) == 0 && mmWrite.defaultExpectation == nil && mmWrite.mock.funcWrite == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWrite.mock.afterWriteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWrite.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Write implements service.AuditWriter
+func (mmWrite *AuditWriterMock) Write(ctx context.Context, params model.CreateAPILogParams) {
+	mm_atomic.AddUint64(&mmWrite.beforeWriteCounter, 1)
+	defer mm_atomic.AddUint64(&mmWrite.afterWriteCounter, 1)
+
+	if mmWrite.inspectFuncWrite != nil {
+		mmWrite.inspectFuncWrite(ctx, params)
+	}
+
+	mm_params := AuditWriterMockWriteParams{ctx, params}
+
+	// Record call args
+	mmWrite.WriteMock.mutex.Lock()
+	mmWrite.WriteMock.callArgs = append(mmWrite.WriteMock.callArgs, &mm_params)
+	mmWrite.WriteMock.mutex.Unlock()
+
+	for _, e := range mmWrite.WriteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmWrite.WriteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWrite.WriteMock.defaultExpectation.Counter, 1)
+		mm_want := mmWrite.WriteMock.defaultExpectation.params
+		mm_want_ptrs := mmWrite.WriteMock.defaultExpectation.paramPtrs
+
+		mm_got := AuditWriterMockWriteParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWrite.t.Errorf("AuditWriterMock.Write got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmWrite.t.Errorf("AuditWriterMock.Write got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWrite.t.Errorf("AuditWriterMock.Write got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmWrite.funcWrite != nil {
+		mmWrite.funcWrite(ctx, params)
+		return
+	}
+	mmWrite.t.Fatalf("Unexpected call to AuditWriterMock.Write. %v %v", ctx, params)
+
+}
+
+// WriteAfterCounter returns a count of finished AuditWriterMock.Write invocations
+func (mmWrite *AuditWriterMock) WriteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.afterWriteCounter)
+}
+
+// WriteBeforeCounter returns a count of AuditWriterMock.Write invocations
+func (mmWrite *AuditWriterMock) WriteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWrite.beforeWriteCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuditWriterMock.Write.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWrite *mAuditWriterMockWrite) Calls() []*AuditWriterMockWriteParams {
+	mmWrite.mutex.RLock()
+
+	argCopy := make([]*AuditWriterMockWriteParams, len(mmWrite.callArgs))
+	copy(argCopy, mmWrite.callArgs)
+
+	mmWrite.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWriteDone returns true if the count of the Write invocations corresponds
+// the number of defined expectations
+func (m *AuditWriterMock) MinimockWriteDone() bool {
+	if m.WriteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WriteMock.invocationsDone()
+}
+
+// MinimockWriteInspect logs each unmet expectation
+func (m *AuditWriterMock) MinimockWriteInspect() {
+	for _, e := range m.WriteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuditWriterMock.Write with params: %#v", *e.params)
+		}
+	}
+
+	afterWriteCounter := mm_atomic.LoadUint64(&m.afterWriteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WriteMock.defaultExpectation != nil && afterWriteCounter < 1 {
+		if m.WriteMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuditWriterMock.Write")
+		} else {
+			m.t.Errorf("Expected call to AuditWriterMock.Write with params: %#v", *m.WriteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWrite != nil && afterWriteCounter < 1 {
+		m.t.Error("Expected call to AuditWriterMock.Write")
+	}
+
+	if !m.WriteMock.invocationsDone() && afterWriteCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuditWriterMock.Write but found %d calls",
+			mm_atomic.LoadUint64(&m.WriteMock.expectedInvocations), afterWriteCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuditWriterMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRunWriterInspect()
+
+			m.MinimockWriteInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuditWriterMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuditWriterMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRunWriterDone() &&
+		m.MinimockWriteDone()
+}
@@ -0,0 +1,728 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/service.AuthService -o auth_service_minimock.go -n AuthServiceMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AuthServiceMock implements service.AuthService
+type AuthServiceMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcLogin          func(ctx context.Context, params model.LoginParams) (resp model.LoginResponse, err error)
+	inspectFuncLogin   func(ctx context.Context, params model.LoginParams)
+	afterLoginCounter  uint64
+	beforeLoginCounter uint64
+	LoginMock          mAuthServiceMockLogin
+
+	funcRefreshToken          func(ctx context.Context, params model.RefreshTokenParams) (resp model.RefreshTokenResponse, err error)
+	inspectFuncRefreshToken   func(ctx context.Context, params model.RefreshTokenParams)
+	afterRefreshTokenCounter  uint64
+	beforeRefreshTokenCounter uint64
+	RefreshTokenMock          mAuthServiceMockRefreshToken
+}
+
+// NewAuthServiceMock returns a mock for service.AuthService
+func NewAuthServiceMock(t minimock.Tester) *AuthServiceMock {
+	m := &AuthServiceMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.LoginMock = mAuthServiceMockLogin{mock: m}
+	m.LoginMock.callArgs = []*AuthServiceMockLoginParams{}
+
+	m.RefreshTokenMock = mAuthServiceMockRefreshToken{mock: m}
+	m.RefreshTokenMock.callArgs = []*AuthServiceMockRefreshTokenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAuthServiceMockLogin struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockLoginExpectation
+	expectations       []*AuthServiceMockLoginExpectation
+
+	callArgs []*AuthServiceMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuthServiceMockLoginExpectation specifies expectation struct of the AuthService.Login
+type AuthServiceMockLoginExpectation struct {
+	mock      *AuthServiceMock
+	params    *AuthServiceMockLoginParams
+	paramPtrs *AuthServiceMockLoginParamPtrs
+	results   *AuthServiceMockLoginResults
+	Counter   uint64
+}
+
+// AuthServiceMockLoginParams contains parameters of the AuthService.Login
+type AuthServiceMockLoginParams struct {
+	ctx    context.Context
+	params model.LoginParams
+}
+
+// AuthServiceMockLoginParamPtrs contains pointers to parameters of the AuthService.Login
+type AuthServiceMockLoginParamPtrs struct {
+	ctx    *context.Context
+	params *model.LoginParams
+}
+
+// AuthServiceMockLoginResults contains results of the AuthService.Login
+type AuthServiceMockLoginResults struct {
+	resp model.LoginResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mAuthServiceMockLogin) Optional() *mAuthServiceMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Expect(ctx context.Context, params model.LoginParams) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &AuthServiceMockLoginParams{ctx, params}
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmLogin
+}
+
+// ExpectParamsParam2 sets up expected param params for AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) ExpectParamsParam2(params model.LoginParams) *mAuthServiceMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &AuthServiceMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.params = &params
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Inspect(f func(ctx context.Context, params model.LoginParams)) *mAuthServiceMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by AuthService.Login
+func (mmLogin *mAuthServiceMockLogin) Return(resp model.LoginResponse, err error) *AuthServiceMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &AuthServiceMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &AuthServiceMockLoginResults{resp, err}
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the AuthService.Login method
+func (mmLogin *mAuthServiceMockLogin) Set(f func(ctx context.Context, params model.LoginParams) (resp model.LoginResponse, err error)) *AuthServiceMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the AuthService.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the AuthService.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	return mmLogin.mock
+}
+
+// When sets expectation for the AuthService.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mAuthServiceMockLogin) When(ctx context.Context, params model.LoginParams) *AuthServiceMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("AuthServiceMock.Login mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockLoginExpectation{
+		mock:   mmLogin.mock,
+		params: &AuthServiceMockLoginParams{ctx, params},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.Login return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockLoginExpectation) Then(resp model.LoginResponse, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockLoginResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.Login should be invoked
+func (mmLogin *mAuthServiceMockLogin) Times(n uint64) *mAuthServiceMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of AuthServiceMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	return mmLogin
+}
+
+func (mmLogin *mAuthServiceMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements service.AuthService
+func (mmLogin *AuthServiceMock) Login(ctx context.Context, params model.LoginParams) (resp model.LoginResponse, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, params)
+	}
+
+	mm_params := AuthServiceMockLoginParams{ctx, params}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockLoginParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("AuthServiceMock.Login got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the AuthServiceMock.Login")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, params)
+	}
+	mmLogin.t.Fatalf("Unexpected call to AuthServiceMock.Login. %v %v", ctx, params)
+	return
+}
+
+// LoginAfterCounter returns a count of finished AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of AuthServiceMock.Login invocations
+func (mmLogin *AuthServiceMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mAuthServiceMockLogin) Calls() []*AuthServiceMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.Login with params: %#v", *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuthServiceMock.Login")
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.Login with params: %#v", *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Error("Expected call to AuthServiceMock.Login")
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.Login but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), afterLoginCounter)
+	}
+}
+
+type mAuthServiceMockRefreshToken struct {
+	optional           bool
+	mock               *AuthServiceMock
+	defaultExpectation *AuthServiceMockRefreshTokenExpectation
+	expectations       []*AuthServiceMockRefreshTokenExpectation
+
+	callArgs []*AuthServiceMockRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// AuthServiceMockRefreshTokenExpectation specifies expectation struct of the AuthService.RefreshToken
+type AuthServiceMockRefreshTokenExpectation struct {
+	mock      *AuthServiceMock
+	params    *AuthServiceMockRefreshTokenParams
+	paramPtrs *AuthServiceMockRefreshTokenParamPtrs
+	results   *AuthServiceMockRefreshTokenResults
+	Counter   uint64
+}
+
+// AuthServiceMockRefreshTokenParams contains parameters of the AuthService.RefreshToken
+type AuthServiceMockRefreshTokenParams struct {
+	ctx    context.Context
+	params model.RefreshTokenParams
+}
+
+// AuthServiceMockRefreshTokenParamPtrs contains pointers to parameters of the AuthService.RefreshToken
+type AuthServiceMockRefreshTokenParamPtrs struct {
+	ctx    *context.Context
+	params *model.RefreshTokenParams
+}
+
+// AuthServiceMockRefreshTokenResults contains results of the AuthService.RefreshToken
+type AuthServiceMockRefreshTokenResults struct {
+	resp model.RefreshTokenResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Optional() *mAuthServiceMockRefreshToken {
+	mmRefreshToken.optional = true
+	return mmRefreshToken
+}
+
+// Expect sets up expected params for AuthService.RefreshToken
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Expect(ctx context.Context, params model.RefreshTokenParams) *mAuthServiceMockRefreshToken {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Set")
+	}
+
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &AuthServiceMockRefreshTokenExpectation{}
+	}
+
+	if mmRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmRefreshToken.defaultExpectation.params = &AuthServiceMockRefreshTokenParams{ctx, params}
+	for _, e := range mmRefreshToken.expectations {
+		if minimock.Equal(e.params, mmRefreshToken.defaultExpectation.params) {
+			mmRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthService.RefreshToken
+func (mmRefreshToken *mAuthServiceMockRefreshToken) ExpectCtxParam1(ctx context.Context) *mAuthServiceMockRefreshToken {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Set")
+	}
+
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &AuthServiceMockRefreshTokenExpectation{}
+	}
+
+	if mmRefreshToken.defaultExpectation.params != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Expect")
+	}
+
+	if mmRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockRefreshTokenParamPtrs{}
+	}
+	mmRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmRefreshToken
+}
+
+// ExpectParamsParam2 sets up expected param params for AuthService.RefreshToken
+func (mmRefreshToken *mAuthServiceMockRefreshToken) ExpectParamsParam2(params model.RefreshTokenParams) *mAuthServiceMockRefreshToken {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Set")
+	}
+
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &AuthServiceMockRefreshTokenExpectation{}
+	}
+
+	if mmRefreshToken.defaultExpectation.params != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Expect")
+	}
+
+	if mmRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmRefreshToken.defaultExpectation.paramPtrs = &AuthServiceMockRefreshTokenParamPtrs{}
+	}
+	mmRefreshToken.defaultExpectation.paramPtrs.params = &params
+
+	return mmRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthService.RefreshToken
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Inspect(f func(ctx context.Context, params model.RefreshTokenParams)) *mAuthServiceMockRefreshToken {
+	if mmRefreshToken.mock.inspectFuncRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("Inspect function is already set for AuthServiceMock.RefreshToken")
+	}
+
+	mmRefreshToken.mock.inspectFuncRefreshToken = f
+
+	return mmRefreshToken
+}
+
+// Return sets up results that will be returned by AuthService.RefreshToken
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Return(resp model.RefreshTokenResponse, err error) *AuthServiceMock {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Set")
+	}
+
+	if mmRefreshToken.defaultExpectation == nil {
+		mmRefreshToken.defaultExpectation = &AuthServiceMockRefreshTokenExpectation{mock: mmRefreshToken.mock}
+	}
+	mmRefreshToken.defaultExpectation.results = &AuthServiceMockRefreshTokenResults{resp, err}
+	return mmRefreshToken.mock
+}
+
+// Set uses given function f to mock the AuthService.RefreshToken method
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Set(f func(ctx context.Context, params model.RefreshTokenParams) (resp model.RefreshTokenResponse, err error)) *AuthServiceMock {
+	if mmRefreshToken.defaultExpectation != nil {
+		mmRefreshToken.mock.t.Fatalf("Default expectation is already set for the AuthService.RefreshToken method")
+	}
+
+	if len(mmRefreshToken.expectations) > 0 {
+		mmRefreshToken.mock.t.Fatalf("Some expectations are already set for the AuthService.RefreshToken method")
+	}
+
+	mmRefreshToken.mock.funcRefreshToken = f
+	return mmRefreshToken.mock
+}
+
+// When sets expectation for the AuthService.RefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmRefreshToken *mAuthServiceMockRefreshToken) When(ctx context.Context, params model.RefreshTokenParams) *AuthServiceMockRefreshTokenExpectation {
+	if mmRefreshToken.mock.funcRefreshToken != nil {
+		mmRefreshToken.mock.t.Fatalf("AuthServiceMock.RefreshToken mock is already set by Set")
+	}
+
+	expectation := &AuthServiceMockRefreshTokenExpectation{
+		mock:   mmRefreshToken.mock,
+		params: &AuthServiceMockRefreshTokenParams{ctx, params},
+	}
+	mmRefreshToken.expectations = append(mmRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthService.RefreshToken return parameters for the expectation previously defined by the When method
+func (e *AuthServiceMockRefreshTokenExpectation) Then(resp model.RefreshTokenResponse, err error) *AuthServiceMock {
+	e.results = &AuthServiceMockRefreshTokenResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times AuthService.RefreshToken should be invoked
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Times(n uint64) *mAuthServiceMockRefreshToken {
+	if n == 0 {
+		mmRefreshToken.mock.t.Fatalf("Times of AuthServiceMock.RefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRefreshToken.expectedInvocations, n)
+	return mmRefreshToken
+}
+
+func (mmRefreshToken *mAuthServiceMockRefreshToken) invocationsDone() bool {
+	if len(mmRefreshToken.expectations) == 0 && mmRefreshToken.defaultExpectation == nil && mmRefreshToken.mock.funcRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRefreshToken.mock.afterRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RefreshToken implements service.AuthService
+func (mmRefreshToken *AuthServiceMock) RefreshToken(ctx context.Context, params model.RefreshTokenParams) (resp model.RefreshTokenResponse, err error) {
+	mm_atomic.AddUint64(&mmRefreshToken.beforeRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefreshToken.afterRefreshTokenCounter, 1)
+
+	if mmRefreshToken.inspectFuncRefreshToken != nil {
+		mmRefreshToken.inspectFuncRefreshToken(ctx, params)
+	}
+
+	mm_params := AuthServiceMockRefreshTokenParams{ctx, params}
+
+	// Record call args
+	mmRefreshToken.RefreshTokenMock.mutex.Lock()
+	mmRefreshToken.RefreshTokenMock.callArgs = append(mmRefreshToken.RefreshTokenMock.callArgs, &mm_params)
+	mmRefreshToken.RefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmRefreshToken.RefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmRefreshToken.RefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefreshToken.RefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefreshToken.RefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmRefreshToken.RefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthServiceMockRefreshTokenParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRefreshToken.t.Errorf("AuthServiceMock.RefreshToken got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmRefreshToken.t.Errorf("AuthServiceMock.RefreshToken got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRefreshToken.t.Errorf("AuthServiceMock.RefreshToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRefreshToken.RefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefreshToken.t.Fatal("No results are set for the AuthServiceMock.RefreshToken")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmRefreshToken.funcRefreshToken != nil {
+		return mmRefreshToken.funcRefreshToken(ctx, params)
+	}
+	mmRefreshToken.t.Fatalf("Unexpected call to AuthServiceMock.RefreshToken. %v %v", ctx, params)
+	return
+}
+
+// RefreshTokenAfterCounter returns a count of finished AuthServiceMock.RefreshToken invocations
+func (mmRefreshToken *AuthServiceMock) RefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshToken.afterRefreshTokenCounter)
+}
+
+// RefreshTokenBeforeCounter returns a count of AuthServiceMock.RefreshToken invocations
+func (mmRefreshToken *AuthServiceMock) RefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefreshToken.beforeRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthServiceMock.RefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRefreshToken *mAuthServiceMockRefreshToken) Calls() []*AuthServiceMockRefreshTokenParams {
+	mmRefreshToken.mutex.RLock()
+
+	argCopy := make([]*AuthServiceMockRefreshTokenParams, len(mmRefreshToken.callArgs))
+	copy(argCopy, mmRefreshToken.callArgs)
+
+	mmRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRefreshTokenDone returns true if the count of the RefreshToken invocations corresponds
+// the number of defined expectations
+func (m *AuthServiceMock) MinimockRefreshTokenDone() bool {
+	if m.RefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RefreshTokenMock.invocationsDone()
+}
+
+// MinimockRefreshTokenInspect logs each unmet expectation
+func (m *AuthServiceMock) MinimockRefreshTokenInspect() {
+	for _, e := range m.RefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthServiceMock.RefreshToken with params: %#v", *e.params)
+		}
+	}
+
+	afterRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefreshTokenMock.defaultExpectation != nil && afterRefreshTokenCounter < 1 {
+		if m.RefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to AuthServiceMock.RefreshToken")
+		} else {
+			m.t.Errorf("Expected call to AuthServiceMock.RefreshToken with params: %#v", *m.RefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefreshToken != nil && afterRefreshTokenCounter < 1 {
+		m.t.Error("Expected call to AuthServiceMock.RefreshToken")
+	}
+
+	if !m.RefreshTokenMock.invocationsDone() && afterRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthServiceMock.RefreshToken but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshTokenMock.expectedInvocations), afterRefreshTokenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AuthServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockLoginInspect()
+
+			m.MinimockRefreshTokenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AuthServiceMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AuthServiceMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockLoginDone() &&
+		m.MinimockRefreshTokenDone()
+}
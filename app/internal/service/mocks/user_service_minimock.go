@@ -37,6 +37,18 @@ type UserServiceMock struct {
 	beforeGetUserCounter uint64
 	GetUserMock          mUserServiceMockGetUser
 
+	funcListUsers          func(ctx context.Context, params model.ListUsersParams) (resp model.ListUsersResponse, err error)
+	inspectFuncListUsers   func(ctx context.Context, params model.ListUsersParams)
+	afterListUsersCounter  uint64
+	beforeListUsersCounter uint64
+	ListUsersMock          mUserServiceMockListUsers
+
+	funcUnlockUser          func(ctx context.Context, params model.UnlockUserParams) (err error)
+	inspectFuncUnlockUser   func(ctx context.Context, params model.UnlockUserParams)
+	afterUnlockUserCounter  uint64
+	beforeUnlockUserCounter uint64
+	UnlockUserMock          mUserServiceMockUnlockUser
+
 	funcUpdateUser          func(ctx context.Context, params model.UpdateUserParams) (err error)
 	inspectFuncUpdateUser   func(ctx context.Context, params model.UpdateUserParams)
 	afterUpdateUserCounter  uint64
@@ -61,6 +73,12 @@ func NewUserServiceMock(t minimock.Tester) *UserServiceMock {
 	m.GetUserMock = mUserServiceMockGetUser{mock: m}
 	m.GetUserMock.callArgs = []*UserServiceMockGetUserParams{}
 
+	m.ListUsersMock = mUserServiceMockListUsers{mock: m}
+	m.ListUsersMock.callArgs = []*UserServiceMockListUsersParams{}
+
+	m.UnlockUserMock = mUserServiceMockUnlockUser{mock: m}
+	m.UnlockUserMock.callArgs = []*UserServiceMockUnlockUserParams{}
+
 	m.UpdateUserMock = mUserServiceMockUpdateUser{mock: m}
 	m.UpdateUserMock.callArgs = []*UserServiceMockUpdateUserParams{}
 
@@ -1031,6 +1049,647 @@ func (m *UserServiceMock) MinimockGetUserInspect() {
 	}
 }
 
+type mUserServiceMockListUsers struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockListUsersExpectation
+	expectations       []*UserServiceMockListUsersExpectation
+
+	callArgs []*UserServiceMockListUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserServiceMockListUsersExpectation specifies expectation struct of the UserService.ListUsers
+type UserServiceMockListUsersExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockListUsersParams
+	paramPtrs *UserServiceMockListUsersParamPtrs
+	results   *UserServiceMockListUsersResults
+	Counter   uint64
+}
+
+// UserServiceMockListUsersParams contains parameters of the UserService.ListUsers
+type UserServiceMockListUsersParams struct {
+	ctx    context.Context
+	params model.ListUsersParams
+}
+
+// UserServiceMockListUsersParamPtrs contains pointers to parameters of the UserService.ListUsers
+type UserServiceMockListUsersParamPtrs struct {
+	ctx    *context.Context
+	params *model.ListUsersParams
+}
+
+// UserServiceMockListUsersResults contains results of the UserService.ListUsers
+type UserServiceMockListUsersResults struct {
+	resp model.ListUsersResponse
+	err  error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListUsers *mUserServiceMockListUsers) Optional() *mUserServiceMockListUsers {
+	mmListUsers.optional = true
+	return mmListUsers
+}
+
+// Expect sets up expected params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Expect(ctx context.Context, params model.ListUsersParams) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by ExpectParams functions")
+	}
+
+	mmListUsers.defaultExpectation.params = &UserServiceMockListUsersParams{ctx, params}
+	for _, e := range mmListUsers.expectations {
+		if minimock.Equal(e.params, mmListUsers.defaultExpectation.params) {
+			mmListUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmListUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectCtxParam1(ctx context.Context) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmListUsers
+}
+
+// ExpectParamsParam2 sets up expected param params for UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) ExpectParamsParam2(params model.ListUsersParams) *mUserServiceMockListUsers {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{}
+	}
+
+	if mmListUsers.defaultExpectation.params != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Expect")
+	}
+
+	if mmListUsers.defaultExpectation.paramPtrs == nil {
+		mmListUsers.defaultExpectation.paramPtrs = &UserServiceMockListUsersParamPtrs{}
+	}
+	mmListUsers.defaultExpectation.paramPtrs.params = &params
+
+	return mmListUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Inspect(f func(ctx context.Context, params model.ListUsersParams)) *mUserServiceMockListUsers {
+	if mmListUsers.mock.inspectFuncListUsers != nil {
+		mmListUsers.mock.t.Fatalf("Inspect function is already set for UserServiceMock.ListUsers")
+	}
+
+	mmListUsers.mock.inspectFuncListUsers = f
+
+	return mmListUsers
+}
+
+// Return sets up results that will be returned by UserService.ListUsers
+func (mmListUsers *mUserServiceMockListUsers) Return(resp model.ListUsersResponse, err error) *UserServiceMock {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	if mmListUsers.defaultExpectation == nil {
+		mmListUsers.defaultExpectation = &UserServiceMockListUsersExpectation{mock: mmListUsers.mock}
+	}
+	mmListUsers.defaultExpectation.results = &UserServiceMockListUsersResults{resp, err}
+	return mmListUsers.mock
+}
+
+// Set uses given function f to mock the UserService.ListUsers method
+func (mmListUsers *mUserServiceMockListUsers) Set(f func(ctx context.Context, params model.ListUsersParams) (resp model.ListUsersResponse, err error)) *UserServiceMock {
+	if mmListUsers.defaultExpectation != nil {
+		mmListUsers.mock.t.Fatalf("Default expectation is already set for the UserService.ListUsers method")
+	}
+
+	if len(mmListUsers.expectations) > 0 {
+		mmListUsers.mock.t.Fatalf("Some expectations are already set for the UserService.ListUsers method")
+	}
+
+	mmListUsers.mock.funcListUsers = f
+	return mmListUsers.mock
+}
+
+// When sets expectation for the UserService.ListUsers which will trigger the result defined by the following
+// Then helper
+func (mmListUsers *mUserServiceMockListUsers) When(ctx context.Context, params model.ListUsersParams) *UserServiceMockListUsersExpectation {
+	if mmListUsers.mock.funcListUsers != nil {
+		mmListUsers.mock.t.Fatalf("UserServiceMock.ListUsers mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockListUsersExpectation{
+		mock:   mmListUsers.mock,
+		params: &UserServiceMockListUsersParams{ctx, params},
+	}
+	mmListUsers.expectations = append(mmListUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.ListUsers return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockListUsersExpectation) Then(resp model.ListUsersResponse, err error) *UserServiceMock {
+	e.results = &UserServiceMockListUsersResults{resp, err}
+	return e.mock
+}
+
+// Times sets number of times UserService.ListUsers should be invoked
+func (mmListUsers *mUserServiceMockListUsers) Times(n uint64) *mUserServiceMockListUsers {
+	if n == 0 {
+		mmListUsers.mock.t.Fatalf("Times of UserServiceMock.ListUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListUsers.expectedInvocations, n)
+	return mmListUsers
+}
+
+func (mmListUsers *mUserServiceMockListUsers) invocationsDone() bool {
+	if len(mmListUsers.expectations) == 0 && mmListUsers.defaultExpectation == nil && mmListUsers.mock.funcListUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListUsers.mock.afterListUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListUsers implements service.UserService
+func (mmListUsers *UserServiceMock) ListUsers(ctx context.Context, params model.ListUsersParams) (resp model.ListUsersResponse, err error) {
+	mm_atomic.AddUint64(&mmListUsers.beforeListUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListUsers.afterListUsersCounter, 1)
+
+	if mmListUsers.inspectFuncListUsers != nil {
+		mmListUsers.inspectFuncListUsers(ctx, params)
+	}
+
+	mm_params := UserServiceMockListUsersParams{ctx, params}
+
+	// Record call args
+	mmListUsers.ListUsersMock.mutex.Lock()
+	mmListUsers.ListUsersMock.callArgs = append(mmListUsers.ListUsersMock.callArgs, &mm_params)
+	mmListUsers.ListUsersMock.mutex.Unlock()
+
+	for _, e := range mmListUsers.ListUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.resp, e.results.err
+		}
+	}
+
+	if mmListUsers.ListUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListUsers.ListUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListUsers.ListUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListUsers.ListUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockListUsersParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListUsers.t.Errorf("UserServiceMock.ListUsers got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListUsers.ListUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListUsers.t.Fatal("No results are set for the UserServiceMock.ListUsers")
+		}
+		return (*mm_results).resp, (*mm_results).err
+	}
+	if mmListUsers.funcListUsers != nil {
+		return mmListUsers.funcListUsers(ctx, params)
+	}
+	mmListUsers.t.Fatalf("Unexpected call to UserServiceMock.ListUsers. %v %v", ctx, params)
+	return
+}
+
+// ListUsersAfterCounter returns a count of finished UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.afterListUsersCounter)
+}
+
+// ListUsersBeforeCounter returns a count of UserServiceMock.ListUsers invocations
+func (mmListUsers *UserServiceMock) ListUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListUsers.beforeListUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.ListUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListUsers *mUserServiceMockListUsers) Calls() []*UserServiceMockListUsersParams {
+	mmListUsers.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockListUsersParams, len(mmListUsers.callArgs))
+	copy(argCopy, mmListUsers.callArgs)
+
+	mmListUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListUsersDone returns true if the count of the ListUsers invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockListUsersDone() bool {
+	if m.ListUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListUsersMock.invocationsDone()
+}
+
+// MinimockListUsersInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockListUsersInspect() {
+	for _, e := range m.ListUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers with params: %#v", *e.params)
+		}
+	}
+
+	afterListUsersCounter := mm_atomic.LoadUint64(&m.afterListUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListUsersMock.defaultExpectation != nil && afterListUsersCounter < 1 {
+		if m.ListUsersMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.ListUsers")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.ListUsers with params: %#v", *m.ListUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListUsers != nil && afterListUsersCounter < 1 {
+		m.t.Error("Expected call to UserServiceMock.ListUsers")
+	}
+
+	if !m.ListUsersMock.invocationsDone() && afterListUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.ListUsers but found %d calls",
+			mm_atomic.LoadUint64(&m.ListUsersMock.expectedInvocations), afterListUsersCounter)
+	}
+}
+
+type mUserServiceMockUnlockUser struct {
+	optional           bool
+	mock               *UserServiceMock
+	defaultExpectation *UserServiceMockUnlockUserExpectation
+	expectations       []*UserServiceMockUnlockUserExpectation
+
+	callArgs []*UserServiceMockUnlockUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// UserServiceMockUnlockUserExpectation specifies expectation struct of the UserService.UnlockUser
+type UserServiceMockUnlockUserExpectation struct {
+	mock      *UserServiceMock
+	params    *UserServiceMockUnlockUserParams
+	paramPtrs *UserServiceMockUnlockUserParamPtrs
+	results   *UserServiceMockUnlockUserResults
+	Counter   uint64
+}
+
+// UserServiceMockUnlockUserParams contains parameters of the UserService.UnlockUser
+type UserServiceMockUnlockUserParams struct {
+	ctx    context.Context
+	params model.UnlockUserParams
+}
+
+// UserServiceMockUnlockUserParamPtrs contains pointers to parameters of the UserService.UnlockUser
+type UserServiceMockUnlockUserParamPtrs struct {
+	ctx    *context.Context
+	params *model.UnlockUserParams
+}
+
+// UserServiceMockUnlockUserResults contains results of the UserService.UnlockUser
+type UserServiceMockUnlockUserResults struct {
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnlockUser *mUserServiceMockUnlockUser) Optional() *mUserServiceMockUnlockUser {
+	mmUnlockUser.optional = true
+	return mmUnlockUser
+}
+
+// Expect sets up expected params for UserService.UnlockUser
+func (mmUnlockUser *mUserServiceMockUnlockUser) Expect(ctx context.Context, params model.UnlockUserParams) *mUserServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &UserServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by ExpectParams functions")
+	}
+
+	mmUnlockUser.defaultExpectation.params = &UserServiceMockUnlockUserParams{ctx, params}
+	for _, e := range mmUnlockUser.expectations {
+		if minimock.Equal(e.params, mmUnlockUser.defaultExpectation.params) {
+			mmUnlockUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnlockUser.defaultExpectation.params)
+		}
+	}
+
+	return mmUnlockUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UserService.UnlockUser
+func (mmUnlockUser *mUserServiceMockUnlockUser) ExpectCtxParam1(ctx context.Context) *mUserServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &UserServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.params != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Expect")
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs == nil {
+		mmUnlockUser.defaultExpectation.paramPtrs = &UserServiceMockUnlockUserParamPtrs{}
+	}
+	mmUnlockUser.defaultExpectation.paramPtrs.ctx = &ctx
+
+	return mmUnlockUser
+}
+
+// ExpectParamsParam2 sets up expected param params for UserService.UnlockUser
+func (mmUnlockUser *mUserServiceMockUnlockUser) ExpectParamsParam2(params model.UnlockUserParams) *mUserServiceMockUnlockUser {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &UserServiceMockUnlockUserExpectation{}
+	}
+
+	if mmUnlockUser.defaultExpectation.params != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Expect")
+	}
+
+	if mmUnlockUser.defaultExpectation.paramPtrs == nil {
+		mmUnlockUser.defaultExpectation.paramPtrs = &UserServiceMockUnlockUserParamPtrs{}
+	}
+	mmUnlockUser.defaultExpectation.paramPtrs.params = &params
+
+	return mmUnlockUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UserService.UnlockUser
+func (mmUnlockUser *mUserServiceMockUnlockUser) Inspect(f func(ctx context.Context, params model.UnlockUserParams)) *mUserServiceMockUnlockUser {
+	if mmUnlockUser.mock.inspectFuncUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("Inspect function is already set for UserServiceMock.UnlockUser")
+	}
+
+	mmUnlockUser.mock.inspectFuncUnlockUser = f
+
+	return mmUnlockUser
+}
+
+// Return sets up results that will be returned by UserService.UnlockUser
+func (mmUnlockUser *mUserServiceMockUnlockUser) Return(err error) *UserServiceMock {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	if mmUnlockUser.defaultExpectation == nil {
+		mmUnlockUser.defaultExpectation = &UserServiceMockUnlockUserExpectation{mock: mmUnlockUser.mock}
+	}
+	mmUnlockUser.defaultExpectation.results = &UserServiceMockUnlockUserResults{err}
+	return mmUnlockUser.mock
+}
+
+// Set uses given function f to mock the UserService.UnlockUser method
+func (mmUnlockUser *mUserServiceMockUnlockUser) Set(f func(ctx context.Context, params model.UnlockUserParams) (err error)) *UserServiceMock {
+	if mmUnlockUser.defaultExpectation != nil {
+		mmUnlockUser.mock.t.Fatalf("Default expectation is already set for the UserService.UnlockUser method")
+	}
+
+	if len(mmUnlockUser.expectations) > 0 {
+		mmUnlockUser.mock.t.Fatalf("Some expectations are already set for the UserService.UnlockUser method")
+	}
+
+	mmUnlockUser.mock.funcUnlockUser = f
+	return mmUnlockUser.mock
+}
+
+// When sets expectation for the UserService.UnlockUser which will trigger the result defined by the following
+// Then helper
+func (mmUnlockUser *mUserServiceMockUnlockUser) When(ctx context.Context, params model.UnlockUserParams) *UserServiceMockUnlockUserExpectation {
+	if mmUnlockUser.mock.funcUnlockUser != nil {
+		mmUnlockUser.mock.t.Fatalf("UserServiceMock.UnlockUser mock is already set by Set")
+	}
+
+	expectation := &UserServiceMockUnlockUserExpectation{
+		mock:   mmUnlockUser.mock,
+		params: &UserServiceMockUnlockUserParams{ctx, params},
+	}
+	mmUnlockUser.expectations = append(mmUnlockUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UserService.UnlockUser return parameters for the expectation previously defined by the When method
+func (e *UserServiceMockUnlockUserExpectation) Then(err error) *UserServiceMock {
+	e.results = &UserServiceMockUnlockUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UserService.UnlockUser should be invoked
+func (mmUnlockUser *mUserServiceMockUnlockUser) Times(n uint64) *mUserServiceMockUnlockUser {
+	if n == 0 {
+		mmUnlockUser.mock.t.Fatalf("Times of UserServiceMock.UnlockUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUnlockUser.expectedInvocations, n)
+	return mmUnlockUser
+}
+
+func (mmUnlockUser *mUserServiceMockUnlockUser) invocationsDone() bool {
+	if len(mmUnlockUser.expectations) == 0 && mmUnlockUser.defaultExpectation == nil && mmUnlockUser.mock.funcUnlockUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUnlockUser.mock.afterUnlockUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnlockUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UnlockUser implements service.UserService
+func (mmUnlockUser *UserServiceMock) UnlockUser(ctx context.Context, params model.UnlockUserParams) (err error) {
+	mm_atomic.AddUint64(&mmUnlockUser.beforeUnlockUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnlockUser.afterUnlockUserCounter, 1)
+
+	if mmUnlockUser.inspectFuncUnlockUser != nil {
+		mmUnlockUser.inspectFuncUnlockUser(ctx, params)
+	}
+
+	mm_params := UserServiceMockUnlockUserParams{ctx, params}
+
+	// Record call args
+	mmUnlockUser.UnlockUserMock.mutex.Lock()
+	mmUnlockUser.UnlockUserMock.callArgs = append(mmUnlockUser.UnlockUserMock.callArgs, &mm_params)
+	mmUnlockUser.UnlockUserMock.mutex.Unlock()
+
+	for _, e := range mmUnlockUser.UnlockUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUnlockUser.UnlockUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnlockUser.UnlockUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnlockUser.UnlockUserMock.defaultExpectation.params
+		mm_want_ptrs := mmUnlockUser.UnlockUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UserServiceMockUnlockUserParams{ctx, params}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUnlockUser.t.Errorf("UserServiceMock.UnlockUser got unexpected parameter ctx, want: %#v, got: %#v%s\n", *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.params != nil && !minimock.Equal(*mm_want_ptrs.params, mm_got.params) {
+				mmUnlockUser.t.Errorf("UserServiceMock.UnlockUser got unexpected parameter params, want: %#v, got: %#v%s\n", *mm_want_ptrs.params, mm_got.params, minimock.Diff(*mm_want_ptrs.params, mm_got.params))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUnlockUser.t.Errorf("UserServiceMock.UnlockUser got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUnlockUser.UnlockUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUnlockUser.t.Fatal("No results are set for the UserServiceMock.UnlockUser")
+		}
+		return (*mm_results).err
+	}
+	if mmUnlockUser.funcUnlockUser != nil {
+		return mmUnlockUser.funcUnlockUser(ctx, params)
+	}
+	mmUnlockUser.t.Fatalf("Unexpected call to UserServiceMock.UnlockUser. %v %v", ctx, params)
+	return
+}
+
+// UnlockUserAfterCounter returns a count of finished UserServiceMock.UnlockUser invocations
+func (mmUnlockUser *UserServiceMock) UnlockUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnlockUser.afterUnlockUserCounter)
+}
+
+// UnlockUserBeforeCounter returns a count of UserServiceMock.UnlockUser invocations
+func (mmUnlockUser *UserServiceMock) UnlockUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnlockUser.beforeUnlockUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UserServiceMock.UnlockUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUnlockUser *mUserServiceMockUnlockUser) Calls() []*UserServiceMockUnlockUserParams {
+	mmUnlockUser.mutex.RLock()
+
+	argCopy := make([]*UserServiceMockUnlockUserParams, len(mmUnlockUser.callArgs))
+	copy(argCopy, mmUnlockUser.callArgs)
+
+	mmUnlockUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUnlockUserDone returns true if the count of the UnlockUser invocations corresponds
+// the number of defined expectations
+func (m *UserServiceMock) MinimockUnlockUserDone() bool {
+	if m.UnlockUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UnlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UnlockUserMock.invocationsDone()
+}
+
+// MinimockUnlockUserInspect logs each unmet expectation
+func (m *UserServiceMock) MinimockUnlockUserInspect() {
+	for _, e := range m.UnlockUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UserServiceMock.UnlockUser with params: %#v", *e.params)
+		}
+	}
+
+	afterUnlockUserCounter := mm_atomic.LoadUint64(&m.afterUnlockUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UnlockUserMock.defaultExpectation != nil && afterUnlockUserCounter < 1 {
+		if m.UnlockUserMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to UserServiceMock.UnlockUser")
+		} else {
+			m.t.Errorf("Expected call to UserServiceMock.UnlockUser with params: %#v", *m.UnlockUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUnlockUser != nil && afterUnlockUserCounter < 1 {
+		m.t.Error("Expected call to UserServiceMock.UnlockUser")
+	}
+
+	if !m.UnlockUserMock.invocationsDone() && afterUnlockUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UserServiceMock.UnlockUser but found %d calls",
+			mm_atomic.LoadUint64(&m.UnlockUserMock.expectedInvocations), afterUnlockUserCounter)
+	}
+}
+
 type mUserServiceMockUpdateUser struct {
 	optional           bool
 	mock               *UserServiceMock
@@ -1361,6 +2020,10 @@ func (m *UserServiceMock) MinimockFinish() {
 
 			m.MinimockGetUserInspect()
 
+			m.MinimockListUsersInspect()
+
+			m.MinimockUnlockUserInspect()
+
 			m.MinimockUpdateUserInspect()
 		}
 	})
@@ -1388,5 +2051,7 @@ func (m *UserServiceMock) minimockDone() bool {
 		m.MinimockCreateUserDone() &&
 		m.MinimockDeleteUserDone() &&
 		m.MinimockGetUserDone() &&
+		m.MinimockListUsersDone() &&
+		m.MinimockUnlockUserDone() &&
 		m.MinimockUpdateUserDone()
 }
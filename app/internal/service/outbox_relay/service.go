@@ -0,0 +1,151 @@
+package outboxrelay
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+)
+
+const (
+	eventIDHeader   = "event_id"
+	eventTypeHeader = "event_type"
+)
+
+type service struct {
+	cfg              *config.Config
+	outboxRepository repository.OutboxRepository
+	producer         sarama.SyncProducer
+	txManager        db.TxManager
+}
+
+// NewService creates a new instance of the outbox relay.
+func NewService(
+	cfg *config.Config,
+	outboxRepository repository.OutboxRepository,
+	producer sarama.SyncProducer,
+	txManager db.TxManager,
+) *service {
+	return &service{
+		cfg:              cfg,
+		outboxRepository: outboxRepository,
+		producer:         producer,
+		txManager:        txManager,
+	}
+}
+
+// RunRelay publishes pending outbox events every relay interval until the context is done.
+func (s *service) RunRelay(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.Outbox.RelayInterval)
+	defer ticker.Stop()
+
+	for {
+		err := s.RelayEvents(ctx)
+		if err != nil {
+			log.Warnf("Failed to relay outbox events, err: %+v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayEvents publishes one batch of pending outbox events and marks the published ones as sent.
+//
+// Events are published one by one in the order they were recorded and the batch stops at the first failure,
+// so a later event of a user is never published before an earlier one. An event may be published more than
+// once if marking it as sent fails, consumers must be idempotent.
+func (s *service) RelayEvents(ctx context.Context) error {
+	var sendErr error
+
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		acquired, txErr := s.outboxRepository.AcquireRelayLock(ctx)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !acquired {
+			return nil
+		}
+
+		events, txErr := s.outboxRepository.ListPendingOutboxEvents(ctx, model.ListPendingOutboxEventsParams{
+			Limit: s.cfg.Outbox.BatchSize,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		sentIDs := make([]int64, 0, len(events))
+
+		for _, event := range events {
+			sendErr = s.send(event)
+			if sendErr != nil {
+				break
+			}
+
+			sentIDs = append(sentIDs, event.EventID)
+		}
+
+		if len(sentIDs) == 0 {
+			return nil
+		}
+
+		return s.outboxRepository.MarkOutboxEventsSent(ctx, model.MarkOutboxEventsSentParams{
+			EventIDs: sentIDs,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return sendErr
+}
+
+func (s *service) send(event model.OutboxEvent) error {
+	topic, err := s.topic(event.EventType)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(strconv.FormatInt(event.AggregateID, 10)),
+		Value: sarama.ByteEncoder(event.Payload),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(eventIDHeader), Value: []byte(strconv.FormatInt(event.EventID, 10))},
+			{Key: []byte(eventTypeHeader), Value: []byte(event.EventType)},
+		},
+		Timestamp: event.CreatedAt,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Cannot publish outbox event(eventID: %d)", event.EventID)
+	}
+
+	log.Infof("Outbox event published, eventID: %d, eventType: %s, topic: %s", event.EventID, event.EventType, topic)
+
+	return nil
+}
+
+func (s *service) topic(eventType model.OutboxEventType) (string, error) {
+	switch eventType {
+	case model.OutboxEventUserCreated:
+		return s.cfg.KafkaProducer.UserCreatedTopicName, nil
+	case model.OutboxEventUserUpdated:
+		return s.cfg.KafkaProducer.UserUpdatedTopicName, nil
+	case model.OutboxEventUserDeleted:
+		return s.cfg.KafkaProducer.UserDeletedTopicName, nil
+	default:
+		return "", errors.Errorf("Unknown outbox event type: %s", eventType)
+	}
+}
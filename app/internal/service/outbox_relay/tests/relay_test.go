@@ -0,0 +1,240 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	saramaMocks "github.com/IBM/sarama/mocks"
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Prrromanssss/auth/config"
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	outboxRelay "github.com/Prrromanssss/auth/internal/service/outbox_relay"
+)
+
+func TestRelayEvents(t *testing.T) {
+	t.Parallel()
+
+	type (
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		producerMockFunc         func(t *testing.T) *saramaMocks.SyncProducer
+	)
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		batchSize = int64(10)
+		userID    = gofakeit.Int64()
+
+		ErrOutboxRepository = errors.New("outbox repository error")
+		ErrProducer         = errors.New("producer error")
+
+		cfg = &config.Config{
+			KafkaProducer: yaml.KafkaProducer{
+				UserCreatedTopicName: "User-Created",
+				UserUpdatedTopicName: "User-Updated",
+				UserDeletedTopicName: "User-Deleted",
+			},
+			Outbox: yaml.Outbox{
+				RelayInterval: time.Second,
+				BatchSize:     batchSize,
+			},
+		}
+
+		listParams = model.ListPendingOutboxEventsParams{
+			Limit: batchSize,
+		}
+
+		events = []model.OutboxEvent{
+			{EventID: 1, AggregateID: userID, EventType: model.OutboxEventUserCreated, Payload: []byte(`{"id":1}`)},
+			{EventID: 2, AggregateID: userID, EventType: model.OutboxEventUserUpdated, Payload: []byte(`{"id":1}`)},
+			{EventID: 3, AggregateID: userID, EventType: model.OutboxEventUserDeleted, Payload: []byte(`{"id":1}`)},
+		}
+
+		expectMessage = func(topic string) saramaMocks.MessageChecker {
+			return func(msg *sarama.ProducerMessage) error {
+				key, err := msg.Key.Encode()
+				if err != nil {
+					return err
+				}
+
+				if msg.Topic != topic || string(key) != strconv.FormatInt(userID, 10) {
+					return errors.New("unexpected message")
+				}
+
+				return nil
+			}
+		}
+
+		txManager = func(mc *minimock.Controller) db.TxManager {
+			mock := dbMocks.NewTxManagerMock(mc)
+			mock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name                 string
+		err                  error
+		outboxRepositoryMock outboxRepositoryMockFunc
+		producerMock         producerMockFunc
+	}{
+		{
+			name: "success case",
+			err:  nil,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(true, nil)
+				mock.ListPendingOutboxEventsMock.Expect(ctx, listParams).Return(events, nil)
+				mock.MarkOutboxEventsSentMock.Expect(ctx, model.MarkOutboxEventsSentParams{
+					EventIDs: []int64{1, 2, 3},
+				}).Return(nil)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(expectMessage("User-Created"))
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(expectMessage("User-Updated"))
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(expectMessage("User-Deleted"))
+
+				return producer
+			},
+		},
+		{
+			name: "no pending events",
+			err:  nil,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(true, nil)
+				mock.ListPendingOutboxEventsMock.Expect(ctx, listParams).Return(nil, nil)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				return saramaMocks.NewSyncProducer(t, nil)
+			},
+		},
+		{
+			name: "relay lock is held by another relay",
+			err:  nil,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(false, nil)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				return saramaMocks.NewSyncProducer(t, nil)
+			},
+		},
+		{
+			name: "producer error stops the batch",
+			err:  ErrProducer,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(true, nil)
+				mock.ListPendingOutboxEventsMock.Expect(ctx, listParams).Return(events, nil)
+				mock.MarkOutboxEventsSentMock.Expect(ctx, model.MarkOutboxEventsSentParams{
+					EventIDs: []int64{1},
+				}).Return(nil)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(expectMessage("User-Created"))
+				producer.ExpectSendMessageWithMessageCheckerFunctionAndFail(expectMessage("User-Updated"), ErrProducer)
+
+				return producer
+			},
+		},
+		{
+			name: "producer error on first event",
+			err:  ErrProducer,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(true, nil)
+				mock.ListPendingOutboxEventsMock.Expect(ctx, listParams).Return(events, nil)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageAndFail(ErrProducer)
+
+				return producer
+			},
+		},
+		{
+			name: "list pending events error",
+			err:  ErrOutboxRepository,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(true, nil)
+				mock.ListPendingOutboxEventsMock.Expect(ctx, listParams).Return(nil, ErrOutboxRepository)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				return saramaMocks.NewSyncProducer(t, nil)
+			},
+		},
+		{
+			name: "mark events sent error",
+			err:  ErrOutboxRepository,
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.AcquireRelayLockMock.Expect(ctx).Return(true, nil)
+				mock.ListPendingOutboxEventsMock.Expect(ctx, listParams).Return(events[:1], nil)
+				mock.MarkOutboxEventsSentMock.Expect(ctx, model.MarkOutboxEventsSentParams{
+					EventIDs: []int64{1},
+				}).Return(ErrOutboxRepository)
+
+				return mock
+			},
+			producerMock: func(t *testing.T) *saramaMocks.SyncProducer {
+				producer := saramaMocks.NewSyncProducer(t, nil)
+				producer.ExpectSendMessageAndSucceed()
+
+				return producer
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			producer := tt.producerMock(t)
+			defer func() {
+				require.NoError(t, producer.Close())
+			}()
+
+			service := outboxRelay.NewService(
+				cfg,
+				tt.outboxRepositoryMock(mc),
+				producer,
+				txManager(mc),
+			)
+
+			err := service.RelayEvents(ctx)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
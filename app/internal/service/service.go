@@ -19,8 +19,83 @@ type UserService interface {
 
 	// DeleteUser removes a user by ID and returns any error.
 	DeleteUser(ctx context.Context, params model.DeleteUserParams) (err error)
+
+	// ListUsers retrieves a page of users and the token of the next page and any error.
+	ListUsers(ctx context.Context, params model.ListUsersParams) (resp model.ListUsersResponse, err error)
+
+	// UnlockUser lifts the login lockout of a user by ID and returns any error.
+	UnlockUser(ctx context.Context, params model.UnlockUserParams) (err error)
+}
+
+// AuditService defines methods for reading the audit log.
+type AuditService interface {
+	// ListAuditEvents retrieves a page of audit events, newest first, and the token of the next page and any error.
+	ListAuditEvents(
+		ctx context.Context,
+		params model.ListAuditEventsParams,
+	) (resp model.ListAuditEventsResponse, err error)
+}
+
+// AuthService defines methods for user authentication.
+type AuthService interface {
+	// Login checks the user's credentials and returns a pair of access and refresh tokens and any error.
+	Login(ctx context.Context, params model.LoginParams) (resp model.LoginResponse, err error)
+
+	// RefreshToken rotates a refresh token and returns a new pair of access and refresh tokens and any error.
+	RefreshToken(ctx context.Context, params model.RefreshTokenParams) (resp model.RefreshTokenResponse, err error)
+}
+
+// AccessService defines methods for checking access to endpoints.
+type AccessService interface {
+	// Check verifies the access token and returns an error if its holder may not call the endpoint.
+	Check(ctx context.Context, params model.CheckParams) (err error)
 }
 
 type ConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
+
+// OutboxRelayService publishes the events recorded in the outbox to Kafka.
+type OutboxRelayService interface {
+	// RunRelay publishes pending outbox events until the context is done and returns any error.
+	RunRelay(ctx context.Context) error
+}
+
+// AuditWriter records audit events asynchronously, outside of the transaction of the call.
+// It is meant for reads, mutating calls record their audit events in their own transaction.
+type AuditWriter interface {
+	// Write queues the audit record of a call. The record is dropped when the writer is overloaded.
+	Write(ctx context.Context, params model.CreateAPILogParams)
+
+	// RunWriter writes the queued records in batches until the context is done, flushes the rest
+	// and returns any error.
+	RunWriter(ctx context.Context) error
+}
+
+// AuditChainService verifies the hash chain of the audit log and signs checkpoints of its head.
+type AuditChainService interface {
+	// VerifyChain walks the audit chain and returns the first record that does not verify, if any, and any error.
+	VerifyChain(ctx context.Context) (resp model.VerifyAuditChainResponse, err error)
+
+	// CreateCheckpoint signs the head of the audit chain and returns whether a checkpoint was stored and any error.
+	CreateCheckpoint(ctx context.Context) (created bool, err error)
+
+	// ListCheckpoints retrieves the checkpoints after a checkpoint, oldest first, and any error.
+	ListCheckpoints(
+		ctx context.Context,
+		params model.ListAuditCheckpointsParams,
+	) (resp []model.AuditCheckpoint, err error)
+
+	// RunCheckpoints creates checkpoints periodically until the context is done and returns any error.
+	RunCheckpoints(ctx context.Context) error
+}
+
+// AuditRetentionService keeps the monthly partitions of the audit log: it creates them ahead of time
+// and archives and removes the ones older than the retention.
+type AuditRetentionService interface {
+	// ApplyRetention creates the upcoming partitions, archives and removes the expired ones and returns any error.
+	ApplyRetention(ctx context.Context) error
+
+	// RunRetention applies the retention periodically until the context is done and returns any error.
+	RunRetention(ctx context.Context) error
+}
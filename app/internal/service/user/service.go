@@ -3,37 +3,63 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/Prrromanssss/platform_common/pkg/db"
 	"github.com/gofiber/fiber/v2/log"
+	"golang.org/x/sync/singleflight"
 
 	modelCache "github.com/Prrromanssss/auth/internal/cache/user/model"
 
 	"github.com/Prrromanssss/auth/internal/cache"
 	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/pagination"
 	"github.com/Prrromanssss/auth/internal/repository"
 	"github.com/Prrromanssss/auth/internal/service"
 )
 
+const (
+	defaultPageSize = 50
+	maxPageSize     = 100
+
+	userResourceType = "user"
+)
+
 type userService struct {
-	userRepository repository.UserRepository
-	logRepository  repository.LogRepository
-	cacheClient    cache.UserCache
-	txManager      db.TxManager
+	userRepository         repository.UserRepository
+	logRepository          repository.LogRepository
+	outboxRepository       repository.OutboxRepository
+	loginFailureRepository repository.LoginFailureRepository
+	auditWriter            service.AuditWriter
+	cacheClient            cache.UserCache
+	pageTokenCodec         pagination.PageTokenCodec
+	txManager              db.TxManager
+
+	getUserGroup singleflight.Group
 }
 
 // NewService creates a new instance of userService with the provided UserRepository.
 func NewService(
 	userRepository repository.UserRepository,
 	logRepository repository.LogRepository,
+	outboxRepository repository.OutboxRepository,
+	loginFailureRepository repository.LoginFailureRepository,
+	auditWriter service.AuditWriter,
 	cacheClient cache.UserCache,
+	pageTokenCodec pagination.PageTokenCodec,
 	txManager db.TxManager,
 ) service.UserService {
 	return &userService{
-		userRepository: userRepository,
-		logRepository:  logRepository,
-		cacheClient:    cacheClient,
-		txManager:      txManager,
+		userRepository:         userRepository,
+		logRepository:          logRepository,
+		outboxRepository:       outboxRepository,
+		loginFailureRepository: loginFailureRepository,
+		auditWriter:            auditWriter,
+		cacheClient:            cacheClient,
+		pageTokenCodec:         pageTokenCodec,
+		txManager:              txManager,
 	}
 }
 
@@ -54,6 +80,7 @@ func (s *userService) CreateUser(
 
 		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
 			Method:       "Create",
+			TargetUserID: &resp.UserID,
 			RequestData:  params,
 			ResponseData: resp,
 		})
@@ -61,6 +88,15 @@ func (s *userService) CreateUser(
 			return txErr
 		}
 
+		txErr = s.outboxRepository.CreateOutboxEvent(ctx, model.CreateOutboxEventParams{
+			AggregateID: resp.UserID,
+			EventType:   model.OutboxEventUserCreated,
+			Payload:     newUserEvent(resp.User),
+		})
+		if txErr != nil {
+			return txErr
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -76,47 +112,94 @@ func (s *userService) CreateUser(
 }
 
 // GetUser retrieves a user's information based on the provided GetUserParams.
+// Reads are audited asynchronously, whether the user came from the cache or from the database.
 func (s *userService) GetUser(
 	ctx context.Context,
 	params model.GetUserParams,
 ) (resp model.GetUserResponse, err error) {
 	log.Infof("userService.GetUser, params: %+v", params)
 
+	resp, err = s.getUser(ctx, params)
+	if err != nil {
+		return model.GetUserResponse{}, err
+	}
+
+	s.auditWriter.Write(ctx, model.CreateAPILogParams{
+		Method:       "Get",
+		TargetUserID: &params.UserID,
+		RequestData:  params,
+		ResponseData: resp,
+	})
+
+	return resp, nil
+}
+
+func (s *userService) getUser(
+	ctx context.Context,
+	params model.GetUserParams,
+) (resp model.GetUserResponse, err error) {
 	user, cacheErr := s.cacheClient.Get(ctx, params)
-	if cacheErr != nil && !errors.Is(cacheErr, modelCache.ErrUserNotFound) {
-		log.Warnf("Failed to get user from cache, params: %+v, err: %+v", params, cacheErr)
-	} else if cacheErr == nil {
+	switch {
+	case cacheErr == nil:
 		log.Infof("User retrieved from cache, userID: %d", params.UserID)
 		return user, nil
+	case errors.Is(cacheErr, modelCache.ErrUserMissing):
+		return model.GetUserResponse{}, model.ErrUserNotFound.WithResource(
+			userResourceType,
+			strconv.FormatInt(params.UserID, 10),
+		)
+	case !errors.Is(cacheErr, modelCache.ErrUserNotFound):
+		log.Warnf("Failed to get user from cache, params: %+v, err: %+v", params, cacheErr)
 	}
 
-	err = s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
-		var txErr error
+	fillCache := errors.Is(cacheErr, modelCache.ErrUserNotFound)
 
-		resp, txErr = s.userRepository.GetUser(ctx, params)
-		if txErr != nil {
-			return txErr
-		}
+	// Concurrent misses for the same user share a single read from the database.
+	result := s.getUserGroup.DoChan(strconv.FormatInt(params.UserID, 10), func() (interface{}, error) {
+		return s.loadUser(ctx, params, fillCache)
+	})
 
-		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
-			Method:       "Get",
-			RequestData:  params,
-			ResponseData: resp,
-		})
-		if txErr != nil {
-			return txErr
+	select {
+	case <-ctx.Done():
+		return model.GetUserResponse{}, ctx.Err()
+	case res := <-result:
+		if res.Err != nil {
+			// The read was cancelled by the caller that started it, not by this one.
+			if res.Shared && ctx.Err() == nil &&
+				(errors.Is(res.Err, context.Canceled) || errors.Is(res.Err, context.DeadlineExceeded)) {
+				return s.loadUser(ctx, params, fillCache)
+			}
+
+			return model.GetUserResponse{}, res.Err
 		}
 
-		return nil
-	})
+		return res.Val.(model.GetUserResponse), nil
+	}
+}
+
+// loadUser reads the user from the database and, if the cache missed, stores the result in the cache.
+// Users that do not exist are stored as negative entries.
+func (s *userService) loadUser(
+	ctx context.Context,
+	params model.GetUserParams,
+	fillCache bool,
+) (resp model.GetUserResponse, err error) {
+	resp, err = s.userRepository.GetUser(ctx, params)
 	if err != nil {
+		if fillCache && errors.Is(err, model.ErrUserNotFound) {
+			cacheErr := s.cacheClient.CreateMissing(ctx, params)
+			if cacheErr != nil {
+				log.Warnf("Failed to create missing user in cache, params: %+v, err: %+v", params, cacheErr)
+			}
+		}
+
 		return model.GetUserResponse{}, err
 	}
 
-	if errors.Is(cacheErr, modelCache.ErrUserNotFound) {
-		cacheErr = s.cacheClient.Create(ctx, resp.User)
+	if fillCache {
+		cacheErr := s.cacheClient.Fill(ctx, resp.User)
 		if cacheErr != nil {
-			log.Warnf("Failed to create user in cache, params: %+v, err: %+v", resp, cacheErr)
+			log.Warnf("Failed to fill user in cache, params: %+v, err: %+v", resp, cacheErr)
 		}
 	}
 
@@ -142,6 +225,7 @@ func (s *userService) UpdateUser(
 
 		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
 			Method:       "Update",
+			TargetUserID: &params.UserID,
 			RequestData:  params,
 			ResponseData: resp,
 		})
@@ -149,6 +233,15 @@ func (s *userService) UpdateUser(
 			return txErr
 		}
 
+		txErr = s.outboxRepository.CreateOutboxEvent(ctx, model.CreateOutboxEventParams{
+			AggregateID: resp.UserID,
+			EventType:   model.OutboxEventUserUpdated,
+			Payload:     newUserEvent(resp.User),
+		})
+		if txErr != nil {
+			return txErr
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -179,8 +272,18 @@ func (s *userService) DeleteUser(
 		}
 
 		txErr = s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
-			Method:      "Delete",
-			RequestData: params,
+			Method:       "Delete",
+			TargetUserID: &params.UserID,
+			RequestData:  params,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.outboxRepository.CreateOutboxEvent(ctx, model.CreateOutboxEventParams{
+			AggregateID: params.UserID,
+			EventType:   model.OutboxEventUserDeleted,
+			Payload:     model.UserEvent{UserID: params.UserID},
 		})
 		if txErr != nil {
 			return txErr
@@ -199,3 +302,129 @@ func (s *userService) DeleteUser(
 
 	return nil
 }
+
+// UnlockUser lifts the login lockout of a user by resetting the failed logins counted for their email.
+// The failed logins counted for client addresses are left as they are.
+func (s *userService) UnlockUser(
+	ctx context.Context,
+	params model.UnlockUserParams,
+) (err error) {
+	log.Infof("userService.UnlockUser, params: %+v", params)
+
+	return s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		user, txErr := s.userRepository.GetUser(ctx, model.GetUserParams{UserID: params.UserID})
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.loginFailureRepository.DeleteLoginFailures(ctx, model.DeleteLoginFailuresParams{
+			Keys: []model.LoginFailureKey{{Scope: model.LoginFailureScopeAccount, Key: user.Email}},
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		return s.logRepository.CreateAPILog(ctx, model.CreateAPILogParams{
+			Method:       "Unlock",
+			TargetUserID: &params.UserID,
+			RequestData:  params,
+		})
+	})
+}
+
+// ListUsers retrieves a page of users that match the filters using keyset pagination on (created_at, id).
+// The page token is bound to the filters and the sort order it was issued for.
+func (s *userService) ListUsers(
+	ctx context.Context,
+	params model.ListUsersParams,
+) (resp model.ListUsersResponse, err error) {
+	log.Infof("userService.ListUsers, params: %+v", params)
+
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	scope := listUsersScope(params)
+
+	var after *model.UserCursor
+
+	if params.PageToken != "" {
+		after = &model.UserCursor{}
+
+		err = s.pageTokenCodec.Decode(params.PageToken, scope, after)
+		if err != nil {
+			return model.ListUsersResponse{}, err
+		}
+	}
+
+	// One extra row tells whether there is a next page without a separate count query.
+	page, err := s.userRepository.ListUsers(ctx, model.ListUsersPageParams{
+		Filter:    params.Filter,
+		SortOrder: params.SortOrder,
+		After:     after,
+		Limit:     pageSize + 1,
+	})
+	if err != nil {
+		return model.ListUsersResponse{}, err
+	}
+
+	resp.Users = page.Users
+
+	if int64(len(page.Users)) > pageSize {
+		resp.Users = page.Users[:pageSize]
+		last := resp.Users[pageSize-1]
+
+		resp.NextPageToken, err = s.pageTokenCodec.Encode(model.UserCursor{
+			CreatedAt: last.CreatedAt,
+			UserID:    last.UserID,
+		}, scope)
+		if err != nil {
+			return model.ListUsersResponse{}, err
+		}
+	}
+
+	return resp, nil
+}
+
+// listUsersScope describes the query a page token belongs to.
+func listUsersScope(params model.ListUsersParams) string {
+	var role, createdFrom, createdTo string
+
+	if params.Filter.Role != nil {
+		role = strconv.FormatInt(*params.Filter.Role, 10)
+	}
+
+	if params.Filter.CreatedFrom != nil {
+		createdFrom = params.Filter.CreatedFrom.UTC().Format(time.RFC3339Nano)
+	}
+
+	if params.Filter.CreatedTo != nil {
+		createdTo = params.Filter.CreatedTo.UTC().Format(time.RFC3339Nano)
+	}
+
+	return fmt.Sprintf(
+		"users:role=%s;email_prefix=%q;created_from=%s;created_to=%s;sort=%d",
+		role,
+		params.Filter.EmailPrefix,
+		createdFrom,
+		createdTo,
+		params.SortOrder,
+	)
+}
+
+// newUserEvent builds the payload of a user lifecycle event from the state of the user.
+func newUserEvent(user model.User) model.UserEvent {
+	return model.UserEvent{
+		UserID:    user.UserID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      user.Role,
+		CreatedAt: &user.CreatedAt,
+		UpdatedAt: &user.UpdatedAt,
+	}
+}
@@ -0,0 +1,164 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Prrromanssss/platform_common/pkg/cache/redis"
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	redigo "github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+
+	userCache "github.com/Prrromanssss/auth/internal/cache/user"
+	"github.com/Prrromanssss/auth/internal/model"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	"github.com/Prrromanssss/auth/internal/service"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	userService "github.com/Prrromanssss/auth/internal/service/user"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+// TestCacheRace reproduces a GetUser that reads the user from the database, stalls while the user
+// is changed, and only then repopulates the cache.
+func TestCacheRace(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = context.Background()
+
+		id  = gofakeit.Int64()
+		req = model.GetUserParams{UserID: id}
+
+		stale = model.User{
+			UserID:    id,
+			Name:      gofakeit.Name(),
+			Email:     gofakeit.Email(),
+			Role:      int64(pb.Role_USER),
+			CreatedAt: time.UnixMilli(gofakeit.Date().UnixMilli()),
+			UpdatedAt: time.UnixMilli(gofakeit.Date().UnixMilli()),
+			Version:   1,
+		}
+
+		updated = model.User{
+			UserID:    id,
+			Name:      gofakeit.Name(),
+			Email:     stale.Email,
+			Role:      int64(pb.Role_ADMIN),
+			CreatedAt: stale.CreatedAt,
+			UpdatedAt: time.UnixMilli(gofakeit.Date().UnixMilli()),
+			Version:   2,
+		}
+
+		updateReq = model.UpdateUserParams{UserID: id, Name: &updated.Name, Role: updated.Role}
+		deleteReq = model.DeleteUserParams{UserID: id}
+	)
+
+	tests := []struct {
+		name               string
+		userRepositoryMock func(mock *repositoryMocks.UserRepositoryMock)
+		change             func(service service.UserService) error
+		want               model.GetUserResponse
+		err                error
+	}{
+		{
+			name: "get racing update",
+			userRepositoryMock: func(mock *repositoryMocks.UserRepositoryMock) {
+				mock.UpdateUserMock.Expect(ctx, updateReq).Return(model.UpdateUserResponse{User: updated}, nil)
+			},
+			change: func(service service.UserService) error {
+				return service.UpdateUser(ctx, updateReq)
+			},
+			want: model.GetUserResponse{User: updated},
+		},
+		{
+			name: "get racing delete",
+			userRepositoryMock: func(mock *repositoryMocks.UserRepositoryMock) {
+				mock.DeleteUserMock.Expect(ctx, deleteReq).Return(nil)
+			},
+			change: func(service service.UserService) error {
+				return service.DeleteUser(ctx, deleteReq)
+			},
+			want: model.GetUserResponse{},
+			err:  model.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mc := minimock.NewController(t)
+			server := miniredis.RunT(t)
+
+			pool := &redigo.Pool{
+				DialContext: func(ctx context.Context) (redigo.Conn, error) {
+					return redigo.DialContext(ctx, "tcp", server.Addr())
+				},
+			}
+			defer pool.Close()
+
+			changed := make(chan struct{})
+
+			userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+			userRepositoryMock.GetUserMock.Set(func(_ context.Context, params model.GetUserParams) (model.GetUserResponse, error) {
+				require.Equal(t, req, params)
+				<-changed
+
+				return model.GetUserResponse{User: stale}, nil
+			})
+			tt.userRepositoryMock(userRepositoryMock)
+
+			logRepositoryMock := repositoryMocks.NewLogRepositoryMock(mc)
+			logRepositoryMock.CreateAPILogMock.Return(nil)
+
+			outboxRepositoryMock := repositoryMocks.NewOutboxRepositoryMock(mc)
+			outboxRepositoryMock.CreateOutboxEventMock.Return(nil)
+
+			auditWriterMock := serviceMocks.NewAuditWriterMock(mc)
+			auditWriterMock.WriteMock.Return()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			service := userService.NewService(
+				userRepositoryMock,
+				logRepositoryMock,
+				outboxRepositoryMock,
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				auditWriterMock,
+				userCache.NewCache(redis.NewClient(pool, time.Second), pool, time.Minute, 0, time.Minute, time.Minute),
+				paginationMocks.NewPageTokenCodecMock(mc),
+				txManagerMock,
+			)
+
+			staleRead := make(chan error, 1)
+
+			go func() {
+				_, err := service.GetUser(ctx, req)
+				staleRead <- err
+			}()
+
+			require.Eventually(t, func() bool {
+				return userRepositoryMock.GetUserBeforeCounter() == 1
+			}, time.Second, time.Millisecond)
+
+			require.NoError(t, tt.change(service))
+			close(changed)
+			require.NoError(t, <-staleRead)
+
+			// The second read must be served by the cache and must see the change.
+			resp, err := service.GetUser(ctx, req)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.want, resp)
+			require.Equal(t, uint64(1), userRepositoryMock.GetUserAfterCounter())
+		})
+	}
+}
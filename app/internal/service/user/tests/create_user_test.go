@@ -14,10 +14,11 @@ import (
 	"github.com/Prrromanssss/auth/internal/cache"
 	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
 	"github.com/Prrromanssss/auth/internal/model"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
 	"github.com/Prrromanssss/auth/internal/repository"
 	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
 	userService "github.com/Prrromanssss/auth/internal/service/user"
-	"github.com/Prrromanssss/auth/pkg/crypto"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
 
@@ -25,10 +26,11 @@ func TestCreate(t *testing.T) {
 	t.Parallel()
 
 	type (
-		userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
-		cacheMockFunc          func(mc *minimock.Controller) cache.UserCache
+		userRepositoryMockFunc   func(mc *minimock.Controller) repository.UserRepository
+		logRepositoryMockFunc    func(mc *minimock.Controller) repository.LogRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		txManagerMockFunc        func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		cacheMockFunc            func(mc *minimock.Controller) cache.UserCache
 	)
 
 	type args struct {
@@ -44,14 +46,14 @@ func TestCreate(t *testing.T) {
 		name           = gofakeit.Name()
 		email          = gofakeit.Email()
 		role           = pb.Role_ADMIN
-		password       = gofakeit.Password(true, true, true, true, true, 10)
-		hashedPassword = crypto.HashPassword(password)
+		hashedPassword = gofakeit.UUID()
 		createdAt      = gofakeit.Date()
 		updatedAt      = gofakeit.Date()
 
-		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
-		ErrCache          = errors.New("cache error")
+		ErrUserRepository   = errors.New("user repository error")
+		ErrLogRepository    = errors.New("log repository error")
+		ErrOutboxRepository = errors.New("outbox repository error")
+		ErrCache            = errors.New("cache error")
 
 		req = model.CreateUserParams{
 			Name:           name,
@@ -73,10 +75,24 @@ func TestCreate(t *testing.T) {
 
 		logApiReq = model.CreateAPILogParams{
 			Method:       "Create",
+			TargetUserID: &id,
 			RequestData:  req,
 			ResponseData: resp,
 		}
 
+		outboxReq = model.CreateOutboxEventParams{
+			AggregateID: id,
+			EventType:   model.OutboxEventUserCreated,
+			Payload: model.UserEvent{
+				UserID:    id,
+				Name:      name,
+				Email:     email,
+				Role:      int64(role),
+				CreatedAt: &createdAt,
+				UpdatedAt: &updatedAt,
+			},
+		}
+
 		cacheUser = model.User{
 			UserID:    id,
 			Name:      name,
@@ -88,14 +104,15 @@ func TestCreate(t *testing.T) {
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		want               model.CreateUserResponse
-		err                error
-		userRepositoryMock userRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
-		cacheMock          cacheMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		want                 model.CreateUserResponse
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		logRepositoryMock    logRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		cacheMock            cacheMockFunc
+		txManagerMock        txManagerMockFunc
 	}{
 		{
 			name: "success case",
@@ -117,6 +134,12 @@ func TestCreate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(nil)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.CreateMock.Expect(ctx, cacheUser).Return(nil)
@@ -151,6 +174,11 @@ func TestCreate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 
@@ -185,6 +213,51 @@ func TestCreate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) cache.UserCache {
+				mock := cacheMocks.NewUserCacheMock(mc)
+
+				return mock
+			},
+			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
+				mock := dbMocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "outbox repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.CreateUserResponse{},
+			err:  ErrOutboxRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.CreateUserMock.Expect(ctx, req).Return(resp, nil)
+
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(ErrOutboxRepository)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 
@@ -219,6 +292,12 @@ func TestCreate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(nil)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.CreateMock.Expect(ctx, cacheUser).Return(ErrCache)
@@ -242,6 +321,7 @@ func TestCreate(t *testing.T) {
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
 			logRepositoryMock := tt.logRepositoryMock(mc)
+			outboxRepositoryMock := tt.outboxRepositoryMock(mc)
 			cacheMock := tt.cacheMock(mc)
 			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
 				resp, txErr := userRepositoryMock.CreateUser(ctx, req)
@@ -258,10 +338,24 @@ func TestCreate(t *testing.T) {
 					return txErr
 				}
 
+				txErr = outboxRepositoryMock.CreateOutboxEvent(ctx, outboxReq)
+				if txErr != nil {
+					return txErr
+				}
+
 				return nil
 			}, mc)
 
-			service := userService.NewService(userRepositoryMock, logRepositoryMock, cacheMock, txManagerMock)
+			service := userService.NewService(
+				userRepositoryMock,
+				logRepositoryMock,
+				outboxRepositoryMock,
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				serviceMocks.NewAuditWriterMock(mc),
+				cacheMock,
+				paginationMocks.NewPageTokenCodecMock(mc),
+				txManagerMock,
+			)
 
 			resp, err := service.CreateUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -14,8 +14,10 @@ import (
 	"github.com/Prrromanssss/auth/internal/cache"
 	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
 	"github.com/Prrromanssss/auth/internal/model"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
 	"github.com/Prrromanssss/auth/internal/repository"
 	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
 	userService "github.com/Prrromanssss/auth/internal/service/user"
 )
 
@@ -23,10 +25,11 @@ func TestDelete(t *testing.T) {
 	t.Parallel()
 
 	type (
-		userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
-		cacheMockFunc          func(mc *minimock.Controller) cache.UserCache
+		userRepositoryMockFunc   func(mc *minimock.Controller) repository.UserRepository
+		logRepositoryMockFunc    func(mc *minimock.Controller) repository.LogRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		txManagerMockFunc        func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		cacheMockFunc            func(mc *minimock.Controller) cache.UserCache
 	)
 
 	type args struct {
@@ -40,28 +43,37 @@ func TestDelete(t *testing.T) {
 
 		id = gofakeit.Int64()
 
-		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
-		ErrCache          = errors.New("cache error")
+		ErrUserRepository   = errors.New("user repository error")
+		ErrLogRepository    = errors.New("log repository error")
+		ErrOutboxRepository = errors.New("outbox repository error")
+		ErrCache            = errors.New("cache error")
 
 		req = model.DeleteUserParams{
 			UserID: id,
 		}
 
 		logApiReq = model.CreateAPILogParams{
-			Method:      "Delete",
-			RequestData: req,
+			Method:       "Delete",
+			TargetUserID: &id,
+			RequestData:  req,
+		}
+
+		outboxReq = model.CreateOutboxEventParams{
+			AggregateID: id,
+			EventType:   model.OutboxEventUserDeleted,
+			Payload:     model.UserEvent{UserID: id},
 		}
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		err                error
-		userRepositoryMock userRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
-		cacheMock          cacheMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		logRepositoryMock    logRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		cacheMock            cacheMockFunc
+		txManagerMock        txManagerMockFunc
 	}{
 		{
 			name: "success case",
@@ -82,6 +94,12 @@ func TestDelete(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(nil)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.DeleteMock.Expect(ctx, req).Return(nil)
@@ -115,6 +133,11 @@ func TestDelete(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 
@@ -148,6 +171,50 @@ func TestDelete(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) cache.UserCache {
+				mock := cacheMocks.NewUserCacheMock(mc)
+
+				return mock
+			},
+			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
+				mock := dbMocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "outbox repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: ErrOutboxRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.DeleteUserMock.Expect(ctx, req).Return(nil)
+
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(ErrOutboxRepository)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 
@@ -181,6 +248,12 @@ func TestDelete(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(nil)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.DeleteMock.Expect(ctx, req).Return(ErrCache)
@@ -204,6 +277,7 @@ func TestDelete(t *testing.T) {
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
 			logRepositoryMock := tt.logRepositoryMock(mc)
+			outboxRepositoryMock := tt.outboxRepositoryMock(mc)
 			cacheMock := tt.cacheMock(mc)
 			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
 				txErr := userRepositoryMock.DeleteUser(ctx, req)
@@ -219,10 +293,24 @@ func TestDelete(t *testing.T) {
 					return txErr
 				}
 
+				txErr = outboxRepositoryMock.CreateOutboxEvent(ctx, outboxReq)
+				if txErr != nil {
+					return txErr
+				}
+
 				return nil
 			}, mc)
 
-			service := userService.NewService(userRepositoryMock, logRepositoryMock, cacheMock, txManagerMock)
+			service := userService.NewService(
+				userRepositoryMock,
+				logRepositoryMock,
+				outboxRepositoryMock,
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				serviceMocks.NewAuditWriterMock(mc),
+				cacheMock,
+				paginationMocks.NewPageTokenCodecMock(mc),
+				txManagerMock,
+			)
 
 			err := service.DeleteUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
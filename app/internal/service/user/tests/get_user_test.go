@@ -3,9 +3,12 @@ package tests
 import (
 	"context"
 	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
-	"github.com/Prrromanssss/platform_common/pkg/db"
 	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/gojuno/minimock/v3"
@@ -15,8 +18,11 @@ import (
 	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
 	modelCache "github.com/Prrromanssss/auth/internal/cache/user/model"
 	"github.com/Prrromanssss/auth/internal/model"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
 	"github.com/Prrromanssss/auth/internal/repository"
 	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	"github.com/Prrromanssss/auth/internal/service"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
 	userService "github.com/Prrromanssss/auth/internal/service/user"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
@@ -26,8 +32,7 @@ func TestGet(t *testing.T) {
 
 	type (
 		userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		auditWriterMockFunc    func(mc *minimock.Controller) service.AuditWriter
 		cacheMockFunc          func(mc *minimock.Controller) cache.UserCache
 	)
 
@@ -48,9 +53,10 @@ func TestGet(t *testing.T) {
 		updatedAt = gofakeit.Date()
 
 		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
 		ErrCache          = errors.New("cache error")
 
+		ErrUserNotFound = model.ErrUserNotFound.WithResource("user", strconv.FormatInt(id, 10))
+
 		req = model.GetUserParams{
 			UserID: id,
 		}
@@ -68,6 +74,7 @@ func TestGet(t *testing.T) {
 
 		logApiReq = model.CreateAPILogParams{
 			Method:       "Get",
+			TargetUserID: &id,
 			RequestData:  req,
 			ResponseData: resp,
 		}
@@ -79,9 +86,8 @@ func TestGet(t *testing.T) {
 		want               model.GetUserResponse
 		err                error
 		userRepositoryMock userRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
+		auditWriterMock    auditWriterMockFunc
 		cacheMock          cacheMockFunc
-		txManagerMock      txManagerMockFunc
 	}{
 		{
 			name: "success case: user retrieved from db, created in cache",
@@ -97,24 +103,16 @@ func TestGet(t *testing.T) {
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				mock := serviceMocks.NewAuditWriterMock(mc)
+				mock.WriteMock.Expect(ctx, logApiReq).Return()
 
 				return mock
 			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
-				mock.CreateMock.Expect(ctx, resp.User).Return(nil)
-
-				return mock
-			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
+				mock.FillMock.Expect(ctx, resp.User).Return(nil)
 
 				return mock
 			},
@@ -133,24 +131,16 @@ func TestGet(t *testing.T) {
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				mock := serviceMocks.NewAuditWriterMock(mc)
+				mock.WriteMock.Expect(ctx, logApiReq).Return()
 
 				return mock
 			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
-				mock.CreateMock.Expect(ctx, resp.User).Return(ErrCache)
-
-				return mock
-			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
+				mock.FillMock.Expect(ctx, resp.User).Return(ErrCache)
 
 				return mock
 			},
@@ -168,8 +158,9 @@ func TestGet(t *testing.T) {
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				mock := serviceMocks.NewAuditWriterMock(mc)
+				mock.WriteMock.Expect(ctx, logApiReq).Return()
 
 				return mock
 			},
@@ -177,14 +168,6 @@ func TestGet(t *testing.T) {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.GetMock.Expect(ctx, req).Return(resp, nil)
 
-				return mock
-			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
-
 				return mock
 			},
 		},
@@ -202,63 +185,46 @@ func TestGet(t *testing.T) {
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-
-				return mock
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				return serviceMocks.NewAuditWriterMock(mc)
 			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
 
-				return mock
-			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
-
 				return mock
 			},
 		},
 		{
-			name: "log repository error",
+			name: "cache error in Create",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: model.GetUserResponse{},
-			err:  ErrLogRepository,
+			want: resp,
+			err:  nil,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
 				mock.GetUserMock.Expect(ctx, req).Return(resp, nil)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(ErrLogRepository)
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				mock := serviceMocks.NewAuditWriterMock(mc)
+				mock.WriteMock.Expect(ctx, logApiReq).Return()
 
 				return mock
 			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
-
-				return mock
-			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
+				mock.FillMock.Expect(ctx, resp.User).Return(ErrCache)
 
 				return mock
 			},
 		},
 		{
-			name: "cache error in Create",
+			name: "cache error in Get",
 			args: args{
 				ctx: ctx,
 				req: req,
@@ -271,59 +237,85 @@ func TestGet(t *testing.T) {
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				mock := serviceMocks.NewAuditWriterMock(mc)
+				mock.WriteMock.Expect(ctx, logApiReq).Return()
 
 				return mock
 			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
-				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
-				mock.CreateMock.Expect(ctx, resp.User).Return(ErrCache)
+				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, ErrCache)
 
 				return mock
 			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
+		},
+		{
+			name: "user cached as missing",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.GetUserResponse{},
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				return serviceMocks.NewAuditWriterMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) cache.UserCache {
+				mock := cacheMocks.NewUserCacheMock(mc)
+				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserMissing)
 
 				return mock
 			},
 		},
 		{
-			name: "cache error in Get",
+			name: "user not found in db, created in cache as missing",
 			args: args{
 				ctx: ctx,
 				req: req,
 			},
-			want: resp,
-			err:  nil,
+			want: model.GetUserResponse{},
+			err:  ErrUserNotFound,
 			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
 				mock := repositoryMocks.NewUserRepositoryMock(mc)
-				mock.GetUserMock.Expect(ctx, req).Return(resp, nil)
+				mock.GetUserMock.Expect(ctx, req).Return(model.GetUserResponse{}, ErrUserNotFound)
 
 				return mock
 			},
-			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
-				mock := repositoryMocks.NewLogRepositoryMock(mc)
-				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
-
-				return mock
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				return serviceMocks.NewAuditWriterMock(mc)
 			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
-				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, ErrCache)
+				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
+				mock.CreateMissingMock.Expect(ctx, req).Return(nil)
 
 				return mock
 			},
-			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
-				mock := dbMocks.NewTxManagerMock(mc)
-				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
-					return f(ctx)
-				})
+		},
+		{
+			name: "user not found in db after cache error, not created in cache",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			want: model.GetUserResponse{},
+			err:  ErrUserNotFound,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, req).Return(model.GetUserResponse{}, ErrUserNotFound)
+
+				return mock
+			},
+			auditWriterMock: func(mc *minimock.Controller) service.AuditWriter {
+				return serviceMocks.NewAuditWriterMock(mc)
+			},
+			cacheMock: func(mc *minimock.Controller) cache.UserCache {
+				mock := cacheMocks.NewUserCacheMock(mc)
+				mock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, ErrCache)
 
 				return mock
 			},
@@ -334,28 +326,16 @@ func TestGet(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			userRepositoryMock := tt.userRepositoryMock(mc)
-			logRepositoryMock := tt.logRepositoryMock(mc)
-			cacheMock := tt.cacheMock(mc)
-			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
-				resp, txErr := userRepositoryMock.GetUser(ctx, req)
-				if txErr != nil {
-					return txErr
-				}
-
-				txErr = logRepositoryMock.CreateAPILog(ctx, model.CreateAPILogParams{
-					Method:       "Create",
-					RequestData:  req,
-					ResponseData: resp,
-				})
-				if txErr != nil {
-					return txErr
-				}
-
-				return nil
-			}, mc)
-
-			service := userService.NewService(userRepositoryMock, logRepositoryMock, cacheMock, txManagerMock)
+			service := userService.NewService(
+				tt.userRepositoryMock(mc),
+				repositoryMocks.NewLogRepositoryMock(mc),
+				repositoryMocks.NewOutboxRepositoryMock(mc),
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				tt.auditWriterMock(mc),
+				tt.cacheMock(mc),
+				paginationMocks.NewPageTokenCodecMock(mc),
+				dbMocks.NewTxManagerMock(mc),
+			)
 
 			resp, err := service.GetUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
@@ -363,3 +343,91 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConcurrentMisses(t *testing.T) {
+	t.Parallel()
+
+	const callers = 10
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id  = gofakeit.Int64()
+		req = model.GetUserParams{UserID: id}
+
+		resp = model.GetUserResponse{
+			User: model.User{
+				UserID:    id,
+				Name:      gofakeit.Name(),
+				Email:     gofakeit.Email(),
+				Role:      int64(pb.Role_USER),
+				CreatedAt: gofakeit.Date(),
+				UpdatedAt: gofakeit.Date(),
+			},
+		}
+
+		release = make(chan struct{})
+		reads   atomic.Int64
+	)
+
+	userRepositoryMock := repositoryMocks.NewUserRepositoryMock(mc)
+	userRepositoryMock.GetUserMock.Set(func(_ context.Context, params model.GetUserParams) (model.GetUserResponse, error) {
+		require.Equal(t, req, params)
+		reads.Add(1)
+		<-release
+
+		return resp, nil
+	})
+
+	auditWriterMock := serviceMocks.NewAuditWriterMock(mc)
+	auditWriterMock.WriteMock.Return()
+
+	cacheMock := cacheMocks.NewUserCacheMock(mc)
+	cacheMock.GetMock.Expect(ctx, req).Return(model.GetUserResponse{}, modelCache.ErrUserNotFound)
+	cacheMock.FillMock.Expect(ctx, resp.User).Return(nil)
+
+	service := userService.NewService(
+		userRepositoryMock,
+		repositoryMocks.NewLogRepositoryMock(mc),
+		repositoryMocks.NewOutboxRepositoryMock(mc),
+		repositoryMocks.NewLoginFailureRepositoryMock(mc),
+		auditWriterMock,
+		cacheMock,
+		paginationMocks.NewPageTokenCodecMock(mc),
+		dbMocks.NewTxManagerMock(mc),
+	)
+
+	var wg sync.WaitGroup
+
+	results := make([]model.GetUserResponse, callers)
+	errs := make([]error, callers)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			results[i], errs[i] = service.GetUser(ctx, req)
+		}(i)
+	}
+
+	require.Eventually(t, func() bool {
+		return cacheMock.GetAfterCounter() == callers
+	}, time.Second, time.Millisecond)
+
+	// Give the last callers time to join the read that is in flight.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, int64(1), reads.Load())
+	require.Equal(t, uint64(1), cacheMock.FillAfterCounter())
+	require.Equal(t, uint64(callers), auditWriterMock.WriteAfterCounter())
+
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		require.Equal(t, resp, results[i])
+	}
+}
@@ -0,0 +1,223 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/pagination"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	userService "github.com/Prrromanssss/auth/internal/service/user"
+	pb "github.com/Prrromanssss/auth/pkg/user_v1"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	type (
+		userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
+		pageTokenCodecMockFunc func(mc *minimock.Controller) pagination.PageTokenCodec
+	)
+
+	type args struct {
+		ctx context.Context
+		req model.ListUsersParams
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		role        = int64(pb.Role_USER)
+		emailPrefix = "john"
+		pageToken   = gofakeit.UUID()
+		nextToken   = gofakeit.UUID()
+
+		ErrUserRepository = errors.New("user repository error")
+
+		filter = model.ListUsersFilter{
+			Role:        &role,
+			EmailPrefix: emailPrefix,
+		}
+
+		users = []model.User{
+			{UserID: 3, Email: gofakeit.Email(), Role: role, CreatedAt: gofakeit.Date()},
+			{UserID: 2, Email: gofakeit.Email(), Role: role, CreatedAt: gofakeit.Date()},
+			{UserID: 1, Email: gofakeit.Email(), Role: role, CreatedAt: gofakeit.Date()},
+		}
+
+		cursor = model.UserCursor{
+			CreatedAt: users[0].CreatedAt,
+			UserID:    users[0].UserID,
+		}
+	)
+
+	tests := []struct {
+		name               string
+		args               args
+		want               model.ListUsersResponse
+		err                error
+		userRepositoryMock userRepositoryMockFunc
+		pageTokenCodecMock pageTokenCodecMockFunc
+	}{
+		{
+			name: "first page with next page",
+			args: args{
+				ctx: ctx,
+				req: model.ListUsersParams{PageSize: 2, Filter: filter},
+			},
+			want: model.ListUsersResponse{
+				Users:         users[:2],
+				NextPageToken: nextToken,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(ctx, model.ListUsersPageParams{
+					Filter: filter,
+					Limit:  3,
+				}).Return(model.ListUsersPageResponse{Users: users}, nil)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				mock := paginationMocks.NewPageTokenCodecMock(mc)
+				mock.EncodeMock.Set(func(c interface{}, scope string) (string, error) {
+					require.Equal(t, model.UserCursor{CreatedAt: users[1].CreatedAt, UserID: users[1].UserID}, c)
+					require.NotEmpty(t, scope)
+
+					return nextToken, nil
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "last page after cursor",
+			args: args{
+				ctx: ctx,
+				req: model.ListUsersParams{
+					PageSize:  2,
+					PageToken: pageToken,
+					Filter:    filter,
+					SortOrder: model.SortOrderAsc,
+				},
+			},
+			want: model.ListUsersResponse{
+				Users: users[1:],
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(ctx, model.ListUsersPageParams{
+					Filter:    filter,
+					SortOrder: model.SortOrderAsc,
+					After:     &cursor,
+					Limit:     3,
+				}).Return(model.ListUsersPageResponse{Users: users[1:]}, nil)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				mock := paginationMocks.NewPageTokenCodecMock(mc)
+				mock.DecodeMock.Set(func(token string, scope string, c interface{}) error {
+					require.Equal(t, pageToken, token)
+					require.NotEmpty(t, scope)
+					*c.(*model.UserCursor) = cursor
+
+					return nil
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "default page size",
+			args: args{
+				ctx: ctx,
+				req: model.ListUsersParams{},
+			},
+			want: model.ListUsersResponse{
+				Users: users,
+			},
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(ctx, model.ListUsersPageParams{
+					Limit: 51,
+				}).Return(model.ListUsersPageResponse{Users: users}, nil)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				return paginationMocks.NewPageTokenCodecMock(mc)
+			},
+		},
+		{
+			name: "invalid page token",
+			args: args{
+				ctx: ctx,
+				req: model.ListUsersParams{PageToken: pageToken},
+			},
+			want: model.ListUsersResponse{},
+			err:  model.ErrInvalidPageToken,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				return repositoryMocks.NewUserRepositoryMock(mc)
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				mock := paginationMocks.NewPageTokenCodecMock(mc)
+				mock.DecodeMock.Return(model.ErrInvalidPageToken)
+
+				return mock
+			},
+		},
+		{
+			name: "user repository error",
+			args: args{
+				ctx: ctx,
+				req: model.ListUsersParams{PageSize: 2},
+			},
+			want: model.ListUsersResponse{},
+			err:  ErrUserRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.ListUsersMock.Expect(ctx, model.ListUsersPageParams{
+					Limit: 3,
+				}).Return(model.ListUsersPageResponse{}, ErrUserRepository)
+
+				return mock
+			},
+			pageTokenCodecMock: func(mc *minimock.Controller) pagination.PageTokenCodec {
+				return paginationMocks.NewPageTokenCodecMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service := userService.NewService(
+				tt.userRepositoryMock(mc),
+				repositoryMocks.NewLogRepositoryMock(mc),
+				repositoryMocks.NewOutboxRepositoryMock(mc),
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				serviceMocks.NewAuditWriterMock(mc),
+				cacheMocks.NewUserCacheMock(mc),
+				tt.pageTokenCodecMock(mc),
+				dbMocks.NewTxManagerMock(mc),
+			)
+
+			resp, err := service.ListUsers(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, resp)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	dbMocks "github.com/Prrromanssss/platform_common/pkg/db/mocks"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+
+	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
+	"github.com/Prrromanssss/auth/internal/model"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
+	"github.com/Prrromanssss/auth/internal/repository"
+	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
+	userService "github.com/Prrromanssss/auth/internal/service/user"
+)
+
+func TestUnlock(t *testing.T) {
+	t.Parallel()
+
+	type (
+		userRepositoryMockFunc         func(mc *minimock.Controller) repository.UserRepository
+		logRepositoryMockFunc          func(mc *minimock.Controller) repository.LogRepository
+		loginFailureRepositoryMockFunc func(mc *minimock.Controller) repository.LoginFailureRepository
+	)
+
+	type args struct {
+		ctx context.Context
+		req model.UnlockUserParams
+	}
+
+	var (
+		ctx = context.Background()
+		mc  = minimock.NewController(t)
+
+		id    = gofakeit.Int64()
+		email = gofakeit.Email()
+
+		ErrUserRepository         = errors.New("user repository error")
+		ErrLoginFailureRepository = errors.New("login failure repository error")
+		ErrLogRepository          = errors.New("log repository error")
+
+		req = model.UnlockUserParams{
+			UserID: id,
+		}
+
+		getUserReq = model.GetUserParams{
+			UserID: id,
+		}
+
+		getUserResp = model.GetUserResponse{
+			User: model.User{
+				UserID: id,
+				Email:  email,
+			},
+		}
+
+		deleteLoginFailuresReq = model.DeleteLoginFailuresParams{
+			Keys: []model.LoginFailureKey{{Scope: model.LoginFailureScopeAccount, Key: email}},
+		}
+
+		logApiReq = model.CreateAPILogParams{
+			Method:       "Unlock",
+			TargetUserID: &id,
+			RequestData:  req,
+		}
+
+		userRepositoryMock = func(mc *minimock.Controller) repository.UserRepository {
+			mock := repositoryMocks.NewUserRepositoryMock(mc)
+			mock.GetUserMock.Expect(ctx, getUserReq).Return(getUserResp, nil)
+
+			return mock
+		}
+	)
+
+	tests := []struct {
+		name                       string
+		args                       args
+		err                        error
+		userRepositoryMock         userRepositoryMockFunc
+		logRepositoryMock          logRepositoryMockFunc
+		loginFailureRepositoryMock loginFailureRepositoryMockFunc
+	}{
+		{
+			name: "success case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err:                nil,
+			userRepositoryMock: userRepositoryMock,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+
+				return mock
+			},
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.DeleteLoginFailuresMock.Expect(ctx, deleteLoginFailuresReq).Return(nil)
+
+				return mock
+			},
+		},
+		{
+			name: "user repository error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: ErrUserRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.GetUserMock.Expect(ctx, getUserReq).Return(model.GetUserResponse{}, ErrUserRepository)
+
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repositoryMocks.NewLogRepositoryMock(mc)
+			},
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				return repositoryMocks.NewLoginFailureRepositoryMock(mc)
+			},
+		},
+		{
+			name: "login failure repository error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err:                ErrLoginFailureRepository,
+			userRepositoryMock: userRepositoryMock,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				return repositoryMocks.NewLogRepositoryMock(mc)
+			},
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.DeleteLoginFailuresMock.Expect(ctx, deleteLoginFailuresReq).Return(ErrLoginFailureRepository)
+
+				return mock
+			},
+		},
+		{
+			name: "log repository error case",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err:                ErrLogRepository,
+			userRepositoryMock: userRepositoryMock,
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(ErrLogRepository)
+
+				return mock
+			},
+			loginFailureRepositoryMock: func(mc *minimock.Controller) repository.LoginFailureRepository {
+				mock := repositoryMocks.NewLoginFailureRepositoryMock(mc)
+				mock.DeleteLoginFailuresMock.Expect(ctx, deleteLoginFailuresReq).Return(nil)
+
+				return mock
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			txManagerMock := dbMocks.NewTxManagerMock(mc)
+			txManagerMock.ReadCommittedMock.Set(func(ctx context.Context, f db.Handler) (err error) {
+				return f(ctx)
+			})
+
+			service := userService.NewService(
+				tt.userRepositoryMock(mc),
+				tt.logRepositoryMock(mc),
+				repositoryMocks.NewOutboxRepositoryMock(mc),
+				tt.loginFailureRepositoryMock(mc),
+				serviceMocks.NewAuditWriterMock(mc),
+				cacheMocks.NewUserCacheMock(mc),
+				paginationMocks.NewPageTokenCodecMock(mc),
+				txManagerMock,
+			)
+
+			err := service.UnlockUser(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
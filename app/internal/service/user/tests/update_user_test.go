@@ -14,8 +14,10 @@ import (
 	"github.com/Prrromanssss/auth/internal/cache"
 	cacheMocks "github.com/Prrromanssss/auth/internal/cache/mocks"
 	"github.com/Prrromanssss/auth/internal/model"
+	paginationMocks "github.com/Prrromanssss/auth/internal/pagination/mocks"
 	"github.com/Prrromanssss/auth/internal/repository"
 	repositoryMocks "github.com/Prrromanssss/auth/internal/repository/mocks"
+	serviceMocks "github.com/Prrromanssss/auth/internal/service/mocks"
 	userService "github.com/Prrromanssss/auth/internal/service/user"
 	pb "github.com/Prrromanssss/auth/pkg/user_v1"
 )
@@ -24,10 +26,11 @@ func TestUpdate(t *testing.T) {
 	t.Parallel()
 
 	type (
-		userRepositoryMockFunc func(mc *minimock.Controller) repository.UserRepository
-		logRepositoryMockFunc  func(mc *minimock.Controller) repository.LogRepository
-		txManagerMockFunc      func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
-		cacheMockFunc          func(mc *minimock.Controller) cache.UserCache
+		userRepositoryMockFunc   func(mc *minimock.Controller) repository.UserRepository
+		logRepositoryMockFunc    func(mc *minimock.Controller) repository.LogRepository
+		outboxRepositoryMockFunc func(mc *minimock.Controller) repository.OutboxRepository
+		txManagerMockFunc        func(f func(context.Context) error, mc *minimock.Controller) db.TxManager
+		cacheMockFunc            func(mc *minimock.Controller) cache.UserCache
 	)
 
 	type args struct {
@@ -46,9 +49,10 @@ func TestUpdate(t *testing.T) {
 		createdAt = gofakeit.Date()
 		updatedAt = gofakeit.Date()
 
-		ErrUserRepository = errors.New("user repository error")
-		ErrLogRepository  = errors.New("log repository error")
-		ErrCache          = errors.New("cache error")
+		ErrUserRepository   = errors.New("user repository error")
+		ErrLogRepository    = errors.New("log repository error")
+		ErrOutboxRepository = errors.New("outbox repository error")
+		ErrCache            = errors.New("cache error")
 
 		req = model.UpdateUserParams{
 			UserID: id,
@@ -69,19 +73,34 @@ func TestUpdate(t *testing.T) {
 
 		logApiReq = model.CreateAPILogParams{
 			Method:       "Update",
+			TargetUserID: &id,
 			RequestData:  req,
 			ResponseData: resp,
 		}
+
+		outboxReq = model.CreateOutboxEventParams{
+			AggregateID: id,
+			EventType:   model.OutboxEventUserUpdated,
+			Payload: model.UserEvent{
+				UserID:    id,
+				Name:      name,
+				Email:     email,
+				Role:      int64(role),
+				CreatedAt: &createdAt,
+				UpdatedAt: &updatedAt,
+			},
+		}
 	)
 
 	tests := []struct {
-		name               string
-		args               args
-		err                error
-		userRepositoryMock userRepositoryMockFunc
-		logRepositoryMock  logRepositoryMockFunc
-		cacheMock          cacheMockFunc
-		txManagerMock      txManagerMockFunc
+		name                 string
+		args                 args
+		err                  error
+		userRepositoryMock   userRepositoryMockFunc
+		logRepositoryMock    logRepositoryMockFunc
+		outboxRepositoryMock outboxRepositoryMockFunc
+		cacheMock            cacheMockFunc
+		txManagerMock        txManagerMockFunc
 	}{
 		{
 			name: "success case",
@@ -102,6 +121,12 @@ func TestUpdate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(nil)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.CreateMock.Expect(ctx, resp.User).Return(nil)
@@ -135,6 +160,11 @@ func TestUpdate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 
@@ -168,6 +198,50 @@ func TestUpdate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+
+				return mock
+			},
+			cacheMock: func(mc *minimock.Controller) cache.UserCache {
+				mock := cacheMocks.NewUserCacheMock(mc)
+
+				return mock
+			},
+			txManagerMock: func(f func(context.Context) error, mc *minimock.Controller) db.TxManager {
+				mock := dbMocks.NewTxManagerMock(mc)
+				mock.ReadCommittedMock.Optional().Set(func(ctx context.Context, f db.Handler) (err error) {
+					return f(ctx)
+				})
+
+				return mock
+			},
+		},
+		{
+			name: "outbox repository error",
+			args: args{
+				ctx: ctx,
+				req: req,
+			},
+			err: ErrOutboxRepository,
+			userRepositoryMock: func(mc *minimock.Controller) repository.UserRepository {
+				mock := repositoryMocks.NewUserRepositoryMock(mc)
+				mock.UpdateUserMock.Expect(ctx, req).Return(resp, nil)
+
+				return mock
+			},
+			logRepositoryMock: func(mc *minimock.Controller) repository.LogRepository {
+				mock := repositoryMocks.NewLogRepositoryMock(mc)
+				mock.CreateAPILogMock.Expect(ctx, logApiReq).Return(nil)
+
+				return mock
+			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(ErrOutboxRepository)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 
@@ -201,6 +275,12 @@ func TestUpdate(t *testing.T) {
 
 				return mock
 			},
+			outboxRepositoryMock: func(mc *minimock.Controller) repository.OutboxRepository {
+				mock := repositoryMocks.NewOutboxRepositoryMock(mc)
+				mock.CreateOutboxEventMock.Expect(ctx, outboxReq).Return(nil)
+
+				return mock
+			},
 			cacheMock: func(mc *minimock.Controller) cache.UserCache {
 				mock := cacheMocks.NewUserCacheMock(mc)
 				mock.CreateMock.Expect(ctx, resp.User).Return(ErrCache)
@@ -224,6 +304,7 @@ func TestUpdate(t *testing.T) {
 
 			userRepositoryMock := tt.userRepositoryMock(mc)
 			logRepositoryMock := tt.logRepositoryMock(mc)
+			outboxRepositoryMock := tt.outboxRepositoryMock(mc)
 			cacheMock := tt.cacheMock(mc)
 			txManagerMock := tt.txManagerMock(func(ctx context.Context) error {
 				resp, txErr := userRepositoryMock.UpdateUser(ctx, req)
@@ -240,10 +321,24 @@ func TestUpdate(t *testing.T) {
 					return txErr
 				}
 
+				txErr = outboxRepositoryMock.CreateOutboxEvent(ctx, outboxReq)
+				if txErr != nil {
+					return txErr
+				}
+
 				return nil
 			}, mc)
 
-			service := userService.NewService(userRepositoryMock, logRepositoryMock, cacheMock, txManagerMock)
+			service := userService.NewService(
+				userRepositoryMock,
+				logRepositoryMock,
+				outboxRepositoryMock,
+				repositoryMocks.NewLoginFailureRepositoryMock(mc),
+				serviceMocks.NewAuditWriterMock(mc),
+				cacheMock,
+				paginationMocks.NewPageTokenCodecMock(mc),
+				txManagerMock,
+			)
 
 			err := service.UpdateUser(tt.args.ctx, tt.args.req)
 			require.Equal(t, tt.err, err)
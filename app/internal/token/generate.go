@@ -0,0 +1,4 @@
+package token
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i TokenManager -o ./mocks/ -s "_minimock.go"
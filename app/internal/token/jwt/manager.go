@@ -0,0 +1,88 @@
+package jwt
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/Prrromanssss/auth/internal/token"
+)
+
+type jwtManager struct {
+	cfg yaml.JWT
+}
+
+// NewManager creates a new instance of jwtManager that signs tokens with the configured keys.
+func NewManager(cfg yaml.JWT) token.TokenManager {
+	return &jwtManager{
+		cfg: cfg,
+	}
+}
+
+// GenerateAccessToken issues an access token signed with the access token secret key.
+func (m *jwtManager) GenerateAccessToken(user model.User) (string, error) {
+	signedToken, _, err := generateToken(user, "", []byte(m.cfg.AccessTokenSecretKey), m.cfg.AccessTokenTTL)
+
+	return signedToken, err
+}
+
+// GenerateRefreshToken issues a refresh token signed with the refresh token secret key.
+func (m *jwtManager) GenerateRefreshToken(user model.User, tokenID string) (string, time.Time, error) {
+	return generateToken(user, tokenID, []byte(m.cfg.RefreshTokenSecretKey), m.cfg.RefreshTokenTTL)
+}
+
+// VerifyAccessToken parses an access token and validates it against the access token secret key.
+func (m *jwtManager) VerifyAccessToken(signedToken string) (*model.UserClaims, error) {
+	return verifyToken(signedToken, []byte(m.cfg.AccessTokenSecretKey))
+}
+
+// VerifyRefreshToken parses a refresh token and validates it against the refresh token secret key.
+func (m *jwtManager) VerifyRefreshToken(signedToken string) (*model.UserClaims, error) {
+	return verifyToken(signedToken, []byte(m.cfg.RefreshTokenSecretKey))
+}
+
+func generateToken(user model.User, tokenID string, secretKey []byte, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	claims := model.UserClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
+			Subject:   strconv.FormatInt(user.UserID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		UserID: user.UserID,
+		Role:   user.Role,
+	}
+
+	signedToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secretKey)
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "Cannot sign token")
+	}
+
+	return signedToken, expiresAt, nil
+}
+
+func verifyToken(signedToken string, secretKey []byte) (*model.UserClaims, error) {
+	claims := &model.UserClaims{}
+
+	_, err := jwt.ParseWithClaims(
+		signedToken,
+		claims,
+		func(_ *jwt.Token) (interface{}, error) {
+			return secretKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "Invalid token")
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,42 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var (
+	// ErrMetadataNotProvided is returned when the incoming context carries no gRPC metadata.
+	ErrMetadataNotProvided = errors.New("metadata is not provided")
+	// ErrAuthorizationHeaderNotProvided is returned when the authorization header is missing from the metadata.
+	ErrAuthorizationHeaderNotProvided = errors.New("authorization header is not provided")
+	// ErrInvalidAuthorizationHeader is returned when the authorization header is not a bearer token.
+	ErrInvalidAuthorizationHeader = errors.New("invalid authorization header format")
+)
+
+// BearerFromContext extracts the bearer token from the authorization header of the incoming gRPC metadata.
+func BearerFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ErrMetadataNotProvided
+	}
+
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", ErrAuthorizationHeaderNotProvided
+	}
+
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", ErrInvalidAuthorizationHeader
+	}
+
+	return strings.TrimPrefix(values[0], bearerPrefix), nil
+}
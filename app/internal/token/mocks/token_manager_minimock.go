@@ -0,0 +1,1311 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/internal/token.TokenManager -o token_manager_minimock.go -n TokenManagerMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// TokenManagerMock implements token.TokenManager
+type TokenManagerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGenerateAccessToken          func(user model.User) (token string, err error)
+	inspectFuncGenerateAccessToken   func(user model.User)
+	afterGenerateAccessTokenCounter  uint64
+	beforeGenerateAccessTokenCounter uint64
+	GenerateAccessTokenMock          mTokenManagerMockGenerateAccessToken
+
+	funcGenerateRefreshToken          func(user model.User, tokenID string) (token string, expiresAt time.Time, err error)
+	inspectFuncGenerateRefreshToken   func(user model.User, tokenID string)
+	afterGenerateRefreshTokenCounter  uint64
+	beforeGenerateRefreshTokenCounter uint64
+	GenerateRefreshTokenMock          mTokenManagerMockGenerateRefreshToken
+
+	funcVerifyAccessToken          func(token string) (claims *model.UserClaims, err error)
+	inspectFuncVerifyAccessToken   func(token string)
+	afterVerifyAccessTokenCounter  uint64
+	beforeVerifyAccessTokenCounter uint64
+	VerifyAccessTokenMock          mTokenManagerMockVerifyAccessToken
+
+	funcVerifyRefreshToken          func(token string) (claims *model.UserClaims, err error)
+	inspectFuncVerifyRefreshToken   func(token string)
+	afterVerifyRefreshTokenCounter  uint64
+	beforeVerifyRefreshTokenCounter uint64
+	VerifyRefreshTokenMock          mTokenManagerMockVerifyRefreshToken
+}
+
+// NewTokenManagerMock returns a mock for token.TokenManager
+func NewTokenManagerMock(t minimock.Tester) *TokenManagerMock {
+	m := &TokenManagerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GenerateAccessTokenMock = mTokenManagerMockGenerateAccessToken{mock: m}
+	m.GenerateAccessTokenMock.callArgs = []*TokenManagerMockGenerateAccessTokenParams{}
+
+	m.GenerateRefreshTokenMock = mTokenManagerMockGenerateRefreshToken{mock: m}
+	m.GenerateRefreshTokenMock.callArgs = []*TokenManagerMockGenerateRefreshTokenParams{}
+
+	m.VerifyAccessTokenMock = mTokenManagerMockVerifyAccessToken{mock: m}
+	m.VerifyAccessTokenMock.callArgs = []*TokenManagerMockVerifyAccessTokenParams{}
+
+	m.VerifyRefreshTokenMock = mTokenManagerMockVerifyRefreshToken{mock: m}
+	m.VerifyRefreshTokenMock.callArgs = []*TokenManagerMockVerifyRefreshTokenParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTokenManagerMockGenerateAccessToken struct {
+	optional           bool
+	mock               *TokenManagerMock
+	defaultExpectation *TokenManagerMockGenerateAccessTokenExpectation
+	expectations       []*TokenManagerMockGenerateAccessTokenExpectation
+
+	callArgs []*TokenManagerMockGenerateAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// TokenManagerMockGenerateAccessTokenExpectation specifies expectation struct of the TokenManager.GenerateAccessToken
+type TokenManagerMockGenerateAccessTokenExpectation struct {
+	mock      *TokenManagerMock
+	params    *TokenManagerMockGenerateAccessTokenParams
+	paramPtrs *TokenManagerMockGenerateAccessTokenParamPtrs
+	results   *TokenManagerMockGenerateAccessTokenResults
+	Counter   uint64
+}
+
+// TokenManagerMockGenerateAccessTokenParams contains parameters of the TokenManager.GenerateAccessToken
+type TokenManagerMockGenerateAccessTokenParams struct {
+	user model.User
+}
+
+// TokenManagerMockGenerateAccessTokenParamPtrs contains pointers to parameters of the TokenManager.GenerateAccessToken
+type TokenManagerMockGenerateAccessTokenParamPtrs struct {
+	user *model.User
+}
+
+// TokenManagerMockGenerateAccessTokenResults contains results of the TokenManager.GenerateAccessToken
+type TokenManagerMockGenerateAccessTokenResults struct {
+	token string
+	err   error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Optional() *mTokenManagerMockGenerateAccessToken {
+	mmGenerateAccessToken.optional = true
+	return mmGenerateAccessToken
+}
+
+// Expect sets up expected params for TokenManager.GenerateAccessToken
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Expect(user model.User) *mTokenManagerMockGenerateAccessToken {
+	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenManagerMock.GenerateAccessToken mock is already set by Set")
+	}
+
+	if mmGenerateAccessToken.defaultExpectation == nil {
+		mmGenerateAccessToken.defaultExpectation = &TokenManagerMockGenerateAccessTokenExpectation{}
+	}
+
+	if mmGenerateAccessToken.defaultExpectation.paramPtrs != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenManagerMock.GenerateAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmGenerateAccessToken.defaultExpectation.params = &TokenManagerMockGenerateAccessTokenParams{user}
+	for _, e := range mmGenerateAccessToken.expectations {
+		if minimock.Equal(e.params, mmGenerateAccessToken.defaultExpectation.params) {
+			mmGenerateAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGenerateAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGenerateAccessToken
+}
+
+// ExpectUserParam1 sets up expected param user for TokenManager.GenerateAccessToken
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) ExpectUserParam1(user model.User) *mTokenManagerMockGenerateAccessToken {
+	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenManagerMock.GenerateAccessToken mock is already set by Set")
+	}
+
+	if mmGenerateAccessToken.defaultExpectation == nil {
+		mmGenerateAccessToken.defaultExpectation = &TokenManagerMockGenerateAccessTokenExpectation{}
+	}
+
+	if mmGenerateAccessToken.defaultExpectation.params != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenManagerMock.GenerateAccessToken mock is already set by Expect")
+	}
+
+	if mmGenerateAccessToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateAccessToken.defaultExpectation.paramPtrs = &TokenManagerMockGenerateAccessTokenParamPtrs{}
+	}
+	mmGenerateAccessToken.defaultExpectation.paramPtrs.user = &user
+
+	return mmGenerateAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenManager.GenerateAccessToken
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Inspect(f func(user model.User)) *mTokenManagerMockGenerateAccessToken {
+	if mmGenerateAccessToken.mock.inspectFuncGenerateAccessToken != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("Inspect function is already set for TokenManagerMock.GenerateAccessToken")
+	}
+
+	mmGenerateAccessToken.mock.inspectFuncGenerateAccessToken = f
+
+	return mmGenerateAccessToken
+}
+
+// Return sets up results that will be returned by TokenManager.GenerateAccessToken
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Return(token string, err error) *TokenManagerMock {
+	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenManagerMock.GenerateAccessToken mock is already set by Set")
+	}
+
+	if mmGenerateAccessToken.defaultExpectation == nil {
+		mmGenerateAccessToken.defaultExpectation = &TokenManagerMockGenerateAccessTokenExpectation{mock: mmGenerateAccessToken.mock}
+	}
+	mmGenerateAccessToken.defaultExpectation.results = &TokenManagerMockGenerateAccessTokenResults{token, err}
+	return mmGenerateAccessToken.mock
+}
+
+// Set uses given function f to mock the TokenManager.GenerateAccessToken method
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Set(f func(user model.User) (token string, err error)) *TokenManagerMock {
+	if mmGenerateAccessToken.defaultExpectation != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("Default expectation is already set for the TokenManager.GenerateAccessToken method")
+	}
+
+	if len(mmGenerateAccessToken.expectations) > 0 {
+		mmGenerateAccessToken.mock.t.Fatalf("Some expectations are already set for the TokenManager.GenerateAccessToken method")
+	}
+
+	mmGenerateAccessToken.mock.funcGenerateAccessToken = f
+	return mmGenerateAccessToken.mock
+}
+
+// When sets expectation for the TokenManager.GenerateAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) When(user model.User) *TokenManagerMockGenerateAccessTokenExpectation {
+	if mmGenerateAccessToken.mock.funcGenerateAccessToken != nil {
+		mmGenerateAccessToken.mock.t.Fatalf("TokenManagerMock.GenerateAccessToken mock is already set by Set")
+	}
+
+	expectation := &TokenManagerMockGenerateAccessTokenExpectation{
+		mock:   mmGenerateAccessToken.mock,
+		params: &TokenManagerMockGenerateAccessTokenParams{user},
+	}
+	mmGenerateAccessToken.expectations = append(mmGenerateAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenManager.GenerateAccessToken return parameters for the expectation previously defined by the When method
+func (e *TokenManagerMockGenerateAccessTokenExpectation) Then(token string, err error) *TokenManagerMock {
+	e.results = &TokenManagerMockGenerateAccessTokenResults{token, err}
+	return e.mock
+}
+
+// Times sets number of times TokenManager.GenerateAccessToken should be invoked
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Times(n uint64) *mTokenManagerMockGenerateAccessToken {
+	if n == 0 {
+		mmGenerateAccessToken.mock.t.Fatalf("Times of TokenManagerMock.GenerateAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGenerateAccessToken.expectedInvocations, n)
+	return mmGenerateAccessToken
+}
+
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) invocationsDone() bool {
+	if len(mmGenerateAccessToken.expectations) == 0 && mmGenerateAccessToken.defaultExpectation == nil && mmGenerateAccessToken.mock.funcGenerateAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGenerateAccessToken.mock.afterGenerateAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGenerateAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GenerateAccessToken implements token.TokenManager
+func (mmGenerateAccessToken *TokenManagerMock) GenerateAccessToken(user model.User) (token string, err error) {
+	mm_atomic.AddUint64(&mmGenerateAccessToken.beforeGenerateAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGenerateAccessToken.afterGenerateAccessTokenCounter, 1)
+
+	if mmGenerateAccessToken.inspectFuncGenerateAccessToken != nil {
+		mmGenerateAccessToken.inspectFuncGenerateAccessToken(user)
+	}
+
+	mm_params := TokenManagerMockGenerateAccessTokenParams{user}
+
+	// Record call args
+	mmGenerateAccessToken.GenerateAccessTokenMock.mutex.Lock()
+	mmGenerateAccessToken.GenerateAccessTokenMock.callArgs = append(mmGenerateAccessToken.GenerateAccessTokenMock.callArgs, &mm_params)
+	mmGenerateAccessToken.GenerateAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmGenerateAccessToken.GenerateAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.token, e.results.err
+		}
+	}
+
+	if mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenManagerMockGenerateAccessTokenParams{user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmGenerateAccessToken.t.Errorf("TokenManagerMock.GenerateAccessToken got unexpected parameter user, want: %#v, got: %#v%s\n", *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGenerateAccessToken.t.Errorf("TokenManagerMock.GenerateAccessToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGenerateAccessToken.GenerateAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGenerateAccessToken.t.Fatal("No results are set for the TokenManagerMock.GenerateAccessToken")
+		}
+		return (*mm_results).token, (*mm_results).err
+	}
+	if mmGenerateAccessToken.funcGenerateAccessToken != nil {
+		return mmGenerateAccessToken.funcGenerateAccessToken(user)
+	}
+	mmGenerateAccessToken.t.Fatalf("Unexpected call to TokenManagerMock.GenerateAccessToken. %v", user)
+	return
+}
+
+// GenerateAccessTokenAfterCounter returns a count of finished TokenManagerMock.GenerateAccessToken invocations
+func (mmGenerateAccessToken *TokenManagerMock) GenerateAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateAccessToken.afterGenerateAccessTokenCounter)
+}
+
+// GenerateAccessTokenBeforeCounter returns a count of TokenManagerMock.GenerateAccessToken invocations
+func (mmGenerateAccessToken *TokenManagerMock) GenerateAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateAccessToken.beforeGenerateAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenManagerMock.GenerateAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGenerateAccessToken *mTokenManagerMockGenerateAccessToken) Calls() []*TokenManagerMockGenerateAccessTokenParams {
+	mmGenerateAccessToken.mutex.RLock()
+
+	argCopy := make([]*TokenManagerMockGenerateAccessTokenParams, len(mmGenerateAccessToken.callArgs))
+	copy(argCopy, mmGenerateAccessToken.callArgs)
+
+	mmGenerateAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGenerateAccessTokenDone returns true if the count of the GenerateAccessToken invocations corresponds
+// the number of defined expectations
+func (m *TokenManagerMock) MinimockGenerateAccessTokenDone() bool {
+	if m.GenerateAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GenerateAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GenerateAccessTokenMock.invocationsDone()
+}
+
+// MinimockGenerateAccessTokenInspect logs each unmet expectation
+func (m *TokenManagerMock) MinimockGenerateAccessTokenInspect() {
+	for _, e := range m.GenerateAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenManagerMock.GenerateAccessToken with params: %#v", *e.params)
+		}
+	}
+
+	afterGenerateAccessTokenCounter := mm_atomic.LoadUint64(&m.afterGenerateAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GenerateAccessTokenMock.defaultExpectation != nil && afterGenerateAccessTokenCounter < 1 {
+		if m.GenerateAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TokenManagerMock.GenerateAccessToken")
+		} else {
+			m.t.Errorf("Expected call to TokenManagerMock.GenerateAccessToken with params: %#v", *m.GenerateAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGenerateAccessToken != nil && afterGenerateAccessTokenCounter < 1 {
+		m.t.Error("Expected call to TokenManagerMock.GenerateAccessToken")
+	}
+
+	if !m.GenerateAccessTokenMock.invocationsDone() && afterGenerateAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenManagerMock.GenerateAccessToken but found %d calls",
+			mm_atomic.LoadUint64(&m.GenerateAccessTokenMock.expectedInvocations), afterGenerateAccessTokenCounter)
+	}
+}
+
+type mTokenManagerMockGenerateRefreshToken struct {
+	optional           bool
+	mock               *TokenManagerMock
+	defaultExpectation *TokenManagerMockGenerateRefreshTokenExpectation
+	expectations       []*TokenManagerMockGenerateRefreshTokenExpectation
+
+	callArgs []*TokenManagerMockGenerateRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// TokenManagerMockGenerateRefreshTokenExpectation specifies expectation struct of the TokenManager.GenerateRefreshToken
+type TokenManagerMockGenerateRefreshTokenExpectation struct {
+	mock      *TokenManagerMock
+	params    *TokenManagerMockGenerateRefreshTokenParams
+	paramPtrs *TokenManagerMockGenerateRefreshTokenParamPtrs
+	results   *TokenManagerMockGenerateRefreshTokenResults
+	Counter   uint64
+}
+
+// TokenManagerMockGenerateRefreshTokenParams contains parameters of the TokenManager.GenerateRefreshToken
+type TokenManagerMockGenerateRefreshTokenParams struct {
+	user    model.User
+	tokenID string
+}
+
+// TokenManagerMockGenerateRefreshTokenParamPtrs contains pointers to parameters of the TokenManager.GenerateRefreshToken
+type TokenManagerMockGenerateRefreshTokenParamPtrs struct {
+	user    *model.User
+	tokenID *string
+}
+
+// TokenManagerMockGenerateRefreshTokenResults contains results of the TokenManager.GenerateRefreshToken
+type TokenManagerMockGenerateRefreshTokenResults struct {
+	token     string
+	expiresAt time.Time
+	err       error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Optional() *mTokenManagerMockGenerateRefreshToken {
+	mmGenerateRefreshToken.optional = true
+	return mmGenerateRefreshToken
+}
+
+// Expect sets up expected params for TokenManager.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Expect(user model.User, tokenID string) *mTokenManagerMockGenerateRefreshToken {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation == nil {
+		mmGenerateRefreshToken.defaultExpectation = &TokenManagerMockGenerateRefreshTokenExpectation{}
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmGenerateRefreshToken.defaultExpectation.params = &TokenManagerMockGenerateRefreshTokenParams{user, tokenID}
+	for _, e := range mmGenerateRefreshToken.expectations {
+		if minimock.Equal(e.params, mmGenerateRefreshToken.defaultExpectation.params) {
+			mmGenerateRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGenerateRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGenerateRefreshToken
+}
+
+// ExpectUserParam1 sets up expected param user for TokenManager.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) ExpectUserParam1(user model.User) *mTokenManagerMockGenerateRefreshToken {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation == nil {
+		mmGenerateRefreshToken.defaultExpectation = &TokenManagerMockGenerateRefreshTokenExpectation{}
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.params != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Expect")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateRefreshToken.defaultExpectation.paramPtrs = &TokenManagerMockGenerateRefreshTokenParamPtrs{}
+	}
+	mmGenerateRefreshToken.defaultExpectation.paramPtrs.user = &user
+
+	return mmGenerateRefreshToken
+}
+
+// ExpectTokenIDParam2 sets up expected param tokenID for TokenManager.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) ExpectTokenIDParam2(tokenID string) *mTokenManagerMockGenerateRefreshToken {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation == nil {
+		mmGenerateRefreshToken.defaultExpectation = &TokenManagerMockGenerateRefreshTokenExpectation{}
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.params != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Expect")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGenerateRefreshToken.defaultExpectation.paramPtrs = &TokenManagerMockGenerateRefreshTokenParamPtrs{}
+	}
+	mmGenerateRefreshToken.defaultExpectation.paramPtrs.tokenID = &tokenID
+
+	return mmGenerateRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenManager.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Inspect(f func(user model.User, tokenID string)) *mTokenManagerMockGenerateRefreshToken {
+	if mmGenerateRefreshToken.mock.inspectFuncGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("Inspect function is already set for TokenManagerMock.GenerateRefreshToken")
+	}
+
+	mmGenerateRefreshToken.mock.inspectFuncGenerateRefreshToken = f
+
+	return mmGenerateRefreshToken
+}
+
+// Return sets up results that will be returned by TokenManager.GenerateRefreshToken
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Return(token string, expiresAt time.Time, err error) *TokenManagerMock {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	if mmGenerateRefreshToken.defaultExpectation == nil {
+		mmGenerateRefreshToken.defaultExpectation = &TokenManagerMockGenerateRefreshTokenExpectation{mock: mmGenerateRefreshToken.mock}
+	}
+	mmGenerateRefreshToken.defaultExpectation.results = &TokenManagerMockGenerateRefreshTokenResults{token, expiresAt, err}
+	return mmGenerateRefreshToken.mock
+}
+
+// Set uses given function f to mock the TokenManager.GenerateRefreshToken method
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Set(f func(user model.User, tokenID string) (token string, expiresAt time.Time, err error)) *TokenManagerMock {
+	if mmGenerateRefreshToken.defaultExpectation != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("Default expectation is already set for the TokenManager.GenerateRefreshToken method")
+	}
+
+	if len(mmGenerateRefreshToken.expectations) > 0 {
+		mmGenerateRefreshToken.mock.t.Fatalf("Some expectations are already set for the TokenManager.GenerateRefreshToken method")
+	}
+
+	mmGenerateRefreshToken.mock.funcGenerateRefreshToken = f
+	return mmGenerateRefreshToken.mock
+}
+
+// When sets expectation for the TokenManager.GenerateRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) When(user model.User, tokenID string) *TokenManagerMockGenerateRefreshTokenExpectation {
+	if mmGenerateRefreshToken.mock.funcGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.mock.t.Fatalf("TokenManagerMock.GenerateRefreshToken mock is already set by Set")
+	}
+
+	expectation := &TokenManagerMockGenerateRefreshTokenExpectation{
+		mock:   mmGenerateRefreshToken.mock,
+		params: &TokenManagerMockGenerateRefreshTokenParams{user, tokenID},
+	}
+	mmGenerateRefreshToken.expectations = append(mmGenerateRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenManager.GenerateRefreshToken return parameters for the expectation previously defined by the When method
+func (e *TokenManagerMockGenerateRefreshTokenExpectation) Then(token string, expiresAt time.Time, err error) *TokenManagerMock {
+	e.results = &TokenManagerMockGenerateRefreshTokenResults{token, expiresAt, err}
+	return e.mock
+}
+
+// Times sets number of times TokenManager.GenerateRefreshToken should be invoked
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Times(n uint64) *mTokenManagerMockGenerateRefreshToken {
+	if n == 0 {
+		mmGenerateRefreshToken.mock.t.Fatalf("Times of TokenManagerMock.GenerateRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGenerateRefreshToken.expectedInvocations, n)
+	return mmGenerateRefreshToken
+}
+
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) invocationsDone() bool {
+	if len(mmGenerateRefreshToken.expectations) == 0 && mmGenerateRefreshToken.defaultExpectation == nil && mmGenerateRefreshToken.mock.funcGenerateRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGenerateRefreshToken.mock.afterGenerateRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGenerateRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GenerateRefreshToken implements token.TokenManager
+func (mmGenerateRefreshToken *TokenManagerMock) GenerateRefreshToken(user model.User, tokenID string) (token string, expiresAt time.Time, err error) {
+	mm_atomic.AddUint64(&mmGenerateRefreshToken.beforeGenerateRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGenerateRefreshToken.afterGenerateRefreshTokenCounter, 1)
+
+	if mmGenerateRefreshToken.inspectFuncGenerateRefreshToken != nil {
+		mmGenerateRefreshToken.inspectFuncGenerateRefreshToken(user, tokenID)
+	}
+
+	mm_params := TokenManagerMockGenerateRefreshTokenParams{user, tokenID}
+
+	// Record call args
+	mmGenerateRefreshToken.GenerateRefreshTokenMock.mutex.Lock()
+	mmGenerateRefreshToken.GenerateRefreshTokenMock.callArgs = append(mmGenerateRefreshToken.GenerateRefreshTokenMock.callArgs, &mm_params)
+	mmGenerateRefreshToken.GenerateRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmGenerateRefreshToken.GenerateRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.token, e.results.expiresAt, e.results.err
+		}
+	}
+
+	if mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenManagerMockGenerateRefreshTokenParams{user, tokenID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmGenerateRefreshToken.t.Errorf("TokenManagerMock.GenerateRefreshToken got unexpected parameter user, want: %#v, got: %#v%s\n", *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+			if mm_want_ptrs.tokenID != nil && !minimock.Equal(*mm_want_ptrs.tokenID, mm_got.tokenID) {
+				mmGenerateRefreshToken.t.Errorf("TokenManagerMock.GenerateRefreshToken got unexpected parameter tokenID, want: %#v, got: %#v%s\n", *mm_want_ptrs.tokenID, mm_got.tokenID, minimock.Diff(*mm_want_ptrs.tokenID, mm_got.tokenID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGenerateRefreshToken.t.Errorf("TokenManagerMock.GenerateRefreshToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGenerateRefreshToken.GenerateRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGenerateRefreshToken.t.Fatal("No results are set for the TokenManagerMock.GenerateRefreshToken")
+		}
+		return (*mm_results).token, (*mm_results).expiresAt, (*mm_results).err
+	}
+	if mmGenerateRefreshToken.funcGenerateRefreshToken != nil {
+		return mmGenerateRefreshToken.funcGenerateRefreshToken(user, tokenID)
+	}
+	mmGenerateRefreshToken.t.Fatalf("Unexpected call to TokenManagerMock.GenerateRefreshToken. %v %v", user, tokenID)
+	return
+}
+
+// GenerateRefreshTokenAfterCounter returns a count of finished TokenManagerMock.GenerateRefreshToken invocations
+func (mmGenerateRefreshToken *TokenManagerMock) GenerateRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateRefreshToken.afterGenerateRefreshTokenCounter)
+}
+
+// GenerateRefreshTokenBeforeCounter returns a count of TokenManagerMock.GenerateRefreshToken invocations
+func (mmGenerateRefreshToken *TokenManagerMock) GenerateRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGenerateRefreshToken.beforeGenerateRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenManagerMock.GenerateRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGenerateRefreshToken *mTokenManagerMockGenerateRefreshToken) Calls() []*TokenManagerMockGenerateRefreshTokenParams {
+	mmGenerateRefreshToken.mutex.RLock()
+
+	argCopy := make([]*TokenManagerMockGenerateRefreshTokenParams, len(mmGenerateRefreshToken.callArgs))
+	copy(argCopy, mmGenerateRefreshToken.callArgs)
+
+	mmGenerateRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGenerateRefreshTokenDone returns true if the count of the GenerateRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *TokenManagerMock) MinimockGenerateRefreshTokenDone() bool {
+	if m.GenerateRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GenerateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GenerateRefreshTokenMock.invocationsDone()
+}
+
+// MinimockGenerateRefreshTokenInspect logs each unmet expectation
+func (m *TokenManagerMock) MinimockGenerateRefreshTokenInspect() {
+	for _, e := range m.GenerateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenManagerMock.GenerateRefreshToken with params: %#v", *e.params)
+		}
+	}
+
+	afterGenerateRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGenerateRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GenerateRefreshTokenMock.defaultExpectation != nil && afterGenerateRefreshTokenCounter < 1 {
+		if m.GenerateRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TokenManagerMock.GenerateRefreshToken")
+		} else {
+			m.t.Errorf("Expected call to TokenManagerMock.GenerateRefreshToken with params: %#v", *m.GenerateRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGenerateRefreshToken != nil && afterGenerateRefreshTokenCounter < 1 {
+		m.t.Error("Expected call to TokenManagerMock.GenerateRefreshToken")
+	}
+
+	if !m.GenerateRefreshTokenMock.invocationsDone() && afterGenerateRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenManagerMock.GenerateRefreshToken but found %d calls",
+			mm_atomic.LoadUint64(&m.GenerateRefreshTokenMock.expectedInvocations), afterGenerateRefreshTokenCounter)
+	}
+}
+
+type mTokenManagerMockVerifyAccessToken struct {
+	optional           bool
+	mock               *TokenManagerMock
+	defaultExpectation *TokenManagerMockVerifyAccessTokenExpectation
+	expectations       []*TokenManagerMockVerifyAccessTokenExpectation
+
+	callArgs []*TokenManagerMockVerifyAccessTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// TokenManagerMockVerifyAccessTokenExpectation specifies expectation struct of the TokenManager.VerifyAccessToken
+type TokenManagerMockVerifyAccessTokenExpectation struct {
+	mock      *TokenManagerMock
+	params    *TokenManagerMockVerifyAccessTokenParams
+	paramPtrs *TokenManagerMockVerifyAccessTokenParamPtrs
+	results   *TokenManagerMockVerifyAccessTokenResults
+	Counter   uint64
+}
+
+// TokenManagerMockVerifyAccessTokenParams contains parameters of the TokenManager.VerifyAccessToken
+type TokenManagerMockVerifyAccessTokenParams struct {
+	token string
+}
+
+// TokenManagerMockVerifyAccessTokenParamPtrs contains pointers to parameters of the TokenManager.VerifyAccessToken
+type TokenManagerMockVerifyAccessTokenParamPtrs struct {
+	token *string
+}
+
+// TokenManagerMockVerifyAccessTokenResults contains results of the TokenManager.VerifyAccessToken
+type TokenManagerMockVerifyAccessTokenResults struct {
+	claims *model.UserClaims
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Optional() *mTokenManagerMockVerifyAccessToken {
+	mmVerifyAccessToken.optional = true
+	return mmVerifyAccessToken
+}
+
+// Expect sets up expected params for TokenManager.VerifyAccessToken
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Expect(token string) *mTokenManagerMockVerifyAccessToken {
+	if mmVerifyAccessToken.mock.funcVerifyAccessToken != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("TokenManagerMock.VerifyAccessToken mock is already set by Set")
+	}
+
+	if mmVerifyAccessToken.defaultExpectation == nil {
+		mmVerifyAccessToken.defaultExpectation = &TokenManagerMockVerifyAccessTokenExpectation{}
+	}
+
+	if mmVerifyAccessToken.defaultExpectation.paramPtrs != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("TokenManagerMock.VerifyAccessToken mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyAccessToken.defaultExpectation.params = &TokenManagerMockVerifyAccessTokenParams{token}
+	for _, e := range mmVerifyAccessToken.expectations {
+		if minimock.Equal(e.params, mmVerifyAccessToken.defaultExpectation.params) {
+			mmVerifyAccessToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyAccessToken.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyAccessToken
+}
+
+// ExpectTokenParam1 sets up expected param token for TokenManager.VerifyAccessToken
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) ExpectTokenParam1(token string) *mTokenManagerMockVerifyAccessToken {
+	if mmVerifyAccessToken.mock.funcVerifyAccessToken != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("TokenManagerMock.VerifyAccessToken mock is already set by Set")
+	}
+
+	if mmVerifyAccessToken.defaultExpectation == nil {
+		mmVerifyAccessToken.defaultExpectation = &TokenManagerMockVerifyAccessTokenExpectation{}
+	}
+
+	if mmVerifyAccessToken.defaultExpectation.params != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("TokenManagerMock.VerifyAccessToken mock is already set by Expect")
+	}
+
+	if mmVerifyAccessToken.defaultExpectation.paramPtrs == nil {
+		mmVerifyAccessToken.defaultExpectation.paramPtrs = &TokenManagerMockVerifyAccessTokenParamPtrs{}
+	}
+	mmVerifyAccessToken.defaultExpectation.paramPtrs.token = &token
+
+	return mmVerifyAccessToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenManager.VerifyAccessToken
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Inspect(f func(token string)) *mTokenManagerMockVerifyAccessToken {
+	if mmVerifyAccessToken.mock.inspectFuncVerifyAccessToken != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("Inspect function is already set for TokenManagerMock.VerifyAccessToken")
+	}
+
+	mmVerifyAccessToken.mock.inspectFuncVerifyAccessToken = f
+
+	return mmVerifyAccessToken
+}
+
+// Return sets up results that will be returned by TokenManager.VerifyAccessToken
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Return(claims *model.UserClaims, err error) *TokenManagerMock {
+	if mmVerifyAccessToken.mock.funcVerifyAccessToken != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("TokenManagerMock.VerifyAccessToken mock is already set by Set")
+	}
+
+	if mmVerifyAccessToken.defaultExpectation == nil {
+		mmVerifyAccessToken.defaultExpectation = &TokenManagerMockVerifyAccessTokenExpectation{mock: mmVerifyAccessToken.mock}
+	}
+	mmVerifyAccessToken.defaultExpectation.results = &TokenManagerMockVerifyAccessTokenResults{claims, err}
+	return mmVerifyAccessToken.mock
+}
+
+// Set uses given function f to mock the TokenManager.VerifyAccessToken method
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Set(f func(token string) (claims *model.UserClaims, err error)) *TokenManagerMock {
+	if mmVerifyAccessToken.defaultExpectation != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("Default expectation is already set for the TokenManager.VerifyAccessToken method")
+	}
+
+	if len(mmVerifyAccessToken.expectations) > 0 {
+		mmVerifyAccessToken.mock.t.Fatalf("Some expectations are already set for the TokenManager.VerifyAccessToken method")
+	}
+
+	mmVerifyAccessToken.mock.funcVerifyAccessToken = f
+	return mmVerifyAccessToken.mock
+}
+
+// When sets expectation for the TokenManager.VerifyAccessToken which will trigger the result defined by the following
+// Then helper
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) When(token string) *TokenManagerMockVerifyAccessTokenExpectation {
+	if mmVerifyAccessToken.mock.funcVerifyAccessToken != nil {
+		mmVerifyAccessToken.mock.t.Fatalf("TokenManagerMock.VerifyAccessToken mock is already set by Set")
+	}
+
+	expectation := &TokenManagerMockVerifyAccessTokenExpectation{
+		mock:   mmVerifyAccessToken.mock,
+		params: &TokenManagerMockVerifyAccessTokenParams{token},
+	}
+	mmVerifyAccessToken.expectations = append(mmVerifyAccessToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenManager.VerifyAccessToken return parameters for the expectation previously defined by the When method
+func (e *TokenManagerMockVerifyAccessTokenExpectation) Then(claims *model.UserClaims, err error) *TokenManagerMock {
+	e.results = &TokenManagerMockVerifyAccessTokenResults{claims, err}
+	return e.mock
+}
+
+// Times sets number of times TokenManager.VerifyAccessToken should be invoked
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Times(n uint64) *mTokenManagerMockVerifyAccessToken {
+	if n == 0 {
+		mmVerifyAccessToken.mock.t.Fatalf("Times of TokenManagerMock.VerifyAccessToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyAccessToken.expectedInvocations, n)
+	return mmVerifyAccessToken
+}
+
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) invocationsDone() bool {
+	if len(mmVerifyAccessToken.expectations) == 0 && mmVerifyAccessToken.defaultExpectation == nil && mmVerifyAccessToken.mock.funcVerifyAccessToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyAccessToken.mock.afterVerifyAccessTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyAccessToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyAccessToken implements token.TokenManager
+func (mmVerifyAccessToken *TokenManagerMock) VerifyAccessToken(token string) (claims *model.UserClaims, err error) {
+	mm_atomic.AddUint64(&mmVerifyAccessToken.beforeVerifyAccessTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyAccessToken.afterVerifyAccessTokenCounter, 1)
+
+	if mmVerifyAccessToken.inspectFuncVerifyAccessToken != nil {
+		mmVerifyAccessToken.inspectFuncVerifyAccessToken(token)
+	}
+
+	mm_params := TokenManagerMockVerifyAccessTokenParams{token}
+
+	// Record call args
+	mmVerifyAccessToken.VerifyAccessTokenMock.mutex.Lock()
+	mmVerifyAccessToken.VerifyAccessTokenMock.callArgs = append(mmVerifyAccessToken.VerifyAccessTokenMock.callArgs, &mm_params)
+	mmVerifyAccessToken.VerifyAccessTokenMock.mutex.Unlock()
+
+	for _, e := range mmVerifyAccessToken.VerifyAccessTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.claims, e.results.err
+		}
+	}
+
+	if mmVerifyAccessToken.VerifyAccessTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyAccessToken.VerifyAccessTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyAccessToken.VerifyAccessTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyAccessToken.VerifyAccessTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenManagerMockVerifyAccessTokenParams{token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmVerifyAccessToken.t.Errorf("TokenManagerMock.VerifyAccessToken got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyAccessToken.t.Errorf("TokenManagerMock.VerifyAccessToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyAccessToken.VerifyAccessTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyAccessToken.t.Fatal("No results are set for the TokenManagerMock.VerifyAccessToken")
+		}
+		return (*mm_results).claims, (*mm_results).err
+	}
+	if mmVerifyAccessToken.funcVerifyAccessToken != nil {
+		return mmVerifyAccessToken.funcVerifyAccessToken(token)
+	}
+	mmVerifyAccessToken.t.Fatalf("Unexpected call to TokenManagerMock.VerifyAccessToken. %v", token)
+	return
+}
+
+// VerifyAccessTokenAfterCounter returns a count of finished TokenManagerMock.VerifyAccessToken invocations
+func (mmVerifyAccessToken *TokenManagerMock) VerifyAccessTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyAccessToken.afterVerifyAccessTokenCounter)
+}
+
+// VerifyAccessTokenBeforeCounter returns a count of TokenManagerMock.VerifyAccessToken invocations
+func (mmVerifyAccessToken *TokenManagerMock) VerifyAccessTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyAccessToken.beforeVerifyAccessTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenManagerMock.VerifyAccessToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyAccessToken *mTokenManagerMockVerifyAccessToken) Calls() []*TokenManagerMockVerifyAccessTokenParams {
+	mmVerifyAccessToken.mutex.RLock()
+
+	argCopy := make([]*TokenManagerMockVerifyAccessTokenParams, len(mmVerifyAccessToken.callArgs))
+	copy(argCopy, mmVerifyAccessToken.callArgs)
+
+	mmVerifyAccessToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyAccessTokenDone returns true if the count of the VerifyAccessToken invocations corresponds
+// the number of defined expectations
+func (m *TokenManagerMock) MinimockVerifyAccessTokenDone() bool {
+	if m.VerifyAccessTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyAccessTokenMock.invocationsDone()
+}
+
+// MinimockVerifyAccessTokenInspect logs each unmet expectation
+func (m *TokenManagerMock) MinimockVerifyAccessTokenInspect() {
+	for _, e := range m.VerifyAccessTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenManagerMock.VerifyAccessToken with params: %#v", *e.params)
+		}
+	}
+
+	afterVerifyAccessTokenCounter := mm_atomic.LoadUint64(&m.afterVerifyAccessTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyAccessTokenMock.defaultExpectation != nil && afterVerifyAccessTokenCounter < 1 {
+		if m.VerifyAccessTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TokenManagerMock.VerifyAccessToken")
+		} else {
+			m.t.Errorf("Expected call to TokenManagerMock.VerifyAccessToken with params: %#v", *m.VerifyAccessTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyAccessToken != nil && afterVerifyAccessTokenCounter < 1 {
+		m.t.Error("Expected call to TokenManagerMock.VerifyAccessToken")
+	}
+
+	if !m.VerifyAccessTokenMock.invocationsDone() && afterVerifyAccessTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenManagerMock.VerifyAccessToken but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyAccessTokenMock.expectedInvocations), afterVerifyAccessTokenCounter)
+	}
+}
+
+type mTokenManagerMockVerifyRefreshToken struct {
+	optional           bool
+	mock               *TokenManagerMock
+	defaultExpectation *TokenManagerMockVerifyRefreshTokenExpectation
+	expectations       []*TokenManagerMockVerifyRefreshTokenExpectation
+
+	callArgs []*TokenManagerMockVerifyRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// TokenManagerMockVerifyRefreshTokenExpectation specifies expectation struct of the TokenManager.VerifyRefreshToken
+type TokenManagerMockVerifyRefreshTokenExpectation struct {
+	mock      *TokenManagerMock
+	params    *TokenManagerMockVerifyRefreshTokenParams
+	paramPtrs *TokenManagerMockVerifyRefreshTokenParamPtrs
+	results   *TokenManagerMockVerifyRefreshTokenResults
+	Counter   uint64
+}
+
+// TokenManagerMockVerifyRefreshTokenParams contains parameters of the TokenManager.VerifyRefreshToken
+type TokenManagerMockVerifyRefreshTokenParams struct {
+	token string
+}
+
+// TokenManagerMockVerifyRefreshTokenParamPtrs contains pointers to parameters of the TokenManager.VerifyRefreshToken
+type TokenManagerMockVerifyRefreshTokenParamPtrs struct {
+	token *string
+}
+
+// TokenManagerMockVerifyRefreshTokenResults contains results of the TokenManager.VerifyRefreshToken
+type TokenManagerMockVerifyRefreshTokenResults struct {
+	claims *model.UserClaims
+	err    error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Optional() *mTokenManagerMockVerifyRefreshToken {
+	mmVerifyRefreshToken.optional = true
+	return mmVerifyRefreshToken
+}
+
+// Expect sets up expected params for TokenManager.VerifyRefreshToken
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Expect(token string) *mTokenManagerMockVerifyRefreshToken {
+	if mmVerifyRefreshToken.mock.funcVerifyRefreshToken != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("TokenManagerMock.VerifyRefreshToken mock is already set by Set")
+	}
+
+	if mmVerifyRefreshToken.defaultExpectation == nil {
+		mmVerifyRefreshToken.defaultExpectation = &TokenManagerMockVerifyRefreshTokenExpectation{}
+	}
+
+	if mmVerifyRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("TokenManagerMock.VerifyRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmVerifyRefreshToken.defaultExpectation.params = &TokenManagerMockVerifyRefreshTokenParams{token}
+	for _, e := range mmVerifyRefreshToken.expectations {
+		if minimock.Equal(e.params, mmVerifyRefreshToken.defaultExpectation.params) {
+			mmVerifyRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerifyRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmVerifyRefreshToken
+}
+
+// ExpectTokenParam1 sets up expected param token for TokenManager.VerifyRefreshToken
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) ExpectTokenParam1(token string) *mTokenManagerMockVerifyRefreshToken {
+	if mmVerifyRefreshToken.mock.funcVerifyRefreshToken != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("TokenManagerMock.VerifyRefreshToken mock is already set by Set")
+	}
+
+	if mmVerifyRefreshToken.defaultExpectation == nil {
+		mmVerifyRefreshToken.defaultExpectation = &TokenManagerMockVerifyRefreshTokenExpectation{}
+	}
+
+	if mmVerifyRefreshToken.defaultExpectation.params != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("TokenManagerMock.VerifyRefreshToken mock is already set by Expect")
+	}
+
+	if mmVerifyRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmVerifyRefreshToken.defaultExpectation.paramPtrs = &TokenManagerMockVerifyRefreshTokenParamPtrs{}
+	}
+	mmVerifyRefreshToken.defaultExpectation.paramPtrs.token = &token
+
+	return mmVerifyRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the TokenManager.VerifyRefreshToken
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Inspect(f func(token string)) *mTokenManagerMockVerifyRefreshToken {
+	if mmVerifyRefreshToken.mock.inspectFuncVerifyRefreshToken != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("Inspect function is already set for TokenManagerMock.VerifyRefreshToken")
+	}
+
+	mmVerifyRefreshToken.mock.inspectFuncVerifyRefreshToken = f
+
+	return mmVerifyRefreshToken
+}
+
+// Return sets up results that will be returned by TokenManager.VerifyRefreshToken
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Return(claims *model.UserClaims, err error) *TokenManagerMock {
+	if mmVerifyRefreshToken.mock.funcVerifyRefreshToken != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("TokenManagerMock.VerifyRefreshToken mock is already set by Set")
+	}
+
+	if mmVerifyRefreshToken.defaultExpectation == nil {
+		mmVerifyRefreshToken.defaultExpectation = &TokenManagerMockVerifyRefreshTokenExpectation{mock: mmVerifyRefreshToken.mock}
+	}
+	mmVerifyRefreshToken.defaultExpectation.results = &TokenManagerMockVerifyRefreshTokenResults{claims, err}
+	return mmVerifyRefreshToken.mock
+}
+
+// Set uses given function f to mock the TokenManager.VerifyRefreshToken method
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Set(f func(token string) (claims *model.UserClaims, err error)) *TokenManagerMock {
+	if mmVerifyRefreshToken.defaultExpectation != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("Default expectation is already set for the TokenManager.VerifyRefreshToken method")
+	}
+
+	if len(mmVerifyRefreshToken.expectations) > 0 {
+		mmVerifyRefreshToken.mock.t.Fatalf("Some expectations are already set for the TokenManager.VerifyRefreshToken method")
+	}
+
+	mmVerifyRefreshToken.mock.funcVerifyRefreshToken = f
+	return mmVerifyRefreshToken.mock
+}
+
+// When sets expectation for the TokenManager.VerifyRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) When(token string) *TokenManagerMockVerifyRefreshTokenExpectation {
+	if mmVerifyRefreshToken.mock.funcVerifyRefreshToken != nil {
+		mmVerifyRefreshToken.mock.t.Fatalf("TokenManagerMock.VerifyRefreshToken mock is already set by Set")
+	}
+
+	expectation := &TokenManagerMockVerifyRefreshTokenExpectation{
+		mock:   mmVerifyRefreshToken.mock,
+		params: &TokenManagerMockVerifyRefreshTokenParams{token},
+	}
+	mmVerifyRefreshToken.expectations = append(mmVerifyRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TokenManager.VerifyRefreshToken return parameters for the expectation previously defined by the When method
+func (e *TokenManagerMockVerifyRefreshTokenExpectation) Then(claims *model.UserClaims, err error) *TokenManagerMock {
+	e.results = &TokenManagerMockVerifyRefreshTokenResults{claims, err}
+	return e.mock
+}
+
+// Times sets number of times TokenManager.VerifyRefreshToken should be invoked
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Times(n uint64) *mTokenManagerMockVerifyRefreshToken {
+	if n == 0 {
+		mmVerifyRefreshToken.mock.t.Fatalf("Times of TokenManagerMock.VerifyRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerifyRefreshToken.expectedInvocations, n)
+	return mmVerifyRefreshToken
+}
+
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) invocationsDone() bool {
+	if len(mmVerifyRefreshToken.expectations) == 0 && mmVerifyRefreshToken.defaultExpectation == nil && mmVerifyRefreshToken.mock.funcVerifyRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerifyRefreshToken.mock.afterVerifyRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerifyRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// VerifyRefreshToken implements token.TokenManager
+func (mmVerifyRefreshToken *TokenManagerMock) VerifyRefreshToken(token string) (claims *model.UserClaims, err error) {
+	mm_atomic.AddUint64(&mmVerifyRefreshToken.beforeVerifyRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerifyRefreshToken.afterVerifyRefreshTokenCounter, 1)
+
+	if mmVerifyRefreshToken.inspectFuncVerifyRefreshToken != nil {
+		mmVerifyRefreshToken.inspectFuncVerifyRefreshToken(token)
+	}
+
+	mm_params := TokenManagerMockVerifyRefreshTokenParams{token}
+
+	// Record call args
+	mmVerifyRefreshToken.VerifyRefreshTokenMock.mutex.Lock()
+	mmVerifyRefreshToken.VerifyRefreshTokenMock.callArgs = append(mmVerifyRefreshToken.VerifyRefreshTokenMock.callArgs, &mm_params)
+	mmVerifyRefreshToken.VerifyRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmVerifyRefreshToken.VerifyRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.claims, e.results.err
+		}
+	}
+
+	if mmVerifyRefreshToken.VerifyRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerifyRefreshToken.VerifyRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerifyRefreshToken.VerifyRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmVerifyRefreshToken.VerifyRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := TokenManagerMockVerifyRefreshTokenParams{token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmVerifyRefreshToken.t.Errorf("TokenManagerMock.VerifyRefreshToken got unexpected parameter token, want: %#v, got: %#v%s\n", *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerifyRefreshToken.t.Errorf("TokenManagerMock.VerifyRefreshToken got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerifyRefreshToken.VerifyRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerifyRefreshToken.t.Fatal("No results are set for the TokenManagerMock.VerifyRefreshToken")
+		}
+		return (*mm_results).claims, (*mm_results).err
+	}
+	if mmVerifyRefreshToken.funcVerifyRefreshToken != nil {
+		return mmVerifyRefreshToken.funcVerifyRefreshToken(token)
+	}
+	mmVerifyRefreshToken.t.Fatalf("Unexpected call to TokenManagerMock.VerifyRefreshToken. %v", token)
+	return
+}
+
+// VerifyRefreshTokenAfterCounter returns a count of finished TokenManagerMock.VerifyRefreshToken invocations
+func (mmVerifyRefreshToken *TokenManagerMock) VerifyRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyRefreshToken.afterVerifyRefreshTokenCounter)
+}
+
+// VerifyRefreshTokenBeforeCounter returns a count of TokenManagerMock.VerifyRefreshToken invocations
+func (mmVerifyRefreshToken *TokenManagerMock) VerifyRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerifyRefreshToken.beforeVerifyRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to TokenManagerMock.VerifyRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerifyRefreshToken *mTokenManagerMockVerifyRefreshToken) Calls() []*TokenManagerMockVerifyRefreshTokenParams {
+	mmVerifyRefreshToken.mutex.RLock()
+
+	argCopy := make([]*TokenManagerMockVerifyRefreshTokenParams, len(mmVerifyRefreshToken.callArgs))
+	copy(argCopy, mmVerifyRefreshToken.callArgs)
+
+	mmVerifyRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyRefreshTokenDone returns true if the count of the VerifyRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *TokenManagerMock) MinimockVerifyRefreshTokenDone() bool {
+	if m.VerifyRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyRefreshTokenMock.invocationsDone()
+}
+
+// MinimockVerifyRefreshTokenInspect logs each unmet expectation
+func (m *TokenManagerMock) MinimockVerifyRefreshTokenInspect() {
+	for _, e := range m.VerifyRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TokenManagerMock.VerifyRefreshToken with params: %#v", *e.params)
+		}
+	}
+
+	afterVerifyRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterVerifyRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyRefreshTokenMock.defaultExpectation != nil && afterVerifyRefreshTokenCounter < 1 {
+		if m.VerifyRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to TokenManagerMock.VerifyRefreshToken")
+		} else {
+			m.t.Errorf("Expected call to TokenManagerMock.VerifyRefreshToken with params: %#v", *m.VerifyRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerifyRefreshToken != nil && afterVerifyRefreshTokenCounter < 1 {
+		m.t.Error("Expected call to TokenManagerMock.VerifyRefreshToken")
+	}
+
+	if !m.VerifyRefreshTokenMock.invocationsDone() && afterVerifyRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to TokenManagerMock.VerifyRefreshToken but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyRefreshTokenMock.expectedInvocations), afterVerifyRefreshTokenCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TokenManagerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGenerateAccessTokenInspect()
+
+			m.MinimockGenerateRefreshTokenInspect()
+
+			m.MinimockVerifyAccessTokenInspect()
+
+			m.MinimockVerifyRefreshTokenInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TokenManagerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TokenManagerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGenerateAccessTokenDone() &&
+		m.MinimockGenerateRefreshTokenDone() &&
+		m.MinimockVerifyAccessTokenDone() &&
+		m.MinimockVerifyRefreshTokenDone()
+}
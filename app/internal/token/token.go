@@ -0,0 +1,20 @@
+package token
+
+import (
+	"time"
+
+	"github.com/Prrromanssss/auth/internal/model"
+)
+
+// TokenManager defines methods for issuing and verifying signed user tokens.
+type TokenManager interface {
+	// GenerateAccessToken issues a short-lived access token for the user.
+	GenerateAccessToken(user model.User) (token string, err error)
+	// GenerateRefreshToken issues a long-lived refresh token with the given ID for the user
+	// and returns the token with its expiration time.
+	GenerateRefreshToken(user model.User, tokenID string) (token string, expiresAt time.Time, err error)
+	// VerifyAccessToken checks the signature and expiration of an access token and returns its claims.
+	VerifyAccessToken(token string) (claims *model.UserClaims, err error)
+	// VerifyRefreshToken checks the signature and expiration of a refresh token and returns its claims.
+	VerifyRefreshToken(token string) (claims *model.UserClaims, err error)
+}
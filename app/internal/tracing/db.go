@@ -0,0 +1,125 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Prrromanssss/platform_common/pkg/db"
+	"github.com/Prrromanssss/platform_common/pkg/db/pg"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const batchSpanName = "db.batch"
+
+type dbClient struct {
+	db.Client
+	db db.DB
+}
+
+// NewDBClient wraps the database client, so that every query runs in a span named after its db.Query,
+// such as "userPGRepo.GetUser".
+func NewDBClient(client db.Client) db.Client {
+	return &dbClient{
+		Client: client,
+		db:     &tracedDB{DB: client.DB()},
+	}
+}
+
+func (c *dbClient) DB() db.DB {
+	return c.db
+}
+
+type tracedDB struct {
+	db.DB
+}
+
+func (d *tracedDB) ScanOneContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) (err error) {
+	ctx, span := startQuerySpan(ctx, q)
+	defer func() { endQuerySpan(span, err) }()
+
+	return d.DB.ScanOneContext(ctx, dest, q, args...)
+}
+
+func (d *tracedDB) ScanAllContext(ctx context.Context, dest interface{}, q db.Query, args ...interface{}) (err error) {
+	ctx, span := startQuerySpan(ctx, q)
+	defer func() { endQuerySpan(span, err) }()
+
+	return d.DB.ScanAllContext(ctx, dest, q, args...)
+}
+
+func (d *tracedDB) ExecContext(ctx context.Context, q db.Query, args ...interface{}) (_ pgconn.CommandTag, err error) {
+	ctx, span := startQuerySpan(ctx, q)
+	defer func() { endQuerySpan(span, err) }()
+
+	return d.DB.ExecContext(ctx, q, args...)
+}
+
+// QueryContext spans the time until the rows are ready to be read.
+func (d *tracedDB) QueryContext(ctx context.Context, q db.Query, args ...interface{}) (_ pgx.Rows, err error) {
+	ctx, span := startQuerySpan(ctx, q)
+	defer func() { endQuerySpan(span, err) }()
+
+	return d.DB.QueryContext(ctx, q, args...)
+}
+
+// QueryRowContext spans the time until the row is ready to be scanned, errors surface on the scan.
+func (d *tracedDB) QueryRowContext(ctx context.Context, q db.Query, args ...interface{}) pgx.Row {
+	ctx, span := startQuerySpan(ctx, q)
+	defer span.End()
+
+	return d.DB.QueryRowContext(ctx, q, args...)
+}
+
+func (d *tracedDB) SendBatchContext(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	ctx, span := tracer().Start(ctx, batchSpanName, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemPostgreSQL,
+	))
+	defer span.End()
+
+	return d.DB.SendBatchContext(ctx, b)
+}
+
+func startQuerySpan(ctx context.Context, q db.Query) (context.Context, trace.Span) {
+	return tracer().Start(ctx, q.Name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemPostgreSQL,
+		semconv.DBOperationName(q.Name),
+		semconv.DBQueryText(q.QueryRaw),
+	))
+}
+
+// endQuerySpan ends the span of a query. A query that finds no row has not failed.
+func endQuerySpan(span trace.Span, err error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+
+	End(span, err)
+}
+
+type txManager struct {
+	txManager db.TxManager
+}
+
+// NewTxManager wraps the transaction manager, so that every transaction runs in a span that holds
+// the spans of its queries. A call nested in a transaction joins it and gets no span of its own.
+func NewTxManager(manager db.TxManager) db.TxManager {
+	return &txManager{txManager: manager}
+}
+
+func (m *txManager) ReadCommitted(ctx context.Context, f db.Handler) (err error) {
+	if _, ok := ctx.Value(pg.TxKey).(pgx.Tx); ok {
+		return m.txManager.ReadCommitted(ctx, f)
+	}
+
+	ctx, span := tracer().Start(ctx, "db.transaction", trace.WithAttributes(
+		semconv.DBSystemPostgreSQL,
+		attribute.String("db.transaction.isolation_level", "read committed"),
+	))
+	defer func() { End(span, err) }()
+
+	return m.txManager.ReadCommitted(ctx, f)
+}
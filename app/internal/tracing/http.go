@@ -0,0 +1,30 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HTTPHandler wraps the HTTP gateway, so that every request runs in a span that continues the trace
+// context of the request headers and is passed on to the gRPC server.
+func HTTPHandler(handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(handler, "gateway")
+}
+
+// HTTPMiddleware names the span of a gateway request after its route, such as "GET /user/v1/{id=*}",
+// which HTTPHandler does not know.
+func HTTPMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + pattern.String())
+			span.SetAttributes(semconv.HTTPRoute(pattern.String()))
+		}
+
+		next(w, r, pathParams)
+	}
+}
@@ -0,0 +1,71 @@
+package tracing
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerCarrier reads and writes the trace context in the headers of a Kafka message.
+type headerCarrier struct {
+	headers *[]sarama.RecordHeader
+}
+
+var _ propagation.TextMapCarrier = headerCarrier{}
+
+func (c headerCarrier) Get(key string) string {
+	for _, header := range *c.headers {
+		if string(header.Key) == key {
+			return string(header.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, header := range *c.headers {
+		if string(header.Key) == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+
+	*c.headers = append(*c.headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.headers))
+	for _, header := range *c.headers {
+		keys = append(keys, string(header.Key))
+	}
+
+	return keys
+}
+
+// StartConsumerSpan starts the span of the processing of a consumed message. The span continues the trace
+// of the producer when the message carries its trace context in the headers.
+func StartConsumerSpan(ctx context.Context, group string, msg *sarama.ConsumerMessage) (context.Context, trace.Span) {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers))
+	for _, header := range msg.Headers {
+		if header != nil {
+			headers = append(headers, *header)
+		}
+	}
+
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headerCarrier{headers: &headers})
+
+	return tracer().Start(ctx, msg.Topic+" process", trace.WithSpanKind(trace.SpanKindConsumer), trace.WithAttributes(
+		semconv.MessagingSystemKafka,
+		semconv.MessagingOperationTypeDeliver,
+		semconv.MessagingDestinationName(msg.Topic),
+		semconv.MessagingDestinationPartitionID(strconv.FormatInt(int64(msg.Partition), 10)),
+		semconv.MessagingKafkaConsumerGroup(group),
+		semconv.MessagingKafkaMessageOffset(int(msg.Offset)),
+	))
+}
@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	cacheClient "github.com/Prrromanssss/platform_common/pkg/cache"
+	redigo "github.com/gomodule/redigo/redis"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type redisClient struct {
+	client cacheClient.RedisClient
+}
+
+// NewRedisClient wraps the Redis client, so that every command runs in a span named after it.
+func NewRedisClient(client cacheClient.RedisClient) cacheClient.RedisClient {
+	return &redisClient{client: client}
+}
+
+func (c *redisClient) HashSet(ctx context.Context, key string, values interface{}) (err error) {
+	ctx, span := StartRedisSpan(ctx, "HSET")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.HashSet(ctx, key, values)
+}
+
+func (c *redisClient) Set(ctx context.Context, key string, value interface{}) (err error) {
+	ctx, span := StartRedisSpan(ctx, "SET")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.Set(ctx, key, value)
+}
+
+func (c *redisClient) HGetAll(ctx context.Context, key string) (_ []interface{}, err error) {
+	ctx, span := StartRedisSpan(ctx, "HGETALL")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.HGetAll(ctx, key)
+}
+
+func (c *redisClient) Exists(ctx context.Context, key string) (_ bool, err error) {
+	ctx, span := StartRedisSpan(ctx, "EXISTS")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.Exists(ctx, key)
+}
+
+func (c *redisClient) Del(ctx context.Context, key string) (err error) {
+	ctx, span := StartRedisSpan(ctx, "DEL")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.Del(ctx, key)
+}
+
+func (c *redisClient) Get(ctx context.Context, key string) (_ interface{}, err error) {
+	ctx, span := StartRedisSpan(ctx, "GET")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.Get(ctx, key)
+}
+
+func (c *redisClient) Expire(ctx context.Context, key string, expiration time.Duration) (err error) {
+	ctx, span := StartRedisSpan(ctx, "EXPIRE")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.Expire(ctx, key, expiration)
+}
+
+func (c *redisClient) Ping(ctx context.Context) (err error) {
+	ctx, span := StartRedisSpan(ctx, "PING")
+	defer func() { EndRedisSpan(span, err) }()
+
+	return c.client.Ping(ctx)
+}
+
+// StartRedisSpan starts the span of a Redis command, for the commands that do not go through the client,
+// such as scripts run on a connection of the pool.
+func StartRedisSpan(ctx context.Context, command string) (context.Context, trace.Span) {
+	return tracer().Start(ctx, "redis."+command, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemRedis,
+		semconv.DBOperationName(command),
+	))
+}
+
+// EndRedisSpan ends the span of a Redis command. A missing key is not a failure.
+func EndRedisSpan(span trace.Span, err error) {
+	if errors.Is(err, redigo.ErrNil) {
+		err = nil
+	}
+
+	End(span, err)
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Prrromanssss/auth/internal/tracing"
+)
+
+// The tests of this package are not parallel, because the tracer provider and the propagator are global.
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return recorder
+}
+
+func TestStartConsumerSpan(t *testing.T) {
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+
+	tests := []struct {
+		name        string
+		headers     []*sarama.RecordHeader
+		wantTraceID string
+		wantParent  string
+	}{
+		{
+			name: "trace context in the headers",
+			headers: []*sarama.RecordHeader{
+				{Key: []byte("x-request-id"), Value: []byte("42")},
+				{Key: []byte("traceparent"), Value: []byte("00-" + traceID + "-" + spanID + "-01")},
+			},
+			wantTraceID: traceID,
+			wantParent:  spanID,
+		},
+		{
+			name:    "no trace context",
+			headers: []*sarama.RecordHeader{nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := setupRecorder(t)
+
+			_, span := tracing.StartConsumerSpan(context.Background(), "auth", &sarama.ConsumerMessage{
+				Topic:     "users",
+				Partition: 2,
+				Offset:    17,
+				Headers:   tt.headers,
+			})
+			span.End()
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, "users process", spans[0].Name())
+			require.Equal(t, trace.SpanKindConsumer, spans[0].SpanKind())
+
+			if tt.wantTraceID == "" {
+				require.False(t, spans[0].Parent().IsValid())
+				return
+			}
+
+			require.Equal(t, tt.wantTraceID, spans[0].SpanContext().TraceID().String())
+			require.Equal(t, tt.wantParent, spans[0].Parent().SpanID().String())
+			require.True(t, spans[0].Parent().IsRemote())
+		})
+	}
+}
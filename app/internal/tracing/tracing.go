@@ -0,0 +1,144 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Prrromanssss/auth/config/yaml"
+	"github.com/Prrromanssss/auth/internal/redact"
+)
+
+// instrumentationName names the tracer of the spans started by the service itself.
+const instrumentationName = "github.com/Prrromanssss/auth"
+
+// Init installs the global tracer provider and the W3C trace context propagator and returns a function
+// that flushes the pending spans and stops the exporter. The propagator is installed even when tracing
+// is disabled, so that the trace context of incoming calls still reaches the audit log.
+func Init(ctx context.Context, cfg yaml.Tracing) (shutdown func(ctx context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	err = cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot create tracing resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, cfg yaml.Tracing) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case yaml.TracingExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot create OTLP trace exporter")
+		}
+
+		return exporter, nil
+	case yaml.TracingExporterFile:
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+		if err != nil {
+			return nil, errors.Wrap(err, "Cannot open trace file")
+		}
+
+		return newWriterExporter(file, file)
+	default:
+		return newWriterExporter(os.Stdout, nil)
+	}
+}
+
+// newWriterExporter creates an exporter that writes the spans as JSON, closing the closer on shutdown.
+func newWriterExporter(w io.Writer, closer io.Closer) (sdktrace.SpanExporter, error) {
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot create trace exporter")
+	}
+
+	if closer == nil {
+		return exporter, nil
+	}
+
+	return &closingExporter{SpanExporter: exporter, closer: closer}, nil
+}
+
+type closingExporter struct {
+	sdktrace.SpanExporter
+	closer io.Closer
+}
+
+func (e *closingExporter) Shutdown(ctx context.Context) error {
+	err := e.SpanExporter.Shutdown(ctx)
+
+	closeErr := e.closer.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// tracer returns the tracer of the service. It is looked up on every call, so that spans go to the provider
+// installed by Init rather than to the one installed when the package was loaded.
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End records the error on the span, if any, and ends the span.
+func End(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// RecordError records the error on the span and marks the span as failed, if there is an error.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, redact.Text(err.Error()))
+}
@@ -0,0 +1,4 @@
+package crypto
+
+//go:generate sh -c "rm -rf mocks && mkdir -p mocks"
+//go:generate minimock -i PasswordHasher -o ./mocks/ -s "_minimock.go"
@@ -2,11 +2,105 @@ package crypto
 
 import (
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
 )
 
-func HashPassword(password string) string {
-	hash := sha256.New()
-	hash.Write([]byte(password))
-	return hex.EncodeToString(hash.Sum(nil))
+// ErrUnknownHashFormat is returned when a stored hash was not produced by any supported algorithm.
+var ErrUnknownHashFormat = errors.New("unknown password hash format")
+
+// PasswordHasher hashes passwords into self-describing PHC strings and verifies passwords against stored hashes.
+type PasswordHasher interface {
+	// Hash returns the PHC string of the password hashed with the current algorithm and parameters.
+	Hash(password string) (encodedHash string, err error)
+	// Verify reports whether the password matches the stored hash.
+	// Argon2id and bcrypt PHC strings as well as legacy unsalted SHA-256 hex digests are accepted.
+	Verify(password, encodedHash string) (ok bool, err error)
+	// NeedsRehash reports whether the stored hash was produced by an outdated algorithm or parameters.
+	NeedsRehash(encodedHash string) bool
+}
+
+type passwordHasher struct {
+	params Argon2Params
+}
+
+// NewPasswordHasher creates a new PasswordHasher that hashes passwords with Argon2id using the given parameters.
+func NewPasswordHasher(params Argon2Params) PasswordHasher {
+	return &passwordHasher{
+		params: params,
+	}
+}
+
+// Hash hashes the password with Argon2id and a random salt.
+func (h *passwordHasher) Hash(password string) (string, error) {
+	return hashArgon2id(password, h.params)
+}
+
+// Verify detects the algorithm of the stored hash and checks the password against it.
+func (h *passwordHasher) Verify(password, encodedHash string) (bool, error) {
+	switch {
+	case strings.HasPrefix(encodedHash, argon2idPrefix):
+		return verifyArgon2id(password, encodedHash)
+	case isBcrypt(encodedHash):
+		return verifyBcrypt(password, encodedHash)
+	case isLegacySHA256(encodedHash):
+		return verifyLegacySHA256(password, encodedHash), nil
+	default:
+		return false, ErrUnknownHashFormat
+	}
+}
+
+// NeedsRehash reports true for every hash that is not Argon2id with the current parameters.
+func (h *passwordHasher) NeedsRehash(encodedHash string) bool {
+	params, _, _, err := decodeArgon2id(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		params.SaltLength != h.params.SaltLength
+}
+
+func isBcrypt(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, "$2a$") ||
+		strings.HasPrefix(encodedHash, "$2b$") ||
+		strings.HasPrefix(encodedHash, "$2y$")
+}
+
+func verifyBcrypt(password, encodedHash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	if err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "Cannot verify bcrypt hash")
+	}
+
+	return true, nil
+}
+
+// isLegacySHA256 reports whether the hash is a hex encoded unsalted SHA-256 digest
+// produced before passwords were stored as PHC strings.
+func isLegacySHA256(encodedHash string) bool {
+	if len(encodedHash) != hex.EncodedLen(sha256.Size) {
+		return false
+	}
+
+	_, err := hex.DecodeString(encodedHash)
+
+	return err == nil
+}
+
+func verifyLegacySHA256(password, encodedHash string) bool {
+	sum := sha256.Sum256([]byte(password))
+
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(strings.ToLower(encodedHash))) == 1
 }
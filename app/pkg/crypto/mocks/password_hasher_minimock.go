@@ -0,0 +1,1002 @@
+// Code generated by http://github.com/gojuno/minimock (v3.3.14). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Prrromanssss/auth/pkg/crypto.PasswordHasher -o password_hasher_minimock.go -n PasswordHasherMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PasswordHasherMock implements crypto.PasswordHasher
+type PasswordHasherMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcHash          func(password string) (encodedHash string, err error)
+	inspectFuncHash   func(password string)
+	afterHashCounter  uint64
+	beforeHashCounter uint64
+	HashMock          mPasswordHasherMockHash
+
+	funcNeedsRehash          func(encodedHash string) (b1 bool)
+	inspectFuncNeedsRehash   func(encodedHash string)
+	afterNeedsRehashCounter  uint64
+	beforeNeedsRehashCounter uint64
+	NeedsRehashMock          mPasswordHasherMockNeedsRehash
+
+	funcVerify          func(password string, encodedHash string) (ok bool, err error)
+	inspectFuncVerify   func(password string, encodedHash string)
+	afterVerifyCounter  uint64
+	beforeVerifyCounter uint64
+	VerifyMock          mPasswordHasherMockVerify
+}
+
+// NewPasswordHasherMock returns a mock for crypto.PasswordHasher
+func NewPasswordHasherMock(t minimock.Tester) *PasswordHasherMock {
+	m := &PasswordHasherMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.HashMock = mPasswordHasherMockHash{mock: m}
+	m.HashMock.callArgs = []*PasswordHasherMockHashParams{}
+
+	m.NeedsRehashMock = mPasswordHasherMockNeedsRehash{mock: m}
+	m.NeedsRehashMock.callArgs = []*PasswordHasherMockNeedsRehashParams{}
+
+	m.VerifyMock = mPasswordHasherMockVerify{mock: m}
+	m.VerifyMock.callArgs = []*PasswordHasherMockVerifyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPasswordHasherMockHash struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockHashExpectation
+	expectations       []*PasswordHasherMockHashExpectation
+
+	callArgs []*PasswordHasherMockHashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PasswordHasherMockHashExpectation specifies expectation struct of the PasswordHasher.Hash
+type PasswordHasherMockHashExpectation struct {
+	mock      *PasswordHasherMock
+	params    *PasswordHasherMockHashParams
+	paramPtrs *PasswordHasherMockHashParamPtrs
+	results   *PasswordHasherMockHashResults
+	Counter   uint64
+}
+
+// PasswordHasherMockHashParams contains parameters of the PasswordHasher.Hash
+type PasswordHasherMockHashParams struct {
+	password string
+}
+
+// PasswordHasherMockHashParamPtrs contains pointers to parameters of the PasswordHasher.Hash
+type PasswordHasherMockHashParamPtrs struct {
+	password *string
+}
+
+// PasswordHasherMockHashResults contains results of the PasswordHasher.Hash
+type PasswordHasherMockHashResults struct {
+	encodedHash string
+	err         error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHash *mPasswordHasherMockHash) Optional() *mPasswordHasherMockHash {
+	mmHash.optional = true
+	return mmHash
+}
+
+// Expect sets up expected params for PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) Expect(password string) *mPasswordHasherMockHash {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	if mmHash.defaultExpectation == nil {
+		mmHash.defaultExpectation = &PasswordHasherMockHashExpectation{}
+	}
+
+	if mmHash.defaultExpectation.paramPtrs != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by ExpectParams functions")
+	}
+
+	mmHash.defaultExpectation.params = &PasswordHasherMockHashParams{password}
+	for _, e := range mmHash.expectations {
+		if minimock.Equal(e.params, mmHash.defaultExpectation.params) {
+			mmHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHash.defaultExpectation.params)
+		}
+	}
+
+	return mmHash
+}
+
+// ExpectPasswordParam1 sets up expected param password for PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) ExpectPasswordParam1(password string) *mPasswordHasherMockHash {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	if mmHash.defaultExpectation == nil {
+		mmHash.defaultExpectation = &PasswordHasherMockHashExpectation{}
+	}
+
+	if mmHash.defaultExpectation.params != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Expect")
+	}
+
+	if mmHash.defaultExpectation.paramPtrs == nil {
+		mmHash.defaultExpectation.paramPtrs = &PasswordHasherMockHashParamPtrs{}
+	}
+	mmHash.defaultExpectation.paramPtrs.password = &password
+
+	return mmHash
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) Inspect(f func(password string)) *mPasswordHasherMockHash {
+	if mmHash.mock.inspectFuncHash != nil {
+		mmHash.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.Hash")
+	}
+
+	mmHash.mock.inspectFuncHash = f
+
+	return mmHash
+}
+
+// Return sets up results that will be returned by PasswordHasher.Hash
+func (mmHash *mPasswordHasherMockHash) Return(encodedHash string, err error) *PasswordHasherMock {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	if mmHash.defaultExpectation == nil {
+		mmHash.defaultExpectation = &PasswordHasherMockHashExpectation{mock: mmHash.mock}
+	}
+	mmHash.defaultExpectation.results = &PasswordHasherMockHashResults{encodedHash, err}
+	return mmHash.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.Hash method
+func (mmHash *mPasswordHasherMockHash) Set(f func(password string) (encodedHash string, err error)) *PasswordHasherMock {
+	if mmHash.defaultExpectation != nil {
+		mmHash.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.Hash method")
+	}
+
+	if len(mmHash.expectations) > 0 {
+		mmHash.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.Hash method")
+	}
+
+	mmHash.mock.funcHash = f
+	return mmHash.mock
+}
+
+// When sets expectation for the PasswordHasher.Hash which will trigger the result defined by the following
+// Then helper
+func (mmHash *mPasswordHasherMockHash) When(password string) *PasswordHasherMockHashExpectation {
+	if mmHash.mock.funcHash != nil {
+		mmHash.mock.t.Fatalf("PasswordHasherMock.Hash mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockHashExpectation{
+		mock:   mmHash.mock,
+		params: &PasswordHasherMockHashParams{password},
+	}
+	mmHash.expectations = append(mmHash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.Hash return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockHashExpectation) Then(encodedHash string, err error) *PasswordHasherMock {
+	e.results = &PasswordHasherMockHashResults{encodedHash, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.Hash should be invoked
+func (mmHash *mPasswordHasherMockHash) Times(n uint64) *mPasswordHasherMockHash {
+	if n == 0 {
+		mmHash.mock.t.Fatalf("Times of PasswordHasherMock.Hash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHash.expectedInvocations, n)
+	return mmHash
+}
+
+func (mmHash *mPasswordHasherMockHash) invocationsDone() bool {
+	if len(mmHash.expectations) == 0 && mmHash.defaultExpectation == nil && mmHash.mock.funcHash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHash.mock.afterHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Hash implements crypto.PasswordHasher
+func (mmHash *PasswordHasherMock) Hash(password string) (encodedHash string, err error) {
+	mm_atomic.AddUint64(&mmHash.beforeHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmHash.afterHashCounter, 1)
+
+	if mmHash.inspectFuncHash != nil {
+		mmHash.inspectFuncHash(password)
+	}
+
+	mm_params := PasswordHasherMockHashParams{password}
+
+	// Record call args
+	mmHash.HashMock.mutex.Lock()
+	mmHash.HashMock.callArgs = append(mmHash.HashMock.callArgs, &mm_params)
+	mmHash.HashMock.mutex.Unlock()
+
+	for _, e := range mmHash.HashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.encodedHash, e.results.err
+		}
+	}
+
+	if mmHash.HashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHash.HashMock.defaultExpectation.Counter, 1)
+		mm_want := mmHash.HashMock.defaultExpectation.params
+		mm_want_ptrs := mmHash.HashMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockHashParams{password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmHash.t.Errorf("PasswordHasherMock.Hash got unexpected parameter password, want: %#v, got: %#v%s\n", *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHash.t.Errorf("PasswordHasherMock.Hash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHash.HashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHash.t.Fatal("No results are set for the PasswordHasherMock.Hash")
+		}
+		return (*mm_results).encodedHash, (*mm_results).err
+	}
+	if mmHash.funcHash != nil {
+		return mmHash.funcHash(password)
+	}
+	mmHash.t.Fatalf("Unexpected call to PasswordHasherMock.Hash. %v", password)
+	return
+}
+
+// HashAfterCounter returns a count of finished PasswordHasherMock.Hash invocations
+func (mmHash *PasswordHasherMock) HashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHash.afterHashCounter)
+}
+
+// HashBeforeCounter returns a count of PasswordHasherMock.Hash invocations
+func (mmHash *PasswordHasherMock) HashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHash.beforeHashCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.Hash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHash *mPasswordHasherMockHash) Calls() []*PasswordHasherMockHashParams {
+	mmHash.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockHashParams, len(mmHash.callArgs))
+	copy(argCopy, mmHash.callArgs)
+
+	mmHash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHashDone returns true if the count of the Hash invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockHashDone() bool {
+	if m.HashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HashMock.invocationsDone()
+}
+
+// MinimockHashInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockHashInspect() {
+	for _, e := range m.HashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.Hash with params: %#v", *e.params)
+		}
+	}
+
+	afterHashCounter := mm_atomic.LoadUint64(&m.afterHashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HashMock.defaultExpectation != nil && afterHashCounter < 1 {
+		if m.HashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PasswordHasherMock.Hash")
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.Hash with params: %#v", *m.HashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHash != nil && afterHashCounter < 1 {
+		m.t.Error("Expected call to PasswordHasherMock.Hash")
+	}
+
+	if !m.HashMock.invocationsDone() && afterHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.Hash but found %d calls",
+			mm_atomic.LoadUint64(&m.HashMock.expectedInvocations), afterHashCounter)
+	}
+}
+
+type mPasswordHasherMockNeedsRehash struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockNeedsRehashExpectation
+	expectations       []*PasswordHasherMockNeedsRehashExpectation
+
+	callArgs []*PasswordHasherMockNeedsRehashParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PasswordHasherMockNeedsRehashExpectation specifies expectation struct of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashExpectation struct {
+	mock      *PasswordHasherMock
+	params    *PasswordHasherMockNeedsRehashParams
+	paramPtrs *PasswordHasherMockNeedsRehashParamPtrs
+	results   *PasswordHasherMockNeedsRehashResults
+	Counter   uint64
+}
+
+// PasswordHasherMockNeedsRehashParams contains parameters of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashParams struct {
+	encodedHash string
+}
+
+// PasswordHasherMockNeedsRehashParamPtrs contains pointers to parameters of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashParamPtrs struct {
+	encodedHash *string
+}
+
+// PasswordHasherMockNeedsRehashResults contains results of the PasswordHasher.NeedsRehash
+type PasswordHasherMockNeedsRehashResults struct {
+	b1 bool
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Optional() *mPasswordHasherMockNeedsRehash {
+	mmNeedsRehash.optional = true
+	return mmNeedsRehash
+}
+
+// Expect sets up expected params for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Expect(encodedHash string) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by ExpectParams functions")
+	}
+
+	mmNeedsRehash.defaultExpectation.params = &PasswordHasherMockNeedsRehashParams{encodedHash}
+	for _, e := range mmNeedsRehash.expectations {
+		if minimock.Equal(e.params, mmNeedsRehash.defaultExpectation.params) {
+			mmNeedsRehash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmNeedsRehash.defaultExpectation.params)
+		}
+	}
+
+	return mmNeedsRehash
+}
+
+// ExpectEncodedHashParam1 sets up expected param encodedHash for PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) ExpectEncodedHashParam1(encodedHash string) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{}
+	}
+
+	if mmNeedsRehash.defaultExpectation.params != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Expect")
+	}
+
+	if mmNeedsRehash.defaultExpectation.paramPtrs == nil {
+		mmNeedsRehash.defaultExpectation.paramPtrs = &PasswordHasherMockNeedsRehashParamPtrs{}
+	}
+	mmNeedsRehash.defaultExpectation.paramPtrs.encodedHash = &encodedHash
+
+	return mmNeedsRehash
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Inspect(f func(encodedHash string)) *mPasswordHasherMockNeedsRehash {
+	if mmNeedsRehash.mock.inspectFuncNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.NeedsRehash")
+	}
+
+	mmNeedsRehash.mock.inspectFuncNeedsRehash = f
+
+	return mmNeedsRehash
+}
+
+// Return sets up results that will be returned by PasswordHasher.NeedsRehash
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Return(b1 bool) *PasswordHasherMock {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	if mmNeedsRehash.defaultExpectation == nil {
+		mmNeedsRehash.defaultExpectation = &PasswordHasherMockNeedsRehashExpectation{mock: mmNeedsRehash.mock}
+	}
+	mmNeedsRehash.defaultExpectation.results = &PasswordHasherMockNeedsRehashResults{b1}
+	return mmNeedsRehash.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.NeedsRehash method
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Set(f func(encodedHash string) (b1 bool)) *PasswordHasherMock {
+	if mmNeedsRehash.defaultExpectation != nil {
+		mmNeedsRehash.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.NeedsRehash method")
+	}
+
+	if len(mmNeedsRehash.expectations) > 0 {
+		mmNeedsRehash.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.NeedsRehash method")
+	}
+
+	mmNeedsRehash.mock.funcNeedsRehash = f
+	return mmNeedsRehash.mock
+}
+
+// When sets expectation for the PasswordHasher.NeedsRehash which will trigger the result defined by the following
+// Then helper
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) When(encodedHash string) *PasswordHasherMockNeedsRehashExpectation {
+	if mmNeedsRehash.mock.funcNeedsRehash != nil {
+		mmNeedsRehash.mock.t.Fatalf("PasswordHasherMock.NeedsRehash mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockNeedsRehashExpectation{
+		mock:   mmNeedsRehash.mock,
+		params: &PasswordHasherMockNeedsRehashParams{encodedHash},
+	}
+	mmNeedsRehash.expectations = append(mmNeedsRehash.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.NeedsRehash return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockNeedsRehashExpectation) Then(b1 bool) *PasswordHasherMock {
+	e.results = &PasswordHasherMockNeedsRehashResults{b1}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.NeedsRehash should be invoked
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Times(n uint64) *mPasswordHasherMockNeedsRehash {
+	if n == 0 {
+		mmNeedsRehash.mock.t.Fatalf("Times of PasswordHasherMock.NeedsRehash mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmNeedsRehash.expectedInvocations, n)
+	return mmNeedsRehash
+}
+
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) invocationsDone() bool {
+	if len(mmNeedsRehash.expectations) == 0 && mmNeedsRehash.defaultExpectation == nil && mmNeedsRehash.mock.funcNeedsRehash == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmNeedsRehash.mock.afterNeedsRehashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmNeedsRehash.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// NeedsRehash implements crypto.PasswordHasher
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehash(encodedHash string) (b1 bool) {
+	mm_atomic.AddUint64(&mmNeedsRehash.beforeNeedsRehashCounter, 1)
+	defer mm_atomic.AddUint64(&mmNeedsRehash.afterNeedsRehashCounter, 1)
+
+	if mmNeedsRehash.inspectFuncNeedsRehash != nil {
+		mmNeedsRehash.inspectFuncNeedsRehash(encodedHash)
+	}
+
+	mm_params := PasswordHasherMockNeedsRehashParams{encodedHash}
+
+	// Record call args
+	mmNeedsRehash.NeedsRehashMock.mutex.Lock()
+	mmNeedsRehash.NeedsRehashMock.callArgs = append(mmNeedsRehash.NeedsRehashMock.callArgs, &mm_params)
+	mmNeedsRehash.NeedsRehashMock.mutex.Unlock()
+
+	for _, e := range mmNeedsRehash.NeedsRehashMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmNeedsRehash.NeedsRehashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmNeedsRehash.NeedsRehashMock.defaultExpectation.Counter, 1)
+		mm_want := mmNeedsRehash.NeedsRehashMock.defaultExpectation.params
+		mm_want_ptrs := mmNeedsRehash.NeedsRehashMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockNeedsRehashParams{encodedHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.encodedHash != nil && !minimock.Equal(*mm_want_ptrs.encodedHash, mm_got.encodedHash) {
+				mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameter encodedHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.encodedHash, mm_got.encodedHash, minimock.Diff(*mm_want_ptrs.encodedHash, mm_got.encodedHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmNeedsRehash.t.Errorf("PasswordHasherMock.NeedsRehash got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmNeedsRehash.NeedsRehashMock.defaultExpectation.results
+		if mm_results == nil {
+			mmNeedsRehash.t.Fatal("No results are set for the PasswordHasherMock.NeedsRehash")
+		}
+		return (*mm_results).b1
+	}
+	if mmNeedsRehash.funcNeedsRehash != nil {
+		return mmNeedsRehash.funcNeedsRehash(encodedHash)
+	}
+	mmNeedsRehash.t.Fatalf("Unexpected call to PasswordHasherMock.NeedsRehash. %v", encodedHash)
+	return
+}
+
+// NeedsRehashAfterCounter returns a count of finished PasswordHasherMock.NeedsRehash invocations
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNeedsRehash.afterNeedsRehashCounter)
+}
+
+// NeedsRehashBeforeCounter returns a count of PasswordHasherMock.NeedsRehash invocations
+func (mmNeedsRehash *PasswordHasherMock) NeedsRehashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmNeedsRehash.beforeNeedsRehashCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.NeedsRehash.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmNeedsRehash *mPasswordHasherMockNeedsRehash) Calls() []*PasswordHasherMockNeedsRehashParams {
+	mmNeedsRehash.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockNeedsRehashParams, len(mmNeedsRehash.callArgs))
+	copy(argCopy, mmNeedsRehash.callArgs)
+
+	mmNeedsRehash.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockNeedsRehashDone returns true if the count of the NeedsRehash invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockNeedsRehashDone() bool {
+	if m.NeedsRehashMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.NeedsRehashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.NeedsRehashMock.invocationsDone()
+}
+
+// MinimockNeedsRehashInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockNeedsRehashInspect() {
+	for _, e := range m.NeedsRehashMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash with params: %#v", *e.params)
+		}
+	}
+
+	afterNeedsRehashCounter := mm_atomic.LoadUint64(&m.afterNeedsRehashCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.NeedsRehashMock.defaultExpectation != nil && afterNeedsRehashCounter < 1 {
+		if m.NeedsRehashMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PasswordHasherMock.NeedsRehash")
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.NeedsRehash with params: %#v", *m.NeedsRehashMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcNeedsRehash != nil && afterNeedsRehashCounter < 1 {
+		m.t.Error("Expected call to PasswordHasherMock.NeedsRehash")
+	}
+
+	if !m.NeedsRehashMock.invocationsDone() && afterNeedsRehashCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.NeedsRehash but found %d calls",
+			mm_atomic.LoadUint64(&m.NeedsRehashMock.expectedInvocations), afterNeedsRehashCounter)
+	}
+}
+
+type mPasswordHasherMockVerify struct {
+	optional           bool
+	mock               *PasswordHasherMock
+	defaultExpectation *PasswordHasherMockVerifyExpectation
+	expectations       []*PasswordHasherMockVerifyExpectation
+
+	callArgs []*PasswordHasherMockVerifyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations uint64
+}
+
+// PasswordHasherMockVerifyExpectation specifies expectation struct of the PasswordHasher.Verify
+type PasswordHasherMockVerifyExpectation struct {
+	mock      *PasswordHasherMock
+	params    *PasswordHasherMockVerifyParams
+	paramPtrs *PasswordHasherMockVerifyParamPtrs
+	results   *PasswordHasherMockVerifyResults
+	Counter   uint64
+}
+
+// PasswordHasherMockVerifyParams contains parameters of the PasswordHasher.Verify
+type PasswordHasherMockVerifyParams struct {
+	password    string
+	encodedHash string
+}
+
+// PasswordHasherMockVerifyParamPtrs contains pointers to parameters of the PasswordHasher.Verify
+type PasswordHasherMockVerifyParamPtrs struct {
+	password    *string
+	encodedHash *string
+}
+
+// PasswordHasherMockVerifyResults contains results of the PasswordHasher.Verify
+type PasswordHasherMockVerifyResults struct {
+	ok  bool
+	err error
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmVerify *mPasswordHasherMockVerify) Optional() *mPasswordHasherMockVerify {
+	mmVerify.optional = true
+	return mmVerify
+}
+
+// Expect sets up expected params for PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) Expect(password string, encodedHash string) *mPasswordHasherMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by ExpectParams functions")
+	}
+
+	mmVerify.defaultExpectation.params = &PasswordHasherMockVerifyParams{password, encodedHash}
+	for _, e := range mmVerify.expectations {
+		if minimock.Equal(e.params, mmVerify.defaultExpectation.params) {
+			mmVerify.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmVerify.defaultExpectation.params)
+		}
+	}
+
+	return mmVerify
+}
+
+// ExpectPasswordParam1 sets up expected param password for PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) ExpectPasswordParam1(password string) *mPasswordHasherMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.params != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Expect")
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs == nil {
+		mmVerify.defaultExpectation.paramPtrs = &PasswordHasherMockVerifyParamPtrs{}
+	}
+	mmVerify.defaultExpectation.paramPtrs.password = &password
+
+	return mmVerify
+}
+
+// ExpectEncodedHashParam2 sets up expected param encodedHash for PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) ExpectEncodedHashParam2(encodedHash string) *mPasswordHasherMockVerify {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{}
+	}
+
+	if mmVerify.defaultExpectation.params != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Expect")
+	}
+
+	if mmVerify.defaultExpectation.paramPtrs == nil {
+		mmVerify.defaultExpectation.paramPtrs = &PasswordHasherMockVerifyParamPtrs{}
+	}
+	mmVerify.defaultExpectation.paramPtrs.encodedHash = &encodedHash
+
+	return mmVerify
+}
+
+// Inspect accepts an inspector function that has same arguments as the PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) Inspect(f func(password string, encodedHash string)) *mPasswordHasherMockVerify {
+	if mmVerify.mock.inspectFuncVerify != nil {
+		mmVerify.mock.t.Fatalf("Inspect function is already set for PasswordHasherMock.Verify")
+	}
+
+	mmVerify.mock.inspectFuncVerify = f
+
+	return mmVerify
+}
+
+// Return sets up results that will be returned by PasswordHasher.Verify
+func (mmVerify *mPasswordHasherMockVerify) Return(ok bool, err error) *PasswordHasherMock {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	if mmVerify.defaultExpectation == nil {
+		mmVerify.defaultExpectation = &PasswordHasherMockVerifyExpectation{mock: mmVerify.mock}
+	}
+	mmVerify.defaultExpectation.results = &PasswordHasherMockVerifyResults{ok, err}
+	return mmVerify.mock
+}
+
+// Set uses given function f to mock the PasswordHasher.Verify method
+func (mmVerify *mPasswordHasherMockVerify) Set(f func(password string, encodedHash string) (ok bool, err error)) *PasswordHasherMock {
+	if mmVerify.defaultExpectation != nil {
+		mmVerify.mock.t.Fatalf("Default expectation is already set for the PasswordHasher.Verify method")
+	}
+
+	if len(mmVerify.expectations) > 0 {
+		mmVerify.mock.t.Fatalf("Some expectations are already set for the PasswordHasher.Verify method")
+	}
+
+	mmVerify.mock.funcVerify = f
+	return mmVerify.mock
+}
+
+// When sets expectation for the PasswordHasher.Verify which will trigger the result defined by the following
+// Then helper
+func (mmVerify *mPasswordHasherMockVerify) When(password string, encodedHash string) *PasswordHasherMockVerifyExpectation {
+	if mmVerify.mock.funcVerify != nil {
+		mmVerify.mock.t.Fatalf("PasswordHasherMock.Verify mock is already set by Set")
+	}
+
+	expectation := &PasswordHasherMockVerifyExpectation{
+		mock:   mmVerify.mock,
+		params: &PasswordHasherMockVerifyParams{password, encodedHash},
+	}
+	mmVerify.expectations = append(mmVerify.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PasswordHasher.Verify return parameters for the expectation previously defined by the When method
+func (e *PasswordHasherMockVerifyExpectation) Then(ok bool, err error) *PasswordHasherMock {
+	e.results = &PasswordHasherMockVerifyResults{ok, err}
+	return e.mock
+}
+
+// Times sets number of times PasswordHasher.Verify should be invoked
+func (mmVerify *mPasswordHasherMockVerify) Times(n uint64) *mPasswordHasherMockVerify {
+	if n == 0 {
+		mmVerify.mock.t.Fatalf("Times of PasswordHasherMock.Verify mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmVerify.expectedInvocations, n)
+	return mmVerify
+}
+
+func (mmVerify *mPasswordHasherMockVerify) invocationsDone() bool {
+	if len(mmVerify.expectations) == 0 && mmVerify.defaultExpectation == nil && mmVerify.mock.funcVerify == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmVerify.mock.afterVerifyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmVerify.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Verify implements crypto.PasswordHasher
+func (mmVerify *PasswordHasherMock) Verify(password string, encodedHash string) (ok bool, err error) {
+	mm_atomic.AddUint64(&mmVerify.beforeVerifyCounter, 1)
+	defer mm_atomic.AddUint64(&mmVerify.afterVerifyCounter, 1)
+
+	if mmVerify.inspectFuncVerify != nil {
+		mmVerify.inspectFuncVerify(password, encodedHash)
+	}
+
+	mm_params := PasswordHasherMockVerifyParams{password, encodedHash}
+
+	// Record call args
+	mmVerify.VerifyMock.mutex.Lock()
+	mmVerify.VerifyMock.callArgs = append(mmVerify.VerifyMock.callArgs, &mm_params)
+	mmVerify.VerifyMock.mutex.Unlock()
+
+	for _, e := range mmVerify.VerifyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ok, e.results.err
+		}
+	}
+
+	if mmVerify.VerifyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmVerify.VerifyMock.defaultExpectation.Counter, 1)
+		mm_want := mmVerify.VerifyMock.defaultExpectation.params
+		mm_want_ptrs := mmVerify.VerifyMock.defaultExpectation.paramPtrs
+
+		mm_got := PasswordHasherMockVerifyParams{password, encodedHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmVerify.t.Errorf("PasswordHasherMock.Verify got unexpected parameter password, want: %#v, got: %#v%s\n", *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+			if mm_want_ptrs.encodedHash != nil && !minimock.Equal(*mm_want_ptrs.encodedHash, mm_got.encodedHash) {
+				mmVerify.t.Errorf("PasswordHasherMock.Verify got unexpected parameter encodedHash, want: %#v, got: %#v%s\n", *mm_want_ptrs.encodedHash, mm_got.encodedHash, minimock.Diff(*mm_want_ptrs.encodedHash, mm_got.encodedHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmVerify.t.Errorf("PasswordHasherMock.Verify got unexpected parameters, want: %#v, got: %#v%s\n", *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmVerify.VerifyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmVerify.t.Fatal("No results are set for the PasswordHasherMock.Verify")
+		}
+		return (*mm_results).ok, (*mm_results).err
+	}
+	if mmVerify.funcVerify != nil {
+		return mmVerify.funcVerify(password, encodedHash)
+	}
+	mmVerify.t.Fatalf("Unexpected call to PasswordHasherMock.Verify. %v %v", password, encodedHash)
+	return
+}
+
+// VerifyAfterCounter returns a count of finished PasswordHasherMock.Verify invocations
+func (mmVerify *PasswordHasherMock) VerifyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.afterVerifyCounter)
+}
+
+// VerifyBeforeCounter returns a count of PasswordHasherMock.Verify invocations
+func (mmVerify *PasswordHasherMock) VerifyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmVerify.beforeVerifyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PasswordHasherMock.Verify.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmVerify *mPasswordHasherMockVerify) Calls() []*PasswordHasherMockVerifyParams {
+	mmVerify.mutex.RLock()
+
+	argCopy := make([]*PasswordHasherMockVerifyParams, len(mmVerify.callArgs))
+	copy(argCopy, mmVerify.callArgs)
+
+	mmVerify.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockVerifyDone returns true if the count of the Verify invocations corresponds
+// the number of defined expectations
+func (m *PasswordHasherMock) MinimockVerifyDone() bool {
+	if m.VerifyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.VerifyMock.invocationsDone()
+}
+
+// MinimockVerifyInspect logs each unmet expectation
+func (m *PasswordHasherMock) MinimockVerifyInspect() {
+	for _, e := range m.VerifyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PasswordHasherMock.Verify with params: %#v", *e.params)
+		}
+	}
+
+	afterVerifyCounter := mm_atomic.LoadUint64(&m.afterVerifyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.VerifyMock.defaultExpectation != nil && afterVerifyCounter < 1 {
+		if m.VerifyMock.defaultExpectation.params == nil {
+			m.t.Error("Expected call to PasswordHasherMock.Verify")
+		} else {
+			m.t.Errorf("Expected call to PasswordHasherMock.Verify with params: %#v", *m.VerifyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcVerify != nil && afterVerifyCounter < 1 {
+		m.t.Error("Expected call to PasswordHasherMock.Verify")
+	}
+
+	if !m.VerifyMock.invocationsDone() && afterVerifyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PasswordHasherMock.Verify but found %d calls",
+			mm_atomic.LoadUint64(&m.VerifyMock.expectedInvocations), afterVerifyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PasswordHasherMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockHashInspect()
+
+			m.MinimockNeedsRehashInspect()
+
+			m.MinimockVerifyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PasswordHasherMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PasswordHasherMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockHashDone() &&
+		m.MinimockNeedsRehashDone() &&
+		m.MinimockVerifyDone()
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Prrromanssss/auth/pkg/crypto"
+)
+
+func TestPasswordHasher(t *testing.T) {
+	t.Parallel()
+
+	var (
+		password = gofakeit.Password(true, true, true, true, true, 10)
+
+		params = crypto.Argon2Params{
+			Memory:      1024,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		}
+
+		hasher = crypto.NewPasswordHasher(params)
+	)
+
+	argon2idHash, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte(password))
+	legacyHash := hex.EncodeToString(sum[:])
+
+	outdatedParams := params
+	outdatedParams.Iterations++
+
+	outdatedHash, err := crypto.NewPasswordHasher(outdatedParams).Hash(password)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		password    string
+		encodedHash string
+		want        bool
+		needsRehash bool
+		err         error
+	}{
+		{
+			name:        "argon2id",
+			password:    password,
+			encodedHash: argon2idHash,
+			want:        true,
+			needsRehash: false,
+		},
+		{
+			name:        "argon2id wrong password",
+			password:    password + "wrong",
+			encodedHash: argon2idHash,
+			want:        false,
+			needsRehash: false,
+		},
+		{
+			name:        "dummy hash",
+			password:    password,
+			encodedHash: crypto.DummyHash(params),
+			want:        false,
+			needsRehash: false,
+		},
+		{
+			name:        "argon2id outdated params",
+			password:    password,
+			encodedHash: outdatedHash,
+			want:        true,
+			needsRehash: true,
+		},
+		{
+			name:        "bcrypt",
+			password:    password,
+			encodedHash: string(bcryptHash),
+			want:        true,
+			needsRehash: true,
+		},
+		{
+			name:        "bcrypt wrong password",
+			password:    password + "wrong",
+			encodedHash: string(bcryptHash),
+			want:        false,
+			needsRehash: true,
+		},
+		{
+			name:        "legacy sha256",
+			password:    password,
+			encodedHash: legacyHash,
+			want:        true,
+			needsRehash: true,
+		},
+		{
+			name:        "legacy sha256 wrong password",
+			password:    password + "wrong",
+			encodedHash: legacyHash,
+			want:        false,
+			needsRehash: true,
+		},
+		{
+			name:        "unknown format",
+			password:    password,
+			encodedHash: "plaintext",
+			want:        false,
+			needsRehash: true,
+			err:         crypto.ErrUnknownHashFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ok, err := hasher.Verify(tt.password, tt.encodedHash)
+			require.Equal(t, tt.err, err)
+			require.Equal(t, tt.want, ok)
+			require.Equal(t, tt.needsRehash, hasher.NeedsRehash(tt.encodedHash))
+		})
+	}
+
+	t.Run("unique salt", func(t *testing.T) {
+		t.Parallel()
+
+		otherHash, err := hasher.Hash(password)
+		require.NoError(t, err)
+		require.NotEqual(t, argon2idHash, otherHash)
+	})
+}
@@ -0,0 +1,167 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v5.27.1
+// source: redact.proto
+
+package redact_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Sensitivity tells how a field must be masked before a message is logged or recorded in the audit log.
+type Sensitivity int32
+
+const (
+	Sensitivity_SENSITIVITY_UNSPECIFIED Sensitivity = 0
+	// SECRET fields, such as passwords and tokens, are always masked.
+	Sensitivity_SECRET Sensitivity = 1
+	// PII fields, such as emails, are masked when personal data redaction is enabled.
+	Sensitivity_PII Sensitivity = 2
+)
+
+// Enum value maps for Sensitivity.
+var (
+	Sensitivity_name = map[int32]string{
+		0: "SENSITIVITY_UNSPECIFIED",
+		1: "SECRET",
+		2: "PII",
+	}
+	Sensitivity_value = map[string]int32{
+		"SENSITIVITY_UNSPECIFIED": 0,
+		"SECRET":                  1,
+		"PII":                     2,
+	}
+)
+
+func (x Sensitivity) Enum() *Sensitivity {
+	p := new(Sensitivity)
+	*p = x
+	return p
+}
+
+func (x Sensitivity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sensitivity) Descriptor() protoreflect.EnumDescriptor {
+	return file_redact_proto_enumTypes[0].Descriptor()
+}
+
+func (Sensitivity) Type() protoreflect.EnumType {
+	return &file_redact_proto_enumTypes[0]
+}
+
+func (x Sensitivity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sensitivity.Descriptor instead.
+func (Sensitivity) EnumDescriptor() ([]byte, []int) {
+	return file_redact_proto_rawDescGZIP(), []int{0}
+}
+
+var file_redact_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*Sensitivity)(nil),
+		Field:         50100,
+		Name:          "redact_v1.sensitivity",
+		Tag:           "varint,50100,opt,name=sensitivity,enum=redact_v1.Sensitivity",
+		Filename:      "redact.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional redact_v1.Sensitivity sensitivity = 50100;
+	E_Sensitivity = &file_redact_proto_extTypes[0]
+)
+
+var File_redact_proto protoreflect.FileDescriptor
+
+var file_redact_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09,
+	0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x31, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x3f, 0x0a, 0x0b, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x45,
+	0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x52, 0x45,
+	0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x49, 0x49, 0x10, 0x02, 0x3a, 0x59, 0x0a, 0x0b,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb4, 0x87, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x72, 0x72, 0x72, 0x6f, 0x6d, 0x61, 0x6e, 0x73, 0x73,
+	0x73, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x72, 0x65, 0x64, 0x61,
+	0x63, 0x74, 0x5f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_redact_proto_rawDescOnce sync.Once
+	file_redact_proto_rawDescData = file_redact_proto_rawDesc
+)
+
+func file_redact_proto_rawDescGZIP() []byte {
+	file_redact_proto_rawDescOnce.Do(func() {
+		file_redact_proto_rawDescData = protoimpl.X.CompressGZIP(file_redact_proto_rawDescData)
+	})
+	return file_redact_proto_rawDescData
+}
+
+var file_redact_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_redact_proto_goTypes = []interface{}{
+	(Sensitivity)(0),                  // 0: redact_v1.Sensitivity
+	(*descriptorpb.FieldOptions)(nil), // 1: google.protobuf.FieldOptions
+}
+var file_redact_proto_depIdxs = []int32{
+	1, // 0: redact_v1.sensitivity:extendee -> google.protobuf.FieldOptions
+	0, // 1: redact_v1.sensitivity:type_name -> redact_v1.Sensitivity
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_redact_proto_init() }
+func file_redact_proto_init() {
+	if File_redact_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_redact_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_redact_proto_goTypes,
+		DependencyIndexes: file_redact_proto_depIdxs,
+		EnumInfos:         file_redact_proto_enumTypes,
+		ExtensionInfos:    file_redact_proto_extTypes,
+	}.Build()
+	File_redact_proto = out.File
+	file_redact_proto_rawDesc = nil
+	file_redact_proto_goTypes = nil
+	file_redact_proto_depIdxs = nil
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE users.refresh_token (
+    id uuid NOT NULL,
+    family_id uuid NOT NULL,
+    user_id integer NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz DEFAULT now(),
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(user_id) REFERENCES users.user(id) ON DELETE CASCADE
+);
+
+CREATE INDEX refresh_token_family_id_idx ON users.refresh_token (family_id);
+
+-- +goose Down
+DROP TABLE users.refresh_token;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE users.access_rule (
+    id integer GENERATED ALWAYS AS IDENTITY,
+    endpoint_address VARCHAR(255) NOT NULL,
+    role_id integer NOT NULL,
+
+    PRIMARY KEY(id),
+    FOREIGN KEY(role_id) REFERENCES users.user_role(id) ON DELETE CASCADE,
+    UNIQUE(endpoint_address, role_id)
+);
+
+INSERT INTO users.access_rule
+    (endpoint_address, role_id)
+SELECT endpoint.address, user_role.id
+FROM (
+    VALUES
+        ('/user_v1.UserV1/Create', 'admin'),
+        ('/user_v1.UserV1/Get', 'admin'),
+        ('/user_v1.UserV1/Update', 'admin'),
+        ('/user_v1.UserV1/Delete', 'admin'),
+        ('/user_v1.UserV1/Get', 'user')
+) AS endpoint(address, role_title)
+JOIN users.user_role ON user_role.title = endpoint.role_title;
+
+-- +goose Down
+DROP TABLE users.access_rule;
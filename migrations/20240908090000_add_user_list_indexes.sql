@@ -0,0 +1,27 @@
+-- +goose Up
+-- The pages are ordered by (created_at, id), which a NULL created_at would never match.
+UPDATE users.user
+SET created_at = COALESCE(updated_at, now())
+WHERE created_at IS NULL;
+
+ALTER TABLE users.user ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX user_created_at_id_idx ON users.user (created_at, id);
+CREATE INDEX user_role_id_created_at_id_idx ON users.user (role_id, created_at, id);
+CREATE INDEX user_email_pattern_idx ON users.user (email varchar_pattern_ops);
+
+INSERT INTO users.access_rule
+    (endpoint_address, role_id)
+SELECT '/user_v1.UserV1/ListUsers', id
+FROM users.user_role
+WHERE title = 'admin';
+
+-- +goose Down
+DELETE FROM users.access_rule
+WHERE endpoint_address = '/user_v1.UserV1/ListUsers';
+
+DROP INDEX users.user_email_pattern_idx;
+DROP INDEX users.user_role_id_created_at_id_idx;
+DROP INDEX users.user_created_at_id_idx;
+
+ALTER TABLE users.user ALTER COLUMN created_at DROP NOT NULL;
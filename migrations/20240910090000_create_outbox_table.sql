@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE users.outbox (
+    id bigserial NOT NULL,
+    aggregate_id bigint NOT NULL,
+    event_type varchar(64) NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamp DEFAULT now(),
+    sent_at timestamp,
+
+    PRIMARY KEY(id)
+);
+
+CREATE INDEX outbox_pending_idx ON users.outbox (id) WHERE sent_at IS NULL;
+
+-- +goose Down
+DROP TABLE users.outbox;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE users.user ADD COLUMN version bigint NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE users.user DROP COLUMN version;
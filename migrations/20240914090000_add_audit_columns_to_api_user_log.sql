@@ -0,0 +1,31 @@
+-- +goose Up
+ALTER TABLE users.api_user_log
+    ADD COLUMN actor_id bigint,
+    ADD COLUMN target_user_id bigint,
+    ADD COLUMN source_ip varchar(64),
+    ADD COLUMN user_agent text,
+    ADD COLUMN grpc_method varchar(255),
+    ADD COLUMN status_code varchar(32) NOT NULL DEFAULT 'OK',
+    ADD COLUMN request_id varchar(64),
+    ADD COLUMN trace_id varchar(32);
+
+CREATE INDEX api_user_log_timestamp_idx ON users.api_user_log (timestamp DESC, id DESC);
+CREATE INDEX api_user_log_actor_idx ON users.api_user_log (actor_id, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_target_user_idx ON users.api_user_log (target_user_id, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_action_idx ON users.api_user_log (action_type, timestamp DESC, id DESC);
+
+-- +goose Down
+DROP INDEX users.api_user_log_action_idx;
+DROP INDEX users.api_user_log_target_user_idx;
+DROP INDEX users.api_user_log_actor_idx;
+DROP INDEX users.api_user_log_timestamp_idx;
+
+ALTER TABLE users.api_user_log
+    DROP COLUMN trace_id,
+    DROP COLUMN request_id,
+    DROP COLUMN status_code,
+    DROP COLUMN grpc_method,
+    DROP COLUMN user_agent,
+    DROP COLUMN source_ip,
+    DROP COLUMN target_user_id,
+    DROP COLUMN actor_id;
@@ -0,0 +1,27 @@
+-- +goose Up
+ALTER TABLE users.api_user_log
+    ADD COLUMN prev_hash bytea,
+    ADD COLUMN hash bytea;
+
+CREATE INDEX api_user_log_chain_head_idx ON users.api_user_log (id DESC) WHERE hash IS NOT NULL;
+
+CREATE TABLE users.api_user_log_checkpoint (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    log_id bigint NOT NULL,
+    hash bytea NOT NULL,
+    signature bytea NOT NULL,
+    created_at timestamp NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX api_user_log_checkpoint_log_idx ON users.api_user_log_checkpoint (log_id DESC);
+
+-- +goose Down
+DROP TABLE users.api_user_log_checkpoint;
+
+DROP INDEX users.api_user_log_chain_head_idx;
+
+ALTER TABLE users.api_user_log
+    DROP COLUMN hash,
+    DROP COLUMN prev_hash;
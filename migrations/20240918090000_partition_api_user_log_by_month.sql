@@ -0,0 +1,159 @@
+-- +goose Up
+ALTER TABLE users.api_user_log RENAME TO api_user_log_unpartitioned;
+ALTER TABLE users.api_user_log_unpartitioned RENAME CONSTRAINT api_user_log_pkey TO api_user_log_unpartitioned_pkey;
+
+DROP INDEX users.api_user_log_timestamp_idx;
+DROP INDEX users.api_user_log_actor_idx;
+DROP INDEX users.api_user_log_target_user_idx;
+DROP INDEX users.api_user_log_action_idx;
+DROP INDEX users.api_user_log_chain_head_idx;
+
+-- Partitioned tables cannot have identity columns, the ids continue from a plain sequence.
+CREATE SEQUENCE users.api_user_log_id_seq AS bigint;
+
+SELECT setval('users.api_user_log_id_seq', COALESCE(MAX(id), 0) + 1, false)
+FROM users.api_user_log_unpartitioned;
+
+-- The partition key must be part of the primary key.
+CREATE TABLE users.api_user_log (
+    id bigint NOT NULL DEFAULT nextval('users.api_user_log_id_seq'),
+    action_type varchar(50) NOT NULL,
+    request_data jsonb NOT NULL,
+    response_data jsonb,
+    timestamp timestamp NOT NULL DEFAULT now(),
+    actor_id bigint,
+    target_user_id bigint,
+    source_ip varchar(64),
+    user_agent text,
+    grpc_method varchar(255),
+    status_code varchar(32) NOT NULL DEFAULT 'OK',
+    request_id varchar(64),
+    trace_id varchar(32),
+    prev_hash bytea,
+    hash bytea,
+
+    PRIMARY KEY (id, timestamp)
+) PARTITION BY RANGE (timestamp);
+
+ALTER SEQUENCE users.api_user_log_id_seq OWNED BY users.api_user_log.id;
+
+-- Catches the rows outside of the monthly partitions, the retention job keeps it empty
+-- by creating the partitions ahead of time.
+CREATE TABLE users.api_user_log_default PARTITION OF users.api_user_log DEFAULT;
+
+-- +goose StatementBegin
+DO $$
+DECLARE
+    partition_month date;
+BEGIN
+    FOR partition_month IN
+        SELECT generate_series(
+            date_trunc('month', COALESCE(MIN(timestamp), localtimestamp)),
+            date_trunc('month', localtimestamp) + interval '3 months',
+            interval '1 month'
+        )::date
+        FROM users.api_user_log_unpartitioned
+    LOOP
+        EXECUTE format(
+            'CREATE TABLE users.%I PARTITION OF users.api_user_log FOR VALUES FROM (%L) TO (%L)',
+            'api_user_log_' || to_char(partition_month, 'YYYY_MM'),
+            partition_month,
+            (partition_month + interval '1 month')::date
+        );
+    END LOOP;
+END
+$$;
+-- +goose StatementEnd
+
+INSERT INTO users.api_user_log (
+    id, action_type, request_data, response_data, timestamp, actor_id, target_user_id, source_ip,
+    user_agent, grpc_method, status_code, request_id, trace_id, prev_hash, hash
+)
+SELECT
+    id, action_type, request_data, response_data, COALESCE(timestamp, localtimestamp), actor_id, target_user_id, source_ip,
+    user_agent, grpc_method, status_code, request_id, trace_id, prev_hash, hash
+FROM users.api_user_log_unpartitioned;
+
+DROP TABLE users.api_user_log_unpartitioned;
+
+CREATE INDEX api_user_log_timestamp_idx ON users.api_user_log (timestamp DESC, id DESC);
+CREATE INDEX api_user_log_actor_idx ON users.api_user_log (actor_id, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_target_user_idx ON users.api_user_log (target_user_id, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_action_idx ON users.api_user_log (action_type, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_chain_head_idx ON users.api_user_log (id DESC) WHERE hash IS NOT NULL;
+
+-- The partitions exported to the archive before they were dropped.
+CREATE TABLE users.api_user_log_archive (
+    id bigint GENERATED ALWAYS AS IDENTITY,
+    partition_name varchar(63) NOT NULL,
+    range_from timestamp NOT NULL,
+    range_to timestamp NOT NULL,
+    file_name text NOT NULL,
+    records bigint NOT NULL,
+    max_log_id bigint,
+    archived_at timestamp NOT NULL DEFAULT now(),
+
+    PRIMARY KEY (id)
+);
+
+-- The records still in the log whose previous record in the chain was archived.
+CREATE TABLE users.api_user_log_chain_seam (
+    log_id bigint NOT NULL,
+    prev_hash bytea NOT NULL,
+    archive_id bigint NOT NULL REFERENCES users.api_user_log_archive (id),
+
+    PRIMARY KEY (log_id)
+);
+
+-- +goose Down
+DROP TABLE users.api_user_log_chain_seam;
+DROP TABLE users.api_user_log_archive;
+
+CREATE TABLE users.api_user_log_unpartitioned (
+    id integer GENERATED BY DEFAULT AS IDENTITY,
+    action_type varchar(50) NOT NULL,
+    request_data jsonb NOT NULL,
+    response_data jsonb,
+    timestamp timestamp DEFAULT now(),
+    actor_id bigint,
+    target_user_id bigint,
+    source_ip varchar(64),
+    user_agent text,
+    grpc_method varchar(255),
+    status_code varchar(32) NOT NULL DEFAULT 'OK',
+    request_id varchar(64),
+    trace_id varchar(32),
+    prev_hash bytea,
+    hash bytea,
+
+    PRIMARY KEY (id)
+);
+
+INSERT INTO users.api_user_log_unpartitioned (
+    id, action_type, request_data, response_data, timestamp, actor_id, target_user_id, source_ip,
+    user_agent, grpc_method, status_code, request_id, trace_id, prev_hash, hash
+)
+SELECT
+    id, action_type, request_data, response_data, timestamp, actor_id, target_user_id, source_ip,
+    user_agent, grpc_method, status_code, request_id, trace_id, prev_hash, hash
+FROM users.api_user_log;
+
+SELECT setval(
+    pg_get_serial_sequence('users.api_user_log_unpartitioned', 'id'),
+    COALESCE(MAX(id), 0) + 1,
+    false
+)
+FROM users.api_user_log_unpartitioned;
+
+ALTER TABLE users.api_user_log_unpartitioned ALTER COLUMN id SET GENERATED ALWAYS;
+
+DROP TABLE users.api_user_log;
+
+ALTER TABLE users.api_user_log_unpartitioned RENAME TO api_user_log;
+ALTER TABLE users.api_user_log RENAME CONSTRAINT api_user_log_unpartitioned_pkey TO api_user_log_pkey;
+
+CREATE INDEX api_user_log_timestamp_idx ON users.api_user_log (timestamp DESC, id DESC);
+CREATE INDEX api_user_log_actor_idx ON users.api_user_log (actor_id, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_target_user_idx ON users.api_user_log (target_user_id, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_action_idx ON users.api_user_log (action_type, timestamp DESC, id DESC);
+CREATE INDEX api_user_log_chain_head_idx ON users.api_user_log (id DESC) WHERE hash IS NOT NULL;
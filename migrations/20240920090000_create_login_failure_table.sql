@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE users.login_failure (
+    scope varchar(16) NOT NULL,
+    key varchar(255) NOT NULL,
+    failures integer NOT NULL,
+    last_failed_at timestamp NOT NULL,
+    locked_until timestamp,
+
+    PRIMARY KEY(scope, key)
+);
+
+INSERT INTO users.access_rule
+    (endpoint_address, role_id)
+SELECT '/user_v1.UserV1/Unlock', id
+FROM users.user_role
+WHERE title = 'admin';
+
+-- +goose Down
+DELETE FROM users.access_rule
+WHERE endpoint_address = '/user_v1.UserV1/Unlock';
+
+DROP TABLE users.login_failure;